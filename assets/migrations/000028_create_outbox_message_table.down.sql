@@ -0,0 +1 @@
+drop table outbox_message;
@@ -0,0 +1,18 @@
+create table outbox_message
+(
+    id              serial8      not null primary key,
+    topic           varchar(255) not null,
+    message_key     varchar(255) not null,
+    payload         bytea        not null,
+    status          varchar(20)  not null,
+    attempts        int4         not null default 0,
+    next_attempt_at timestamp    not null default now(),
+    last_error      text         not null default '',
+    sent_at         timestamp,
+    created_at      timestamp    not null default now(),
+    updated_at      timestamp    not null default now()
+);
+
+create index outbox_message_status_key_idx on outbox_message (status, message_key, id);
+
+select create_updated_at_trigger('outbox_message');
@@ -0,0 +1,35 @@
+drop trigger if exists loan_package_request_notify on loan_package_request;
+drop trigger if exists loan_package_offer_interest_notify on loan_package_offer_interest;
+drop trigger if exists symbol_notify on symbol;
+drop trigger if exists blacklist_symbol_notify on blacklist_symbol;
+
+CREATE OR REPLACE FUNCTION notify_trigger() RETURNS TRIGGER AS
+$trigger$
+DECLARE
+    new_row   JSONB;
+    old_row   JSONB;
+    payload   TEXT;
+    notify_id UUID;
+BEGIN
+    notify_id := uuid_generate_v4();
+    new_row := row_to_json(NEW);
+    old_row := row_to_json(OLD);
+
+    payload := json_build_object(
+        'id', notify_id,
+        'timestamp', CURRENT_TIMESTAMP,
+        'action', LOWER(TG_OP),
+        'db_schema', TG_TABLE_SCHEMA,
+        'table', TG_TABLE_NAME,
+        'record', new_row,
+        'old', old_row
+        )::TEXT;
+
+    -- Notify the channel
+    PERFORM pg_notify('db_event', payload);
+
+    RETURN NULL;
+END;
+$trigger$ LANGUAGE plpgsql;
+
+drop table db_event_log;
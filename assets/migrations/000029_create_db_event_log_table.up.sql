@@ -0,0 +1,54 @@
+create table db_event_log
+(
+    seq          serial8      not null primary key,
+    id           uuid         not null,
+    action       varchar(10)  not null,
+    db_schema    varchar(100) not null,
+    db_table     varchar(100) not null,
+    record       jsonb,
+    old          jsonb,
+    processed_at timestamp,
+    created_at   timestamp    not null default now()
+);
+
+create index db_event_log_unprocessed_idx on db_event_log (seq) where processed_at is null;
+
+-- keep every change in db_event_log so events missed by the listener can be replayed,
+-- and only notify the sequence because pg_notify payloads are limited to 8000 bytes
+CREATE OR REPLACE FUNCTION notify_trigger() RETURNS TRIGGER AS
+$trigger$
+DECLARE
+    new_row   JSONB;
+    old_row   JSONB;
+    payload   TEXT;
+    notify_id UUID;
+    event_seq BIGINT;
+BEGIN
+    notify_id := uuid_generate_v4();
+    new_row := row_to_json(NEW);
+    old_row := row_to_json(OLD);
+
+    INSERT INTO db_event_log (id, action, db_schema, db_table, record, old)
+    VALUES (notify_id, LOWER(TG_OP), TG_TABLE_SCHEMA, TG_TABLE_NAME, new_row, old_row)
+    RETURNING seq INTO event_seq;
+
+    payload := json_build_object(
+        'id', notify_id,
+        'seq', event_seq,
+        'timestamp', CURRENT_TIMESTAMP,
+        'action', LOWER(TG_OP),
+        'db_schema', TG_TABLE_SCHEMA,
+        'table', TG_TABLE_NAME
+        )::TEXT;
+
+    -- Notify the channel
+    PERFORM pg_notify('db_event', payload);
+
+    RETURN NULL;
+END;
+$trigger$ LANGUAGE plpgsql;
+
+select create_notify_trigger('loan_package_request');
+select create_notify_trigger('loan_package_offer_interest');
+select create_notify_trigger('symbol');
+select create_notify_trigger('blacklist_symbol');
@@ -0,0 +1,5 @@
+update loan_package_request
+set status = 'CONFIRMED'
+where status in ('DECLINED', 'CANCELLED');
+
+drop table if exists loan_package_request_status_history;
@@ -0,0 +1,22 @@
+create table loan_package_request_status_history
+(
+    id                      serial8     not null primary key,
+    loan_package_request_id int8        not null references loan_package_request (id) on delete cascade,
+    from_status             varchar(20),
+    to_status               varchar(20) not null,
+    actor                   varchar(50) not null,
+    reason                  varchar(50) not null default '',
+    created_at              timestamp   not null default now()
+);
+
+create index loan_package_request_status_history_request_id_index on loan_package_request_status_history (loan_package_request_id);
+
+-- requests declined without any offer line used to stay CONFIRMED
+update loan_package_request
+set status = 'DECLINED'
+where status = 'CONFIRMED'
+  and not exists (select 1
+                  from loan_package_offer
+                           inner join loan_package_offer_interest
+                                      on loan_package_offer_interest.loan_package_offer_id = loan_package_offer.id
+                  where loan_package_offer.loan_package_request_id = loan_package_request.id);
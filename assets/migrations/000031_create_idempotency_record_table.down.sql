@@ -0,0 +1 @@
+drop table idempotency_record;
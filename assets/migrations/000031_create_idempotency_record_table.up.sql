@@ -0,0 +1,17 @@
+create table idempotency_record
+(
+    id              serial8      not null primary key,
+    investor_id     varchar(20)  not null,
+    idempotency_key varchar(255) not null,
+    request_hash    varchar(64)  not null,
+    status          varchar(20)  not null,
+    response_status int4         not null default 0,
+    response_body   bytea,
+    created_at      timestamp    not null default now(),
+    updated_at      timestamp    not null default now()
+);
+
+create unique index idempotency_record_investor_key_idx on idempotency_record (investor_id, idempotency_key);
+create index idempotency_record_created_at_idx on idempotency_record (created_at);
+
+select create_updated_at_trigger('idempotency_record');
@@ -0,0 +1,8 @@
+create table rate_limit_bucket
+(
+    bucket_key  varchar(255)     not null primary key,
+    tokens      double precision not null,
+    refilled_at timestamp        not null
+);
+
+create index rate_limit_bucket_refilled_at_idx on rate_limit_bucket (refilled_at);
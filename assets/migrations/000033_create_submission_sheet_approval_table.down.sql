@@ -0,0 +1,2 @@
+drop table approval_delegation;
+drop table submission_sheet_approval;
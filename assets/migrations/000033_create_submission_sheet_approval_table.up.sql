@@ -0,0 +1,24 @@
+create table submission_sheet_approval
+(
+    id                  serial8      not null primary key,
+    submission_sheet_id int8         not null references submission_sheet_metadata (id) on delete cascade,
+    approver            varchar(255) not null,
+    on_behalf_of        varchar(255) not null default '',
+    created_at          timestamp    not null default now(),
+    expires_at          timestamp    not null
+);
+
+-- an approver counts once per sheet, whether approving directly or through a delegation
+create unique index submission_sheet_approval_principal_idx
+    on submission_sheet_approval (submission_sheet_id, (coalesce(nullif(on_behalf_of, ''), approver)));
+
+create table approval_delegation
+(
+    id         serial8      not null primary key,
+    delegator  varchar(255) not null,
+    delegate   varchar(255) not null,
+    expires_at timestamp    not null,
+    created_at timestamp    not null default now()
+);
+
+create index approval_delegation_delegate_idx on approval_delegation (delegate, delegator);
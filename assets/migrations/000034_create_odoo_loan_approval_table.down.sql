@@ -0,0 +1 @@
+drop table odoo_loan_approval;
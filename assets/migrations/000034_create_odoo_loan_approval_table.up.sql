@@ -0,0 +1,18 @@
+create table odoo_loan_approval
+(
+    id                  serial8      not null primary key,
+    submission_sheet_id int8         not null references submission_sheet_metadata (id) on delete cascade,
+    odoo_record_id      int8         not null,
+    sync_status         varchar(20)  not null,
+    odoo_state          varchar(50)  not null default '',
+    attempts            int4         not null default 0,
+    last_error          text         not null default '',
+    next_attempt_at     timestamp    not null default now(),
+    created_at          timestamp    not null default now(),
+    updated_at          timestamp    not null default now()
+);
+
+create unique index odoo_loan_approval_submission_sheet_id_idx on odoo_loan_approval (submission_sheet_id);
+create index odoo_loan_approval_sync_status_next_attempt_at_idx on odoo_loan_approval (sync_status, next_attempt_at);
+
+select create_updated_at_trigger('odoo_loan_approval');
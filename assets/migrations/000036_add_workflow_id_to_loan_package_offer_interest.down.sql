@@ -0,0 +1,4 @@
+drop index if exists loan_package_offer_interest_creating_updated_at_index;
+
+alter table loan_package_offer_interest
+    drop column workflow_id;
@@ -0,0 +1,11 @@
+-- track the temporal workflow creating the loan package of an offer line so it can be polled and reconciled
+alter table loan_package_offer_interest
+    add column workflow_id varchar(100) null;
+
+-- lines started before the column existed used the deterministic workflow id of the publisher
+update loan_package_offer_interest
+set workflow_id = 'auto-create-loan-package-v3-' || id
+where status = 'PACKAGE_CREATING';
+
+create index loan_package_offer_interest_creating_updated_at_index on loan_package_offer_interest (updated_at)
+    where status = 'PACKAGE_CREATING';
@@ -0,0 +1,2 @@
+alter table loan_request_scheduler_config
+    drop column rules;
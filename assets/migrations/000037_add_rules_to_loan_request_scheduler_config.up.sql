@@ -0,0 +1,3 @@
+-- per asset type and stock exchange decline rules, maximum_loan_rate stays the default threshold
+alter table loan_request_scheduler_config
+    add column rules jsonb not null default '[]';
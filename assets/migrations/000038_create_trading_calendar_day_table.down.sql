@@ -0,0 +1 @@
+drop table trading_calendar_day;
@@ -0,0 +1,19 @@
+-- holidays and half days of the trading calendar, stock_exchange_code ALL applies to every exchange
+create table trading_calendar_day
+(
+    id                  serial8     not null primary key,
+    stock_exchange_code varchar(20) not null,
+    date                date        not null,
+    type                varchar(20) not null,
+    close_time          varchar(5)  not null default '',
+    description         text        not null default '',
+    source              varchar(20) not null default 'MANUAL',
+    created_at          timestamp   not null default now(),
+    updated_at          timestamp   not null default now()
+);
+
+create unique index trading_calendar_day_stock_exchange_code_date_idx on trading_calendar_day (stock_exchange_code, date);
+create index trading_calendar_day_date_idx on trading_calendar_day (date);
+
+select create_updated_at_trigger('trading_calendar_day');
+select audit.audit_table('trading_calendar_day');
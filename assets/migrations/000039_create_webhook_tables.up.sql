@@ -0,0 +1,40 @@
+-- endpoints of partner systems, event_types lists the webhook event types sent to the endpoint
+create table webhook_subscription
+(
+    id          serial8      not null primary key,
+    name        varchar(255) not null,
+    url         text         not null,
+    secret      varchar(255) not null,
+    event_types jsonb        not null default '[]',
+    active      boolean      not null default true,
+    created_by  varchar(255) not null default '',
+    created_at  timestamp    not null default now(),
+    updated_at  timestamp    not null default now()
+);
+
+select create_updated_at_trigger('webhook_subscription');
+select audit.audit_table('webhook_subscription', true, true, array ['secret']);
+
+-- one delivery of an event to a subscription, a replay is a new delivery of the same event
+create table webhook_delivery
+(
+    id              serial8     not null primary key,
+    subscription_id int8        not null references webhook_subscription (id) on delete cascade,
+    event_id        varchar(64) not null,
+    event_type      varchar(50) not null,
+    payload         jsonb       not null,
+    status          varchar(20) not null,
+    attempts        int4        not null default 0,
+    next_attempt_at timestamp   not null default now(),
+    last_error      text        not null default '',
+    response_status int4        not null default 0,
+    replay_of_id    int8 references webhook_delivery (id) on delete set null,
+    delivered_at    timestamp,
+    created_at      timestamp   not null default now(),
+    updated_at      timestamp   not null default now()
+);
+
+create index webhook_delivery_status_next_attempt_at_idx on webhook_delivery (status, next_attempt_at);
+create index webhook_delivery_subscription_id_idx on webhook_delivery (subscription_id, id);
+
+select create_updated_at_trigger('webhook_delivery');
@@ -0,0 +1,14 @@
+drop table suggested_offer_conversion;
+
+drop trigger if exists audit_trigger_row on suggested_offer;
+drop trigger if exists audit_trigger_stm on suggested_offer;
+
+drop index suggested_offer_config_id_status_idx;
+
+alter table suggested_offer
+    drop column investor_id,
+    drop column status,
+    drop column note,
+    drop column responded_by,
+    drop column responded_at,
+    drop column converted_at;
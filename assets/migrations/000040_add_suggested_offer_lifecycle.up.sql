@@ -0,0 +1,25 @@
+-- suggestions move from NEW to CONTACTED, CONVERTED or REJECTED as the admins respond to them
+alter table suggested_offer
+    add column investor_id  varchar(512) not null default '',
+    add column status       varchar(20)  not null default 'NEW',
+    add column note         text         not null default '',
+    add column responded_by varchar(512) not null default '',
+    add column responded_at timestamp,
+    add column converted_at timestamp;
+
+create index suggested_offer_config_id_status_idx on suggested_offer (config_id, status);
+
+select audit.audit_table('suggested_offer');
+
+-- the loan package requests a suggestion was converted into, one per symbol
+create table suggested_offer_conversion
+(
+    id                      serial8     not null primary key,
+    suggested_offer_id      int8        not null references suggested_offer (id),
+    loan_package_request_id int8        not null references loan_package_request (id),
+    symbol                  varchar(20) not null,
+    created_at              timestamp   not null default now(),
+    unique (loan_package_request_id)
+);
+
+create index suggested_offer_conversion_suggested_offer_id_idx on suggested_offer_conversion (suggested_offer_id);
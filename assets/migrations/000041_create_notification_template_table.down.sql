@@ -0,0 +1,4 @@
+alter table investor
+    drop column if exists preferred_locale;
+
+drop table if exists notification_template;
@@ -0,0 +1,42 @@
+-- wording of the investor notifications, content is a go text/template rendered with the notify data of its key
+create table notification_template
+(
+    id          serial8      not null primary key,
+    key         varchar(100) not null,
+    locale      varchar(5)   not null,
+    content     text         not null,
+    description text         not null default '',
+    created_by  varchar(255) not null default '',
+    updated_by  varchar(255) not null default '',
+    created_at  timestamp    not null default now(),
+    updated_at  timestamp    not null default now(),
+    unique (key, locale)
+);
+
+select create_updated_at_trigger('notification_template');
+select audit.audit_table('notification_template');
+
+alter table investor
+    add column preferred_locale varchar(5) not null default 'vi';
+
+insert into notification_template (key, locale, content, description)
+values ('suggested_offer_created.title', 'vi',
+        '{{if eq .Config.ValueType "INTEREST_RATE"}}Phản hồi đề cử Margin {{percent .Config.Value}}%/năm{{end}}',
+        'Title of the suggested offer feedback notification'),
+       ('suggested_offer_created.title', 'en',
+        '{{if eq .Config.ValueType "INTEREST_RATE"}}Margin offer feedback {{percent .Config.Value}}%/year{{end}}',
+        'Title of the suggested offer feedback notification'),
+       ('loan_package_ready.loan_type', 'vi',
+        '{{if eq .LoanType "FLEXIBLE"}}Linh hoạt{{else}}Đảm bảo{{end}}',
+        'Loan type of the loan package ready notification'),
+       ('loan_package_ready.loan_type', 'en',
+        '{{if eq .LoanType "FLEXIBLE"}}Flexible{{else}}Guaranteed{{end}}',
+        'Loan type of the loan package ready notification'),
+       ('loan_package_ready.loan_rate', 'vi', '{{percent .LoanRate}}%',
+        'Loan rate of the loan package ready notification'),
+       ('loan_package_ready.loan_rate', 'en', '{{percent .LoanRate}}%',
+        'Loan rate of the loan package ready notification'),
+       ('loan_package_ready.interest_rate', 'vi', '{{percent .InterestRate}}%/năm',
+        'Interest rate of the loan package ready notification'),
+       ('loan_package_ready.interest_rate', 'en', '{{percent .InterestRate}}%/year',
+        'Interest rate of the loan package ready notification');
@@ -0,0 +1,2 @@
+alter table outbox_message
+    drop column if exists trace_context;
@@ -0,0 +1,3 @@
+-- the trace context of the transaction a message was stored in, the relay publishes the message in that trace
+alter table outbox_message
+    add column trace_context jsonb not null default '{}';
@@ -0,0 +1 @@
+drop table cache_entry;
@@ -0,0 +1,9 @@
+create unlogged table cache_entry
+(
+    cache_key  varchar(255) not null primary key,
+    value      jsonb        not null,
+    expires_at timestamp    not null
+);
+
+create index cache_entry_cache_key_pattern_idx on cache_entry (cache_key varchar_pattern_ops);
+create index cache_entry_expires_at_idx on cache_entry (expires_at);
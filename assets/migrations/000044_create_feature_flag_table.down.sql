@@ -0,0 +1,2 @@
+drop table if exists feature_flag;
+drop function if exists notify_feature_flag_changed();
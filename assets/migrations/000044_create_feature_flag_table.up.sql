@@ -0,0 +1,33 @@
+-- runtime feature flags, a flag here overrides the features of the config with the same name.
+-- rules holds the allowed investor ids and the segments (rollout percentage, custody code prefixes,
+-- margin statuses, minimum app version) an investor must all match, killed turns the flag off for everyone
+create table feature_flag
+(
+    id          serial8      not null primary key,
+    name        varchar(100) not null unique,
+    description text         not null default '',
+    killed      boolean      not null default false,
+    rules       jsonb        not null default '{}',
+    created_by  varchar(255) not null default '',
+    updated_by  varchar(255) not null default '',
+    created_at  timestamp    not null default now(),
+    updated_at  timestamp    not null default now()
+);
+
+select create_updated_at_trigger('feature_flag');
+select audit.audit_table('feature_flag');
+
+-- the replicas reload their flags when the name of a changed flag is notified
+create or replace function notify_feature_flag_changed() returns trigger as
+$$
+BEGIN
+    PERFORM pg_notify('feature_flag_changed', coalesce(new.name, old.name));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+create trigger feature_flag_changed
+    after insert or update or delete
+    on feature_flag
+    for each row
+execute procedure notify_feature_flag_changed();
@@ -0,0 +1,4 @@
+drop index scheduler_job_job_type_scheduled_at_idx;
+
+alter table scheduler_job
+    drop column scheduled_at;
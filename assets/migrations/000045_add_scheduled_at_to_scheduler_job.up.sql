@@ -0,0 +1,8 @@
+-- the cron tick a run belongs to, a tick is run by the first instance that records it, manual runs have none
+alter table scheduler_job
+    add column scheduled_at timestamp;
+
+create unique index scheduler_job_job_type_scheduled_at_idx on scheduler_job (job_type, scheduled_at);
+
+-- lock contention is no longer recorded
+delete from scheduler_job where job_status = 'SKIPPED';
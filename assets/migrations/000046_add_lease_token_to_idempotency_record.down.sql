@@ -0,0 +1,2 @@
+alter table idempotency_record
+    drop column lease_token;
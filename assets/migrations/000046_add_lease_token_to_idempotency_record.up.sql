@@ -0,0 +1,3 @@
+-- identifies the request holding the key, a request whose lease was taken over no longer matches it
+alter table idempotency_record
+    add column lease_token uuid not null default uuid_generate_v4();
@@ -0,0 +1,2 @@
+alter table suggested_offer
+    drop column custody_code;
@@ -0,0 +1,3 @@
+-- the investor of a suggestion is created from it on conversion, suggestions made before are left empty
+alter table suggested_offer
+    add column custody_code varchar(20) not null default '';
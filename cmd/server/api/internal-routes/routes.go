@@ -5,11 +5,13 @@ import (
 	"github.com/samber/do"
 
 	"financing-offer/cmd/server/middlewares"
+	"financing-offer/internal/core/entity"
 	investorHttp "financing-offer/internal/core/investor/transport/http"
 	loanOfferHttp "financing-offer/internal/core/loanoffer/transport/http"
 	loanOfferInterestHttp "financing-offer/internal/core/loanofferinterest/http"
 )
 
+// NewRoutes keeps the internal routes for their deprecation period, the jobs are triggered through the scheduler jobs api
 func NewRoutes(group *gin.RouterGroup, middleware middlewares.Middleware, injector *do.Injector) {
 	internalRoutes := group.Group("/internal")
 	internalRoutes.Use(middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"))
@@ -18,10 +20,23 @@ func NewRoutes(group *gin.RouterGroup, middleware middlewares.Middleware, inject
 	investorHandler := do.MustInvoke[*investorHttp.InvestorHandler](injector)
 
 	groupLoanPackageOffer := internalRoutes.Group("/loan-package-offers")
-	groupLoanPackageOffer.GET("/expire", loanOfferHandler.ManualTriggerExpireLoanOffers)
+	groupLoanPackageOffer.GET(
+		"/expire", middleware.Deprecated(jobTriggerPath(entity.JobTypeExpireLoanOffers)),
+		loanOfferHandler.ManualTriggerExpireLoanOffers,
+	)
 	groupLoanPackageOfferInterest := internalRoutes.Group("/loan-package-offer-interests")
-	groupLoanPackageOfferInterest.GET("/sync-loan-package-data", loanOfferInterestHandler.FillWithLoanPackageData)
+	groupLoanPackageOfferInterest.GET(
+		"/sync-loan-package-data", middleware.Deprecated(jobTriggerPath(entity.JobTypeSyncLoanPackageData)),
+		loanOfferInterestHandler.FillWithLoanPackageData,
+	)
 
 	groupInvestor := internalRoutes.Group("/investors")
-	groupInvestor.POST("/sync-investor-data", investorHandler.FillInvestorIdsFromRequests)
+	groupInvestor.POST(
+		"/sync-investor-data", middleware.Deprecated(jobTriggerPath(entity.JobTypeFillInvestorIds)),
+		investorHandler.FillInvestorIdsFromRequests,
+	)
+}
+
+func jobTriggerPath(jobType entity.JobType) string {
+	return "/api/v1/schedulers/jobs/" + string(jobType) + "/trigger"
 }
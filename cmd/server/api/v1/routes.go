@@ -7,6 +7,7 @@ import (
 
 	"financing-offer/cmd/server/middlewares"
 	configHttp "financing-offer/internal/config/transport/http"
+	auditHttp "financing-offer/internal/core/audit/transport/http"
 	"financing-offer/internal/core/awaiting_confirm_request/transport/http"
 	blacklistSymbolHttp "financing-offer/internal/core/blacklistsymbol/transport/http"
 	combinedRequestHttp "financing-offer/internal/core/combined_loan_request/transport/http"
@@ -17,6 +18,7 @@ import (
 	loanOfferInterestHttp "financing-offer/internal/core/loanofferinterest/http"
 	loanPackageRequestHttp "financing-offer/internal/core/loanpackagerequest/transport/http"
 	loanPolicyTemplateHttp "financing-offer/internal/core/loanpolicytemplate/transport/http"
+	notificationTemplateHttp "financing-offer/internal/core/notificationtemplate/transport/http"
 	promotionCampaignHttp "financing-offer/internal/core/promotion_campaign/transport/http"
 	promotionLoanPackageHttp "financing-offer/internal/core/promotion_loan_package/transport/http"
 	schedulerHttp "financing-offer/internal/core/scheduler/transport/http"
@@ -28,7 +30,12 @@ import (
 	suggestedOfferConfigHttp "financing-offer/internal/core/suggested_offer_config/transport/http"
 	symbolHttp "financing-offer/internal/core/symbol/transport/http"
 	symbolScoreHttp "financing-offer/internal/core/symbolscore/transport/http"
+	tradingCalendarHttp "financing-offer/internal/core/tradingcalendar/transport/http"
+	webhookHttp "financing-offer/internal/core/webhook/transport/http"
 	featureHttp "financing-offer/internal/featureflag/transport/http"
+	"financing-offer/internal/permission"
+	permissionHttp "financing-offer/internal/permission/transport/http"
+	cacheHttp "financing-offer/internal/sharedcache/transport/http"
 )
 
 func NewRoutes(engine *gin.RouterGroup, middleware middlewares.Middleware, injector *do.Injector) {
@@ -44,6 +51,7 @@ func NewRoutes(engine *gin.RouterGroup, middleware middlewares.Middleware, injec
 	featureHandler := do.MustInvoke[*featureHttp.FeatureHandler](injector)
 	configHandler := do.MustInvoke[*configHttp.ConfigHandler](injector)
 	loanRequestSchedulerConfigHandler := do.MustInvoke[*schedulerHttp.SchedulerHandler](injector)
+	schedulerJobHandler := do.MustInvoke[*schedulerHttp.JobHandler](injector)
 	awaitingConfirmRequestHandler := do.MustInvoke[*http.AwaitingConfirmRequestHandler](injector)
 	combinedRequestHandler := do.MustInvoke[*combinedRequestHttp.CombinedLoanRequestHandler](injector)
 	investorAccountHandler := do.MustInvoke[*investorAccountHttp.InvestorAccountHandler](injector)
@@ -56,107 +64,287 @@ func NewRoutes(engine *gin.RouterGroup, middleware middlewares.Middleware, injec
 	configurationHandler := do.MustInvoke[*configurationHttp.ConfigurationHandler](injector)
 	submissionDefaultHandler := do.MustInvoke[*submissionDefaultHttp.SubmissionDefaultHandler](injector)
 	promotionCampaignHandler := do.MustInvoke[*promotionCampaignHttp.PromotionCampaignHandler](injector)
+	permissionHandler := do.MustInvoke[*permissionHttp.PermissionHandler](injector)
+	auditHandler := do.MustInvoke[*auditHttp.AuditHandler](injector)
+	tradingCalendarHandler := do.MustInvoke[*tradingCalendarHttp.TradingCalendarHandler](injector)
+	webhookHandler := do.MustInvoke[*webhookHttp.WebhookHandler](injector)
+	notificationTemplateHandler := do.MustInvoke[*notificationTemplateHttp.NotificationTemplateHandler](injector)
+	cacheHandler := do.MustInvoke[*cacheHttp.CacheHandler](injector)
 
 	v1Routes := engine.Group("/v1")
 	v2Routes := engine.Group("/v2")
 
-	groupSymbol := v1Routes.Group("/symbols", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"))
-	groupSymbol.GET("", symbolHandler.GetAll)
-	groupSymbol.GET("/:id", symbolHandler.GetById)
-	groupSymbol.POST("", symbolHandler.Create)
-	groupSymbol.PATCH("/:id", symbolHandler.Update)
-	groupSymbol.POST("/:id/blacklist-symbols", blacklistSymbolHandler.Create)
-	groupSymbol.POST("/:id/cancel-requests", loanPackageRequestHandler.CancelAllLoanPackageRequestBySymbolId)
+	groupSymbol := v1Routes.Group("/symbols", middleware.RequireAuthenticatedUser())
+	groupSymbol.GET("", middleware.RequirePermission(permission.SymbolRead), symbolHandler.GetAll)
+	groupSymbol.GET("/:id", middleware.RequirePermission(permission.SymbolRead), symbolHandler.GetById)
+	groupSymbol.POST("", middleware.RequirePermission(permission.SymbolWrite), symbolHandler.Create)
+	groupSymbol.PATCH("/:id", middleware.RequirePermission(permission.SymbolWrite), symbolHandler.Update)
+	groupSymbol.POST(
+		"/:id/blacklist-symbols", middleware.RequirePermission(permission.SymbolBlacklist),
+		blacklistSymbolHandler.Create,
+	)
+	groupSymbol.POST(
+		"/:id/cancel-requests", middleware.RequirePermission(permission.SymbolCancelRequests),
+		loanPackageRequestHandler.CancelAllLoanPackageRequestBySymbolId,
+	)
+	groupSymbol.GET(
+		"/:id/audit", middleware.RequirePermission(permission.AuditLogRead), auditHandler.GetEntityAuditLogs("symbol"),
+	)
+
+	groupStockExchange := v1Routes.Group("/stock-exchanges", middleware.RequireAuthenticatedUser())
+	groupStockExchange.GET("", middleware.RequirePermission(permission.StockExchangeRead), stockExchangeHandler.GetAll)
+	groupStockExchange.POST(
+		"", middleware.RequirePermission(permission.StockExchangeWrite), stockExchangeHandler.Create,
+	)
+	groupStockExchange.PATCH(
+		"/:id", middleware.RequirePermission(permission.StockExchangeWrite), stockExchangeHandler.Update,
+	)
+	groupStockExchange.DELETE(
+		"/:id", middleware.RequirePermission(permission.StockExchangeWrite), stockExchangeHandler.Delete,
+	)
+
+	groupTradingCalendar := v1Routes.Group("/trading-calendar", middleware.RequireAuthenticatedUser())
+	groupTradingCalendar.GET(
+		"/days", middleware.RequirePermission(permission.TradingCalendarRead), tradingCalendarHandler.GetDays,
+	)
+	groupTradingCalendar.POST(
+		"/days", middleware.RequirePermission(permission.TradingCalendarWrite), tradingCalendarHandler.CreateDay,
+	)
+	groupTradingCalendar.PATCH(
+		"/days/:id", middleware.RequirePermission(permission.TradingCalendarWrite), tradingCalendarHandler.UpdateDay,
+	)
+	groupTradingCalendar.DELETE(
+		"/days/:id", middleware.RequirePermission(permission.TradingCalendarWrite), tradingCalendarHandler.DeleteDay,
+	)
+	groupTradingCalendar.GET(
+		"/session", middleware.RequirePermission(permission.TradingCalendarRead), tradingCalendarHandler.GetSession,
+	)
+	groupTradingCalendar.GET(
+		"/add-trading-days", middleware.RequirePermission(permission.TradingCalendarRead),
+		tradingCalendarHandler.AddTradingDays,
+	)
 
-	groupStockExchange := v1Routes.Group("/stock-exchanges", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"))
-	groupStockExchange.GET("", stockExchangeHandler.GetAll)
-	groupStockExchange.POST("", stockExchangeHandler.Create)
-	groupStockExchange.PATCH("/:id", stockExchangeHandler.Update)
-	groupStockExchange.DELETE("/:id", stockExchangeHandler.Delete)
+	groupWebhook := v1Routes.Group("/webhooks", middleware.RequireAuthenticatedUser())
+	groupWebhook.GET(
+		"/subscriptions", middleware.RequirePermission(permission.WebhookRead), webhookHandler.GetSubscriptions,
+	)
+	groupWebhook.POST(
+		"/subscriptions", middleware.RequirePermission(permission.WebhookWrite), webhookHandler.CreateSubscription,
+	)
+	groupWebhook.GET(
+		"/subscriptions/:id", middleware.RequirePermission(permission.WebhookRead), webhookHandler.GetSubscriptionById,
+	)
+	groupWebhook.PATCH(
+		"/subscriptions/:id", middleware.RequirePermission(permission.WebhookWrite), webhookHandler.UpdateSubscription,
+	)
+	groupWebhook.DELETE(
+		"/subscriptions/:id", middleware.RequirePermission(permission.WebhookWrite), webhookHandler.DeleteSubscription,
+	)
+	groupWebhook.GET(
+		"/subscriptions/:id/deliveries", middleware.RequirePermission(permission.WebhookRead),
+		webhookHandler.GetDeliveries,
+	)
+	groupWebhook.POST(
+		"/deliveries/:id/replay", middleware.RequirePermission(permission.WebhookWrite), webhookHandler.ReplayDelivery,
+	)
+
+	groupNotificationTemplate := v1Routes.Group("/notification-templates", middleware.RequireAuthenticatedUser())
+	groupNotificationTemplate.GET(
+		"", middleware.RequirePermission(permission.NotificationTemplateRead), notificationTemplateHandler.GetAll,
+	)
+	groupNotificationTemplate.POST(
+		"", middleware.RequirePermission(permission.NotificationTemplateWrite), notificationTemplateHandler.Create,
+	)
+	groupNotificationTemplate.POST(
+		"/preview", middleware.RequirePermission(permission.NotificationTemplateRead), notificationTemplateHandler.Preview,
+	)
+	groupNotificationTemplate.GET(
+		"/:id", middleware.RequirePermission(permission.NotificationTemplateRead), notificationTemplateHandler.GetById,
+	)
+	groupNotificationTemplate.PATCH(
+		"/:id", middleware.RequirePermission(permission.NotificationTemplateWrite), notificationTemplateHandler.Update,
+	)
+	groupNotificationTemplate.DELETE(
+		"/:id", middleware.RequirePermission(permission.NotificationTemplateWrite), notificationTemplateHandler.Delete,
+	)
 
-	groupSymbolScore := v1Routes.Group("/symbol-scores", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"))
-	groupSymbolScore.POST("", symbolScoreHandler.Create)
-	groupSymbolScore.PATCH("/:id", symbolScoreHandler.Update)
+	groupCache := v1Routes.Group("/cache", middleware.RequireAuthenticatedUser())
+	groupCache.DELETE("", middleware.RequirePermission(permission.CachePurge), cacheHandler.Purge)
+
+	groupSymbolScore := v1Routes.Group("/symbol-scores", middleware.RequireAuthenticatedUser())
+	groupSymbolScore.POST("", middleware.RequirePermission(permission.SymbolScoreWrite), symbolScoreHandler.Create)
+	groupSymbolScore.PATCH("/:id", middleware.RequirePermission(permission.SymbolScoreWrite), symbolScoreHandler.Update)
 
 	groupAdminLoanPackageRequest := v1Routes.Group(
-		"/loan-package-requests", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
-	)
-	groupAdminLoanPackageRequest.GET("", loanPackageRequestHandler.GetAll)
-	groupAdminLoanPackageRequest.GET("/:id", loanPackageRequestHandler.AdminGetById)
-	groupAdminLoanPackageRequest.POST("/:id/admin-confirm", loanPackageRequestHandler.AdminConfirmUserRequest)
-	groupAdminLoanPackageRequest.POST("/:id/cancel", loanPackageRequestHandler.AdminCancelLoanRequest)
-	groupAdminLoanPackageRequest.GET("/:id/available-packages", loanPackageRequestHandler.GetAvailablePackages)
-	groupAdminLoanPackageRequest.POST("/:id/submissions", loanPackageRequestHandler.AdminConfirmWithNewLoanPackage)
+		"/loan-package-requests", middleware.RequireAuthenticatedUser(),
+	)
+	groupAdminLoanPackageRequest.GET(
+		"", middleware.RequirePermission(permission.LoanRequestRead), loanPackageRequestHandler.GetAll,
+	)
+	groupAdminLoanPackageRequest.GET(
+		"/:id", middleware.RequirePermission(permission.LoanRequestRead), loanPackageRequestHandler.AdminGetById,
+	)
+	groupAdminLoanPackageRequest.GET(
+		"/:id/history", middleware.RequirePermission(permission.LoanRequestRead),
+		loanPackageRequestHandler.AdminGetStatusHistories,
+	)
+	groupAdminLoanPackageRequest.GET(
+		"/:id/audit", middleware.RequirePermission(permission.AuditLogRead),
+		auditHandler.GetEntityAuditLogs("loan_package_request"),
+	)
+	groupAdminLoanPackageRequest.POST(
+		"/:id/admin-confirm", middleware.RequirePermission(permission.LoanRequestConfirm),
+		loanPackageRequestHandler.AdminConfirmUserRequest,
+	)
+	groupAdminLoanPackageRequest.POST(
+		"/:id/cancel", middleware.RequirePermission(permission.LoanRequestDecline),
+		loanPackageRequestHandler.AdminCancelLoanRequest,
+	)
+	groupAdminLoanPackageRequest.GET(
+		"/:id/available-packages", middleware.RequirePermission(permission.LoanRequestRead),
+		loanPackageRequestHandler.GetAvailablePackages,
+	)
 	groupAdminLoanPackageRequest.POST(
-		"/:id/cancel-with-submission", loanPackageRequestHandler.AdminDeclineLoanRequestWithNewLoanPackage,
+		"/:id/submissions", middleware.RequirePermission(permission.LoanRequestConfirm),
+		loanPackageRequestHandler.AdminConfirmWithNewLoanPackage,
+	)
+	groupAdminLoanPackageRequest.POST(
+		"/:id/cancel-with-submission", middleware.RequirePermission(permission.LoanRequestDecline),
+		loanPackageRequestHandler.AdminDeclineLoanRequestWithNewLoanPackage,
 	)
 
-	groupAdminLoanPackageRequest.GET("/:id/latest-submission", loanPackageRequestHandler.AdminGetLatestSubmissionSheet)
+	groupAdminLoanPackageRequest.GET(
+		"/:id/latest-submission", middleware.RequirePermission(permission.LoanRequestRead),
+		loanPackageRequestHandler.AdminGetLatestSubmissionSheet,
+	)
 
-	groupAdminLoanPackageRequest.GET("/underlying", loanPackageRequestHandler.GetAllUnderlyingRequests)
+	groupAdminLoanPackageRequest.GET(
+		"/underlying", middleware.RequirePermission(permission.LoanRequestRead),
+		loanPackageRequestHandler.GetAllUnderlyingRequests,
+	)
 
-	scoreGroup := v1Routes.Group("/score-groups", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"))
-	scoreGroup.GET("", scoreGroupHandler.GetAll)
-	scoreGroup.POST("", scoreGroupHandler.Create)
-	scoreGroup.GET("/:id/available-packages", scoreGroupHandler.GetAvailablePackages)
-	scoreGroup.PATCH("/:id", scoreGroupHandler.Update)
-	scoreGroup.DELETE("/:id", scoreGroupHandler.Delete)
+	scoreGroup := v1Routes.Group("/score-groups", middleware.RequireAuthenticatedUser())
+	scoreGroup.GET("", middleware.RequirePermission(permission.ScoreGroupRead), scoreGroupHandler.GetAll)
+	scoreGroup.POST("", middleware.RequirePermission(permission.ScoreGroupWrite), scoreGroupHandler.Create)
+	scoreGroup.GET(
+		"/:id/available-packages", middleware.RequirePermission(permission.ScoreGroupRead),
+		scoreGroupHandler.GetAvailablePackages,
+	)
+	scoreGroup.PATCH("/:id", middleware.RequirePermission(permission.ScoreGroupWrite), scoreGroupHandler.Update)
+	scoreGroup.DELETE("/:id", middleware.RequirePermission(permission.ScoreGroupWrite), scoreGroupHandler.Delete)
+	scoreGroup.GET(
+		"/:id/audit", middleware.RequirePermission(permission.AuditLogRead), auditHandler.GetEntityAuditLogs("score_group"),
+	)
 
 	groupScoreGroupInterest := v1Routes.Group(
-		"/score-group-interests", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/score-group-interests", middleware.RequireAuthenticatedUser(),
+	)
+	groupScoreGroupInterest.GET(
+		"", middleware.RequirePermission(permission.ScoreGroupRead), scoreGroupInterestHandler.GetAll,
+	)
+	groupScoreGroupInterest.GET(
+		"/:id", middleware.RequirePermission(permission.ScoreGroupRead), scoreGroupInterestHandler.GetById,
+	)
+	groupScoreGroupInterest.POST(
+		"", middleware.RequirePermission(permission.ScoreGroupWrite), scoreGroupInterestHandler.Create,
+	)
+	groupScoreGroupInterest.PATCH(
+		"/:id", middleware.RequirePermission(permission.ScoreGroupWrite), scoreGroupInterestHandler.Update,
+	)
+	groupScoreGroupInterest.DELETE(
+		"/:id", middleware.RequirePermission(permission.ScoreGroupWrite), scoreGroupInterestHandler.Delete,
 	)
-	groupScoreGroupInterest.GET("", scoreGroupInterestHandler.GetAll)
-	groupScoreGroupInterest.GET("/:id", scoreGroupInterestHandler.GetById)
-	groupScoreGroupInterest.POST("", scoreGroupInterestHandler.Create)
-	groupScoreGroupInterest.PATCH("/:id", scoreGroupInterestHandler.Update)
-	groupScoreGroupInterest.DELETE("/:id", scoreGroupInterestHandler.Delete)
 
 	groupLoanOfferInterest := v1Routes.Group(
-		"/loan-offer-interests", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/loan-offer-interests", middleware.RequireAuthenticatedUser(),
+	)
+	groupLoanOfferInterest.GET(
+		"", middleware.RequirePermission(permission.LoanOfferRead), offerInterestHandler.GetAllWithFilter,
 	)
-	groupLoanOfferInterest.GET("", offerInterestHandler.GetAllWithFilter)
 	groupLoanOfferInterest.POST(
-		"/:id/assign-loan-contract", offerInterestHandler.CreateAssignedLoanOfferInterestLoanContract,
+		"/:id/assign-loan-contract", middleware.RequirePermission(permission.LoanOfferWrite),
+		offerInterestHandler.CreateAssignedLoanOfferInterestLoanContract,
 	)
 
 	offlineUpdatesWithIdUri := "/:id/offline-updates"
-	groupLoanOffer := v1Routes.Group("/loan-package-offers", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"))
-	groupLoanOffer.GET(offlineUpdatesWithIdUri, loanOfferHandler.GetOfflineOfferUpdateHistory)
-	groupLoanOffer.POST(offlineUpdatesWithIdUri, loanOfferHandler.CreateOfflineOfferUpdate)
-	groupLoanOffer.POST("/:id/assign-loan", loanOfferHandler.AdminAssignLoanId)
-	groupLoanOffer.POST("/:id/cancel", loanOfferHandler.AdminCancelLoanPackageOfferInterest)
+	groupLoanOffer := v1Routes.Group("/loan-package-offers", middleware.RequireAuthenticatedUser())
+	groupLoanOffer.GET(
+		offlineUpdatesWithIdUri, middleware.RequirePermission(permission.LoanOfferRead),
+		loanOfferHandler.GetOfflineOfferUpdateHistory,
+	)
+	groupLoanOffer.POST(
+		offlineUpdatesWithIdUri, middleware.RequirePermission(permission.LoanOfferWrite),
+		loanOfferHandler.CreateOfflineOfferUpdate,
+	)
+	groupLoanOffer.POST(
+		"/:id/assign-loan", middleware.RequirePermission(permission.LoanOfferWrite), loanOfferHandler.AdminAssignLoanId,
+	)
+	groupLoanOffer.POST(
+		"/:id/cancel", middleware.RequirePermission(permission.LoanOfferWrite),
+		loanOfferHandler.AdminCancelLoanPackageOfferInterest,
+	)
+	groupLoanOffer.GET(
+		"/:id/audit", middleware.RequirePermission(permission.AuditLogRead),
+		auditHandler.GetEntityAuditLogs("loan_package_offer"),
+	)
 
 	groupDerivativeLoanOffer := v1Routes.Group(
-		"/derivative-loan-package-offers", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/derivative-loan-package-offers", middleware.RequireAuthenticatedUser(),
+	)
+	groupDerivativeLoanOffer.GET(
+		offlineUpdatesWithIdUri, middleware.RequirePermission(permission.LoanOfferRead),
+		loanOfferHandler.GetDerivativeOfflineOfferUpdateHistory,
+	)
+	groupDerivativeLoanOffer.POST(
+		offlineUpdatesWithIdUri, middleware.RequirePermission(permission.LoanOfferWrite),
+		loanOfferHandler.CreateDerivativeOfflineOfferUpdate,
+	)
+	groupDerivativeLoanOffer.POST(
+		"/:id/assign-loan", middleware.RequirePermission(permission.LoanOfferWrite), loanOfferHandler.AdminAssignLoanId,
 	)
-	groupDerivativeLoanOffer.GET(offlineUpdatesWithIdUri, loanOfferHandler.GetDerivativeOfflineOfferUpdateHistory)
-	groupDerivativeLoanOffer.POST(offlineUpdatesWithIdUri, loanOfferHandler.CreateDerivativeOfflineOfferUpdate)
-	groupDerivativeLoanOffer.POST("/:id/assign-loan", loanOfferHandler.AdminAssignLoanId)
 
 	groupAwaitingConfirmRequest := v1Routes.Group(
-		"/awaiting-confirm-requests", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/awaiting-confirm-requests", middleware.RequireAuthenticatedUser(),
+	)
+	groupAwaitingConfirmRequest.GET(
+		"", middleware.RequirePermission(permission.LoanRequestRead), awaitingConfirmRequestHandler.GetAll,
 	)
-	groupAwaitingConfirmRequest.GET("", awaitingConfirmRequestHandler.GetAll)
 
 	groupDerivativeAwaitingConfirmRequest := v1Routes.Group(
-		"/derivative-awaiting-confirm-requests", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/derivative-awaiting-confirm-requests", middleware.RequireAuthenticatedUser(),
 	)
 
-	groupDerivativeAwaitingConfirmRequest.GET("", awaitingConfirmRequestHandler.GetAllDerivative)
+	groupDerivativeAwaitingConfirmRequest.GET(
+		"", middleware.RequirePermission(permission.LoanRequestRead), awaitingConfirmRequestHandler.GetAllDerivative,
+	)
 
 	groupCombinedRequest := v1Routes.Group(
-		"combined-requests", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"combined-requests", middleware.RequireAuthenticatedUser(),
+	)
+	groupCombinedRequest.GET(
+		"", middleware.RequirePermission(permission.LoanRequestRead), combinedRequestHandler.GetAll,
 	)
-	groupCombinedRequest.GET("", combinedRequestHandler.GetAll)
 
 	groupAdminConfiguration := v1Routes.Group(
-		"/configurations", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/configurations", middleware.RequireAuthenticatedUser(),
+	)
+	groupAdminConfiguration.POST(
+		"/promotion-loan-packages", middleware.RequirePermission(permission.PromotionWrite),
+		promotionLoanPackageHandler.SetPromotionLoanPackages,
+	)
+	groupAdminConfiguration.POST(
+		"/loan-rate", middleware.RequirePermission(permission.ConfigLoanRateWrite), configurationHandler.SetLoanRate,
+	)
+	groupAdminConfiguration.GET(
+		"/loan-rate", middleware.RequirePermission(permission.ConfigRead), configurationHandler.GetLoanRate,
+	)
+	groupAdminConfiguration.POST(
+		"/margin-pool", middleware.RequirePermission(permission.ConfigMarginPoolWrite),
+		configurationHandler.SetMarginPool,
+	)
+	groupAdminConfiguration.GET(
+		"/margin-pool", middleware.RequirePermission(permission.ConfigRead), configurationHandler.GetMarginPool,
 	)
-	groupAdminConfiguration.POST("/promotion-loan-packages", promotionLoanPackageHandler.SetPromotionLoanPackages)
-	groupAdminConfiguration.POST("/loan-rate", configurationHandler.SetLoanRate)
-	groupAdminConfiguration.GET("/loan-rate", configurationHandler.GetLoanRate)
-	groupAdminConfiguration.POST("/margin-pool", configurationHandler.SetMarginPool)
-	groupAdminConfiguration.GET("/margin-pool", configurationHandler.GetMarginPool)
 
 	// investor routes
 
@@ -165,18 +353,25 @@ func NewRoutes(engine *gin.RouterGroup, middleware middlewares.Middleware, injec
 
 	groupInvestorLoanPackageRequest := v1Routes.Group(
 		"/my-loan-package-request", middleware.RequireAuthenticatedUser(),
-		middleware.RequireFeatureEnable("loanRequest"),
+		middleware.RequireFeatureEnable("loanRequest"), middleware.RateLimit("loanPackageRequest"),
 	)
 	groupInvestorLoanPackageRequest.GET("", loanPackageRequestHandler.InvestorGetAll)
 	groupInvestorLoanPackageRequest.GET("/:id", loanPackageRequestHandler.InvestorGetById)
-	groupInvestorLoanPackageRequest.POST("", loanPackageRequestHandler.InvestorRequest)
+	groupInvestorLoanPackageRequest.POST("", middleware.Idempotent(), loanPackageRequestHandler.InvestorRequest)
 
 	groupInvestorDerivativeRequest := v1Routes.Group(
 		"/my-derivative-requests", middleware.RequireAuthenticatedUser(),
 	)
-	groupInvestorDerivativeRequest.POST("", loanPackageRequestHandler.InvestorRequestDerivative)
+	groupInvestorDerivativeRequest.POST(
+		"", middleware.Idempotent(), loanPackageRequestHandler.InvestorRequestDerivative,
+	)
+
+	groupInvestorNotificationLocale := v1Routes.Group("/my-notification-locale", middleware.RequireAuthenticatedUser())
+	groupInvestorNotificationLocale.PUT("", notificationTemplateHandler.SetPreferredLocale)
 
-	groupInvestorLoggedRequest := v1Routes.Group("/my-logged-requests", middleware.RequireAuthenticatedUser())
+	groupInvestorLoggedRequest := v1Routes.Group(
+		"/my-logged-requests", middleware.RequireAuthenticatedUser(), middleware.RateLimit("loggedRequest"),
+	)
 	groupInvestorLoggedRequest.POST("", loanPackageRequestHandler.SaveLoanRateExistedRequest)
 
 	groupInvestorLoanPackageOffer := v1Routes.Group("/my-loan-package-offer", middleware.RequireAuthenticatedUser())
@@ -186,21 +381,42 @@ func NewRoutes(engine *gin.RouterGroup, middleware middlewares.Middleware, injec
 
 	groupOfferInterest := v1Routes.Group("/my-loan-offer-interests", middleware.RequireAuthenticatedUser())
 	groupOfferInterest.POST(
-		"/confirm", middleware.RequireHOActive(), offerInterestHandler.InvestorConfirmMultipleLoanPackageInterest,
+		"/confirm", middleware.RequireHOActive(), middleware.Idempotent(),
+		offerInterestHandler.InvestorConfirmMultipleLoanPackageInterest,
 	)
 	groupOfferInterest.POST(
-		"/:id/confirm", middleware.RequireHOActive(), offerInterestHandler.InvestorConfirmLoanPackageInterest,
+		"/:id/confirm", middleware.RequireHOActive(), middleware.Idempotent(),
+		offerInterestHandler.InvestorConfirmLoanPackageInterest,
 	)
 	groupOfferInterest.POST("/:id/cancel", offerInterestHandler.InvestorCancelLoanPackageOfferInterest)
+	groupOfferInterest.GET("/:id/loan-package-status", offerInterestHandler.InvestorGetLoanPackageCreationStatus)
+
+	groupMe := v1Routes.Group("/me", middleware.RequireAuthenticatedUser())
+	groupMe.GET("/permissions", permissionHandler.GetMyPermissions)
 
 	groupFeature := v1Routes.Group("/features")
 	groupFeature.GET("/:name/verify", featureHandler.CheckFeatureEnable)
 
+	groupFeatureFlag := v1Routes.Group("/feature-flags", middleware.RequireAuthenticatedUser())
+	groupFeatureFlag.GET("", middleware.RequirePermission(permission.FeatureFlagRead), featureHandler.GetAll)
+	groupFeatureFlag.POST("", middleware.RequirePermission(permission.FeatureFlagWrite), featureHandler.Create)
+	groupFeatureFlag.GET("/:id", middleware.RequirePermission(permission.FeatureFlagRead), featureHandler.GetById)
+	groupFeatureFlag.PATCH("/:id", middleware.RequirePermission(permission.FeatureFlagWrite), featureHandler.Update)
+	groupFeatureFlag.PUT(
+		"/:id/kill-switch", middleware.RequirePermission(permission.FeatureFlagWrite), featureHandler.SetKillSwitch,
+	)
+	groupFeatureFlag.DELETE("/:id", middleware.RequirePermission(permission.FeatureFlagWrite), featureHandler.Delete)
+	groupFeatureFlag.GET(
+		"/:id/audit", middleware.RequirePermission(permission.AuditLogRead), auditHandler.GetEntityAuditLogs("feature_flag"),
+	)
+
 	groupBlacklistSymbol := v1Routes.Group(
 		"/blacklist-symbols",
-		middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		middleware.RequireAuthenticatedUser(),
+	)
+	groupBlacklistSymbol.PATCH(
+		"/:id", middleware.RequirePermission(permission.SymbolBlacklist), blacklistSymbolHandler.Update,
 	)
-	groupBlacklistSymbol.PATCH("/:id", blacklistSymbolHandler.Update)
 
 	groupUserConfig := v1Routes.Group("/my-configurations", middleware.RequireAuthenticatedUser())
 	groupUserConfig.GET("", configHandler.GetConfiguration)
@@ -218,13 +434,23 @@ func NewRoutes(engine *gin.RouterGroup, middleware middlewares.Middleware, injec
 	groupLoanOfferSymbol.GET("/:symbol-code", symbolHandler.GetSymbolNotActiveBlacklist)
 
 	loanPolicyTemplate := v1Routes.Group(
-		"/loan-policy-template", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/loan-policy-template", middleware.RequireAuthenticatedUser(),
+	)
+	loanPolicyTemplate.GET(
+		"", middleware.RequirePermission(permission.LoanPolicyRead), loanPolicyTemplateHandler.GetAll,
+	)
+	loanPolicyTemplate.POST(
+		"", middleware.RequirePermission(permission.LoanPolicyWrite), loanPolicyTemplateHandler.Create,
+	)
+	loanPolicyTemplate.GET(
+		"/:id", middleware.RequirePermission(permission.LoanPolicyRead), loanPolicyTemplateHandler.GetById,
+	)
+	loanPolicyTemplate.PUT(
+		"/:id", middleware.RequirePermission(permission.LoanPolicyWrite), loanPolicyTemplateHandler.Update,
+	)
+	loanPolicyTemplate.DELETE(
+		"/:id", middleware.RequirePermission(permission.LoanPolicyWrite), loanPolicyTemplateHandler.Delete,
 	)
-	loanPolicyTemplate.GET("", loanPolicyTemplateHandler.GetAll)
-	loanPolicyTemplate.POST("", loanPolicyTemplateHandler.Create)
-	loanPolicyTemplate.GET("/:id", loanPolicyTemplateHandler.GetById)
-	loanPolicyTemplate.PUT("/:id", loanPolicyTemplateHandler.Update)
-	loanPolicyTemplate.DELETE("/:id", loanPolicyTemplateHandler.Delete)
 
 	marginOperationGroup := v1Routes.Group("mo")
 	marginOperationGroup.GET("/applicable-loan-rates", financialProductHandler.GetLoanRates)
@@ -232,60 +458,143 @@ func NewRoutes(engine *gin.RouterGroup, middleware middlewares.Middleware, injec
 
 	schedulerGroup := v1Routes.Group(
 		"/schedulers",
-		middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		middleware.RequireAuthenticatedUser(),
 	)
 	schedulerGroup.GET(
-		"/loan-request-scheduler-config", loanRequestSchedulerConfigHandler.GetAllLoanRequestSchedulerConfigs,
+		"/loan-request-scheduler-config", middleware.RequirePermission(permission.SchedulerRead),
+		loanRequestSchedulerConfigHandler.GetAllLoanRequestSchedulerConfigs,
 	)
 	schedulerGroup.GET(
-		"/loan-request-scheduler-config/current",
+		"/loan-request-scheduler-config/current", middleware.RequirePermission(permission.SchedulerRead),
 		loanRequestSchedulerConfigHandler.GetCurrentLoanRequestSchedulerConfig,
 	)
 	schedulerGroup.POST(
-		"/loan-request-scheduler-config", loanRequestSchedulerConfigHandler.CreateLoanRequestSchedulerConfig,
+		"/loan-request-scheduler-config", middleware.RequirePermission(permission.SchedulerWrite),
+		loanRequestSchedulerConfigHandler.CreateLoanRequestSchedulerConfig,
+	)
+	schedulerGroup.POST(
+		"/loan-request-scheduler-config/dry-run", middleware.RequirePermission(permission.SchedulerRead),
+		loanRequestSchedulerConfigHandler.DryRunLoanRequestSchedulerConfig,
+	)
+	schedulerGroup.GET("/jobs", middleware.RequirePermission(permission.SchedulerRead), schedulerJobHandler.GetJobs)
+	schedulerGroup.GET(
+		"/jobs/:type/runs", middleware.RequirePermission(permission.SchedulerRead), schedulerJobHandler.GetJobRuns,
+	)
+	schedulerGroup.POST(
+		"/jobs/:type/trigger", middleware.RequirePermission(permission.SchedulerWrite), schedulerJobHandler.TriggerJob,
 	)
 
 	groupInvestorAccount := v1Routes.Group(
-		"/investor-accounts", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/investor-accounts", middleware.RequireAuthenticatedUser(),
 	)
 	groupInvestorAccount.PUT(
-		"/:account-no/margin-status", investorAccountHandler.VerifyAndUpdateInvestorAccountMarginStatus,
+		"/:account-no/margin-status", middleware.RequirePermission(permission.InvestorAccountWrite),
+		investorAccountHandler.VerifyAndUpdateInvestorAccountMarginStatus,
 	)
 
 	submissionSheetGroup := v1Routes.Group(
-		"/submission-sheets", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/submission-sheets", middleware.RequireAuthenticatedUser(),
+	)
+	submissionSheetGroup.POST(
+		"", middleware.RequirePermission(permission.SubmissionWrite), submissionSheetHandler.Upsert,
+	)
+	submissionSheetGroup.POST(
+		"/:id/approve", middleware.RequirePermission(permission.SubmissionApprove),
+		submissionSheetHandler.AdminApproveSubmissionSheet,
+	)
+	submissionSheetGroup.POST(
+		"/:id/reject", middleware.RequirePermission(permission.SubmissionApprove),
+		submissionSheetHandler.AdminRejectSubmissionSheet,
+	)
+	submissionSheetGroup.GET(
+		"/:id/approvals", middleware.RequirePermission(permission.LoanRequestRead),
+		submissionSheetHandler.GetApprovals,
+	)
+	submissionSheetGroup.GET(
+		"/:id/audit", middleware.RequirePermission(permission.AuditLogRead),
+		auditHandler.GetEntityAuditLogs("submission_sheet_metadata"),
+	)
+	submissionSheetGroup.GET(
+		"/:id/odoo-payload", middleware.RequirePermission(permission.LoanRequestRead),
+		submissionSheetHandler.PreviewOdooPayload,
+	)
+	submissionSheetGroup.POST(
+		"/delegations", middleware.RequirePermission(permission.SubmissionApprove),
+		submissionSheetHandler.CreateDelegation,
+	)
+	submissionSheetGroup.DELETE(
+		"/delegations/:id", middleware.RequirePermission(permission.SubmissionApprove),
+		submissionSheetHandler.RevokeDelegation,
 	)
-	submissionSheetGroup.POST("", submissionSheetHandler.Upsert)
-	submissionSheetGroup.POST("/:id/approve", submissionSheetHandler.AdminApproveSubmissionSheet)
-	submissionSheetGroup.POST("/:id/reject", submissionSheetHandler.AdminRejectSubmissionSheet)
 
 	groupSuggestedOfferConfig := v1Routes.Group(
-		"/suggested-offer-configs", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"),
+		"/suggested-offer-configs", middleware.RequireAuthenticatedUser(),
+	)
+	groupSuggestedOfferConfig.POST(
+		"", middleware.RequirePermission(permission.SuggestedOfferWrite), suggestedOfferConfigHandler.Create,
+	)
+	groupSuggestedOfferConfig.PATCH(
+		"/:id", middleware.RequirePermission(permission.SuggestedOfferWrite), suggestedOfferConfigHandler.Update,
+	)
+	groupSuggestedOfferConfig.PATCH(
+		"/:id/status", middleware.RequirePermission(permission.SuggestedOfferWrite),
+		suggestedOfferConfigHandler.UpdateStatus,
+	)
+	groupSuggestedOfferConfig.GET(
+		"", middleware.RequirePermission(permission.SuggestedOfferRead), suggestedOfferConfigHandler.GetAll,
+	)
+	groupSuggestedOfferConfig.GET(
+		"/:id", middleware.RequirePermission(permission.SuggestedOfferRead), suggestedOfferConfigHandler.GetById,
 	)
-	groupSuggestedOfferConfig.POST("", suggestedOfferConfigHandler.Create)
-	groupSuggestedOfferConfig.PATCH("/:id", suggestedOfferConfigHandler.Update)
-	groupSuggestedOfferConfig.PATCH("/:id/status", suggestedOfferConfigHandler.UpdateStatus)
-	groupSuggestedOfferConfig.GET("", suggestedOfferConfigHandler.GetAll)
-	groupSuggestedOfferConfig.GET("/:id", suggestedOfferConfigHandler.GetById)
 
 	groupUserSuggestedOfferConfig := v1Routes.Group(
 		"/active-suggested-offer-config", middleware.RequireAuthenticatedUser(),
 	)
 	groupUserSuggestedOfferConfig.GET("", suggestedOfferConfigHandler.Get)
 
-	groupSuggestedOffer := v1Routes.Group("/suggested-offers")
+	groupSuggestedOffer := v1Routes.Group("/suggested-offers", middleware.RateLimit("suggestedOffer"))
 	groupSuggestedOffer.POST("", suggestedOfferHandler.CreateOffer)
 
-	groupSubmissionDefault := v1Routes.Group("/submission-defaults", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"))
-	groupSubmissionDefault.GET("", submissionDefaultHandler.GetSubmissionDefault)
-	groupSubmissionDefault.POST("", submissionDefaultHandler.SetSubmissionDefault)
+	groupAdminSuggestedOffer := v1Routes.Group("/suggested-offers", middleware.RequireAuthenticatedUser())
+	groupAdminSuggestedOffer.GET(
+		"", middleware.RequirePermission(permission.SuggestedOfferRead), suggestedOfferHandler.GetAll,
+	)
+	groupAdminSuggestedOffer.GET(
+		"/conversion-metrics", middleware.RequirePermission(permission.SuggestedOfferRead),
+		suggestedOfferHandler.GetConversionMetrics,
+	)
+	groupAdminSuggestedOffer.GET(
+		"/:id", middleware.RequirePermission(permission.SuggestedOfferRead), suggestedOfferHandler.GetById,
+	)
+	groupAdminSuggestedOffer.PATCH(
+		"/:id/status", middleware.RequirePermission(permission.SuggestedOfferWrite),
+		suggestedOfferHandler.UpdateStatus,
+	)
+	groupAdminSuggestedOffer.POST(
+		"/:id/convert", middleware.RequirePermission(permission.SuggestedOfferWrite), suggestedOfferHandler.Convert,
+	)
+
+	groupSubmissionDefault := v1Routes.Group("/submission-defaults", middleware.RequireAuthenticatedUser())
+	groupSubmissionDefault.GET(
+		"", middleware.RequirePermission(permission.SubmissionDefaultRead),
+		submissionDefaultHandler.GetSubmissionDefault,
+	)
+	groupSubmissionDefault.POST(
+		"", middleware.RequirePermission(permission.SubmissionDefaultSet),
+		submissionDefaultHandler.SetSubmissionDefault,
+	)
 
-	promotionCampaign := v1Routes.Group("/promotion-campaigns", middleware.RequireOneOfRoles("ADMIN", "FINANCIAL_ADMIN"))
-	promotionCampaign.GET("", promotionCampaignHandler.GetAll)
-	promotionCampaign.POST("", promotionCampaignHandler.Create)
-	promotionCampaign.PATCH("/:id", promotionCampaignHandler.Update)
+	promotionCampaign := v1Routes.Group("/promotion-campaigns", middleware.RequireAuthenticatedUser())
+	promotionCampaign.GET("", middleware.RequirePermission(permission.PromotionRead), promotionCampaignHandler.GetAll)
+	promotionCampaign.POST("", middleware.RequirePermission(permission.PromotionWrite), promotionCampaignHandler.Create)
+	promotionCampaign.PATCH(
+		"/:id", middleware.RequirePermission(permission.PromotionWrite), promotionCampaignHandler.Update,
+	)
 
 	groupUserPromotionCampaignPackage := v1Routes.Group("/my-promotion-campaigns", middleware.RequireAuthenticatedUser())
 	groupUserPromotionCampaignPackage.GET("", promotionCampaignHandler.GetAll)
 
+	groupAuditLog := v1Routes.Group("/audit-logs", middleware.RequireAuthenticatedUser())
+	groupAuditLog.GET("", middleware.RequirePermission(permission.AuditLogRead), auditHandler.GetAll)
+
 }
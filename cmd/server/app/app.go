@@ -9,9 +9,13 @@ import (
 	"financing-offer/cmd/server/middlewares"
 	"financing-offer/internal/config"
 	flexOpenApiRepo "financing-offer/internal/core/flex/repository"
+	"financing-offer/internal/core/idempotency"
 	"financing-offer/internal/database"
 	"financing-offer/internal/di"
 	"financing-offer/internal/featureflag"
+	"financing-offer/internal/permission"
+	"financing-offer/internal/ratelimit"
+	"financing-offer/internal/tracing"
 	"financing-offer/pkg/environment"
 	"financing-offer/pkg/shutdown"
 )
@@ -30,6 +34,9 @@ func Run(logger *slog.Logger, tasks *shutdown.Tasks) error {
 		return err
 	}
 
+	if err := tracing.Setup(cfg.Tracing, tasks); err != nil {
+		return err
+	}
 	getDbFunc, atomicExecutor, err := database.New(cfg.Db, tasks)
 	if err != nil {
 		return err
@@ -57,11 +64,33 @@ func Run(logger *slog.Logger, tasks *shutdown.Tasks) error {
 			Config:             cfg,
 			FeatureFlagUseCase: do.MustInvoke[featureflag.UseCase](injector),
 			FlexRepo:           do.MustInvoke[flexOpenApiRepo.FlexOpenApiRepository](injector),
+			IdempotencyUseCase: do.MustInvoke[idempotency.UseCase](injector),
+			RateLimiter:        do.MustInvoke[ratelimit.Limiter](injector),
+			PermissionUseCase:  do.MustInvoke[permission.UseCase](injector),
 		},
 	}
 	if err := application.StartScheduler(); err != nil {
 		return err
 	}
+	application.StartOutboxRelay()
+	application.StartWebhookDelivery()
+	if err := application.StartCdcConsumer(); err != nil {
+		return err
+	}
+	if err := application.StartCacheInvalidation(); err != nil {
+		return err
+	}
+	if err := application.StartFeatureFlagListener(); err != nil {
+		return err
+	}
+	if err := application.StartLifecycleWorker(); err != nil {
+		return err
+	}
+	application.StartKafkaConsumer()
+	if err := application.RegisterKpiMetrics(); err != nil {
+		return err
+	}
+	application.ServeMetrics()
 
 	return application.ServeHTTP()
 }
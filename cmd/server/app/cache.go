@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+
+	"github.com/samber/do"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/sharedcache"
+)
+
+func (app *Application) StartCacheInvalidation() error {
+	if app.Config.Cache.Store != config.CacheStorePostgres {
+		return nil
+	}
+	postgresCache := do.MustInvoke[*sharedcache.PostgresCache](app.Injector)
+	ctx, cancel := context.WithCancel(context.Background())
+	app.Tasks.AddShutdownTask(
+		func(_ context.Context) error {
+			cancel()
+			return nil
+		},
+	)
+	return postgresCache.Start(ctx)
+}
@@ -0,0 +1,24 @@
+package app
+
+import (
+	"context"
+
+	"github.com/samber/do"
+
+	"financing-offer/internal/dbevent"
+)
+
+func (app *Application) StartCdcConsumer() error {
+	if !app.Config.Cdc.Enable {
+		return nil
+	}
+	consumer := do.MustInvoke[*dbevent.Consumer](app.Injector)
+	ctx, cancel := context.WithCancel(context.Background())
+	app.Tasks.AddShutdownTask(
+		func(_ context.Context) error {
+			cancel()
+			return nil
+		},
+	)
+	return consumer.Start(ctx)
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"context"
+
+	"github.com/samber/do"
+
+	"financing-offer/internal/featureflag"
+)
+
+func (app *Application) StartFeatureFlagListener() error {
+	store := do.MustInvoke[*featureflag.Store](app.Injector)
+	ctx, cancel := context.WithCancel(context.Background())
+	app.Tasks.AddShutdownTask(
+		func(_ context.Context) error {
+			cancel()
+			return nil
+		},
+	)
+	return store.Start(ctx)
+}
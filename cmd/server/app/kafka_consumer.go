@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+
+	"github.com/samber/do"
+
+	"financing-offer/internal/event"
+)
+
+func (app *Application) StartKafkaConsumer() {
+	if !app.Config.Kafka.Consumer.Enable {
+		return
+	}
+	consumerGroup := do.MustInvoke[*event.ConsumerGroup](app.Injector)
+	ctx, cancel := context.WithCancel(context.Background())
+	consumerGroup.Start(ctx)
+	app.Tasks.AddShutdownTask(
+		func(_ context.Context) error {
+			cancel()
+			consumerGroup.Wait()
+			return nil
+		},
+	)
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	"github.com/samber/do"
+
+	lifecycleWorker "financing-offer/internal/core/lifecycle/transport/worker"
+)
+
+func (app *Application) StartLifecycleWorker() error {
+	if !app.Config.Temporal.Worker.Enable {
+		return nil
+	}
+	w := do.MustInvoke[*lifecycleWorker.LoanRequestLifecycleWorker](app.Injector)
+	if err := w.Start(); err != nil {
+		return err
+	}
+	app.Tasks.AddShutdownTask(
+		func(_ context.Context) error {
+			w.Stop()
+			return nil
+		},
+	)
+	return nil
+}
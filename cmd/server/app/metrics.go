@@ -0,0 +1,51 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/samber/do"
+
+	"financing-offer/internal/metrics"
+)
+
+// RegisterKpiMetrics adds the business gauges read from the database to /metrics
+func (app *Application) RegisterKpiMetrics() error {
+	if !app.Config.Metrics.EnableKpi {
+		return nil
+	}
+	return metrics.Registry.Register(do.MustInvoke[*metrics.KpiCollector](app.Injector))
+}
+
+// ServeMetrics serves /metrics on the internal metrics port, apart from the public routes so the business gauges
+// are only scraped from inside the cluster
+func (app *Application) ServeMetrics() {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	srv := &http.Server{
+		Addr:         fmt.Sprintf(":%d", app.Config.Metrics.Port),
+		Handler:      mux,
+		ErrorLog:     log.New(os.Stderr, "", 0),
+		IdleTimeout:  defaultIdleTimeout,
+		ReadTimeout:  defaultReadTimeout,
+		WriteTimeout: defaultWriteTimeout,
+	}
+	app.Tasks.AddShutdownTask(
+		func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, defaultShutdownPeriod)
+			defer cancel()
+			return srv.Shutdown(ctx)
+		},
+	)
+	go func() {
+		app.Logger.Info(fmt.Sprintf("starting metrics server on %s", srv.Addr))
+		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			app.Logger.Error("metrics server", slog.String("error", err.Error()))
+		}
+	}()
+}
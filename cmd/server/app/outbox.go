@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	"github.com/samber/do"
+
+	outboxWorker "financing-offer/internal/core/outbox/transport/worker"
+)
+
+func (app *Application) StartOutboxRelay() {
+	relayWorker := do.MustInvoke[*outboxWorker.RelayWorker](app.Injector)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		relayWorker.Run(ctx)
+	}()
+	app.Tasks.AddShutdownTask(
+		func(_ context.Context) error {
+			cancel()
+			<-done
+			return nil
+		},
+	)
+}
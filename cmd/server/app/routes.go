@@ -1,6 +1,8 @@
 package app
 
 import (
+	"fmt"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -9,6 +11,7 @@ import (
 
 	internalroutes "financing-offer/cmd/server/api/internal-routes"
 	v1 "financing-offer/cmd/server/api/v1"
+	"financing-offer/cmd/server/middlewares"
 	"financing-offer/cmd/server/request"
 	"financing-offer/internal/config"
 	"financing-offer/pkg/docs"
@@ -19,7 +22,7 @@ import (
 //	@securityDefinitions.apikey	BearerAuth
 //	@in							header
 //	@name						Authorization
-func (app *Application) routes() http.Handler {
+func (app *Application) routes() (http.Handler, error) {
 	if app.Config.Env == config.EnvProduction {
 		gin.SetMode(gin.ReleaseMode)
 	}
@@ -30,6 +33,11 @@ func (app *Application) routes() http.Handler {
 	docs.SwaggerInfo.BasePath = "/api"
 	docs.SwaggerInfo.Schemes = []string{"http", "https"}
 	r := gin.New()
+	if err := middlewares.TrustProxies(r, app.Config.Proxy); err != nil {
+		return nil, fmt.Errorf("routes: %w", err)
+	}
+	// the use cases take the gin context, the span and baggage of the request are read from the request context
+	r.ContextWithFallback = true
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowAllOrigins = true
 	corsConfig.AllowMethods = []string{"POST", "GET", "PUT", "DELETE", "OPTIONS"}
@@ -39,8 +47,10 @@ func (app *Application) routes() http.Handler {
 	r.Use(
 		cors.New(corsConfig),
 		gin.Recovery(),
+		middleware.TracingMiddleware(),
 		request.AddRequestId(),
 		middleware.JsonLoggerMiddleware(),
+		middleware.MetricsMiddleware(),
 	)
 	r.NoRoute(app.notFound)
 	r.NoMethod(app.methodNotAllowed)
@@ -55,5 +65,5 @@ func (app *Application) routes() http.Handler {
 	publicGroup := r.Group("/public")
 	v1.NewPublicRoutes(publicGroup, middleware, app.Injector)
 	internalroutes.NewRoutes(apiGroup, middleware, app.Injector)
-	return r
+	return r, nil
 }
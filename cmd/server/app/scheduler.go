@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -9,9 +10,8 @@ import (
 	"github.com/robfig/cron/v3"
 	"github.com/samber/do"
 
-	"financing-offer/internal/config"
-	loanOfferScheduler "financing-offer/internal/core/loanoffer/transport/scheduler"
-	loanRequestScheduler "financing-offer/internal/core/loanpackagerequest/transport/scheduler"
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/scheduler"
 )
 
 var _ cron.Logger = (*logConverter)(nil)
@@ -47,22 +47,41 @@ func (app *Application) StartScheduler() error {
 		func(_ context.Context) error {
 			cronCtx := c.Stop()
 			<-cronCtx.Done()
+			do.MustInvoke[*scheduler.JobRunner](app.Injector).Wait()
 			return nil
 		},
 	)
 	c.Start()
+	runOnStart(app.Logger, app.Injector)
 	return nil
 }
 
 func register(injector *do.Injector, c *cron.Cron) error {
-	cfg := do.MustInvoke[config.AppConfig](injector)
-	loanOfferHandler := do.MustInvoke[*loanOfferScheduler.LoanOfferScheduler](injector)
-	loanRequestHandler := do.MustInvoke[*loanRequestScheduler.LoanRequestScheduler](injector)
-	if _, err := c.AddFunc(cfg.Cron.ExpireLoanOffers, loanOfferHandler.ExpireLoanOffers); err != nil {
-		return err
-	}
-	if _, err := c.AddFunc(cfg.Cron.DeclineLoanRequests, loanRequestHandler.DeclineLoanRequests); err != nil {
-		return err
+	registry := do.MustInvoke[*scheduler.JobRegistry](injector)
+	// every replica registers the jobs, the runner lets a single one run each tick
+	runner := do.MustInvoke[*scheduler.JobRunner](injector)
+	for _, job := range registry.Jobs() {
+		if job.Cron == "" {
+			continue
+		}
+		if _, err := c.AddFunc(job.Cron, runner.Wrap(job)); err != nil {
+			return fmt.Errorf("register job %s: %w", job.Type, err)
+		}
 	}
 	return nil
 }
+
+func runOnStart(logger *slog.Logger, injector *do.Injector) {
+	registry := do.MustInvoke[*scheduler.JobRegistry](injector)
+	runner := do.MustInvoke[*scheduler.JobRunner](injector)
+	for _, job := range registry.Jobs() {
+		if !job.RunOnStart {
+			continue
+		}
+		_, err := runner.Trigger(context.Background(), job, "system")
+		if err != nil && !errors.Is(err, apperrors.ErrSchedulerJobRunning) {
+			// the cron ticks of the job still run
+			logger.Error("run on start not triggered", slog.String("job", string(job.Type)), slog.String("error", err.Error()))
+		}
+	}
+}
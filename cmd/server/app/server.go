@@ -18,9 +18,13 @@ const (
 )
 
 func (app *Application) ServeHTTP() error {
+	handler, err := app.routes()
+	if err != nil {
+		return err
+	}
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%d", app.Config.HttpPort),
-		Handler:      app.routes(),
+		Handler:      handler,
 		ErrorLog:     log.New(os.Stderr, "", 0),
 		IdleTimeout:  defaultIdleTimeout,
 		ReadTimeout:  defaultReadTimeout,
@@ -37,7 +41,7 @@ func (app *Application) ServeHTTP() error {
 
 	app.Logger.Info(fmt.Sprintf("starting server on %s", srv.Addr))
 
-	err := srv.ListenAndServe()
+	err = srv.ListenAndServe()
 	if !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	"github.com/samber/do"
+
+	webhookWorker "financing-offer/internal/core/webhook/transport/worker"
+)
+
+func (app *Application) StartWebhookDelivery() {
+	deliveryWorker := do.MustInvoke[*webhookWorker.DeliveryWorker](app.Injector)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		deliveryWorker.Run(ctx)
+	}()
+	app.Tasks.AddShutdownTask(
+		func(_ context.Context) error {
+			cancel()
+			<-done
+			return nil
+		},
+	)
+}
@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 
@@ -10,7 +11,9 @@ import (
 
 	"financing-offer/internal/appcontext"
 	"financing-offer/internal/apperrors"
+	featureHttp "financing-offer/internal/featureflag/transport/http"
 	"financing-offer/internal/jwttoken"
+	"financing-offer/internal/permission"
 )
 
 func (middleware *Middleware) Authenticate() gin.HandlerFunc {
@@ -79,6 +82,23 @@ func (middleware *Middleware) RequireOneOfRoles(allowedRoles ...string) gin.Hand
 	}
 }
 
+func (middleware *Middleware) RequirePermission(required permission.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authenticatedUser := appcontext.ContextGetCustomerInfo(c)
+		if authenticatedUser == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"Error": "Unauthorized"})
+			return
+		}
+		if !middleware.PermissionUseCase.HasPermission(authenticatedUser.Roles, required) {
+			c.AbortWithStatusJSON(
+				http.StatusForbidden, gin.H{"Error": "You are not allowed to perform this action"},
+			)
+			return
+		}
+		c.Next()
+	}
+}
+
 func matchRole(userRoles []string, allowedRoles []string) bool {
 	for _, userRole := range userRoles {
 		for _, allowedRole := range allowedRoles {
@@ -92,14 +112,12 @@ func matchRole(userRoles []string, allowedRoles []string) bool {
 
 func (middleware *Middleware) RequireFeatureEnable(featureName string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		customerInfo := appcontext.ContextGetCustomerInfo(c)
-		if customerInfo == nil || customerInfo.InvestorId == "" {
+		subject, err := featureHttp.FeatureFlagSubject(c)
+		if err != nil {
 			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"Error": "You're not allowed to perform this action"})
 			return
 		}
-		if isEnable, err := middleware.FeatureFlagUseCase.IsFeatureEnable(
-			featureName, customerInfo.InvestorId,
-		); err != nil || !isEnable {
+		if isEnable, err := middleware.FeatureFlagUseCase.IsFeatureEnable(c, featureName, subject); err != nil || !isEnable {
 			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"Error": "You're not allowed to perform this action"})
 			return
 		}
@@ -110,7 +128,7 @@ func (middleware *Middleware) RequireHOActive() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		isHOActive, err := middleware.FlexRepo.IsHOActive(c)
 		if err != nil {
-			middleware.Logger.Error("Error checking HO status ", err)
+			middleware.Logger.Error("Error checking HO status", slog.String("error", err.Error()))
 			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"Error": "an error happened, please try again later"})
 			return
 		}
@@ -0,0 +1,14 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/config"
+)
+
+// TrustProxies has engine read the client IP from the headers of the configured proxies only,
+// so that a client cannot pick the IP it is rate limited by
+func TrustProxies(engine *gin.Engine, cfg config.ProxyConfig) error {
+	engine.TrustedPlatform = cfg.TrustedPlatform
+	return engine.SetTrustedProxies(cfg.TrustedProxies)
+}
@@ -0,0 +1,21 @@
+package middlewares
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecated marks the responses of a route kept for a deprecation period, pointing the callers to its successor
+func (middleware *Middleware) Deprecated(successor string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		middleware.Logger.Warn(
+			"deprecated route called",
+			slog.String("route", c.FullPath()),
+			slog.String("successor", successor),
+		)
+		c.Header("Deprecation", "true")
+		c.Header("Link", "<"+successor+">; rel=\"successor-version\"")
+		c.Next()
+	}
+}
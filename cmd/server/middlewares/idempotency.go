@@ -0,0 +1,131 @@
+package middlewares
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/appcontext"
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/number"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	idempotencyResponseFormat = "application/json; charset=utf-8"
+)
+
+// bodyCaptureWriter keeps a copy of the response body so it can be stored for replays
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Idempotent replays the stored response when an investor retries a request with the same Idempotency-Key.
+// Requests without the header are passed through unchanged
+func (middleware *Middleware) Idempotent() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			abortWithAppError(c, apperrors.ErrIdempotencyKeyInvalid)
+			return
+		}
+		investor := appcontext.ContextGetCustomerInfo(c)
+		if investor == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"Error": "Unauthorized"})
+			return
+		}
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"Error": "cannot read request body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		record, err := middleware.IdempotencyUseCase.Begin(
+			c, investor.InvestorId, key, hashRequest(c.Request.Method, c.Request.URL.Path, body),
+		)
+		if err != nil {
+			var appErr apperrors.AppError
+			if errors.As(err, &appErr) {
+				abortWithAppError(c, appErr)
+				return
+			}
+			middleware.Logger.Error("Idempotent Begin", slog.String("error", err.Error()))
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"Error": "an error happened, please try again later"})
+			return
+		}
+		if record.Status == entity.IdempotencyRecordStatusCompleted {
+			c.Header(IdempotentReplayedHeader, "true")
+			c.Data(int(record.ResponseStatus), idempotencyResponseFormat, record.ResponseBody)
+			c.Abort()
+			return
+		}
+
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			// the handler failed or panicked, let the investor retry with the same key
+			if err := middleware.IdempotencyUseCase.Release(c, record); err != nil {
+				middleware.Logger.Error("Idempotent Release", slog.String("error", err.Error()))
+			}
+		}()
+		c.Next()
+		if c.Writer.Status() >= http.StatusInternalServerError {
+			return
+		}
+		if err := middleware.IdempotencyUseCase.Complete(
+			c, record, int32(c.Writer.Status()), writer.body.Bytes(),
+		); err != nil {
+			middleware.Logger.Error("Idempotent Complete", slog.String("error", err.Error()))
+			return
+		}
+		completed = true
+	}
+}
+
+func hashRequest(method string, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(path))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func abortWithAppError(c *gin.Context, appErr apperrors.AppError) {
+	c.AbortWithStatusJSON(
+		number.GetFirstThreeDigits(appErr.Code), gin.H{
+			"error": appErr.Message,
+			"code":  appErr.Code,
+		},
+	)
+}
@@ -5,7 +5,10 @@ import (
 
 	"financing-offer/internal/config"
 	flexOpenApiRepo "financing-offer/internal/core/flex/repository"
+	"financing-offer/internal/core/idempotency"
 	"financing-offer/internal/featureflag"
+	"financing-offer/internal/permission"
+	"financing-offer/internal/ratelimit"
 )
 
 type Middleware struct {
@@ -13,4 +16,7 @@ type Middleware struct {
 	Config             config.AppConfig
 	FeatureFlagUseCase featureflag.UseCase
 	FlexRepo           flexOpenApiRepo.FlexOpenApiRepository
+	IdempotencyUseCase idempotency.UseCase
+	RateLimiter        ratelimit.Limiter
+	PermissionUseCase  permission.UseCase
 }
@@ -0,0 +1,44 @@
+package middlewares
+
+import (
+	"log/slog"
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/appcontext"
+	"financing-offer/internal/apperrors"
+)
+
+const RetryAfterHeader = "Retry-After"
+
+// RateLimit applies the token bucket configured for group to each investor, or to each client IP when the caller is anonymous.
+// Requests are let through when the limiter itself fails
+func (middleware *Middleware) RateLimit(group string) gin.HandlerFunc {
+	rule, ok := middleware.Config.RateLimit.Groups[group]
+	if !middleware.Config.RateLimit.Enable || !ok || rule.Requests <= 0 || rule.Period <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+	return func(c *gin.Context) {
+		key := group + ":ip:" + c.ClientIP()
+		if investor := appcontext.ContextGetCustomerInfo(c); investor != nil && investor.InvestorId != "" {
+			key = group + ":investor:" + investor.InvestorId
+		}
+		decision, err := middleware.RateLimiter.Allow(c, key, rule)
+		if err != nil {
+			middleware.Logger.Error("RateLimit Allow", slog.String("error", err.Error()))
+			c.Next()
+			return
+		}
+		if !decision.Allowed {
+			retryAfter := int64(math.Ceil(decision.RetryAfter.Seconds()))
+			c.Header(RetryAfterHeader, strconv.FormatInt(retryAfter, 10))
+			abortWithAppError(c, apperrors.ErrTooManyRequests(retryAfter))
+			return
+		}
+		c.Next()
+	}
+}
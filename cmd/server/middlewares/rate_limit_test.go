@@ -0,0 +1,74 @@
+package middlewares
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/ratelimit"
+)
+
+func TestMiddleware_RateLimit(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	newEngine := func(t *testing.T, proxy config.ProxyConfig) *gin.Engine {
+		middleware := &Middleware{
+			Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+			Config: config.AppConfig{
+				RateLimit: config.RateLimitConfig{
+					Enable: true,
+					Groups: map[string]config.RateLimitRule{"public": {Requests: 1, Period: time.Hour}},
+				},
+			},
+			RateLimiter: ratelimit.NewInProcessLimiter(),
+		}
+		engine := gin.New()
+		assert.Nil(t, TrustProxies(engine, proxy))
+		engine.GET(
+			"/suggested-offers", middleware.RateLimit("public"), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			},
+		)
+		return engine
+	}
+	call := func(engine *gin.Engine, remoteAddr string, forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodGet, "/suggested-offers", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run(
+		"spoofed X-Forwarded-For is ignored", func(t *testing.T) {
+			engine := newEngine(t, config.ProxyConfig{TrustedProxies: []string{"10.0.0.0/8"}})
+			assert.Equal(t, http.StatusOK, call(engine, "203.0.113.7:4321", "198.51.100.1"))
+			assert.Equal(t, http.StatusTooManyRequests, call(engine, "203.0.113.7:4321", "198.51.100.2"))
+		},
+	)
+
+	t.Run(
+		"client IP forwarded by a trusted proxy", func(t *testing.T) {
+			engine := newEngine(t, config.ProxyConfig{TrustedProxies: []string{"10.0.0.0/8"}})
+			assert.Equal(t, http.StatusOK, call(engine, "10.1.2.3:4321", "198.51.100.1"))
+			assert.Equal(t, http.StatusOK, call(engine, "10.1.2.3:4321", "198.51.100.2"))
+			assert.Equal(t, http.StatusTooManyRequests, call(engine, "10.1.2.3:4321", "198.51.100.1"))
+		},
+	)
+
+	t.Run(
+		"no trusted proxy", func(t *testing.T) {
+			engine := newEngine(t, config.ProxyConfig{})
+			assert.Equal(t, http.StatusOK, call(engine, "10.1.2.3:4321", "198.51.100.1"))
+			assert.Equal(t, http.StatusTooManyRequests, call(engine, "10.1.2.3:4321", "198.51.100.2"))
+		},
+	)
+}
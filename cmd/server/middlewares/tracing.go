@@ -0,0 +1,21 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// TracingMiddleware starts the server span of every request, continuing the trace context sent by the caller.
+// The probes are not traced
+func (middleware *Middleware) TracingMiddleware() gin.HandlerFunc {
+	return otelgin.Middleware(
+		middleware.Config.Tracing.ServiceName,
+		otelgin.WithFilter(
+			func(r *http.Request) bool {
+				return r.URL.Path != "/status"
+			},
+		),
+	)
+}
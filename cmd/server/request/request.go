@@ -3,13 +3,32 @@ package request
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
 )
 
-const RequestIdKey = "requestId"
+const (
+	RequestIdKey        = "requestId"
+	RequestIdBaggageKey = "request_id"
+)
 
+// AddRequestId tags the request and its span with a new request id, the id also joins the baggage of the trace so it
+// travels with the trace context to the upstream calls, kafka messages and workflows of the request
 func AddRequestId() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		requestId, _ := uuid.NewRandom()
 		c.Set(RequestIdKey, requestId.String())
+		ctx := c.Request.Context()
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String(RequestIdBaggageKey, requestId.String()))
+		member, err := baggage.NewMember(RequestIdBaggageKey, requestId.String())
+		if err != nil {
+			return
+		}
+		bag, err := baggage.FromContext(ctx).SetMember(member)
+		if err != nil {
+			return
+		}
+		c.Request = c.Request.WithContext(baggage.ContextWithBaggage(ctx, bag))
 	}
 }
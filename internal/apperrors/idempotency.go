@@ -0,0 +1,14 @@
+package apperrors
+
+var (
+	ErrIdempotencyKeyInvalid = New(nil, WithCode(400_0035), WithMessage("invalid idempotency key"))
+	ErrIdempotencyKeyReused  = New(
+		nil, WithCode(409_0036), WithMessage("idempotency key was already used with a different payload"),
+	)
+	ErrIdempotencyKeyInProgress = New(
+		nil, WithCode(409_0037), WithMessage("a request with the same idempotency key is still being processed"),
+	)
+	ErrIdempotencyLeaseLost = New(
+		nil, WithCode(409_0051), WithMessage("the idempotency key was taken over by another request"),
+	)
+)
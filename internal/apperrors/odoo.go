@@ -0,0 +1,7 @@
+package apperrors
+
+import "fmt"
+
+func ErrInvalidOdooPayload(message string) AppError {
+	return New(nil, WithCode(400_0043), WithMessage(fmt.Sprintf("invalid odoo payload: %s", message)))
+}
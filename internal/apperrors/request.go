@@ -72,6 +72,23 @@ func ErrInvalidFlowType(ft entity.FlowType) AppError {
 	return New(nil, WithCode(400_0014), WithMessage(fmt.Sprintf("invalid flow type: %s", ft)))
 }
 
+// ErrInvalidRequestStatusTransition keeps the code of ErrInvalidRequestStatus so clients can keep matching on it
+func ErrInvalidRequestStatusTransition(from, to entity.LoanPackageRequestStatus) AppError {
+	return New(
+		nil, WithCode(400_0003), WithMessage(
+			fmt.Sprintf("invalid request status transition from %s to %s", from, to),
+		),
+	)
+}
+
 func ErrInvalidInput(message string) AppError {
 	return New(nil, WithCode(400_0019), WithMessage(message))
 }
+
+func ErrTooManyRequests(retryAfterSeconds int64) AppError {
+	return New(
+		nil, WithCode(429_0038), WithMessage(
+			fmt.Sprintf("too many requests, please retry after %d seconds", retryAfterSeconds),
+		),
+	)
+}
@@ -0,0 +1,11 @@
+package apperrors
+
+var (
+	ErrSchedulerJobNotFound = New(nil, WithCode(404_0044), WithMessage("scheduler job not found"))
+	ErrSchedulerJobRunning  = New(
+		nil, WithCode(409_0045), WithMessage("scheduler job is already running on another instance"),
+	)
+	ErrSchedulerJobTickTaken = New(
+		nil, WithCode(409_0050), WithMessage("scheduler job tick was already run by another instance"),
+	)
+)
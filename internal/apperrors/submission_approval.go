@@ -0,0 +1,14 @@
+package apperrors
+
+var (
+	ErrSubmissionSelfApproval = New(
+		nil, WithCode(403_0039), WithMessage("submission must be approved by a user other than its creator"),
+	)
+	ErrSubmissionAlreadyApproved = New(
+		nil, WithCode(409_0040), WithMessage("submission was already approved by this user"),
+	)
+	ErrApprovalDelegationNotFound = New(
+		nil, WithCode(403_0041), WithMessage("no active approval delegation from this user"),
+	)
+	ErrInvalidApprovalDelegation = New(nil, WithCode(400_0042), WithMessage("invalid approval delegation"))
+)
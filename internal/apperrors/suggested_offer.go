@@ -0,0 +1,19 @@
+package apperrors
+
+import (
+	"fmt"
+
+	"financing-offer/internal/core/entity"
+)
+
+var ErrSuggestedOfferWithoutInvestor = New(
+	nil, WithCode(400_0046), WithMessage("suggested offer has no investor, it cannot be converted"),
+)
+
+func ErrInvalidSuggestedOfferStatusTransition(from, to entity.SuggestedOfferStatus) AppError {
+	return New(
+		nil, WithCode(409_0047), WithMessage(
+			fmt.Sprintf("invalid suggested offer status transition from %s to %s", from, to),
+		),
+	)
+}
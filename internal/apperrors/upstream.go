@@ -0,0 +1,14 @@
+package apperrors
+
+import "fmt"
+
+// ErrUpstreamUnavailable is returned when an upstream service cannot be reached, its circuit breaker is open
+// or all its call slots are taken
+func ErrUpstreamUnavailable(upstream string, err error) AppError {
+	return New(err, WithCode(503_0048), WithMessage(fmt.Sprintf("upstream %s unavailable: ", upstream)))
+}
+
+// ErrUpstreamTimeout is returned when an upstream service did not answer in time
+func ErrUpstreamTimeout(upstream string, err error) AppError {
+	return New(err, WithCode(504_0049), WithMessage(fmt.Sprintf("upstream %s timed out: ", upstream)))
+}
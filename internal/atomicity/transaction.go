@@ -4,11 +4,15 @@ import (
 	"context"
 	"database/sql"
 
+	"financing-offer/internal/appcontext"
 	"financing-offer/internal/apperrors"
 )
 
 const TxKey = "transactionInstance"
 
+// auditActorSetting is read by the audit trigger to record the application user behind a change
+const auditActorSetting = "audit.actor"
+
 type DbAtomicExecutor struct {
 	DB *sql.DB
 }
@@ -18,6 +22,12 @@ func (e *DbAtomicExecutor) Execute(parentCtx context.Context, executeFunc func(c
 	if err != nil {
 		return apperrors.New(err, apperrors.WithCode(500), apperrors.WithMessage("begin transaction"))
 	}
+	if actor := auditActor(parentCtx); actor != "" {
+		if _, err := tx.ExecContext(parentCtx, "SELECT set_config($1, $2, true)", auditActorSetting, actor); err != nil {
+			_ = tx.Rollback()
+			return apperrors.New(err, apperrors.WithCode(500), apperrors.WithMessage("set audit actor"))
+		}
+	}
 	transactionalCtx := ContextSetTx(parentCtx, tx)
 	defer func() {
 		if r := recover(); r != nil {
@@ -40,6 +50,17 @@ func (e *DbAtomicExecutor) Execute(parentCtx context.Context, executeFunc func(c
 	return err
 }
 
+func auditActor(ctx context.Context) string {
+	user := appcontext.ContextGetCustomerInfo(ctx)
+	if user == nil {
+		return ""
+	}
+	if user.Sub != "" {
+		return user.Sub
+	}
+	return user.Username
+}
+
 func ContextSetTx(ctx context.Context, tx *sql.Tx) context.Context {
 	return context.WithValue(ctx, TxKey, tx)
 }
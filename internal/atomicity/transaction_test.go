@@ -7,6 +7,9 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/appcontext"
+	"financing-offer/internal/jwttoken"
 )
 
 func TestAtomicExecutor(t *testing.T) {
@@ -104,4 +107,43 @@ func TestAtomicExecutor(t *testing.T) {
 			assert.NotNil(t, err)
 		},
 	)
+
+	t.Run(
+		"tx sets audit actor", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec("SELECT set_config").WithArgs("audit.actor", "dnse.admin@dnse.com.vn").
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectCommit()
+			executor := DbAtomicExecutor{DB: db}
+			ctx := context.WithValue(
+				context.Background(), appcontext.UserInformation, &jwttoken.AdminClaims{Sub: "dnse.admin@dnse.com.vn"},
+			)
+			err := executor.Execute(
+				ctx, func(tc context.Context) error {
+					return nil
+				},
+			)
+			assert.Nil(t, err)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"cannot set audit actor", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec("SELECT set_config").WillReturnError(errors.New("set config error"))
+			mock.ExpectRollback()
+			executor := DbAtomicExecutor{DB: db}
+			ctx := context.WithValue(
+				context.Background(), appcontext.UserInformation, &jwttoken.AdminClaims{Sub: "dnse.admin@dnse.com.vn"},
+			)
+			err := executor.Execute(
+				ctx, func(tc context.Context) error {
+					t.Error("execute func must not run")
+					return nil
+				},
+			)
+			assert.NotNil(t, err)
+		},
+	)
 }
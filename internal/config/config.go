@@ -9,20 +9,23 @@ import (
 	"github.com/knadh/koanf/v2"
 	iofs "io/fs"
 	"strings"
+	"time"
 )
 
 const (
 	EnvPrefix     = "APP__"
 	EnvProduction = "prod"
 
-	SavingsTaskQueueName = "savings_queue"
+	SavingsTaskQueueName     = "savings_queue"
+	LoanRequestTaskQueueName = "financing_offer_loan_request_queue"
 )
 
 type AppConfig struct {
-	Env         string   `koanf:"env"`
-	HttpPort    int      `koanf:"httpPort"`
-	ConnectPort int      `koanf:"connectPort"`
-	Db          DbConfig `koanf:"db"`
+	Env         string      `koanf:"env"`
+	HttpPort    int         `koanf:"httpPort"`
+	ConnectPort int         `koanf:"connectPort"`
+	Proxy       ProxyConfig `koanf:"proxy"`
+	Db          DbConfig    `koanf:"db"`
 	Jwt         struct {
 		PublicKey string `koanf:"publicKey"`
 	} `koanf:"jwt"`
@@ -35,19 +38,34 @@ type AppConfig struct {
 	Mattermost struct {
 		WebhookUrl string `koanf:"webhookUrl"`
 	} `koanf:"mattermost"`
-	Cron              Cron                     `koanf:"cron"`
-	Temporal          TemporalClientConfig     `koanf:"temporal"`
-	FinancialProduct  FinancialProductConfig   `koanf:"financialProduct"`
-	MoService         MoServiceConfig          `koanf:"moService"`
-	Features          map[string]FeatureConfig `koanf:"features"`
-	LoanRequest       LoanRequestConfig        `koanf:"loanRequest"`
-	FinancingApi      FinancingApiConfig       `koanf:"financingApi"`
-	BestPromotions    BestPromotionsConfig     `koanf:"bestPromotions"`
-	OrderService      OrderServiceConfig       `koanf:"orderService"`
-	FlexOpenApi       FlexOpenApiConfig        `koanf:"flexOpenApi"`
-	OdooService       OdooServiceConfig        `koanf:"OdooService"`
-	ProductCategoryId int64                    `koanf:"productCategoryId"`
-	OdooCategoryId    int64                    `koanf:"odooCategoryId"`
+	Cron                Cron                      `koanf:"cron"`
+	Jobs                map[string]JobConfig      `koanf:"jobs"`
+	Temporal            TemporalClientConfig      `koanf:"temporal"`
+	FinancialProduct    FinancialProductConfig    `koanf:"financialProduct"`
+	MoService           MoServiceConfig           `koanf:"moService"`
+	Features            map[string]FeatureConfig  `koanf:"features"`
+	LoanRequest         LoanRequestConfig         `koanf:"loanRequest"`
+	FinancingApi        FinancingApiConfig        `koanf:"financingApi"`
+	BestPromotions      BestPromotionsConfig      `koanf:"bestPromotions"`
+	OrderService        OrderServiceConfig        `koanf:"orderService"`
+	FlexOpenApi         FlexOpenApiConfig         `koanf:"flexOpenApi"`
+	OdooService         OdooServiceConfig         `koanf:"OdooService"`
+	ProductCategoryId   int64                     `koanf:"productCategoryId"`
+	OdooCategoryId      int64                     `koanf:"odooCategoryId"`
+	Outbox              OutboxConfig              `koanf:"outbox"`
+	Cdc                 CdcConfig                 `koanf:"cdc"`
+	Idempotency         IdempotencyConfig         `koanf:"idempotency"`
+	RateLimit           RateLimitConfig           `koanf:"rateLimit"`
+	Permissions         map[string][]string       `koanf:"permissions"`
+	SubmissionApproval  SubmissionApprovalConfig  `koanf:"submissionApproval"`
+	OdooSync            OdooSyncConfig            `koanf:"odooSync"`
+	LoanPackageCreation LoanPackageCreationConfig `koanf:"loanPackageCreation"`
+	TradingCalendar     TradingCalendarConfig     `koanf:"tradingCalendar"`
+	Webhook             WebhookConfig             `koanf:"webhook"`
+	Metrics             MetricsConfig             `koanf:"metrics"`
+	Tracing             TracingConfig             `koanf:"tracing"`
+	Cache               CacheConfig               `koanf:"cache"`
+	FeatureFlag         FeatureFlagConfig         `koanf:"featureFlag"`
 }
 
 type LoanRequestConfig struct {
@@ -59,36 +77,127 @@ type LoanRequestConfig struct {
 	DeclinedRequestDisplayPeriod int     `koanf:"declinedRequestDisplayPeriod"`
 }
 
+// TradingCalendarConfig sets the HH:MM session times of a trading day in Timezone,
+// the session of a half day closes at HalfDayCloseTime unless the day sets its own close
+type TradingCalendarConfig struct {
+	Timezone         string                    `koanf:"timezone"`
+	OpenTime         string                    `koanf:"openTime"`
+	CloseTime        string                    `koanf:"closeTime"`
+	HalfDayCloseTime string                    `koanf:"halfDayCloseTime"`
+	CutOffTime       string                    `koanf:"cutOffTime"`
+	Sync             TradingCalendarSyncConfig `koanf:"sync"`
+}
+
+// TradingCalendarSyncConfig seeds the holidays of the next HorizonDays days from the financing-api
+type TradingCalendarSyncConfig struct {
+	Enable      bool `koanf:"enable"`
+	HorizonDays int  `koanf:"horizonDays"`
+}
+
 type FeatureConfig struct {
 	Enable      bool     `koanf:"enable"`
 	InvestorIds []string `koanf:"investorIds"`
 }
 
+// FeatureFlagConfig sets how long the flags stored in postgres are kept in process without a change being notified,
+// a change notified by postgres reloads them on the next read
+type FeatureFlagConfig struct {
+	RefreshInterval time.Duration `koanf:"refreshInterval"`
+}
+
 type FinancialProductConfig struct {
-	Url   string `koanf:"url"`
-	Token string `koanf:"token"`
+	Url   string           `koanf:"url"`
+	Token string           `koanf:"token"`
+	Http  HttpClientConfig `koanf:"http"`
 }
 
 type MoServiceConfig struct {
-	Url   string `koanf:"url"`
-	Token string `koanf:"token"`
+	Url   string           `koanf:"url"`
+	Token string           `koanf:"token"`
+	Http  HttpClientConfig `koanf:"http"`
 }
 
 type FinancingApiConfig struct {
-	Url   string `koanf:"url"`
-	Token string `koanf:"token"`
+	Url   string           `koanf:"url"`
+	Token string           `koanf:"token"`
+	Http  HttpClientConfig `koanf:"http"`
 }
 
 type OrderServiceConfig struct {
-	Url   string `koanf:"url"`
-	Token string `koanf:"token"`
+	Url   string           `koanf:"url"`
+	Token string           `koanf:"token"`
+	Http  HttpClientConfig `koanf:"http"`
 }
 
 type OdooServiceConfig struct {
-	Url      string `koanf:"url"`
-	Db       string `koanf:"db"`
-	Uid      string `koanf:"uid"`
-	Password string `koanf:"password"`
+	Url      string                     `koanf:"url"`
+	Db       string                     `koanf:"db"`
+	Uid      string                     `koanf:"uid"`
+	Password string                     `koanf:"password"`
+	Models   map[string]OdooModelConfig `koanf:"models"`
+}
+
+// OdooModelConfig maps an approval payload onto the fields of an Odoo model, Environments replaces
+// the model, timezone or fields that are set for the environment the service runs in
+type OdooModelConfig struct {
+	Model        string                     `koanf:"model"`
+	Timezone     string                     `koanf:"timezone"`
+	Fields       []OdooFieldConfig          `koanf:"fields"`
+	Environments map[string]OdooModelConfig `koanf:"environments"`
+}
+
+// OdooFieldConfig sends the Source value of a payload as the Odoo field Name.
+// Type is one of string, integer, float or datetime, Decimals rounds decimal values and Layout formats dates
+type OdooFieldConfig struct {
+	Name      string `koanf:"name"`
+	Source    string `koanf:"source"`
+	Type      string `koanf:"type"`
+	Decimals  *int32 `koanf:"decimals"`
+	Layout    string `koanf:"layout"`
+	Prefix    string `koanf:"prefix"`
+	Required  bool   `koanf:"required"`
+	OmitEmpty bool   `koanf:"omitEmpty"`
+}
+
+const OdooLoanApprovalModel = "loanApproval"
+
+// ForEnv resolves the environment specific model configurations
+func (c OdooServiceConfig) ForEnv(env string) OdooServiceConfig {
+	models := make(map[string]OdooModelConfig, len(c.Models))
+	for name, model := range c.Models {
+		override, ok := model.Environments[env]
+		if ok {
+			if override.Model != "" {
+				model.Model = override.Model
+			}
+			if override.Timezone != "" {
+				model.Timezone = override.Timezone
+			}
+			if len(override.Fields) > 0 {
+				model.Fields = override.Fields
+			}
+		}
+		model.Environments = nil
+		models[name] = model
+	}
+	c.Models = models
+	return c
+}
+
+// OdooSyncConfig controls how approval decisions are pulled back from Odoo, failed applications are retried
+// with exponential backoff from RetryBackoff up to MaxRetryBackoff
+type OdooSyncConfig struct {
+	BatchSize       int64         `koanf:"batchSize"`
+	MaxAttempts     int32         `koanf:"maxAttempts"`
+	RetryBackoff    time.Duration `koanf:"retryBackoff"`
+	MaxRetryBackoff time.Duration `koanf:"maxRetryBackoff"`
+}
+
+// LoanPackageCreationConfig controls the reconciliation of offer lines left creating their loan package,
+// lines untouched for StaleAfter are settled from the outcome of their workflow
+type LoanPackageCreationConfig struct {
+	StaleAfter time.Duration `koanf:"staleAfter"`
+	BatchSize  int64         `koanf:"batchSize"`
 }
 
 type BestPromotionsConfig struct {
@@ -106,19 +215,188 @@ type DbConfig struct {
 }
 
 type KafkaConfig struct {
-	Host              string `koanf:"host"`
-	Retry             int    `koanf:"retry"`
-	NotificationTopic string `koanf:"notificationTopic"`
+	Host              string              `koanf:"host"`
+	Retry             int                 `koanf:"retry"`
+	NotificationTopic string              `koanf:"notificationTopic"`
+	Consumer          KafkaConsumerConfig `koanf:"consumer"`
+}
+
+// KafkaStartOffsetFirst makes a new consumer group read the topics from the oldest retained message instead of the newest
+const KafkaStartOffsetFirst = "first"
+
+type KafkaConsumerConfig struct {
+	Enable                    bool          `koanf:"enable"`
+	GroupId                   string        `koanf:"groupId"`
+	StartOffset               string        `koanf:"startOffset"`
+	MaxAttempts               int           `koanf:"maxAttempts"`
+	RetryBackoff              time.Duration `koanf:"retryBackoff"`
+	MaxRetryBackoff           time.Duration `koanf:"maxRetryBackoff"`
+	DlqTopicSuffix            string        `koanf:"dlqTopicSuffix"`
+	LoanPackageActivatedTopic string        `koanf:"loanPackageActivatedTopic"`
+	AccountMarginChangedTopic string        `koanf:"accountMarginChangedTopic"`
+}
+
+type OutboxConfig struct {
+	PollInterval    time.Duration `koanf:"pollInterval"`
+	BatchSize       int64         `koanf:"batchSize"`
+	MaxAttempts     int32         `koanf:"maxAttempts"`
+	RetryBackoff    time.Duration `koanf:"retryBackoff"`
+	MaxRetryBackoff time.Duration `koanf:"maxRetryBackoff"`
+}
+
+// WebhookConfig sets the delivery of webhook events, a failed delivery is retried with a doubling backoff
+// until MaxAttempts, Timeout bounds one request to a subscriber, ClaimLease hides a claimed batch from other pollers
+// and must outlast BatchSize requests of Timeout
+type WebhookConfig struct {
+	PollInterval    time.Duration `koanf:"pollInterval"`
+	BatchSize       int64         `koanf:"batchSize"`
+	MaxAttempts     int32         `koanf:"maxAttempts"`
+	RetryBackoff    time.Duration `koanf:"retryBackoff"`
+	MaxRetryBackoff time.Duration `koanf:"maxRetryBackoff"`
+	Timeout         time.Duration `koanf:"timeout"`
+	ClaimLease      time.Duration `koanf:"claimLease"`
+}
+
+// MetricsConfig sets the business gauges read on every scrape of /metrics, an offer with a pending line counts as
+// nearing expiry within OfferExpiryWindow of its expiry and a line is stale once PACKAGE_CREATING for
+// PackageCreatingStaleAfter, KpiTimeout bounds the queries of one scrape. /metrics is served on the internal Port
+// only, never next to the public routes
+type MetricsConfig struct {
+	Port                      int           `koanf:"port"`
+	EnableKpi                 bool          `koanf:"enableKpi"`
+	OfferExpiryWindow         time.Duration `koanf:"offerExpiryWindow"`
+	PackageCreatingStaleAfter time.Duration `koanf:"packageCreatingStaleAfter"`
+	KpiTimeout                time.Duration `koanf:"kpiTimeout"`
+}
+
+// TracingConfig sets where the spans of the service go, the otlp exporter sends them over http to Endpoint and the
+// noop exporter drops them while still propagating the trace context. SampleRatio is the share of new traces recorded,
+// a trace started upstream keeps the sampling decision of its parent
+type TracingConfig struct {
+	Exporter    string  `koanf:"exporter"`
+	Endpoint    string  `koanf:"endpoint"`
+	Insecure    bool    `koanf:"insecure"`
+	ServiceName string  `koanf:"serviceName"`
+	SampleRatio float64 `koanf:"sampleRatio"`
 }
 
+const (
+	TracingExporterOtlp = "otlp"
+	TracingExporterNoop = "noop"
+)
+
+// HttpClientConfig sets the resilience of the calls to one upstream service. Timeout bounds one attempt, an idempotent
+// request failing on the transport or with a 5xx is retried MaxRetries times with a jittered backoff doubling from
+// RetryBackoff up to MaxRetryBackoff, TotalTimeout bounds the call with all its attempts. BreakerThreshold consecutive
+// failed calls open the circuit breaker of the upstream for BreakerOpenDuration, MaxConcurrent bounds the calls in
+// flight and a call waits up to MaxWait for a free one. Zero fields take the httpclient defaults
+type HttpClientConfig struct {
+	Timeout             time.Duration `koanf:"timeout"`
+	MaxRetries          int           `koanf:"maxRetries"`
+	RetryBackoff        time.Duration `koanf:"retryBackoff"`
+	MaxRetryBackoff     time.Duration `koanf:"maxRetryBackoff"`
+	BreakerThreshold    int           `koanf:"breakerThreshold"`
+	BreakerOpenDuration time.Duration `koanf:"breakerOpenDuration"`
+	MaxConcurrent       int           `koanf:"maxConcurrent"`
+	MaxWait             time.Duration `koanf:"maxWait"`
+	TotalTimeout        time.Duration `koanf:"totalTimeout"`
+}
+
+type CdcConfig struct {
+	Enable         bool          `koanf:"enable"`
+	TopicPrefix    string        `koanf:"topicPrefix"`
+	ReplayInterval time.Duration `koanf:"replayInterval"`
+	ReplayDelay    time.Duration `koanf:"replayDelay"`
+	Retention      time.Duration `koanf:"retention"`
+	BatchSize      int64         `koanf:"batchSize"`
+}
+
+// IdempotencyConfig keeps the responses of the keys for Retention. A key left IN_PROGRESS by a request that
+// crashed can be taken over by a retry once it has not been updated for InProgressLease
+type IdempotencyConfig struct {
+	Retention       time.Duration `koanf:"retention"`
+	InProgressLease time.Duration `koanf:"inProgressLease"`
+}
+
+// SubmissionApprovalConfig requires a second approver once a sheet's loan rate or the request's limit amount
+// reaches its threshold, a zero threshold never requires one
+type SubmissionApprovalConfig struct {
+	DualApprovalLoanRate    float64       `koanf:"dualApprovalLoanRate"`
+	DualApprovalLimitAmount float64       `koanf:"dualApprovalLimitAmount"`
+	ApprovalTtl             time.Duration `koanf:"approvalTtl"`
+	MaxDelegationDuration   time.Duration `koanf:"maxDelegationDuration"`
+}
+
+// ProxyConfig tells which proxies in front of the service are trusted to forward the client IP.
+// TrustedProxies lists their IPs or CIDRs, the X-Forwarded-For header sent by anyone else is ignored.
+// TrustedPlatform names the header a platform such as a CDN sets the client IP in, it takes precedence when set
+type ProxyConfig struct {
+	TrustedProxies  []string `koanf:"trustedProxies"`
+	TrustedPlatform string   `koanf:"trustedPlatform"`
+}
+
+type RateLimitConfig struct {
+	Enable    bool                     `koanf:"enable"`
+	Store     string                   `koanf:"store"`
+	Retention time.Duration            `koanf:"retention"`
+	Groups    map[string]RateLimitRule `koanf:"groups"`
+}
+
+// RateLimitRule allows Requests per Period with bursts up to Burst requests, Burst defaults to Requests
+type RateLimitRule struct {
+	Requests int           `koanf:"requests"`
+	Period   time.Duration `koanf:"period"`
+	Burst    int           `koanf:"burst"`
+}
+
+const (
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+)
+
+// CacheConfig selects where the cached values live, the postgres store shares them between replicas which keep
+// each value locally for LocalTtl at most. Ttls overrides the ttl of the keys starting with each of its prefixes
+type CacheConfig struct {
+	Store            string                   `koanf:"store"`
+	LocalTtl         time.Duration            `koanf:"localTtl"`
+	OperationTimeout time.Duration            `koanf:"operationTimeout"`
+	Ttls             map[string]time.Duration `koanf:"ttls"`
+}
+
+const (
+	CacheStoreMemory   = "memory"
+	CacheStorePostgres = "postgres"
+)
+
 type TemporalClientConfig struct {
-	Host      string `koanf:"host"`
-	Namespace string `koanf:"namespace"`
+	Host      string               `koanf:"host"`
+	Namespace string               `koanf:"namespace"`
+	Worker    TemporalWorkerConfig `koanf:"worker"`
+}
+
+// TemporalWorkerConfig controls the worker running the loan request lifecycle workflows of this service,
+// the durable offer expiry timers of the workflows replace the ExpireLoanOffers cron job while it is enabled
+type TemporalWorkerConfig struct {
+	Enable          bool          `koanf:"enable"`
+	RecheckInterval time.Duration `koanf:"recheckInterval"`
 }
 
 type Cron struct {
-	ExpireLoanOffers    string `koanf:"expireLoanOffers"`
-	DeclineLoanRequests string `koanf:"declineLoanRequests"`
+	ExpireLoanOffers             string `koanf:"expireLoanOffers"`
+	DeclineLoanRequests          string `koanf:"declineLoanRequests"`
+	PurgeIdempotency             string `koanf:"purgeIdempotency"`
+	PurgeRateLimits              string `koanf:"purgeRateLimits"`
+	SyncOdooApprovals            string `koanf:"syncOdooApprovals"`
+	ReconcileLoanPackageCreation string `koanf:"reconcileLoanPackageCreation"`
+	SyncTradingCalendar          string `koanf:"syncTradingCalendar"`
+	PurgeCacheEntries            string `koanf:"purgeCacheEntries"`
+}
+
+// JobConfig is the run policy of a scheduler job keyed by its type in Jobs, a job left out runs once with the default timeout
+type JobConfig struct {
+	Timeout      time.Duration `koanf:"timeout"`
+	MaxAttempts  int           `koanf:"maxAttempts"`
+	RetryBackoff time.Duration `koanf:"retryBackoff"`
 }
 
 type MarginPoolConfig struct {
@@ -126,9 +404,10 @@ type MarginPoolConfig struct {
 }
 
 type FlexOpenApiConfig struct {
-	Url      string `koanf:"url"`
-	Username string `koanf:"username"`
-	Password string `koanf:"password"`
+	Url      string           `koanf:"url"`
+	Username string           `koanf:"username"`
+	Password string           `koanf:"password"`
+	Http     HttpClientConfig `koanf:"http"`
 }
 
 func InitConfig[T any](configFile iofs.FS) (T, error) {
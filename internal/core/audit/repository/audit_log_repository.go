@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"financing-offer/internal/core/entity"
+)
+
+type AuditLogRepository interface {
+	// GetAll returns the row level actions recorded in audit.logged_actions, latest first
+	GetAll(ctx context.Context, filter entity.AuditLogFilter) ([]entity.AuditLog, error)
+	Count(ctx context.Context, filter entity.AuditLogFilter) (int64, error)
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+
+	"financing-offer/internal/core/audit/repository"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+)
+
+var _ repository.AuditLogRepository = (*AuditLogPostgresRepository)(nil)
+
+type AuditLogPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewAuditLogPostgresRepository(getDbFunc database.GetDbFunc) *AuditLogPostgresRepository {
+	return &AuditLogPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *AuditLogPostgresRepository) GetAll(ctx context.Context, filter entity.AuditLogFilter) ([]entity.AuditLog, error) {
+	stm := loggedActions.SELECT(loggedActions.AllColumns).
+		WHERE(applyFilter(filter)).
+		ORDER_BY(loggedActions.EventID.DESC())
+	if limit := filter.Limit(); limit > 0 {
+		stm = stm.LIMIT(limit).OFFSET(filter.Offset())
+	}
+	dest := make([]loggedAction, 0)
+	if err := stm.QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []entity.AuditLog{}, nil
+		}
+		return nil, fmt.Errorf("AuditLogPostgresRepository GetAll %w", err)
+	}
+	return MapLoggedActionsToEntity(dest), nil
+}
+
+func (r *AuditLogPostgresRepository) Count(ctx context.Context, filter entity.AuditLogFilter) (int64, error) {
+	dest := struct {
+		Count int64
+	}{}
+	if err := loggedActions.SELECT(postgres.COUNT(loggedActions.EventID).AS("count")).
+		WHERE(applyFilter(filter)).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("AuditLogPostgresRepository Count %w", err)
+	}
+	return dest.Count, nil
+}
+
+func applyFilter(filter entity.AuditLogFilter) postgres.BoolExpression {
+	// statement level events carry no row data and cannot be turned into diffs
+	condition := loggedActions.StatementOnly.IS_FALSE()
+	if filter.TableName.IsPresent() {
+		condition = condition.AND(loggedActions.TableName.EQ(postgres.String(filter.TableName.Get())))
+	}
+	if filter.RowId.IsPresent() {
+		condition = condition.AND(
+			postgres.RawBool(
+				"logged_actions.row_data -> 'id' = #rowId", postgres.RawArgs{"#rowId": filter.RowId.Get()},
+			),
+		)
+	}
+	if filter.Actor.IsPresent() {
+		condition = condition.AND(loggedActions.Actor.EQ(postgres.String(filter.Actor.Get())))
+	}
+	if filter.Action.IsPresent() {
+		condition = condition.AND(loggedActions.Action.EQ(postgres.String(filter.Action.Get().Code())))
+	}
+	if filter.From.IsPresent() {
+		condition = condition.AND(loggedActions.ActionTstampTx.GT_EQ(postgres.TimestampzT(filter.From.Get())))
+	}
+	if filter.To.IsPresent() {
+		condition = condition.AND(loggedActions.ActionTstampTx.LT(postgres.TimestampzT(filter.To.Get())))
+	}
+	return condition
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+	"financing-offer/pkg/optional"
+)
+
+func TestAuditLogPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, _ := dbtest.New()
+	repo := NewAuditLogPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	columns := []string{
+		"logged_actions.event_id",
+		"logged_actions.table_name",
+		"logged_actions.session_user_name",
+		"logged_actions.action_tstamp_tx",
+		"logged_actions.action",
+		"logged_actions.row_data",
+		"logged_actions.changed_fields",
+		"logged_actions.statement_only",
+		"logged_actions.actor",
+	}
+
+	t.Run(
+		"GetAllSuccess", func(t *testing.T) {
+			now := time.Now()
+			mock.ExpectQuery(`(?s)SELECT .+ FROM audit.logged_actions .+row_data -> 'id' = .+ORDER BY logged_actions.event_id DESC`).
+				WillReturnRows(
+					mock.NewRows(columns).
+						AddRow(
+							2, "symbol", "finoffer", now, "U",
+							[]byte(`"id"=>"1", "status"=>"ACTIVE", "last_updated_by"=>NULL`),
+							[]byte(`"status"=>"INACTIVE", "last_updated_by"=>"dnse.admin"`), false, "dnse.admin",
+						).
+						AddRow(1, "symbol", "finoffer", now, "I", []byte(`"id"=>"1", "status"=>"ACTIVE"`), nil, false, nil),
+				)
+			auditLogs, err := repo.GetAll(
+				context.Background(), entity.AuditLogFilter{
+					Paging:    core.Paging{Size: 10, Number: 1},
+					TableName: optional.Some("symbol"),
+					RowId:     optional.Some("1"),
+				},
+			)
+			assert.Nil(t, err)
+			assert.Len(t, auditLogs, 2)
+			inactive, admin, active, id := "INACTIVE", "dnse.admin", "ACTIVE", "1"
+			assert.Equal(
+				t, entity.AuditLog{
+					EventId:     2,
+					TableName:   "symbol",
+					RowId:       "1",
+					Action:      entity.AuditActionUpdate,
+					Actor:       "dnse.admin",
+					SessionUser: "finoffer",
+					ActionAt:    now,
+					Changes: []entity.AuditFieldChange{
+						{Field: "last_updated_by", OldValue: nil, NewValue: &admin},
+						{Field: "status", OldValue: &active, NewValue: &inactive},
+					},
+				}, auditLogs[0],
+			)
+			assert.Equal(t, entity.AuditActionInsert, auditLogs[1].Action)
+			assert.Equal(t, "", auditLogs[1].Actor)
+			assert.Equal(
+				t, []entity.AuditFieldChange{
+					{Field: "id", NewValue: &id},
+					{Field: "status", NewValue: &active},
+				}, auditLogs[1].Changes,
+			)
+		},
+	)
+
+	t.Run(
+		"GetAllFailure", func(t *testing.T) {
+			mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("error"))
+			_, err := repo.GetAll(context.Background(), entity.AuditLogFilter{})
+			assert.Equal(t, "AuditLogPostgresRepository GetAll jet: error", err.Error())
+		},
+	)
+
+	t.Run(
+		"CountSuccess", func(t *testing.T) {
+			mock.ExpectQuery(`(?s)SELECT COUNT\(logged_actions.event_id\).+logged_actions.actor = `).
+				WillReturnRows(mock.NewRows([]string{"count"}).AddRow(3))
+			count, err := repo.Count(
+				context.Background(), entity.AuditLogFilter{
+					Actor:  optional.Some("dnse.admin"),
+					Action: optional.Some(entity.AuditActionDelete),
+				},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(3), count)
+		},
+	)
+
+	t.Run(
+		"CountFailure", func(t *testing.T) {
+			mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("error"))
+			_, err := repo.Count(context.Background(), entity.AuditLogFilter{})
+			assert.Equal(t, "AuditLogPostgresRepository Count jet: error", err.Error())
+		},
+	)
+}
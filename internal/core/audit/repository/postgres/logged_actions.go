@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/lib/pq/hstore"
+)
+
+// loggedActions declares audit.logged_actions by hand, the generated models only cover the public schema
+var loggedActions = newLoggedActionsTable()
+
+type loggedActionsTable struct {
+	postgres.Table
+
+	EventID         postgres.ColumnInteger
+	TableName       postgres.ColumnString
+	SessionUserName postgres.ColumnString
+	ActionTstampTx  postgres.ColumnTimestampz
+	Action          postgres.ColumnString
+	RowData         postgres.ColumnString
+	ChangedFields   postgres.ColumnString
+	StatementOnly   postgres.ColumnBool
+	Actor           postgres.ColumnString
+
+	AllColumns postgres.ColumnList
+}
+
+func newLoggedActionsTable() *loggedActionsTable {
+	var (
+		eventIDColumn         = postgres.IntegerColumn("event_id")
+		tableNameColumn       = postgres.StringColumn("table_name")
+		sessionUserNameColumn = postgres.StringColumn("session_user_name")
+		actionTstampTxColumn  = postgres.TimestampzColumn("action_tstamp_tx")
+		actionColumn          = postgres.StringColumn("action")
+		rowDataColumn         = postgres.StringColumn("row_data")
+		changedFieldsColumn   = postgres.StringColumn("changed_fields")
+		statementOnlyColumn   = postgres.BoolColumn("statement_only")
+		actorColumn           = postgres.StringColumn("actor")
+		allColumns            = postgres.ColumnList{
+			eventIDColumn, tableNameColumn, sessionUserNameColumn, actionTstampTxColumn, actionColumn,
+			rowDataColumn, changedFieldsColumn, statementOnlyColumn, actorColumn,
+		}
+	)
+	return &loggedActionsTable{
+		Table:           postgres.NewTable("audit", "logged_actions", "", allColumns...),
+		EventID:         eventIDColumn,
+		TableName:       tableNameColumn,
+		SessionUserName: sessionUserNameColumn,
+		ActionTstampTx:  actionTstampTxColumn,
+		Action:          actionColumn,
+		RowData:         rowDataColumn,
+		ChangedFields:   changedFieldsColumn,
+		StatementOnly:   statementOnlyColumn,
+		Actor:           actorColumn,
+		AllColumns:      allColumns,
+	}
+}
+
+type loggedAction struct {
+	EventID         int64         `sql:"primary_key" alias:"logged_actions.event_id"`
+	TableName       string        `alias:"logged_actions.table_name"`
+	SessionUserName *string       `alias:"logged_actions.session_user_name"`
+	ActionTstampTx  time.Time     `alias:"logged_actions.action_tstamp_tx"`
+	Action          string        `alias:"logged_actions.action"`
+	RowData         hstore.Hstore `alias:"logged_actions.row_data"`
+	ChangedFields   hstore.Hstore `alias:"logged_actions.changed_fields"`
+	StatementOnly   bool          `alias:"logged_actions.statement_only"`
+	Actor           *string       `alias:"logged_actions.actor"`
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"database/sql"
+	"slices"
+
+	"github.com/lib/pq/hstore"
+
+	"financing-offer/internal/core/entity"
+)
+
+func MapLoggedActionsToEntity(loggedActions []loggedAction) []entity.AuditLog {
+	res := make([]entity.AuditLog, 0, len(loggedActions))
+	for _, loggedAction := range loggedActions {
+		res = append(res, MapLoggedActionToEntity(loggedAction))
+	}
+	return res
+}
+
+func MapLoggedActionToEntity(loggedAction loggedAction) entity.AuditLog {
+	action := entity.AuditActionFromCode(loggedAction.Action)
+	auditLog := entity.AuditLog{
+		EventId:   loggedAction.EventID,
+		TableName: loggedAction.TableName,
+		Action:    action,
+		ActionAt:  loggedAction.ActionTstampTx,
+		Changes:   MapAuditFieldChanges(action, loggedAction.RowData, loggedAction.ChangedFields),
+	}
+	if id, ok := loggedAction.RowData.Map["id"]; ok && id.Valid {
+		auditLog.RowId = id.String
+	}
+	if loggedAction.Actor != nil {
+		auditLog.Actor = *loggedAction.Actor
+	}
+	if loggedAction.SessionUserName != nil {
+		auditLog.SessionUser = *loggedAction.SessionUserName
+	}
+	return auditLog
+}
+
+// MapAuditFieldChanges decodes the hstore row data of an action into per-field diffs ordered by field,
+// rowData holds the new row of an insert and the old row of an update or a delete,
+// changedFields holds the new values of the fields an update changed
+func MapAuditFieldChanges(action entity.AuditAction, rowData hstore.Hstore, changedFields hstore.Hstore) []entity.AuditFieldChange {
+	changes := make([]entity.AuditFieldChange, 0)
+	switch action {
+	case entity.AuditActionInsert:
+		for field, value := range rowData.Map {
+			changes = append(changes, entity.AuditFieldChange{Field: field, NewValue: nullStringPointer(value)})
+		}
+	case entity.AuditActionDelete:
+		for field, value := range rowData.Map {
+			changes = append(changes, entity.AuditFieldChange{Field: field, OldValue: nullStringPointer(value)})
+		}
+	case entity.AuditActionUpdate:
+		for field, value := range changedFields.Map {
+			changes = append(
+				changes, entity.AuditFieldChange{
+					Field:    field,
+					OldValue: nullStringPointer(rowData.Map[field]),
+					NewValue: nullStringPointer(value),
+				},
+			)
+		}
+	}
+	slices.SortFunc(
+		changes, func(a, b entity.AuditFieldChange) int {
+			if a.Field < b.Field {
+				return -1
+			}
+			if a.Field > b.Field {
+				return 1
+			}
+			return 0
+		},
+	)
+	return changes
+}
+
+func nullStringPointer(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	return &value.String
+}
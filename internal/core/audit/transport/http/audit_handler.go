@@ -0,0 +1,97 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/core/audit"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/handler"
+	"financing-offer/pkg/optional"
+)
+
+type AuditHandler struct {
+	handler.BaseHandler
+	logger  *slog.Logger
+	useCase audit.UseCase
+}
+
+func NewAuditHandler(baseHandler handler.BaseHandler, logger *slog.Logger, useCase audit.UseCase) *AuditHandler {
+	return &AuditHandler{
+		BaseHandler: baseHandler,
+		logger:      logger,
+		useCase:     useCase,
+	}
+}
+
+// GetAll godoc
+//
+//	@Summary		Get audit logs
+//	@Description	Get the row changes recorded by the audit trigger, latest first
+//	@Tags			audit,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			tableName		query		string	false	"audited table"
+//	@Param			rowId			query		string	false	"id of the audited row"
+//	@Param			actor			query		string	false	"application user"
+//	@Param			action			query		string	false	"INSERT, UPDATE, DELETE or TRUNCATE"
+//	@Param			from			query		string	false	"inclusive lower bound of the action time"
+//	@Param			to				query		string	false	"exclusive upper bound of the action time"
+//	@Param			page[size]		query		int		false	"page size"
+//	@Param			page[number]	query		int		false	"page number"
+//	@Success		200				{object}	handler.ResponseWithPaging[[]entity.AuditLog]
+//	@Failure		400				{object}	handler.ErrorResponse
+//	@Failure		500				{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/audit-logs [get]
+func (h *AuditHandler) GetAll(ctx *gin.Context) {
+	req := GetAuditLogsRequest{}
+	if err := h.ParseQueryWithPagination(ctx, &req.Paging, &req); err != nil {
+		h.logger.Error("get audit logs", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "parse query")
+		return
+	}
+	if !req.From.IsZero() && !req.To.IsZero() && !req.From.Before(req.To) {
+		h.RenderBadRequest(ctx, "from must be before to")
+		return
+	}
+	h.renderAuditLogs(ctx, req.toFilter())
+}
+
+// GetEntityAuditLogs lists the audit logs of the row of tableName identified by the id path param
+func (h *AuditHandler) GetEntityAuditLogs(tableName string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := h.ParamsInt(ctx)
+		if err != nil {
+			h.RenderBadRequest(ctx, "id invalid")
+			return
+		}
+		req := GetAuditLogsRequest{}
+		if err := h.ParseQueryWithPagination(ctx, &req.Paging, &req); err != nil {
+			h.logger.Error("get entity audit logs", slog.String("error", err.Error()))
+			h.RenderBadRequest(ctx, "parse query")
+			return
+		}
+		filter := req.toFilter()
+		filter.TableName = optional.Some(tableName)
+		filter.RowId = optional.Some(strconv.FormatInt(id, 10))
+		h.renderAuditLogs(ctx, filter)
+	}
+}
+
+func (h *AuditHandler) renderAuditLogs(ctx *gin.Context, filter entity.AuditLogFilter) {
+	res, meta, err := h.useCase.GetAll(ctx, filter)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.ResponseWithPaging[[]entity.AuditLog]{
+			Data:     res,
+			MetaData: meta,
+		},
+	)
+}
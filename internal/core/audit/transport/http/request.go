@@ -0,0 +1,31 @@
+package http
+
+import (
+	"time"
+
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/optional"
+)
+
+type GetAuditLogsRequest struct {
+	Paging    core.Paging
+	TableName string             `form:"tableName"`
+	RowId     string             `form:"rowId"`
+	Actor     string             `form:"actor"`
+	Action    entity.AuditAction `form:"action" binding:"omitempty,oneof=INSERT UPDATE DELETE TRUNCATE"`
+	From      time.Time          `form:"from"`
+	To        time.Time          `form:"to"`
+}
+
+func (r GetAuditLogsRequest) toFilter() entity.AuditLogFilter {
+	return entity.AuditLogFilter{
+		Paging:    r.Paging,
+		TableName: optional.FromValueNonZero(r.TableName),
+		RowId:     optional.FromValueNonZero(r.RowId),
+		Actor:     optional.FromValueNonZero(r.Actor),
+		Action:    optional.FromValueNonZero(r.Action),
+		From:      optional.FromValueNonZero(r.From),
+		To:        optional.FromValueNonZero(r.To),
+	}
+}
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/audit/repository"
+	"financing-offer/internal/core/entity"
+)
+
+type UseCase interface {
+	GetAll(ctx context.Context, filter entity.AuditLogFilter) ([]entity.AuditLog, core.PagingMetaData, error)
+}
+
+type auditUseCase struct {
+	repository repository.AuditLogRepository
+}
+
+func NewUseCase(repository repository.AuditLogRepository) UseCase {
+	return &auditUseCase{
+		repository: repository,
+	}
+}
+
+func (u *auditUseCase) GetAll(ctx context.Context, filter entity.AuditLogFilter) ([]entity.AuditLog, core.PagingMetaData, error) {
+	var (
+		eg             errgroup.Group
+		auditLogs      []entity.AuditLog
+		pagingMetaData = core.PagingMetaData{PageSize: filter.Size, PageNumber: filter.Number}
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.repository.GetAll(ctx, filter)
+			auditLogs = res
+			return scopedErr
+		},
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.repository.Count(ctx, filter)
+			pagingMetaData.Total = res
+			pagingMetaData.TotalPages = filter.TotalPages(res)
+			return scopedErr
+		},
+	)
+	if err := eg.Wait(); err != nil {
+		return nil, pagingMetaData, fmt.Errorf("auditUseCase GetAll %w", err)
+	}
+	return auditLogs, pagingMetaData, nil
+}
@@ -133,7 +133,10 @@ func ApplyWhere(filter entity.CombinedLoanRequestFilter) postgres.BoolExpression
 		expr = expr.AND(table.LoanPackageRequest.Status.EQ(postgres.String(entity.LoanPackageRequestStatusPending.String())))
 	}
 	if filter.Status == entity.CombinedLoanRequestStatusCancelled {
-		expr = expr.AND(table.LoanPackageRequest.Status.EQ(postgres.String(entity.LoanPackageRequestStatusConfirmed.String())))
+		statuses := funcs.Map(
+			entity.LoanPackageRequestStatusConfirmed.MatchingStatuses(), entity.LoanPackageRequestStatus.String,
+		)
+		expr = expr.AND(table.LoanPackageRequest.Status.IN(querymod.In(statuses)...))
 	}
 	if len(filter.Ids) > 0 {
 		expr = expr.AND(table.LoanPackageRequest.ID.IN(querymod.In(filter.Ids)...))
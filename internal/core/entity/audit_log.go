@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"time"
+
+	"financing-offer/internal/core"
+	"financing-offer/pkg/optional"
+)
+
+type AuditAction string
+
+const (
+	AuditActionInsert   AuditAction = "INSERT"
+	AuditActionUpdate   AuditAction = "UPDATE"
+	AuditActionDelete   AuditAction = "DELETE"
+	AuditActionTruncate AuditAction = "TRUNCATE"
+)
+
+// AuditActionFromCode maps the single letter action of audit.logged_actions
+func AuditActionFromCode(code string) AuditAction {
+	switch code {
+	case "I":
+		return AuditActionInsert
+	case "U":
+		return AuditActionUpdate
+	case "D":
+		return AuditActionDelete
+	case "T":
+		return AuditActionTruncate
+	default:
+		return AuditAction(code)
+	}
+}
+
+func (a AuditAction) Code() string {
+	return string(a)[:1]
+}
+
+func (a AuditAction) IsValid() bool {
+	switch a {
+	case AuditActionInsert, AuditActionUpdate, AuditActionDelete, AuditActionTruncate:
+		return true
+	default:
+		return false
+	}
+}
+
+type AuditLog struct {
+	EventId   int64       `json:"eventId"`
+	TableName string      `json:"tableName"`
+	RowId     string      `json:"rowId"`
+	Action    AuditAction `json:"action"`
+	// Actor is the application user of the transaction, empty for changes made outside the service
+	Actor       string             `json:"actor"`
+	SessionUser string             `json:"sessionUser"`
+	ActionAt    time.Time          `json:"actionAt"`
+	Changes     []AuditFieldChange `json:"changes"`
+}
+
+// AuditFieldChange is the value of a column before and after the action, nil when the row did not exist
+type AuditFieldChange struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"oldValue"`
+	NewValue *string `json:"newValue"`
+}
+
+type AuditLogFilter struct {
+	core.Paging
+	TableName optional.Optional[string]      `json:"tableName"`
+	RowId     optional.Optional[string]      `json:"rowId"`
+	Actor     optional.Optional[string]      `json:"actor"`
+	Action    optional.Optional[AuditAction] `json:"action"`
+	From      optional.Optional[time.Time]   `json:"from"`
+	To        optional.Optional[time.Time]   `json:"to"`
+}
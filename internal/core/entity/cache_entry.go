@@ -0,0 +1,13 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CacheEntry is a value of the cache shared between the replicas
+type CacheEntry struct {
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	ExpiresAt time.Time       `json:"expiresAt"`
+}
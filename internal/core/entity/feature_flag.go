@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// FeatureFlag turns a feature on for the investors matching its rules, a killed flag is off for everyone
+type FeatureFlag struct {
+	Id          int64            `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Killed      bool             `json:"killed"`
+	Rules       FeatureFlagRules `json:"rules"`
+	CreatedBy   string           `json:"createdBy"`
+	UpdatedBy   string           `json:"updatedBy"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	UpdatedAt   time.Time        `json:"updatedAt"`
+}
+
+// FeatureFlagRules enables the flag for InvestorIds, and for the other investors matching every configured segment.
+// RolloutPercentage is the share of the investors, bucketed by a hash of their id, the flag is rolled out to.
+// Empty segments match every investor
+type FeatureFlagRules struct {
+	InvestorIds         []string       `json:"investorIds"`
+	RolloutPercentage   int            `json:"rolloutPercentage"`
+	CustodyCodePrefixes []string       `json:"custodyCodePrefixes"`
+	MarginStatuses      []MarginStatus `json:"marginStatuses"`
+	MinAppVersion       string         `json:"minAppVersion"`
+}
+
+// FeatureFlagSubject is the investor a flag is evaluated for, AppVersion is the version of the app sending the request
+type FeatureFlagSubject struct {
+	InvestorId  string
+	CustodyCode string
+	AppVersion  string
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type IdempotencyRecord struct {
+	Id             int64                   `json:"id"`
+	InvestorId     string                  `json:"investorId"`
+	IdempotencyKey string                  `json:"idempotencyKey"`
+	RequestHash    string                  `json:"requestHash"`
+	Status         IdempotencyRecordStatus `json:"status"`
+	ResponseStatus int32                   `json:"responseStatus"`
+	ResponseBody   []byte                  `json:"responseBody"`
+	CreatedAt      time.Time               `json:"createdAt"`
+	UpdatedAt      time.Time               `json:"updatedAt"`
+	// LeaseToken identifies the request holding the key, it changes when the key is taken over
+	LeaseToken uuid.UUID `json:"-"`
+}
+
+type IdempotencyRecordStatus string
+
+const (
+	IdempotencyRecordStatusInProgress IdempotencyRecordStatus = "IN_PROGRESS"
+	IdempotencyRecordStatusCompleted  IdempotencyRecordStatus = "COMPLETED"
+)
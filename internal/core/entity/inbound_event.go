@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// LoanPackageActivatedEvent is consumed from financial product once the loan package created for an offer line is active
+type LoanPackageActivatedEvent struct {
+	LoanPackageOfferInterestId int64     `json:"loanPackageOfferInterestId"`
+	LoanPackageId              int64     `json:"loanPackageId"`
+	LoanPackageAccountId       int64     `json:"loanPackageAccountId"`
+	LoanProductIdRef           int64     `json:"loanProductIdRef"`
+	ActivatedAt                time.Time `json:"activatedAt"`
+}
+
+// AccountMarginChangedEvent is consumed from MO when the margin status of an account changes
+type AccountMarginChangedEvent struct {
+	AccountNo    string       `json:"accountNo"`
+	InvestorId   string       `json:"investorId"`
+	MarginStatus MarginStatus `json:"marginStatus"`
+	ChangedAt    time.Time    `json:"changedAt"`
+}
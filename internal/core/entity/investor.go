@@ -5,8 +5,9 @@ import (
 )
 
 type Investor struct {
-	InvestorId  string    `json:"investorId"`
-	CustodyCode string    `json:"custodyCode"`
-	CreatedAt   time.Time `json:"-"`
-	UpdatedAt   time.Time `json:"-"`
+	InvestorId      string    `json:"investorId"`
+	CustodyCode     string    `json:"custodyCode"`
+	PreferredLocale Locale    `json:"-"`
+	CreatedAt       time.Time `json:"-"`
+	UpdatedAt       time.Time `json:"-"`
 }
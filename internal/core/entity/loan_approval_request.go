@@ -3,7 +3,6 @@ package entity
 import (
 	"fmt"
 	"github.com/shopspring/decimal"
-	"strconv"
 	"time"
 )
 
@@ -25,23 +24,69 @@ type LoanApprovalRequest struct {
 	CategoryId         int64           `json:"category_id"`
 }
 
-func (loanApprovalRequest *LoanApprovalRequest) ToOdooFormat() []map[string]any {
-	return []map[string]any{
-		{
-			"loan_request_id":      strconv.FormatInt(loanApprovalRequest.LoanRequestId, 10),
-			"create_at":            loanApprovalRequest.CreateAt.UTC().Format(time.DateTime),
-			"submission_id":        strconv.FormatInt(loanApprovalRequest.SubmissionId, 10),
-			"submission_by":        loanApprovalRequest.SubmissionBy,
-			"submission_create_at": loanApprovalRequest.SubmissionCreateAt.UTC().Format(time.DateTime),
-			"investor_id":          loanApprovalRequest.InvestorId,
-			"account_no":           loanApprovalRequest.AccountNo,
-			"symbol":               loanApprovalRequest.Symbol,
-			"loan_rate":            loanApprovalRequest.LoanRate.String(),
-			"source":               loanApprovalRequest.Source,
-			"interest_rate":        loanApprovalRequest.InterestRate.String(),
-			"buying_fee":           loanApprovalRequest.BuyingFee.String(),
-			"term":                 strconv.FormatInt(int64(loanApprovalRequest.Term), 10),
-			"description":          fmt.Sprintf("%s-%s", "test", loanApprovalRequest.Description),
-		},
+// NewLoanApprovalRequest builds the Odoo approval payload of a submission sheet
+func NewLoanApprovalRequest(request LoanPackageRequest, submissionSheet SubmissionSheet, symbol string, categoryId int64) LoanApprovalRequest {
+	var formattedSource string
+	for index, loanPolicy := range submissionSheet.Detail.LoanPolicies {
+		formattedLoanRate := loanPolicy.InterestRate.Mul(decimal.NewFromInt(100))
+		if index == 0 {
+			formattedSource = fmt.Sprintf("%s: %s%%", loanPolicy.Source, formattedLoanRate)
+		}
+		formattedSource = fmt.Sprintf("%s, %s: %s%%", formattedSource, loanPolicy.Source, formattedLoanRate)
 	}
+	var (
+		interestRate decimal.Decimal
+		term         int32
+	)
+	if len(submissionSheet.Detail.LoanPolicies) > 0 {
+		interestRate = submissionSheet.Detail.LoanPolicies[0].InterestRate // All loan policy share the same interest rate
+		term = submissionSheet.Detail.LoanPolicies[0].Term
+	}
+	formattedLoanRate := decimal.NewFromInt(1).Sub(submissionSheet.Detail.LoanRate.InitialRate).Mul(decimal.NewFromInt(100))
+	return LoanApprovalRequest{
+		LoanRequestId:      request.Id,
+		CreateAt:           request.CreatedAt,
+		SubmissionId:       submissionSheet.Metadata.Id,
+		SubmissionBy:       submissionSheet.Metadata.Creator,
+		SubmissionCreateAt: submissionSheet.Metadata.CreatedAt,
+		InvestorId:         request.InvestorId,
+		AccountNo:          request.AccountNo,
+		Symbol:             symbol,
+		LoanRate:           formattedLoanRate,
+		Source:             formattedSource,
+		InterestRate:       interestRate,
+		BuyingFee:          submissionSheet.Detail.FirmBuyingFee,
+		Term:               term,
+		Description:        submissionSheet.Detail.Comment,
+		CategoryId:         categoryId,
+	}
+}
+
+// OdooValues exposes the payload values by source name for the configured Odoo field mapping
+func (loanApprovalRequest *LoanApprovalRequest) OdooValues() map[string]any {
+	return map[string]any{
+		"loan_request_id":      loanApprovalRequest.LoanRequestId,
+		"create_at":            loanApprovalRequest.CreateAt,
+		"submission_id":        loanApprovalRequest.SubmissionId,
+		"submission_by":        loanApprovalRequest.SubmissionBy,
+		"submission_create_at": loanApprovalRequest.SubmissionCreateAt,
+		"investor_id":          loanApprovalRequest.InvestorId,
+		"account_no":           loanApprovalRequest.AccountNo,
+		"symbol":               loanApprovalRequest.Symbol,
+		"loan_rate":            loanApprovalRequest.LoanRate,
+		"source":               loanApprovalRequest.Source,
+		"interest_rate":        loanApprovalRequest.InterestRate,
+		"buying_fee":           loanApprovalRequest.BuyingFee,
+		"term":                 int64(loanApprovalRequest.Term),
+		"description":          loanApprovalRequest.Description,
+		"category_id":          loanApprovalRequest.CategoryId,
+	}
+}
+
+// OdooPayloadPreview is the XML-RPC call that would be sent to Odoo, with the password masked
+type OdooPayloadPreview struct {
+	Model  string         `json:"model"`
+	Method string         `json:"method"`
+	Values map[string]any `json:"values"`
+	Xml    string         `json:"xml"`
 }
@@ -30,8 +30,9 @@ func (o LoanPackageOffer) IsExpired() bool {
 
 type LoanPackageOfferFilter struct {
 	core.Paging
-	InvestorId          string                       `json:"investorId"`
-	Symbol              optional.Optional[string]    `json:"symbol"`
-	OfferInterestStatus []string                     `json:"offerInterestStatus"`
-	AssetType           optional.Optional[AssetType] `json:"assetType"`
+	InvestorId           string                       `json:"investorId"`
+	Symbol               optional.Optional[string]    `json:"symbol"`
+	OfferInterestStatus  []string                     `json:"offerInterestStatus"`
+	AssetType            optional.Optional[AssetType] `json:"assetType"`
+	LoanPackageRequestId optional.Optional[int64]     `json:"loanPackageRequestId"`
 }
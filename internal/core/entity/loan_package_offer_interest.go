@@ -28,6 +28,7 @@ type LoanPackageOfferInterest struct {
 	AssetType               AssetType                      `json:"assetType"`
 	InitialRate             decimal.Decimal                `json:"initialRate"`
 	ContractSize            int64                          `json:"contractSize"`
+	WorkflowId              string                         `json:"workflowId,omitempty"`
 
 	LoanContract     *LoanContract     `json:"loanContract,omitempty"`
 	LoanPackageOffer *LoanPackageOffer `json:"loanPackageOffer,omitempty"`
@@ -60,7 +61,7 @@ func (s LoanPackageOfferInterestStatus) NextStatuses(flowType FlowType) []LoanPa
 			case LoanPackageOfferInterestStatusPending:
 				return []LoanPackageOfferInterestStatus{LoanPackageOfferInterestStatusCancelled, LoanPackageOfferInterestStatusLoanPackageCreated, LoanPackageOfferInterestStatusCreatingLoanPackage}
 			case LoanPackageOfferInterestStatusCreatingLoanPackage:
-				return []LoanPackageOfferInterestStatus{LoanPackageOfferInterestStatusLoanPackageCreated}
+				return []LoanPackageOfferInterestStatus{LoanPackageOfferInterestStatusLoanPackageCreated, LoanPackageOfferInterestStatusPending}
 			default:
 				return []LoanPackageOfferInterestStatus{}
 			}
@@ -86,6 +87,37 @@ func LoanPackageOfferInterestStatusFromString(str string) LoanPackageOfferIntere
 	return ""
 }
 
+// LoanPackageCreationResult is the result of a completed CreateAndAssignLoanPackageWorkflow,
+// it carries the same loan package as the LoanPackageActivatedEvent financial product publishes for the line
+type LoanPackageCreationResult struct {
+	LoanPackageId        int64 `json:"loanPackageId"`
+	LoanPackageAccountId int64 `json:"loanPackageAccountId"`
+	LoanProductIdRef     int64 `json:"loanProductIdRef"`
+}
+
+// LoanPackageWorkflowStatus is the outcome of the temporal workflow creating the loan package of an offer line
+type LoanPackageWorkflowStatus string
+
+const (
+	LoanPackageWorkflowStatusRunning   LoanPackageWorkflowStatus = "RUNNING"
+	LoanPackageWorkflowStatusCompleted LoanPackageWorkflowStatus = "COMPLETED"
+	LoanPackageWorkflowStatusFailed    LoanPackageWorkflowStatus = "FAILED"
+	LoanPackageWorkflowStatusNotFound  LoanPackageWorkflowStatus = "NOT_FOUND"
+)
+
+func (s LoanPackageWorkflowStatus) String() string {
+	return string(s)
+}
+
+// LoanPackageCreationStatus is polled by the investor app after confirming an offer line that needs a new loan package
+type LoanPackageCreationStatus struct {
+	LoanPackageOfferInterestId int64                          `json:"loanPackageOfferInterestId"`
+	Status                     LoanPackageOfferInterestStatus `json:"status"`
+	WorkflowId                 string                         `json:"workflowId,omitempty"`
+	WorkflowStatus             LoanPackageWorkflowStatus      `json:"workflowStatus,omitempty"`
+	LoanId                     int64                          `json:"loanId,omitempty"`
+}
+
 type LoanPackageOfferReadyNotify struct {
 	InvestorId      string
 	RequestName     string
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"slices"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -65,18 +66,55 @@ type LoanPackageRequestStatus string
 const (
 	LoanPackageRequestStatusPending   LoanPackageRequestStatus = "PENDING"
 	LoanPackageRequestStatusConfirmed LoanPackageRequestStatus = "CONFIRMED"
+	LoanPackageRequestStatusDeclined  LoanPackageRequestStatus = "DECLINED"
+	LoanPackageRequestStatusCancelled LoanPackageRequestStatus = "CANCELLED"
+	LoanPackageRequestStatusExpired   LoanPackageRequestStatus = "EXPIRED"
 )
 
 func (l LoanPackageRequestStatus) String() string {
 	return string(l)
 }
 
+func (l LoanPackageRequestStatus) NextStatuses() []LoanPackageRequestStatus {
+	switch l {
+	case LoanPackageRequestStatusPending:
+		return []LoanPackageRequestStatus{
+			LoanPackageRequestStatusConfirmed, LoanPackageRequestStatusDeclined, LoanPackageRequestStatusCancelled,
+			LoanPackageRequestStatusExpired,
+		}
+	default:
+		return []LoanPackageRequestStatus{}
+	}
+}
+
+// MatchingStatuses lists the statuses a status filter on l matches. Requests were stored as CONFIRMED once processed
+// until they got their own declined, cancelled and expired states, so filtering on CONFIRMED keeps matching all of them
+func (l LoanPackageRequestStatus) MatchingStatuses() []LoanPackageRequestStatus {
+	if l == LoanPackageRequestStatusConfirmed {
+		return []LoanPackageRequestStatus{
+			LoanPackageRequestStatusConfirmed, LoanPackageRequestStatusDeclined, LoanPackageRequestStatusCancelled,
+			LoanPackageRequestStatusExpired,
+		}
+	}
+	return []LoanPackageRequestStatus{l}
+}
+
+func (l LoanPackageRequestStatus) CanTransitionTo(next LoanPackageRequestStatus) bool {
+	return slices.Contains(l.NextStatuses(), next)
+}
+
 func LoanPackageRequestStatusFromString(s string) LoanPackageRequestStatus {
 	switch s {
 	case string(LoanPackageRequestStatusPending):
 		return LoanPackageRequestStatusPending
 	case string(LoanPackageRequestStatusConfirmed):
 		return LoanPackageRequestStatusConfirmed
+	case string(LoanPackageRequestStatusDeclined):
+		return LoanPackageRequestStatusDeclined
+	case string(LoanPackageRequestStatusCancelled):
+		return LoanPackageRequestStatusCancelled
+	case string(LoanPackageRequestStatusExpired):
+		return LoanPackageRequestStatusExpired
 	default:
 		return LoanPackageRequestStatusPending
 	}
@@ -93,17 +131,6 @@ func (l LoanPackageRequestType) String() string {
 	return string(l)
 }
 
-func (l LoanPackageRequestType) StringNotify() string {
-	switch l {
-	case LoanPackageRequestTypeFlexible:
-		return "Linh hoạt"
-	case LoanPackageRequestTypeGuaranteed:
-		return "Đảm bảo"
-	default:
-		return "Đảm bảo"
-	}
-}
-
 func LoanPackageRequestTypeFromString(s string) LoanPackageRequestType {
 	switch s {
 	case string(LoanPackageRequestTypeFlexible):
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type LoanPackageRequestStatusHistory struct {
+	Id                   int64                    `json:"id"`
+	LoanPackageRequestId int64                    `json:"loanPackageRequestId"`
+	FromStatus           LoanPackageRequestStatus `json:"fromStatus"`
+	ToStatus             LoanPackageRequestStatus `json:"toStatus"`
+	Actor                string                   `json:"actor"`
+	Reason               string                   `json:"reason"`
+	CreatedAt            time.Time                `json:"createdAt"`
+}
+
+const (
+	LoanPackageRequestStatusReasonInvestorRequest    = "INVESTOR_REQUEST"
+	LoanPackageRequestStatusReasonAdminConfirmed     = "ADMIN_CONFIRMED"
+	LoanPackageRequestStatusReasonSubmissionApproved = "SUBMISSION_APPROVED"
+	LoanPackageRequestStatusReasonAlternativeOption  = "ALTERNATIVE_OPTION"
+	LoanPackageRequestStatusReasonAdminDeclined      = "ADMIN_DECLINED"
+	LoanPackageRequestStatusReasonHighLoanRate       = "HIGH_LOAN_RATE"
+	LoanPackageRequestStatusReasonSymbolCancelled    = "SYMBOL_CANCELLED"
+	LoanPackageRequestStatusReasonLowLimitAmount     = "LOW_LIMIT_AMOUNT"
+	LoanPackageRequestStatusReasonHighLimitAmount    = "HIGH_LIMIT_AMOUNT"
+	LoanPackageRequestStatusReasonRequestExpired     = "REQUEST_EXPIRED"
+	LoanPackageRequestStatusReasonSuggestedOffer     = "SUGGESTED_OFFER_CONVERTED"
+)
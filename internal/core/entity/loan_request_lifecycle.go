@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	LoanRequestLifecycleWorkflowName       = "LoanRequestLifecycleWorkflow"
+	CreateAndAssignLoanPackageWorkflowName = "CreateAndAssignLoanPackageWorkflow"
+	// LoanRequestUpdatedSignal asks the lifecycle workflow to reload the request and its latest offer
+	LoanRequestUpdatedSignal = "loan-request-updated"
+	// OfferInterestConfirmedSignal hands the creation of the loan package of a confirmed offer line to the lifecycle workflow
+	OfferInterestConfirmedSignal = "offer-interest-confirmed"
+)
+
+func LoanRequestLifecycleWorkflowId(loanPackageRequestId int64) string {
+	return fmt.Sprintf("loan-request-lifecycle-%d", loanPackageRequestId)
+}
+
+func LoanPackageCreationWorkflowId(loanPackageOfferInterestId int64) string {
+	return fmt.Sprintf("auto-create-loan-package-v3-%d", loanPackageOfferInterestId)
+}
+
+type LoanRequestLifecycleInput struct {
+	LoanPackageRequestId int64
+	// RecheckInterval bounds how long the workflow sleeps without a signal before reloading the request
+	RecheckInterval time.Duration
+}
+
+type OfferInterestConfirmed struct {
+	LoanPackageOfferInterestId int64
+}
+
+// LoanRequestLifecycle is the state the lifecycle workflow reloads from the database on every wake up
+type LoanRequestLifecycle struct {
+	LoanPackageRequestId int64
+	RequestStatus        LoanPackageRequestStatus
+	OfferId              int64
+	OfferExpiredAt       time.Time
+	// Done is set once the request is closed or every line of its latest offer is settled
+	Done bool
+}
+
+func NewLoanRequestLifecycle(request LoanPackageRequest, latestOffer *LoanPackageOffer) LoanRequestLifecycle {
+	lifecycle := LoanRequestLifecycle{
+		LoanPackageRequestId: request.Id,
+		RequestStatus:        request.Status,
+	}
+	if request.Status == LoanPackageRequestStatusDeclined || request.Status == LoanPackageRequestStatusCancelled ||
+		request.Status == LoanPackageRequestStatusExpired {
+		lifecycle.Done = true
+		return lifecycle
+	}
+	if latestOffer == nil {
+		return lifecycle
+	}
+	lifecycle.OfferId = latestOffer.Id
+	lifecycle.OfferExpiredAt = latestOffer.ExpiredAt
+	if len(latestOffer.LoanPackageOfferInterests) == 0 {
+		return lifecycle
+	}
+	lifecycle.Done = true
+	for _, offerLine := range latestOffer.LoanPackageOfferInterests {
+		if offerLine.Status == LoanPackageOfferInterestStatusPending ||
+			offerLine.Status == LoanPackageOfferInterestStatusCreatingLoanPackage {
+			lifecycle.Done = false
+			break
+		}
+	}
+	return lifecycle
+}
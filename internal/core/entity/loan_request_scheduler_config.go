@@ -1,15 +1,145 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/shopspring/decimal"
+
+	"financing-offer/pkg/optional"
 )
 
 type LoanRequestSchedulerConfig struct {
-	ID              int64           `json:"id"`
-	MaximumLoanRate decimal.Decimal `json:"maximumLoanRate"`
-	AffectedFrom    time.Time       `json:"affectedFrom"`
-	CreatedAt       time.Time       `json:"createdAt"`
-	UpdatedAt       time.Time       `json:"updatedAt"`
+	ID              int64                    `json:"id"`
+	MaximumLoanRate decimal.Decimal          `json:"maximumLoanRate"`
+	Rules           []LoanRequestDeclineRule `json:"rules"`
+	AffectedFrom    time.Time                `json:"affectedFrom"`
+	CreatedAt       time.Time                `json:"createdAt"`
+	UpdatedAt       time.Time                `json:"updatedAt"`
+}
+
+// LoanRequestDeclineRule declines the pending requests of its asset type and stock exchange,
+// a rule without AssetType or StockExchangeCode applies to all of them. Only the most specific rule matching a request
+// is applied, its unset thresholds are not taken from the broader rules
+type LoanRequestDeclineRule struct {
+	AssetType          optional.Optional[AssetType]       `json:"assetType"`
+	StockExchangeCode  optional.Optional[string]          `json:"stockExchangeCode"`
+	MaximumLoanRate    optional.Optional[decimal.Decimal] `json:"maximumLoanRate"`
+	MinimumLimitAmount optional.Optional[decimal.Decimal] `json:"minimumLimitAmount"`
+	MaximumLimitAmount optional.Optional[decimal.Decimal] `json:"maximumLimitAmount"`
+	MaximumAgeHours    optional.Optional[int64]           `json:"maximumAgeHours"`
+}
+
+func (r LoanRequestDeclineRule) matches(assetType AssetType, stockExchangeCode string) bool {
+	if r.AssetType.IsPresent() && r.AssetType.Get() != assetType {
+		return false
+	}
+	if r.StockExchangeCode.IsPresent() && r.StockExchangeCode.Get() != stockExchangeCode {
+		return false
+	}
+	return true
+}
+
+// specificity ranks a stock exchange rule above an asset type rule, and a rule scoped by both above either
+func (r LoanRequestDeclineRule) specificity() int {
+	res := 0
+	if r.StockExchangeCode.IsPresent() {
+		res += 2
+	}
+	if r.AssetType.IsPresent() {
+		res++
+	}
+	return res
+}
+
+func (r LoanRequestDeclineRule) scope() string {
+	return fmt.Sprintf("%s/%s", r.AssetType.Get(), r.StockExchangeCode.Get())
+}
+
+// RuleFor returns the most specific rule applying to requests of assetType on stockExchangeCode as it is,
+// a threshold it leaves unset is not checked even when a broader rule sets it. The maximum loan rate of the config
+// is the only fallback, used when the rule sets none
+func (c LoanRequestSchedulerConfig) RuleFor(assetType AssetType, stockExchangeCode string) LoanRequestDeclineRule {
+	res := LoanRequestDeclineRule{}
+	found := false
+	for _, rule := range c.Rules {
+		if !rule.matches(assetType, stockExchangeCode) {
+			continue
+		}
+		if !found || rule.specificity() > res.specificity() {
+			res = rule
+			found = true
+		}
+	}
+	if !res.MaximumLoanRate.IsPresent() {
+		res.MaximumLoanRate = optional.Some(c.MaximumLoanRate)
+	}
+	return res
+}
+
+// DeclineReason returns the status history reason the request is declined for at now, false when it stays pending
+func (c LoanRequestSchedulerConfig) DeclineReason(candidate LoanRequestDeclineCandidate, now time.Time) (string, bool) {
+	request := candidate.LoanPackageRequest
+	rule := c.RuleFor(request.AssetType, candidate.StockExchangeCode)
+	if request.LoanRate.GreaterThanOrEqual(rule.MaximumLoanRate.Get()) {
+		return LoanPackageRequestStatusReasonHighLoanRate, true
+	}
+	if rule.MinimumLimitAmount.IsPresent() && request.LimitAmount.LessThan(rule.MinimumLimitAmount.Get()) {
+		return LoanPackageRequestStatusReasonLowLimitAmount, true
+	}
+	if rule.MaximumLimitAmount.IsPresent() && request.LimitAmount.GreaterThan(rule.MaximumLimitAmount.Get()) {
+		return LoanPackageRequestStatusReasonHighLimitAmount, true
+	}
+	if rule.MaximumAgeHours.IsPresent() && now.Sub(request.CreatedAt) >= time.Duration(rule.MaximumAgeHours.Get())*time.Hour {
+		return LoanPackageRequestStatusReasonRequestExpired, true
+	}
+	return "", false
+}
+
+// Validate returns the first invalid threshold of the config or its rules
+func (c LoanRequestSchedulerConfig) Validate() error {
+	if !c.MaximumLoanRate.IsPositive() || c.MaximumLoanRate.GreaterThan(decimal.NewFromInt(1)) {
+		return fmt.Errorf("maximumLoanRate must be greater than 0 and at most 1")
+	}
+	scopes := make(map[string]bool, len(c.Rules))
+	for i, rule := range c.Rules {
+		if rule.AssetType.IsPresent() && rule.AssetType.Get() != AssetTypeUnderlying && rule.AssetType.Get() != AssetTypeDerivative {
+			return fmt.Errorf("rules[%d]: unknown assetType %s", i, rule.AssetType.Get())
+		}
+		if rule.StockExchangeCode.IsPresent() && rule.StockExchangeCode.Get() == "" {
+			return fmt.Errorf("rules[%d]: stockExchangeCode must not be empty", i)
+		}
+		if rule.MaximumLoanRate.IsPresent() &&
+			(!rule.MaximumLoanRate.Get().IsPositive() || rule.MaximumLoanRate.Get().GreaterThan(decimal.NewFromInt(1))) {
+			return fmt.Errorf("rules[%d]: maximumLoanRate must be greater than 0 and at most 1", i)
+		}
+		if rule.MinimumLimitAmount.IsPresent() && rule.MinimumLimitAmount.Get().IsNegative() {
+			return fmt.Errorf("rules[%d]: minimumLimitAmount must not be negative", i)
+		}
+		if rule.MinimumLimitAmount.IsPresent() && rule.MaximumLimitAmount.IsPresent() &&
+			rule.MinimumLimitAmount.Get().GreaterThan(rule.MaximumLimitAmount.Get()) {
+			return fmt.Errorf("rules[%d]: minimumLimitAmount must not exceed maximumLimitAmount", i)
+		}
+		if rule.MaximumAgeHours.IsPresent() && rule.MaximumAgeHours.Get() <= 0 {
+			return fmt.Errorf("rules[%d]: maximumAgeHours must be positive", i)
+		}
+		if scopes[rule.scope()] {
+			return fmt.Errorf("rules[%d]: duplicated rule for assetType and stockExchangeCode", i)
+		}
+		scopes[rule.scope()] = true
+	}
+	return nil
+}
+
+// LoanRequestDeclineCandidate is a pending request with the stock exchange of its symbol
+type LoanRequestDeclineCandidate struct {
+	LoanPackageRequest
+	StockExchangeCode string
+}
+
+// LoanRequestDecline is a pending request a scheduler config declines
+type LoanRequestDecline struct {
+	LoanPackageRequest LoanPackageRequest `json:"loanPackageRequest"`
+	StockExchangeCode  string             `json:"stockExchangeCode"`
+	Reason             string             `json:"reason"`
 }
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Locale string
+
+const (
+	LocaleVi Locale = "vi"
+	LocaleEn Locale = "en"
+
+	// DefaultLocale is used for investors without a preference and for keys without a template in the preferred locale
+	DefaultLocale = LocaleVi
+)
+
+var Locales = []Locale{LocaleVi, LocaleEn}
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+func (l Locale) IsValid() bool {
+	for _, locale := range Locales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+func LocaleFromString(s string) Locale {
+	if locale := Locale(s); locale.IsValid() {
+		return locale
+	}
+	return DefaultLocale
+}
+
+type NotificationTemplateKey string
+
+const (
+	NotificationTemplateKeySuggestedOfferCreatedTitle NotificationTemplateKey = "suggested_offer_created.title"
+	NotificationTemplateKeyLoanPackageReadyLoanType   NotificationTemplateKey = "loan_package_ready.loan_type"
+	NotificationTemplateKeyLoanPackageReadyLoanRate   NotificationTemplateKey = "loan_package_ready.loan_rate"
+	NotificationTemplateKeyLoanPackageReadyInterest   NotificationTemplateKey = "loan_package_ready.interest_rate"
+)
+
+func (k NotificationTemplateKey) String() string {
+	return string(k)
+}
+
+// SampleData returns notify data of the type the key is rendered with, templates are validated and previewed against it
+func (k NotificationTemplateKey) SampleData() (any, bool) {
+	switch k {
+	case NotificationTemplateKeySuggestedOfferCreatedTitle:
+		return SuggestedOfferCreatedNotify{
+			InvestorId: "0001000115",
+			Config: SuggestedOfferConfig{
+				Name:      "Margin",
+				Value:     decimal.NewFromFloat(0.099),
+				ValueType: ValueTypeInterestRate,
+			},
+			Offer: SuggestedOffer{AccountNo: "0001000115", Symbols: []string{"HPG"}},
+		}, true
+	case NotificationTemplateKeyLoanPackageReadyLoanType,
+		NotificationTemplateKeyLoanPackageReadyLoanRate,
+		NotificationTemplateKeyLoanPackageReadyInterest:
+		return LoanPackageOfferReadyNotify{
+			InvestorId:   "0001000115",
+			RequestName:  "HPG",
+			AccountNo:    "0001000115",
+			Symbol:       "HPG",
+			LoanRate:     decimal.NewFromFloat(0.5),
+			LoanType:     LoanPackageRequestTypeFlexible,
+			InterestRate: decimal.NewFromFloat(0.099),
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+// NotificationTemplate is the wording of a notification field in one locale, Content is a text/template
+// executed with the notify data of its key
+type NotificationTemplate struct {
+	Id          int64                   `json:"id"`
+	Key         NotificationTemplateKey `json:"key"`
+	Locale      Locale                  `json:"locale"`
+	Content     string                  `json:"content"`
+	Description string                  `json:"description"`
+	CreatedBy   string                  `json:"createdBy"`
+	UpdatedBy   string                  `json:"updatedBy"`
+	CreatedAt   time.Time               `json:"createdAt"`
+	UpdatedAt   time.Time               `json:"updatedAt"`
+}
+
+type NotificationTemplateFilter struct {
+	Keys    []NotificationTemplateKey
+	Locales []Locale
+}
+
+// NotificationTemplatePreview renders Content for Key, the sample data of the key is used when Data is empty
+type NotificationTemplatePreview struct {
+	Key     NotificationTemplateKey `json:"key"`
+	Content string                  `json:"content"`
+	Data    map[string]any          `json:"data"`
+}
+
+type SuggestedOfferCreatedNotify struct {
+	InvestorId string
+	Config     SuggestedOfferConfig
+	Offer      SuggestedOffer
+}
@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// OdooLoanApproval maps a submission sheet to the approval.finx record created for it in Odoo
+type OdooLoanApproval struct {
+	Id                int64                      `json:"id"`
+	SubmissionSheetId int64                      `json:"submissionSheetId"`
+	OdooRecordId      int64                      `json:"odooRecordId"`
+	SyncStatus        OdooLoanApprovalSyncStatus `json:"syncStatus"`
+	OdooState         OdooLoanApprovalState      `json:"odooState"`
+	Attempts          int32                      `json:"attempts"`
+	LastError         string                     `json:"lastError"`
+	NextAttemptAt     time.Time                  `json:"nextAttemptAt"`
+	CreatedAt         time.Time                  `json:"createdAt"`
+	UpdatedAt         time.Time                  `json:"updatedAt"`
+}
+
+type OdooLoanApprovalSyncStatus string
+
+const (
+	// OdooLoanApprovalSyncStatusPending waits for a decision to be made in Odoo
+	OdooLoanApprovalSyncStatusPending OdooLoanApprovalSyncStatus = "PENDING"
+	// OdooLoanApprovalSyncStatusSynced means both sides agree on the decision
+	OdooLoanApprovalSyncStatusSynced OdooLoanApprovalSyncStatus = "SYNCED"
+	// OdooLoanApprovalSyncStatusReporting waits for a decision made in this service to be written to Odoo
+	OdooLoanApprovalSyncStatusReporting OdooLoanApprovalSyncStatus = "REPORTING"
+	// OdooLoanApprovalSyncStatusFailed gave up applying the Odoo decision, see LastError
+	OdooLoanApprovalSyncStatusFailed OdooLoanApprovalSyncStatus = "FAILED"
+)
+
+type OdooLoanApprovalState string
+
+const (
+	OdooLoanApprovalStateApproved OdooLoanApprovalState = "approved"
+	OdooLoanApprovalStateRefused  OdooLoanApprovalState = "refused"
+)
+
+// OdooLoanApprovalDecision is an approval.finx record that was approved or refused in Odoo
+type OdooLoanApprovalDecision struct {
+	RecordId  int64
+	State     OdooLoanApprovalState
+	DecidedBy string
+	DecidedAt time.Time
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+)
+
+type OutboxMessage struct {
+	Id            int64               `json:"id"`
+	Topic         string              `json:"topic"`
+	MessageKey    string              `json:"messageKey"`
+	Payload       []byte              `json:"payload"`
+	Status        OutboxMessageStatus `json:"status"`
+	Attempts      int32               `json:"attempts"`
+	NextAttemptAt time.Time           `json:"nextAttemptAt"`
+	LastError     string              `json:"lastError"`
+	SentAt        *time.Time          `json:"sentAt"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	UpdatedAt     time.Time           `json:"updatedAt"`
+	// TraceContext is the trace context of the transaction the message was stored in
+	TraceContext map[string]string `json:"traceContext"`
+}
+
+type OutboxMessageStatus string
+
+const (
+	OutboxMessageStatusPending OutboxMessageStatus = "PENDING"
+	OutboxMessageStatusSent    OutboxMessageStatus = "SENT"
+	OutboxMessageStatusDead    OutboxMessageStatus = "DEAD"
+)
@@ -0,0 +1,11 @@
+package entity
+
+import (
+	"time"
+)
+
+type RateLimitBucket struct {
+	Key        string    `json:"key"`
+	Tokens     float64   `json:"tokens"`
+	RefilledAt time.Time `json:"refilledAt"`
+}
@@ -2,6 +2,9 @@ package entity
 
 import (
 	"time"
+
+	"financing-offer/internal/core"
+	"financing-offer/pkg/optional"
 )
 
 type SchedulerJob struct {
@@ -10,19 +13,65 @@ type SchedulerJob struct {
 	JobStatus    JobStatus `json:"jobStatus"`
 	TriggerBy    string    `json:"triggerBy"`
 	TrackingData string    `json:"trackingData"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	// ScheduledAt is the cron tick of the run, nil for a run triggered by an admin
+	ScheduledAt *time.Time `json:"scheduledAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
 }
 
 type JobStatus string
 
 const (
+	JobStatusRunning JobStatus = "RUNNING"
 	JobStatusSuccess JobStatus = "SUCCESS"
 	JobStatusFail    JobStatus = "FAIL"
+	// JobStatusSkipped records a run that could not take the lock of the job
+	JobStatusSkipped JobStatus = "SKIPPED"
 )
 
 type JobType string
 
 const (
 	JobTypeDeclineHighRiskLoanRequest JobType = "JobTypeDeclineHighRiskLoanRequest"
+
+	// job types of the runs recorded by the cron scheduler
+	JobTypeExpireLoanOffers             JobType = "ExpireLoanOffers"
+	JobTypeDeclineLoanRequests          JobType = "DeclineLoanRequests"
+	JobTypePurgeIdempotency             JobType = "PurgeIdempotency"
+	JobTypeSyncOdooApprovals            JobType = "SyncOdooApprovals"
+	JobTypeReconcileLoanPackageCreation JobType = "ReconcileLoanPackageCreation"
+	JobTypePurgeRateLimits              JobType = "PurgeRateLimits"
+	JobTypeSyncTradingCalendar          JobType = "SyncTradingCalendar"
+	JobTypePurgeCacheEntries            JobType = "PurgeCacheEntries"
+
+	// job types of the runs only triggered by admins
+	JobTypeSyncLoanPackageData JobType = "SyncLoanPackageData"
+	JobTypeFillInvestorIds     JobType = "FillInvestorIds"
 )
+
+// SchedulerJobRunTracking is the tracking data of a run recorded by the job runner
+type SchedulerJobRunTracking struct {
+	Instance   string         `json:"instance"`
+	DurationMs int64          `json:"durationMs,omitempty"`
+	Attempts   int            `json:"attempts,omitempty"`
+	Error      string         `json:"error,omitempty"`
+	Reason     string         `json:"reason,omitempty"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// SchedulerJobInfo describes a job of the registry with its latest run
+type SchedulerJobInfo struct {
+	Type         JobType       `json:"type"`
+	Cron         string        `json:"cron"`
+	Scheduled    bool          `json:"scheduled"`
+	Timeout      string        `json:"timeout"`
+	MaxAttempts  int           `json:"maxAttempts"`
+	RetryBackoff string        `json:"retryBackoff"`
+	LastRun      *SchedulerJob `json:"lastRun"`
+}
+
+type SchedulerJobFilter struct {
+	core.Paging
+	JobType   optional.Optional[JobType]   `json:"jobType"`
+	JobStatus optional.Optional[JobStatus] `json:"jobStatus"`
+}
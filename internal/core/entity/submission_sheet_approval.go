@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+type SubmissionSheetApproval struct {
+	Id                int64     `json:"id"`
+	SubmissionSheetId int64     `json:"submissionSheetId"`
+	Approver          string    `json:"approver"`
+	OnBehalfOf        string    `json:"onBehalfOf"`
+	CreatedAt         time.Time `json:"createdAt"`
+	ExpiresAt         time.Time `json:"expiresAt"`
+}
+
+// Principal is the user the approval counts for, the delegator when approved through a delegation
+func (a SubmissionSheetApproval) Principal() string {
+	if a.OnBehalfOf != "" {
+		return a.OnBehalfOf
+	}
+	return a.Approver
+}
+
+type SubmissionSheetApprovalProgress struct {
+	SubmissionSheetId int64                     `json:"submissionSheetId"`
+	Status            SubmissionSheetStatus     `json:"status"`
+	RequiredApprovals int                       `json:"requiredApprovals"`
+	Approvals         []SubmissionSheetApproval `json:"approvals"`
+}
+
+type ApprovalDelegation struct {
+	Id        int64     `json:"id"`
+	Delegator string    `json:"delegator"`
+	Delegate  string    `json:"delegate"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
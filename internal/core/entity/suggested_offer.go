@@ -1,15 +1,121 @@
 package entity
 
 import (
+	"slices"
 	"time"
+
+	"github.com/shopspring/decimal"
+
+	"financing-offer/internal/core"
+	"financing-offer/pkg/optional"
 )
 
 type SuggestedOffer struct {
-	Id        int64                 `json:"id"`
-	ConfigId  int64                 `json:"-"`
-	Config    *SuggestedOfferConfig `json:"config"`
-	AccountNo string                `json:"accountNo"`
-	Symbols   []string              `json:"symbols"`
-	CreatedAt time.Time             `json:"createdAt"`
-	UpdatedAt time.Time             `json:"updatedAt"`
+	Id                    int64                 `json:"id"`
+	ConfigId              int64                 `json:"-"`
+	Config                *SuggestedOfferConfig `json:"config"`
+	AccountNo             string                `json:"accountNo"`
+	InvestorId            string                `json:"investorId"`
+	CustodyCode           string                `json:"custodyCode"`
+	Symbols               []string              `json:"symbols"`
+	Status                SuggestedOfferStatus  `json:"status"`
+	Note                  string                `json:"note"`
+	RespondedBy           string                `json:"respondedBy"`
+	RespondedAt           time.Time             `json:"respondedAt"`
+	ConvertedAt           time.Time             `json:"convertedAt"`
+	LoanPackageRequestIds []int64               `json:"loanPackageRequestIds,omitempty"`
+	CreatedAt             time.Time             `json:"createdAt"`
+	UpdatedAt             time.Time             `json:"updatedAt"`
+}
+
+type SuggestedOfferStatus string
+
+const (
+	SuggestedOfferStatusNew       SuggestedOfferStatus = "NEW"
+	SuggestedOfferStatusContacted SuggestedOfferStatus = "CONTACTED"
+	SuggestedOfferStatusConverted SuggestedOfferStatus = "CONVERTED"
+	SuggestedOfferStatusRejected  SuggestedOfferStatus = "REJECTED"
+)
+
+func (s SuggestedOfferStatus) String() string {
+	return string(s)
+}
+
+func (s SuggestedOfferStatus) NextStatuses() []SuggestedOfferStatus {
+	switch s {
+	case SuggestedOfferStatusNew:
+		return []SuggestedOfferStatus{SuggestedOfferStatusContacted, SuggestedOfferStatusConverted, SuggestedOfferStatusRejected}
+	case SuggestedOfferStatusContacted:
+		return []SuggestedOfferStatus{SuggestedOfferStatusConverted, SuggestedOfferStatusRejected}
+	default:
+		return []SuggestedOfferStatus{}
+	}
+}
+
+func (s SuggestedOfferStatus) CanTransitionTo(next SuggestedOfferStatus) bool {
+	return slices.Contains(s.NextStatuses(), next)
+}
+
+func SuggestedOfferStatusFromString(s string) SuggestedOfferStatus {
+	switch s {
+	case string(SuggestedOfferStatusNew):
+		return SuggestedOfferStatusNew
+	case string(SuggestedOfferStatusContacted):
+		return SuggestedOfferStatusContacted
+	case string(SuggestedOfferStatusConverted):
+		return SuggestedOfferStatusConverted
+	case string(SuggestedOfferStatusRejected):
+		return SuggestedOfferStatusRejected
+	default:
+		return SuggestedOfferStatusNew
+	}
+}
+
+type SuggestedOfferFilter struct {
+	core.Paging
+	ConfigIds  []int64
+	Statuses   []SuggestedOfferStatus
+	AccountNo  optional.Optional[string]
+	InvestorId optional.Optional[string]
+	Symbol     optional.Optional[string]
+	StartDate  optional.Optional[time.Time]
+	EndDate    optional.Optional[time.Time]
+}
+
+// SuggestedOfferConversion links a converted suggestion to the loan package request created for one of its symbols
+type SuggestedOfferConversion struct {
+	Id                   int64     `json:"id"`
+	SuggestedOfferId     int64     `json:"suggestedOfferId"`
+	LoanPackageRequestId int64     `json:"loanPackageRequestId"`
+	Symbol               string    `json:"symbol"`
+	CreatedAt            time.Time `json:"createdAt"`
+}
+
+// SuggestedOfferConversionTerms are applied to the loan package request of every symbol of a converted suggestion,
+// the loan rate of a LOAN_RATE program is used when LoanRate is zero
+type SuggestedOfferConversionTerms struct {
+	LoanRate           decimal.Decimal        `json:"loanRate"`
+	LimitAmount        decimal.Decimal        `json:"limitAmount"`
+	Type               LoanPackageRequestType `json:"type"`
+	GuaranteedDuration int                    `json:"guaranteedDuration"`
+}
+
+// SuggestedOfferConversionMetric counts the suggestions of a program by status and the loan package requests they were converted into
+type SuggestedOfferConversionMetric struct {
+	Config             SuggestedOfferConfig `json:"config"`
+	Total              int64                `json:"total"`
+	New                int64                `json:"new"`
+	Contacted          int64                `json:"contacted"`
+	Converted          int64                `json:"converted"`
+	Rejected           int64                `json:"rejected"`
+	ConversionRate     decimal.Decimal      `json:"conversionRate"`
+	LoanRequests       int64                `json:"loanRequests"`
+	ConfirmedRequests  int64                `json:"confirmedRequests"`
+	RequestConfirmRate decimal.Decimal      `json:"requestConfirmRate"`
+}
+
+type SuggestedOfferMetricFilter struct {
+	ConfigIds []int64
+	StartDate optional.Optional[time.Time]
+	EndDate   optional.Optional[time.Time]
 }
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	"financing-offer/pkg/optional"
+)
+
+// TradingCalendarAllExchanges is the stock exchange code of the days that apply to every exchange
+const TradingCalendarAllExchanges = "ALL"
+
+type TradingCalendarDayType string
+
+const (
+	TradingCalendarDayTypeHoliday TradingCalendarDayType = "HOLIDAY"
+	TradingCalendarDayTypeHalfDay TradingCalendarDayType = "HALF_DAY"
+)
+
+func (t TradingCalendarDayType) String() string {
+	return string(t)
+}
+
+type TradingCalendarDaySource string
+
+const (
+	TradingCalendarDaySourceManual       TradingCalendarDaySource = "MANUAL"
+	TradingCalendarDaySourceFinancingApi TradingCalendarDaySource = "FINANCING_API"
+)
+
+func (s TradingCalendarDaySource) String() string {
+	return string(s)
+}
+
+// TradingCalendarDay is a holiday or a half day of a stock exchange, the weekend is never traded.
+// CloseTime is the HH:MM close of a half day, the configured half day close is used when it is empty
+type TradingCalendarDay struct {
+	Id                int64                    `json:"id"`
+	StockExchangeCode string                   `json:"stockExchangeCode"`
+	Date              time.Time                `json:"date"`
+	Type              TradingCalendarDayType   `json:"type"`
+	CloseTime         string                   `json:"closeTime"`
+	Description       string                   `json:"description"`
+	Source            TradingCalendarDaySource `json:"source"`
+	CreatedAt         time.Time                `json:"createdAt"`
+	UpdatedAt         time.Time                `json:"updatedAt"`
+}
+
+type TradingCalendarDayFilter struct {
+	StockExchangeCodes []string
+	DateFrom           optional.Optional[time.Time]
+	DateTo             optional.Optional[time.Time]
+}
+
+// TradingSession is the session of a stock exchange on a date, the times are zero when it is not a trading day
+type TradingSession struct {
+	StockExchangeCode string    `json:"stockExchangeCode"`
+	Date              time.Time `json:"date"`
+	TradingDay        bool      `json:"tradingDay"`
+	HalfDay           bool      `json:"halfDay"`
+	OpenAt            time.Time `json:"openAt"`
+	CloseAt           time.Time `json:"closeAt"`
+	CutOffAt          time.Time `json:"cutOffAt"`
+}
@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"financing-offer/internal/core"
+	"financing-offer/pkg/optional"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookEventTypeRequestCreated      WebhookEventType = "REQUEST_CREATED"
+	WebhookEventTypeOfferReady          WebhookEventType = "OFFER_READY"
+	WebhookEventTypeOfferInterestSigned WebhookEventType = "OFFER_INTEREST_SIGNED"
+	WebhookEventTypeOfferExpired        WebhookEventType = "OFFER_EXPIRED"
+	WebhookEventTypeSubmissionApproved  WebhookEventType = "SUBMISSION_APPROVED"
+)
+
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventTypeRequestCreated,
+	WebhookEventTypeOfferReady,
+	WebhookEventTypeOfferInterestSigned,
+	WebhookEventTypeOfferExpired,
+	WebhookEventTypeSubmissionApproved,
+}
+
+func (t WebhookEventType) String() string {
+	return string(t)
+}
+
+func (t WebhookEventType) IsValid() bool {
+	for _, eventType := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSubscription is an endpoint of a partner system receiving the events of EventTypes,
+// Secret signs the deliveries and is only returned when the subscription is created
+type WebhookSubscription struct {
+	Id         int64              `json:"id"`
+	Name       string             `json:"name"`
+	Url        string             `json:"url"`
+	Secret     string             `json:"secret,omitempty"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Active     bool               `json:"active"`
+	CreatedBy  string             `json:"createdBy"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
+}
+
+func (s WebhookSubscription) Subscribes(eventType WebhookEventType) bool {
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEvent is the JSON body of a delivery
+type WebhookEvent struct {
+	Id         string           `json:"id"`
+	Type       WebhookEventType `json:"type"`
+	OccurredAt time.Time        `json:"occurredAt"`
+	Data       any              `json:"data"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSuccess WebhookDeliveryStatus = "SUCCESS"
+	WebhookDeliveryStatusDead    WebhookDeliveryStatus = "DEAD"
+)
+
+// WebhookDelivery is one delivery of an event to a subscription, a failed attempt stays PENDING
+// until the retries are exhausted
+type WebhookDelivery struct {
+	Id             int64                 `json:"id"`
+	SubscriptionId int64                 `json:"subscriptionId"`
+	EventId        string                `json:"eventId"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Payload        string                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int32                 `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt"`
+	LastError      string                `json:"lastError"`
+	ResponseStatus int32                 `json:"responseStatus"`
+	ReplayOfId     *int64                `json:"replayOfId"`
+	DeliveredAt    *time.Time            `json:"deliveredAt"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+type WebhookDeliveryFilter struct {
+	core.Paging
+	SubscriptionId optional.Optional[int64]                 `json:"subscriptionId"`
+	EventType      optional.Optional[WebhookEventType]      `json:"eventType"`
+	Status         optional.Optional[WebhookDeliveryStatus] `json:"status"`
+}
+
+// WebhookOfferReadyData is the data of an OFFER_READY event, sent when a loan package is assigned to the account of the investor
+type WebhookOfferReadyData struct {
+	LoanPackageRequestId       int64           `json:"loanPackageRequestId"`
+	LoanPackageOfferInterestId int64           `json:"loanPackageOfferInterestId"`
+	InvestorId                 string          `json:"investorId"`
+	AccountNo                  string          `json:"accountNo"`
+	Symbol                     string          `json:"symbol"`
+	AssetType                  AssetType       `json:"assetType"`
+	LoanPackageId              int64           `json:"loanPackageId"`
+	LoanRate                   decimal.Decimal `json:"loanRate"`
+	InterestRate               decimal.Decimal `json:"interestRate"`
+}
+
+// WebhookOfferInterestSignedData is the data of an OFFER_INTEREST_SIGNED event, sent when the investor confirms offer lines
+type WebhookOfferInterestSignedData struct {
+	LoanPackageRequestId        int64   `json:"loanPackageRequestId"`
+	LoanPackageOfferId          int64   `json:"loanPackageOfferId"`
+	LoanPackageOfferInterestIds []int64 `json:"loanPackageOfferInterestIds"`
+	InvestorId                  string  `json:"investorId"`
+}
+
+// WebhookOfferExpiredData is the data of an OFFER_EXPIRED event, sent when the pending lines of an offer are cancelled on expiry
+type WebhookOfferExpiredData struct {
+	LoanPackageOfferId   int64     `json:"loanPackageOfferId"`
+	LoanPackageRequestId int64     `json:"loanPackageRequestId"`
+	ExpiredAt            time.Time `json:"expiredAt"`
+}
+
+// WebhookSubmissionApprovedData is the data of a SUBMISSION_APPROVED event
+type WebhookSubmissionApprovedData struct {
+	SubmissionSheetId    int64  `json:"submissionSheetId"`
+	LoanPackageRequestId int64  `json:"loanPackageRequestId"`
+	ApprovedBy           string `json:"approvedBy"`
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"financing-offer/internal/core/entity"
+)
+
+type IdempotencyRecordRepository interface {
+	// Acquire inserts the record, or takes over an existing one created before expiredBefore or left IN_PROGRESS
+	// since leaseExpiredBefore. It returns qrm.ErrNoRows when the key is held by a live record
+	Acquire(ctx context.Context, record entity.IdempotencyRecord, expiredBefore time.Time, leaseExpiredBefore time.Time) (entity.IdempotencyRecord, error)
+	GetByKey(ctx context.Context, investorId string, idempotencyKey string) (entity.IdempotencyRecord, error)
+	// Complete stores the response of the record while it is held with leaseToken,
+	// it returns false when the lease was lost to another request
+	Complete(ctx context.Context, id int64, leaseToken uuid.UUID, responseStatus int32, responseBody []byte) (bool, error)
+	// Delete drops the record while it is held with leaseToken, it returns false when the lease was lost to another request
+	Delete(ctx context.Context, id int64, leaseToken uuid.UUID) (bool, error)
+	DeleteCreatedBefore(ctx context.Context, before time.Time) (int64, error)
+}
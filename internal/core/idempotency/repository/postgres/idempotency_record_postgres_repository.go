@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/idempotency/repository"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+)
+
+var _ repository.IdempotencyRecordRepository = (*IdempotencyRecordPostgresRepository)(nil)
+
+type IdempotencyRecordPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewIdempotencyRecordPostgresRepository(getDbFunc database.GetDbFunc) *IdempotencyRecordPostgresRepository {
+	return &IdempotencyRecordPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *IdempotencyRecordPostgresRepository) Acquire(ctx context.Context, record entity.IdempotencyRecord, expiredBefore time.Time, leaseExpiredBefore time.Time) (entity.IdempotencyRecord, error) {
+	acquired := model.IdempotencyRecord{}
+	err := table.IdempotencyRecord.INSERT(
+		table.IdempotencyRecord.InvestorID,
+		table.IdempotencyRecord.IdempotencyKey,
+		table.IdempotencyRecord.RequestHash,
+		table.IdempotencyRecord.Status,
+		table.IdempotencyRecord.ResponseStatus,
+		table.IdempotencyRecord.ResponseBody,
+		table.IdempotencyRecord.LeaseToken,
+	).
+		MODEL(MapIdempotencyRecordEntityToDb(record)).
+		ON_CONFLICT(table.IdempotencyRecord.InvestorID, table.IdempotencyRecord.IdempotencyKey).
+		DO_UPDATE(
+			postgres.SET(
+				table.IdempotencyRecord.RequestHash.SET(table.IdempotencyRecord.EXCLUDED.RequestHash),
+				table.IdempotencyRecord.Status.SET(table.IdempotencyRecord.EXCLUDED.Status),
+				table.IdempotencyRecord.ResponseStatus.SET(table.IdempotencyRecord.EXCLUDED.ResponseStatus),
+				table.IdempotencyRecord.ResponseBody.SET(table.IdempotencyRecord.EXCLUDED.ResponseBody),
+				table.IdempotencyRecord.LeaseToken.SET(table.IdempotencyRecord.EXCLUDED.LeaseToken),
+				table.IdempotencyRecord.CreatedAt.SET(postgres.LOCALTIMESTAMP()),
+			).WHERE(
+				table.IdempotencyRecord.CreatedAt.LT(postgres.TimestampT(expiredBefore)).OR(
+					table.IdempotencyRecord.Status.EQ(postgres.String(string(entity.IdempotencyRecordStatusInProgress))).
+						AND(table.IdempotencyRecord.UpdatedAt.LT(postgres.TimestampT(leaseExpiredBefore))),
+				),
+			),
+		).
+		RETURNING(table.IdempotencyRecord.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &acquired)
+	if err != nil {
+		return entity.IdempotencyRecord{}, fmt.Errorf("IdempotencyRecordPostgresRepository Acquire: %w", err)
+	}
+	return MapIdempotencyRecordDbToEntity(acquired), nil
+}
+
+func (r *IdempotencyRecordPostgresRepository) GetByKey(ctx context.Context, investorId string, idempotencyKey string) (entity.IdempotencyRecord, error) {
+	dest := model.IdempotencyRecord{}
+	err := table.IdempotencyRecord.SELECT(table.IdempotencyRecord.AllColumns).
+		WHERE(
+			table.IdempotencyRecord.InvestorID.EQ(postgres.String(investorId)).
+				AND(table.IdempotencyRecord.IdempotencyKey.EQ(postgres.String(idempotencyKey))),
+		).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return entity.IdempotencyRecord{}, fmt.Errorf("IdempotencyRecordPostgresRepository GetByKey: %w", err)
+	}
+	return MapIdempotencyRecordDbToEntity(dest), nil
+}
+
+func (r *IdempotencyRecordPostgresRepository) Complete(ctx context.Context, id int64, leaseToken uuid.UUID, responseStatus int32, responseBody []byte) (bool, error) {
+	res, err := table.IdempotencyRecord.UPDATE(
+		table.IdempotencyRecord.Status,
+		table.IdempotencyRecord.ResponseStatus,
+		table.IdempotencyRecord.ResponseBody,
+	).
+		MODEL(
+			model.IdempotencyRecord{
+				Status:         string(entity.IdempotencyRecordStatusCompleted),
+				ResponseStatus: responseStatus,
+				ResponseBody:   responseBody,
+			},
+		).
+		WHERE(leasedBy(id, leaseToken)).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return false, fmt.Errorf("IdempotencyRecordPostgresRepository Complete: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("IdempotencyRecordPostgresRepository Complete: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *IdempotencyRecordPostgresRepository) Delete(ctx context.Context, id int64, leaseToken uuid.UUID) (bool, error) {
+	res, err := table.IdempotencyRecord.DELETE().
+		WHERE(leasedBy(id, leaseToken)).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return false, fmt.Errorf("IdempotencyRecordPostgresRepository Delete: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("IdempotencyRecordPostgresRepository Delete: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *IdempotencyRecordPostgresRepository) DeleteCreatedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := table.IdempotencyRecord.DELETE().
+		WHERE(table.IdempotencyRecord.CreatedAt.LT(postgres.TimestampT(before))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("IdempotencyRecordPostgresRepository DeleteCreatedBefore: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("IdempotencyRecordPostgresRepository DeleteCreatedBefore: %w", err)
+	}
+	return deleted, nil
+}
+
+func leasedBy(id int64, leaseToken uuid.UUID) postgres.BoolExpression {
+	return table.IdempotencyRecord.ID.EQ(postgres.Int64(id)).
+		AND(table.IdempotencyRecord.LeaseToken.EQ(postgres.UUID(leaseToken)))
+}
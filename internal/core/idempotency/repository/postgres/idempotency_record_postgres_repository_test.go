@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestIdempotencyRecordPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, _ := dbtest.New()
+	repo := NewIdempotencyRecordPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	columns := []string{
+		"idempotency_record.id",
+		"idempotency_record.investor_id",
+		"idempotency_record.idempotency_key",
+		"idempotency_record.request_hash",
+		"idempotency_record.status",
+		"idempotency_record.response_status",
+		"idempotency_record.response_body",
+		"idempotency_record.created_at",
+		"idempotency_record.updated_at",
+		"idempotency_record.lease_token",
+	}
+
+	leaseToken := uuid.New()
+
+	t.Run("AcquireSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)INSERT INTO public.idempotency_record .+lease_token.+ON CONFLICT .+DO UPDATE .+lease_token = excluded.lease_token.+WHERE .+created_at < .+OR .+status = .+updated_at < .+RETURNING`).
+			WillReturnRows(
+				mock.NewRows(columns).AddRow(1, "investor", "key", "hash", "IN_PROGRESS", 0, nil, now, now, leaseToken),
+			)
+		acquired, err := repo.Acquire(
+			context.Background(), entity.IdempotencyRecord{
+				InvestorId:     "investor",
+				IdempotencyKey: "key",
+				RequestHash:    "hash",
+				Status:         entity.IdempotencyRecordStatusInProgress,
+				LeaseToken:     leaseToken,
+			}, now, now,
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), acquired.Id)
+		assert.Equal(t, leaseToken, acquired.LeaseToken)
+		assert.Equal(t, entity.IdempotencyRecordStatusInProgress, acquired.Status)
+	})
+
+	t.Run("AcquireHeldByLiveRecord", func(t *testing.T) {
+		mock.ExpectQuery("INSERT").WillReturnRows(mock.NewRows(columns))
+		_, err := repo.Acquire(context.Background(), entity.IdempotencyRecord{}, time.Now(), time.Now())
+		assert.ErrorIs(t, err, qrm.ErrNoRows)
+	})
+
+	t.Run("GetByKeySuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery("SELECT .+ FROM public.idempotency_record").WillReturnRows(
+			mock.NewRows(columns).AddRow(1, "investor", "key", "hash", "COMPLETED", 201, []byte(`{"id":1}`), now, now, leaseToken),
+		)
+		record, err := repo.GetByKey(context.Background(), "investor", "key")
+		assert.Nil(t, err)
+		assert.Equal(t, entity.IdempotencyRecordStatusCompleted, record.Status)
+		assert.Equal(t, int32(201), record.ResponseStatus)
+		assert.Equal(t, []byte(`{"id":1}`), record.ResponseBody)
+	})
+
+	t.Run("GetByKeyFailure", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.GetByKey(context.Background(), "investor", "key")
+		assert.Equal(t, "IdempotencyRecordPostgresRepository GetByKey: jet: error", err.Error())
+	})
+
+	t.Run("CompleteSuccess", func(t *testing.T) {
+		mock.ExpectExec(`(?s)UPDATE public.idempotency_record .+WHERE .+id = .+AND .+lease_token = `).
+			WithArgs("COMPLETED", int32(201), []byte(`{"id":1}`), int64(1), leaseToken.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		completed, err := repo.Complete(context.Background(), 1, leaseToken, 201, []byte(`{"id":1}`))
+		assert.Nil(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("CompleteLeaseLost", func(t *testing.T) {
+		mock.ExpectExec("UPDATE public.idempotency_record").WillReturnResult(sqlmock.NewResult(0, 0))
+		completed, err := repo.Complete(context.Background(), 1, leaseToken, 201, nil)
+		assert.Nil(t, err)
+		assert.False(t, completed)
+	})
+
+	t.Run("CompleteFailure", func(t *testing.T) {
+		mock.ExpectExec("UPDATE").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.Complete(context.Background(), 1, leaseToken, 201, nil)
+		assert.Equal(t, "IdempotencyRecordPostgresRepository Complete: error", err.Error())
+	})
+
+	t.Run("DeleteSuccess", func(t *testing.T) {
+		mock.ExpectExec(`(?s)DELETE FROM public.idempotency_record\s+WHERE .+id = .+AND .+lease_token = `).
+			WithArgs(int64(1), leaseToken.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		deleted, err := repo.Delete(context.Background(), 1, leaseToken)
+		assert.Nil(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("DeleteLeaseLost", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM public.idempotency_record").WillReturnResult(sqlmock.NewResult(0, 0))
+		deleted, err := repo.Delete(context.Background(), 1, leaseToken)
+		assert.Nil(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("DeleteFailure", func(t *testing.T) {
+		mock.ExpectExec("DELETE").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.Delete(context.Background(), 1, leaseToken)
+		assert.Equal(t, "IdempotencyRecordPostgresRepository Delete: error", err.Error())
+	})
+
+	t.Run("DeleteCreatedBeforeSuccess", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM public.idempotency_record").WillReturnResult(sqlmock.NewResult(0, 3))
+		deleted, err := repo.DeleteCreatedBefore(context.Background(), time.Now())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), deleted)
+	})
+
+	t.Run("DeleteCreatedBeforeFailure", func(t *testing.T) {
+		mock.ExpectExec("DELETE").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.DeleteCreatedBefore(context.Background(), time.Now())
+		assert.Equal(t, "IdempotencyRecordPostgresRepository DeleteCreatedBefore: error", err.Error())
+	})
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func MapIdempotencyRecordDbToEntity(record model.IdempotencyRecord) entity.IdempotencyRecord {
+	return entity.IdempotencyRecord{
+		Id:             record.ID,
+		InvestorId:     record.InvestorID,
+		IdempotencyKey: record.IdempotencyKey,
+		RequestHash:    record.RequestHash,
+		Status:         entity.IdempotencyRecordStatus(record.Status),
+		ResponseStatus: record.ResponseStatus,
+		ResponseBody:   record.ResponseBody,
+		CreatedAt:      record.CreatedAt,
+		UpdatedAt:      record.UpdatedAt,
+		LeaseToken:     record.LeaseToken,
+	}
+}
+
+func MapIdempotencyRecordEntityToDb(record entity.IdempotencyRecord) model.IdempotencyRecord {
+	return model.IdempotencyRecord{
+		ID:             record.Id,
+		InvestorID:     record.InvestorId,
+		IdempotencyKey: record.IdempotencyKey,
+		RequestHash:    record.RequestHash,
+		Status:         string(record.Status),
+		ResponseStatus: record.ResponseStatus,
+		ResponseBody:   record.ResponseBody,
+		CreatedAt:      record.CreatedAt,
+		UpdatedAt:      record.UpdatedAt,
+		LeaseToken:     record.LeaseToken,
+	}
+}
@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/idempotency"
+	"financing-offer/internal/core/scheduler"
+)
+
+type IdempotencyScheduler struct {
+	logger       *slog.Logger
+	useCase      idempotency.UseCase
+	errorService apperrors.Service
+}
+
+func NewIdempotencyScheduler(logger *slog.Logger, useCase idempotency.UseCase, errorService apperrors.Service) *IdempotencyScheduler {
+	return &IdempotencyScheduler{
+		logger:       logger,
+		useCase:      useCase,
+		errorService: errorService,
+	}
+}
+
+func (s *IdempotencyScheduler) PurgeExpired(ctx context.Context) error {
+	deleted, err := s.useCase.PurgeExpired(ctx)
+	if err != nil {
+		s.logger.Error("PurgeExpired", slog.String("error", err.Error()))
+		if scheduler.FinalAttempt(ctx) {
+			if err := s.errorService.NotifyError(ctx, err); err != nil {
+				s.logger.Error("PurgeExpired NotifyError", slog.String("error", err.Error()))
+			}
+		}
+		return err
+	}
+	s.logger.Info("PurgeExpired", slog.Int64("deleted", deleted))
+	scheduler.Track(ctx, "deleted", deleted)
+	return nil
+}
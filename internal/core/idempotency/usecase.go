@@ -0,0 +1,106 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/idempotency/repository"
+)
+
+type UseCase interface {
+	// Begin reserves the key for the investor. The returned record is IN_PROGRESS when the caller owns the key
+	// and COMPLETED when the stored response should be replayed
+	Begin(ctx context.Context, investorId string, idempotencyKey string, requestHash string) (entity.IdempotencyRecord, error)
+	// Complete stores the response of the record returned by Begin,
+	// apperrors.ErrIdempotencyLeaseLost is returned when the key was taken over by another request meanwhile
+	Complete(ctx context.Context, record entity.IdempotencyRecord, responseStatus int32, responseBody []byte) error
+	// Release drops a reserved key so that the request can be retried with it,
+	// apperrors.ErrIdempotencyLeaseLost is returned when the key was taken over by another request meanwhile
+	Release(ctx context.Context, record entity.IdempotencyRecord) error
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+type useCase struct {
+	cfg        config.IdempotencyConfig
+	repository repository.IdempotencyRecordRepository
+}
+
+func NewUseCase(cfg config.IdempotencyConfig, repository repository.IdempotencyRecordRepository) UseCase {
+	return &useCase{
+		cfg:        cfg,
+		repository: repository,
+	}
+}
+
+func (u *useCase) Begin(ctx context.Context, investorId string, idempotencyKey string, requestHash string) (entity.IdempotencyRecord, error) {
+	errorTemplate := "idempotency Begin %w"
+	now := time.Now()
+	record, err := u.repository.Acquire(
+		ctx, entity.IdempotencyRecord{
+			InvestorId:     investorId,
+			IdempotencyKey: idempotencyKey,
+			RequestHash:    requestHash,
+			Status:         entity.IdempotencyRecordStatusInProgress,
+			LeaseToken:     uuid.New(),
+		}, now.Add(-u.cfg.Retention), now.Add(-u.cfg.InProgressLease),
+	)
+	if err == nil {
+		return record, nil
+	}
+	if !apperrors.IsNotFoundError(err) {
+		return entity.IdempotencyRecord{}, fmt.Errorf(errorTemplate, err)
+	}
+	existing, err := u.repository.GetByKey(ctx, investorId, idempotencyKey)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			// released by the other request in the meantime
+			return entity.IdempotencyRecord{}, apperrors.ErrIdempotencyKeyInProgress
+		}
+		return entity.IdempotencyRecord{}, fmt.Errorf(errorTemplate, err)
+	}
+	if existing.RequestHash != requestHash {
+		return entity.IdempotencyRecord{}, apperrors.ErrIdempotencyKeyReused
+	}
+	if existing.Status != entity.IdempotencyRecordStatusCompleted {
+		return entity.IdempotencyRecord{}, apperrors.ErrIdempotencyKeyInProgress
+	}
+	return existing, nil
+}
+
+func (u *useCase) Complete(ctx context.Context, record entity.IdempotencyRecord, responseStatus int32, responseBody []byte) error {
+	errorTemplate := "idempotency Complete %w"
+	completed, err := u.repository.Complete(ctx, record.Id, record.LeaseToken, responseStatus, responseBody)
+	if err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	if !completed {
+		return fmt.Errorf(errorTemplate, apperrors.ErrIdempotencyLeaseLost)
+	}
+	return nil
+}
+
+func (u *useCase) Release(ctx context.Context, record entity.IdempotencyRecord) error {
+	errorTemplate := "idempotency Release %w"
+	deleted, err := u.repository.Delete(ctx, record.Id, record.LeaseToken)
+	if err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	if !deleted {
+		return fmt.Errorf(errorTemplate, apperrors.ErrIdempotencyLeaseLost)
+	}
+	return nil
+}
+
+func (u *useCase) PurgeExpired(ctx context.Context) (int64, error) {
+	deleted, err := u.repository.DeleteCreatedBefore(ctx, time.Now().Add(-u.cfg.Retention))
+	if err != nil {
+		return 0, fmt.Errorf("idempotency PurgeExpired %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,183 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestIdempotencyUseCase_Begin(t *testing.T) {
+	t.Parallel()
+	cfg := config.IdempotencyConfig{Retention: time.Hour, InProgressLease: time.Minute}
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockIdempotencyRecordRepository) {
+		repository := mock.NewMockIdempotencyRecordRepository(t)
+		return NewUseCase(cfg, repository), repository
+	}
+	inProgress := entity.IdempotencyRecord{
+		Id:             1,
+		InvestorId:     "investor",
+		IdempotencyKey: "key",
+		RequestHash:    "hash",
+		Status:         entity.IdempotencyRecordStatusInProgress,
+	}
+
+	t.Run(
+		"Begin_acquired", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Acquire(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(record entity.IdempotencyRecord) bool {
+						return record.InvestorId == "investor" && record.IdempotencyKey == "key" &&
+							record.RequestHash == "hash" && record.Status == entity.IdempotencyRecordStatusInProgress &&
+							record.LeaseToken != uuid.Nil
+					},
+				), testifyMock.Anything, testifyMock.Anything,
+			).Return(inProgress, nil)
+			record, err := useCase.Begin(context.Background(), "investor", "key", "hash")
+			assert.Nil(t, err)
+			assert.Equal(t, inProgress, record)
+		},
+	)
+
+	t.Run(
+		"Begin_replay_completed", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			completed := inProgress
+			completed.Status = entity.IdempotencyRecordStatusCompleted
+			completed.ResponseStatus = 200
+			completed.ResponseBody = []byte(`{"data":{}}`)
+			repository.EXPECT().Acquire(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).
+				Return(entity.IdempotencyRecord{}, qrm.ErrNoRows)
+			repository.EXPECT().GetByKey(testifyMock.Anything, "investor", "key").Return(completed, nil)
+			record, err := useCase.Begin(context.Background(), "investor", "key", "hash")
+			assert.Nil(t, err)
+			assert.Equal(t, completed, record)
+		},
+	)
+
+	t.Run(
+		"Begin_payload_mismatch", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Acquire(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).
+				Return(entity.IdempotencyRecord{}, qrm.ErrNoRows)
+			repository.EXPECT().GetByKey(testifyMock.Anything, "investor", "key").Return(inProgress, nil)
+			_, err := useCase.Begin(context.Background(), "investor", "key", "other-hash")
+			assert.ErrorIs(t, err, apperrors.ErrIdempotencyKeyReused)
+		},
+	)
+
+	t.Run(
+		"Begin_in_progress", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Acquire(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).
+				Return(entity.IdempotencyRecord{}, qrm.ErrNoRows)
+			repository.EXPECT().GetByKey(testifyMock.Anything, "investor", "key").Return(inProgress, nil)
+			_, err := useCase.Begin(context.Background(), "investor", "key", "hash")
+			assert.ErrorIs(t, err, apperrors.ErrIdempotencyKeyInProgress)
+		},
+	)
+
+	t.Run(
+		"Begin_takes_over_after_lease", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Acquire(
+				testifyMock.Anything, testifyMock.Anything,
+				testifyMock.MatchedBy(
+					func(expiredBefore time.Time) bool {
+						return expiredBefore.Before(time.Now().Add(-59 * time.Minute))
+					},
+				),
+				testifyMock.MatchedBy(
+					func(leaseExpiredBefore time.Time) bool {
+						return leaseExpiredBefore.Before(time.Now().Add(-59*time.Second)) &&
+							leaseExpiredBefore.After(time.Now().Add(-2*time.Minute))
+					},
+				),
+			).Return(inProgress, nil)
+			record, err := useCase.Begin(context.Background(), "investor", "key", "hash")
+			assert.Nil(t, err)
+			assert.Equal(t, inProgress, record)
+		},
+	)
+
+	t.Run(
+		"Begin_error", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Acquire(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).
+				Return(entity.IdempotencyRecord{}, errors.New("db error"))
+			_, err := useCase.Begin(context.Background(), "investor", "key", "hash")
+			assert.Equal(t, "idempotency Begin db error", err.Error())
+		},
+	)
+}
+
+func TestIdempotencyUseCase_Complete(t *testing.T) {
+	t.Parallel()
+	record := entity.IdempotencyRecord{Id: 1, LeaseToken: uuid.New(), Status: entity.IdempotencyRecordStatusInProgress}
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockIdempotencyRecordRepository) {
+		repository := mock.NewMockIdempotencyRecordRepository(t)
+		return NewUseCase(config.IdempotencyConfig{}, repository), repository
+	}
+
+	t.Run(
+		"Complete_success", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Complete(testifyMock.Anything, record.Id, record.LeaseToken, int32(201), []byte(`{}`)).
+				Return(true, nil)
+			assert.Nil(t, useCase.Complete(context.Background(), record, 201, []byte(`{}`)))
+		},
+	)
+
+	t.Run(
+		"Complete_lease_lost", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Complete(testifyMock.Anything, record.Id, record.LeaseToken, int32(201), []byte(`{}`)).
+				Return(false, nil)
+			err := useCase.Complete(context.Background(), record, 201, []byte(`{}`))
+			assert.ErrorIs(t, err, apperrors.ErrIdempotencyLeaseLost)
+		},
+	)
+
+	t.Run(
+		"Release_success", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Delete(testifyMock.Anything, record.Id, record.LeaseToken).Return(true, nil)
+			assert.Nil(t, useCase.Release(context.Background(), record))
+		},
+	)
+
+	t.Run(
+		"Release_lease_lost", func(t *testing.T) {
+			useCase, repository := newUseCase(t)
+			repository.EXPECT().Delete(testifyMock.Anything, record.Id, record.LeaseToken).Return(false, nil)
+			assert.ErrorIs(t, useCase.Release(context.Background(), record), apperrors.ErrIdempotencyLeaseLost)
+		},
+	)
+}
+
+func TestIdempotencyUseCase_PurgeExpired(t *testing.T) {
+	t.Parallel()
+	repository := mock.NewMockIdempotencyRecordRepository(t)
+	useCase := NewUseCase(config.IdempotencyConfig{Retention: time.Hour}, repository)
+	repository.EXPECT().DeleteCreatedBefore(
+		testifyMock.Anything, testifyMock.MatchedBy(
+			func(before time.Time) bool {
+				return before.Before(time.Now().Add(-59 * time.Minute))
+			},
+		),
+	).Return(int64(2), nil)
+	deleted, err := useCase.PurgeExpired(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), deleted)
+}
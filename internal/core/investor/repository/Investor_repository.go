@@ -12,4 +12,6 @@ type InvestorPersistenceRepository interface {
 	Update(ctx context.Context, investor entity.Investor) (entity.Investor, error)
 	GetAllUniqueInvestorIdsFromRequests(ctx context.Context) ([]string, error)
 	GetAllInvestorIdsForMigration(ctx context.Context) ([]string, error)
+	GetPreferredLocale(ctx context.Context, investorId string) (entity.Locale, error)
+	UpdatePreferredLocale(ctx context.Context, investorId string, locale entity.Locale) error
 }
@@ -2,13 +2,16 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
 
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/investor/repository"
 	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
 	"financing-offer/internal/database/dbmodels/finoffer/public/table"
 )
 
@@ -69,7 +72,7 @@ func (r *InvestorPostgresRepository) BulkCreate(ctx context.Context, investors [
 func (r *InvestorPostgresRepository) Update(ctx context.Context, investor entity.Investor) (entity.Investor, error) {
 	updatedModel := MapInvestorEntityToDb(investor)
 	if _, err := table.Investor.
-		UPDATE(table.Investor.MutableColumns).
+		UPDATE(table.Investor.CustodyCode).
 		MODEL(updatedModel).
 		WHERE(table.Investor.InvestorID.EQ(postgres.String(investor.InvestorId))).
 		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
@@ -78,6 +81,32 @@ func (r *InvestorPostgresRepository) Update(ctx context.Context, investor entity
 	return investor, nil
 }
 
+// GetPreferredLocale returns the default locale for an investor who never requested a loan
+func (r *InvestorPostgresRepository) GetPreferredLocale(ctx context.Context, investorId string) (entity.Locale, error) {
+	var dest model.Investor
+	if err := table.Investor.
+		SELECT(table.Investor.PreferredLocale).
+		WHERE(table.Investor.InvestorID.EQ(postgres.String(investorId))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return entity.DefaultLocale, nil
+		}
+		return "", fmt.Errorf("InvestorPostgresRepository GetPreferredLocale %w", err)
+	}
+	return entity.LocaleFromString(dest.PreferredLocale), nil
+}
+
+func (r *InvestorPostgresRepository) UpdatePreferredLocale(ctx context.Context, investorId string, locale entity.Locale) error {
+	if _, err := table.Investor.
+		UPDATE(table.Investor.PreferredLocale).
+		SET(locale.String()).
+		WHERE(table.Investor.InvestorID.EQ(postgres.String(investorId))).
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("InvestorPostgresRepository UpdatePreferredLocale %w", err)
+	}
+	return nil
+}
+
 func NewInvestorPostgresRepository(getDbFunc database.GetDbFunc) *InvestorPostgresRepository {
 	return &InvestorPostgresRepository{getDbFunc: getDbFunc}
 }
@@ -15,19 +15,25 @@ func MapInvestorsDbToEntity(investorDb []model.Investor) []entity.Investor {
 
 func MapInvestorDbToEntity(investor model.Investor) entity.Investor {
 	return entity.Investor{
-		InvestorId:  investor.InvestorID,
-		CustodyCode: investor.CustodyCode,
-		CreatedAt:   investor.CreatedAt,
-		UpdatedAt:   investor.UpdatedAt,
+		InvestorId:      investor.InvestorID,
+		CustodyCode:     investor.CustodyCode,
+		PreferredLocale: entity.LocaleFromString(investor.PreferredLocale),
+		CreatedAt:       investor.CreatedAt,
+		UpdatedAt:       investor.UpdatedAt,
 	}
 }
 
 func MapInvestorEntityToDb(investor entity.Investor) model.Investor {
+	locale := investor.PreferredLocale
+	if !locale.IsValid() {
+		locale = entity.DefaultLocale
+	}
 	return model.Investor{
-		InvestorID:  investor.InvestorId,
-		CustodyCode: investor.CustodyCode,
-		CreatedAt:   investor.CreatedAt,
-		UpdatedAt:   investor.UpdatedAt,
+		InvestorID:      investor.InvestorId,
+		CustodyCode:     investor.CustodyCode,
+		CreatedAt:       investor.CreatedAt,
+		UpdatedAt:       investor.UpdatedAt,
+		PreferredLocale: locale.String(),
 	}
 }
 
@@ -19,13 +19,14 @@ type InvestorHandler struct {
 // FillInvestorIdsFromRequests godoc
 //
 //	@Summary		Fill investor ids from requests
-//	@Description	Fill investor ids from requests
+//	@Description	Fill investor ids from requests, deprecated in favor of triggering the FillInvestorIds scheduler job
 //	@Tags			investor,admin
 //	@Accept			json
 //	@Produce		json
 //	@Success		200	{object}	handler.BaseResponse[int]
 //	@Failure		500	{object}	handler.ErrorResponse
 //	@Security		BearerAuth
+//	@Deprecated
 //	@Router			/internal/investors/sync-investor-data [post]
 func (h *InvestorHandler) FillInvestorIdsFromRequests(ctx *gin.Context) {
 	investorIdsCount, err := h.investorUseCase.FillInvestorIdsFromRequests(ctx)
@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/investor"
+	"financing-offer/internal/core/scheduler"
+)
+
+type InvestorScheduler struct {
+	logger       *slog.Logger
+	useCase      investor.UseCase
+	errorService apperrors.Service
+}
+
+func NewInvestorScheduler(logger *slog.Logger, useCase investor.UseCase, errorService apperrors.Service) *InvestorScheduler {
+	return &InvestorScheduler{
+		logger:       logger,
+		useCase:      useCase,
+		errorService: errorService,
+	}
+}
+
+func (s *InvestorScheduler) FillInvestorIds(ctx context.Context) error {
+	filled, err := s.useCase.FillInvestorIdsFromRequests(ctx)
+	if err != nil {
+		s.logger.Error("FillInvestorIds", slog.String("error", err.Error()))
+		if scheduler.FinalAttempt(ctx) {
+			if err := s.errorService.NotifyError(ctx, err); err != nil {
+				s.logger.Error("FillInvestorIds NotifyError", slog.String("error", err.Error()))
+			}
+		}
+		return err
+	}
+	s.logger.Info("FillInvestorIds", slog.Int("filled", filled))
+	scheduler.Track(ctx, "filled", filled)
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 type InvestorAccountRepository interface {
 	GetByAccountNo(ctx context.Context, accountNo string) (entity.InvestorAccount, error)
+	GetByInvestorId(ctx context.Context, investorId string) ([]entity.InvestorAccount, error)
 	Update(ctx context.Context, account entity.InvestorAccount) (entity.InvestorAccount, error)
 	Create(ctx context.Context, account entity.InvestorAccount) (entity.InvestorAccount, error)
 }
@@ -32,6 +32,23 @@ func (r *InvestorAccountPostgresRepository) GetByAccountNo(ctx context.Context,
 	return MapInvestorAccountDbToEntity(account), nil
 }
 
+func (r *InvestorAccountPostgresRepository) GetByInvestorId(ctx context.Context, investorId string) ([]entity.InvestorAccount, error) {
+	errorTemplate := "GetByInvestorId %w"
+	accounts := make([]model.InvestorAccount, 0)
+	if err := table.InvestorAccount.
+		SELECT(table.InvestorAccount.AllColumns).
+		WHERE(table.InvestorAccount.InvestorID.EQ(postgres.String(investorId))).
+		ORDER_BY(table.InvestorAccount.AccountNo).
+		QueryContext(ctx, r.getDbFunc(ctx), &accounts); err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	res := make([]entity.InvestorAccount, 0, len(accounts))
+	for _, account := range accounts {
+		res = append(res, MapInvestorAccountDbToEntity(account))
+	}
+	return res, nil
+}
+
 func (r *InvestorAccountPostgresRepository) Update(ctx context.Context, account entity.InvestorAccount) (entity.InvestorAccount, error) {
 	errorTemplate := "Update %w"
 	updateModel := MapInvestorAccountEntityToDb(account)
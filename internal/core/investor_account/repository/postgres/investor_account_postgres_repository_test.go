@@ -55,6 +55,59 @@ func TestInvestorAccountPostgresRepository_GetByAccountNo(t *testing.T) {
 	})
 }
 
+func TestInvestorAccountPostgresRepository_GetByInvestorId(t *testing.T) {
+	t.Parallel()
+	db, mock, err := dbtest.New()
+	if err != nil {
+		t.Error(err)
+	}
+	repo := NewInvestorAccountPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	// Test data
+	accounts := []entity.InvestorAccount{
+		{
+			AccountNo:    "0001000115",
+			InvestorId:   "0001000115",
+			MarginStatus: entity.MarginStatusVersion2,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+		{
+			AccountNo:    "0001000116",
+			InvestorId:   "0001000115",
+			MarginStatus: entity.MarginStatusVersion3,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+	// Test cases
+	t.Run("Get investor_accounts by investor id success", func(t *testing.T) {
+		rows := sqlmock.NewRows(
+			[]string{
+				"investor_account.account_no",
+				"investor_account.investor_id",
+				"investor_account.margin_status",
+				"investor_account.created_at",
+				"investor_account.updated_at",
+			})
+		for _, account := range accounts {
+			rows.AddRow(account.AccountNo, account.InvestorId, account.MarginStatus, account.CreatedAt, account.UpdatedAt)
+		}
+		mock.ExpectQuery("SELECT").WithArgs("0001000115").WillReturnRows(rows)
+		res, err := repo.GetByInvestorId(context.Background(), "0001000115")
+		assert.Nil(t, err)
+		assert.Equal(t, accounts, res)
+	})
+	t.Run("Get investor_accounts by investor id error", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").WithArgs("4").WillReturnError(assert.AnError)
+		_, err := repo.GetByInvestorId(context.Background(), "4")
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
 func TestInvestorAccountPostgresRepository_Update(t *testing.T) {
 	t.Parallel()
 	db, mock, err := dbtest.New()
@@ -0,0 +1,38 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/investor_account"
+	"financing-offer/internal/event"
+)
+
+// NewAccountMarginChangedHandler keeps investor_account in line with the margin status changes published by MO,
+// so VerifyAndUpdateInvestorAccountMarginStatus mostly finds the account up to date
+func NewAccountMarginChangedHandler(
+	cfg config.KafkaConsumerConfig,
+	logger *slog.Logger,
+	useCase investor_account.UseCase,
+) event.Handler {
+	return event.NewJsonHandler(
+		cfg.AccountMarginChangedTopic, func(ctx context.Context, _ string, changed entity.AccountMarginChangedEvent) error {
+			if changed.AccountNo == "" {
+				return event.Poison(errors.New("accountNo is required"))
+			}
+			account, err := useCase.HandleAccountMarginChanged(ctx, changed)
+			if err != nil {
+				return err
+			}
+			logger.Info(
+				"account margin changed",
+				slog.String("accountNo", account.AccountNo),
+				slog.String("marginStatus", account.MarginStatus.String()),
+			)
+			return nil
+		},
+	)
+}
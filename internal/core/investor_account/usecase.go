@@ -17,6 +17,7 @@ import (
 
 type UseCase interface {
 	VerifyAndUpdateInvestorAccountMarginStatus(ctx context.Context, request entity.InvestorAccount) (entity.InvestorAccount, error)
+	HandleAccountMarginChanged(ctx context.Context, event entity.AccountMarginChangedEvent) (entity.InvestorAccount, error)
 }
 
 type useCase struct {
@@ -64,6 +65,42 @@ func (u *useCase) VerifyAndUpdateInvestorAccountMarginStatus(ctx context.Context
 	return account, nil
 }
 
+// HandleAccountMarginChanged saves the margin status MO reported for an account.
+// Accounts only move from version 2 to version 3, so a version 2 event reaching a version 3 account is outdated and ignored
+func (u *useCase) HandleAccountMarginChanged(ctx context.Context, event entity.AccountMarginChangedEvent) (entity.InvestorAccount, error) {
+	errorTemplate := "HandleAccountMarginChanged useCase %w"
+	marginStatus := entity.MarginStatusFromString(event.MarginStatus.String())
+	if marginStatus == "" {
+		return entity.InvestorAccount{}, apperrors.ErrInvalidInput(fmt.Sprintf("unknown margin status %s", event.MarginStatus))
+	}
+	account, err := u.repository.GetByAccountNo(ctx, event.AccountNo)
+	if errors.Is(err, qrm.ErrNoRows) {
+		account, err = u.repository.Create(
+			ctx, entity.InvestorAccount{
+				AccountNo:    event.AccountNo,
+				InvestorId:   event.InvestorId,
+				MarginStatus: marginStatus,
+			},
+		)
+		if err != nil {
+			return entity.InvestorAccount{}, fmt.Errorf(errorTemplate, err)
+		}
+		return account, nil
+	}
+	if err != nil {
+		return entity.InvestorAccount{}, fmt.Errorf(errorTemplate, err)
+	}
+	if account.MarginStatus == marginStatus || account.MarginStatus == entity.MarginStatusVersion3 {
+		return account, nil
+	}
+	account.MarginStatus = marginStatus
+	account, err = u.repository.Update(ctx, account)
+	if err != nil {
+		return entity.InvestorAccount{}, fmt.Errorf(errorTemplate, err)
+	}
+	return account, nil
+}
+
 func (u *useCase) checkMarginStatus(ctx context.Context, accountNo string) (entity.MarginStatus, error) {
 	errorTemplate := "checkMarginStatus useCase %w"
 	loanPackages, err := u.orderServiceRepository.GetAllAccountLoanPackages(ctx, accountNo)
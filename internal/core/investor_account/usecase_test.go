@@ -367,3 +367,65 @@ func TestInvestorAccountUseCase(t *testing.T) {
 		},
 	)
 }
+
+func TestInvestorAccountUseCase_HandleAccountMarginChanged(t *testing.T) {
+	t.Parallel()
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockInvestorAccountRepository) {
+		investorAccountRepository := mock.NewMockInvestorAccountRepository(t)
+		return NewUseCase(investorAccountRepository, mock.NewMockOrderServiceRepository(t)), investorAccountRepository
+	}
+	changed := entity.AccountMarginChangedEvent{
+		AccountNo:    "1",
+		InvestorId:   "1",
+		MarginStatus: entity.MarginStatusVersion3,
+	}
+
+	t.Run(
+		"HandleAccountMarginChanged creates the missing account", func(t *testing.T) {
+			useCase, investorAccountRepository := newUseCase(t)
+			created := entity.InvestorAccount{AccountNo: "1", InvestorId: "1", MarginStatus: entity.MarginStatusVersion3}
+			investorAccountRepository.EXPECT().GetByAccountNo(mock2.Anything, "1").Return(entity.InvestorAccount{}, qrm.ErrNoRows)
+			investorAccountRepository.EXPECT().Create(mock2.Anything, created).Return(created, nil)
+			account, err := useCase.HandleAccountMarginChanged(context.Background(), changed)
+			assert.Nil(t, err)
+			assert.Equal(t, created, account)
+		},
+	)
+
+	t.Run(
+		"HandleAccountMarginChanged upgrades a version 2 account", func(t *testing.T) {
+			useCase, investorAccountRepository := newUseCase(t)
+			investorAccountRepository.EXPECT().GetByAccountNo(mock2.Anything, "1").Return(
+				entity.InvestorAccount{AccountNo: "1", InvestorId: "1", MarginStatus: entity.MarginStatusVersion2}, nil,
+			)
+			upgraded := entity.InvestorAccount{AccountNo: "1", InvestorId: "1", MarginStatus: entity.MarginStatusVersion3}
+			investorAccountRepository.EXPECT().Update(mock2.Anything, upgraded).Return(upgraded, nil)
+			account, err := useCase.HandleAccountMarginChanged(context.Background(), changed)
+			assert.Nil(t, err)
+			assert.Equal(t, entity.MarginStatusVersion3, account.MarginStatus)
+		},
+	)
+
+	t.Run(
+		"HandleAccountMarginChanged ignores an outdated version 2 event", func(t *testing.T) {
+			useCase, investorAccountRepository := newUseCase(t)
+			current := entity.InvestorAccount{AccountNo: "1", InvestorId: "1", MarginStatus: entity.MarginStatusVersion3}
+			investorAccountRepository.EXPECT().GetByAccountNo(mock2.Anything, "1").Return(current, nil)
+			outdated := changed
+			outdated.MarginStatus = entity.MarginStatusVersion2
+			account, err := useCase.HandleAccountMarginChanged(context.Background(), outdated)
+			assert.Nil(t, err)
+			assert.Equal(t, current, account)
+		},
+	)
+
+	t.Run(
+		"HandleAccountMarginChanged unknown margin status", func(t *testing.T) {
+			useCase, _ := newUseCase(t)
+			unknown := changed
+			unknown.MarginStatus = "v4"
+			_, err := useCase.HandleAccountMarginChanged(context.Background(), unknown)
+			assert.Equal(t, apperrors.ErrInvalidInput("unknown margin status v4"), err)
+		},
+	)
+}
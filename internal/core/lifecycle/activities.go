@@ -0,0 +1,58 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/loanoffer"
+	"financing-offer/internal/core/loanofferinterest"
+	"financing-offer/internal/core/loanpackagerequest"
+)
+
+// Activities exposes the usecases the lifecycle workflow drives, the usecases keep sending their own notifications
+type Activities struct {
+	loanPackageRequestUseCase loanpackagerequest.UseCase
+	loanOfferUseCase          loanoffer.UseCase
+	loanOfferInterestUseCase  loanofferinterest.UseCase
+}
+
+func NewActivities(
+	loanPackageRequestUseCase loanpackagerequest.UseCase,
+	loanOfferUseCase loanoffer.UseCase,
+	loanOfferInterestUseCase loanofferinterest.UseCase,
+) *Activities {
+	return &Activities{
+		loanPackageRequestUseCase: loanPackageRequestUseCase,
+		loanOfferUseCase:          loanOfferUseCase,
+		loanOfferInterestUseCase:  loanOfferInterestUseCase,
+	}
+}
+
+func (a *Activities) LoadLoanRequestLifecycle(ctx context.Context, loanPackageRequestId int64) (entity.LoanRequestLifecycle, error) {
+	request, err := a.loanPackageRequestUseCase.GetById(ctx, loanPackageRequestId, entity.LoanPackageFilter{})
+	if err != nil {
+		return entity.LoanRequestLifecycle{}, fmt.Errorf("Activities LoadLoanRequestLifecycle %w", err)
+	}
+	latestOffer, err := a.loanOfferUseCase.GetLatestByRequestId(ctx, loanPackageRequestId)
+	if err != nil {
+		return entity.LoanRequestLifecycle{}, fmt.Errorf("Activities LoadLoanRequestLifecycle %w", err)
+	}
+	if !latestOffer.IsPresent() {
+		return entity.NewLoanRequestLifecycle(request, nil), nil
+	}
+	offer := latestOffer.Get()
+	return entity.NewLoanRequestLifecycle(request, &offer), nil
+}
+
+func (a *Activities) ExpireLoanOffer(ctx context.Context, loanOfferId int64) error {
+	return a.loanOfferUseCase.ExpireLoanOffer(ctx, loanOfferId)
+}
+
+func (a *Activities) PrepareLoanPackageCreation(ctx context.Context, loanPackageOfferInterestId int64) (entity.AssignmentState, error) {
+	return a.loanOfferInterestUseCase.PrepareLoanPackageCreation(ctx, loanPackageOfferInterestId)
+}
+
+func (a *Activities) ReleaseLoanPackageCreation(ctx context.Context, loanPackageOfferInterestId int64, cause string) error {
+	return a.loanOfferInterestUseCase.ReleaseLoanPackageCreation(ctx, loanPackageOfferInterestId, cause)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+)
+
+type LoanRequestLifecycleRepository interface {
+	// LoanRequestUpdated wakes the lifecycle workflow of the request up, starting it when it is not running
+	LoanRequestUpdated(ctx context.Context, loanPackageRequestId int64) error
+	// OfferInterestConfirmed hands the creation of the loan package of the offer line to the lifecycle workflow,
+	// it reports false when the lifecycle workflows are disabled and the caller must create the package itself
+	OfferInterestConfirmed(ctx context.Context, loanPackageRequestId int64, loanPackageOfferInterestId int64) (bool, error)
+}
@@ -0,0 +1,67 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/client"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/lifecycle/repository"
+)
+
+var _ repository.LoanRequestLifecycleRepository = (*LoanRequestLifecycleTemporalRepository)(nil)
+
+type LoanRequestLifecycleTemporalRepository struct {
+	config         config.TemporalWorkerConfig
+	temporalClient client.Client
+}
+
+func NewLoanRequestLifecycleTemporalRepository(config config.TemporalWorkerConfig, temporalClient client.Client) *LoanRequestLifecycleTemporalRepository {
+	return &LoanRequestLifecycleTemporalRepository{
+		config:         config,
+		temporalClient: temporalClient,
+	}
+}
+
+func (r *LoanRequestLifecycleTemporalRepository) LoanRequestUpdated(ctx context.Context, loanPackageRequestId int64) error {
+	if !r.config.Enable {
+		return nil
+	}
+	if err := r.signalWithStart(ctx, loanPackageRequestId, entity.LoanRequestUpdatedSignal, nil); err != nil {
+		return fmt.Errorf("LoanRequestLifecycleTemporalRepository LoanRequestUpdated %w", err)
+	}
+	return nil
+}
+
+func (r *LoanRequestLifecycleTemporalRepository) OfferInterestConfirmed(ctx context.Context, loanPackageRequestId int64, loanPackageOfferInterestId int64) (bool, error) {
+	if !r.config.Enable {
+		return false, nil
+	}
+	if err := r.signalWithStart(
+		ctx, loanPackageRequestId, entity.OfferInterestConfirmedSignal,
+		entity.OfferInterestConfirmed{LoanPackageOfferInterestId: loanPackageOfferInterestId},
+	); err != nil {
+		return false, fmt.Errorf("LoanRequestLifecycleTemporalRepository OfferInterestConfirmed %w", err)
+	}
+	return true, nil
+}
+
+func (r *LoanRequestLifecycleTemporalRepository) signalWithStart(ctx context.Context, loanPackageRequestId int64, signalName string, signalArg any) error {
+	_, err := r.temporalClient.SignalWithStartWorkflow(
+		ctx,
+		entity.LoanRequestLifecycleWorkflowId(loanPackageRequestId),
+		signalName,
+		signalArg,
+		client.StartWorkflowOptions{
+			TaskQueue: config.LoanRequestTaskQueueName,
+		},
+		entity.LoanRequestLifecycleWorkflowName,
+		entity.LoanRequestLifecycleInput{
+			LoanPackageRequestId: loanPackageRequestId,
+			RecheckInterval:      r.config.RecheckInterval,
+		},
+	)
+	return err
+}
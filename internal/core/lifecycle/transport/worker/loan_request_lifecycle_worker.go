@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"fmt"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/lifecycle"
+)
+
+// LoanRequestLifecycleWorker polls the loan request task queue and runs the lifecycle workflows and their activities
+type LoanRequestLifecycleWorker struct {
+	worker worker.Worker
+}
+
+func NewLoanRequestLifecycleWorker(temporalClient client.Client, activities *lifecycle.Activities) *LoanRequestLifecycleWorker {
+	w := worker.New(temporalClient, config.LoanRequestTaskQueueName, worker.Options{})
+	w.RegisterWorkflowWithOptions(
+		lifecycle.LoanRequestLifecycleWorkflow,
+		workflow.RegisterOptions{Name: entity.LoanRequestLifecycleWorkflowName},
+	)
+	w.RegisterActivity(activities)
+	return &LoanRequestLifecycleWorker{worker: w}
+}
+
+func (w *LoanRequestLifecycleWorker) Start() error {
+	if err := w.worker.Start(); err != nil {
+		return fmt.Errorf("LoanRequestLifecycleWorker Start %w", err)
+	}
+	return nil
+}
+
+func (w *LoanRequestLifecycleWorker) Stop() {
+	w.worker.Stop()
+}
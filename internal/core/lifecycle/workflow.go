@@ -0,0 +1,144 @@
+package lifecycle
+
+import (
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+)
+
+const (
+	defaultRecheckInterval = 6 * time.Hour
+	// maxHistoryLength keeps the history of a request waiting on its investor for weeks well below the server limit
+	maxHistoryLength = 2000
+)
+
+var activityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+		MaximumAttempts:    10,
+	},
+}
+
+// LoanRequestLifecycleWorkflow follows a loan request from the admin offer to the loan package of the confirmed line.
+// It sleeps until the latest offer expires, a signal arrives or the recheck interval elapses, then reloads the request
+// from the database, so a missed signal only delays the workflow until the next recheck.
+// It ends once the request is closed or every line of its latest offer is settled.
+func LoanRequestLifecycleWorkflow(ctx workflow.Context, input entity.LoanRequestLifecycleInput) error {
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	recheckInterval := input.RecheckInterval
+	if recheckInterval <= 0 {
+		recheckInterval = defaultRecheckInterval
+	}
+	updatedChannel := workflow.GetSignalChannel(ctx, entity.LoanRequestUpdatedSignal)
+	confirmedChannel := workflow.GetSignalChannel(ctx, entity.OfferInterestConfirmedSignal)
+	var a *Activities
+	for {
+		if err := createConfirmedLoanPackages(ctx, confirmedChannel); err != nil {
+			return err
+		}
+		// the reload below covers every update received so far
+		for updatedChannel.ReceiveAsync(nil) {
+		}
+		if workflow.GetInfo(ctx).GetCurrentHistoryLength() > maxHistoryLength {
+			return workflow.NewContinueAsNewError(ctx, entity.LoanRequestLifecycleWorkflowName, input)
+		}
+		var lifecycle entity.LoanRequestLifecycle
+		if err := workflow.ExecuteActivity(
+			ctx, a.LoadLoanRequestLifecycle, input.LoanPackageRequestId,
+		).Get(ctx, &lifecycle); err != nil {
+			return err
+		}
+		if lifecycle.Done && confirmedChannel.Len() == 0 {
+			return nil
+		}
+		wait, expiring := recheckInterval, false
+		if lifecycle.OfferId != 0 && !lifecycle.OfferExpiredAt.IsZero() {
+			if untilExpiry := lifecycle.OfferExpiredAt.Sub(workflow.Now(ctx)); untilExpiry <= wait {
+				wait, expiring = untilExpiry, true
+			}
+		}
+		if wait > 0 {
+			fired, err := waitForWakeUp(ctx, wait, updatedChannel, confirmedChannel)
+			if err != nil {
+				return err
+			}
+			expiring = expiring && fired
+		}
+		if expiring {
+			if err := workflow.ExecuteActivity(ctx, a.ExpireLoanOffer, lifecycle.OfferId).Get(ctx, nil); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// waitForWakeUp blocks until the timer fires or a signal arrives and reports whether the timer fired,
+// signals are left on their channel for the loop to consume
+func waitForWakeUp(ctx workflow.Context, wait time.Duration, channels ...workflow.ReceiveChannel) (bool, error) {
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+	var (
+		fired    bool
+		timerErr error
+	)
+	selector := workflow.NewSelector(ctx)
+	selector.AddFuture(
+		workflow.NewTimer(timerCtx, wait), func(f workflow.Future) {
+			timerErr = f.Get(timerCtx, nil)
+			fired = timerErr == nil
+		},
+	)
+	for _, channel := range channels {
+		selector.AddReceive(channel, func(workflow.ReceiveChannel, bool) {})
+	}
+	selector.Select(ctx)
+	return fired, timerErr
+}
+
+func createConfirmedLoanPackages(ctx workflow.Context, confirmedChannel workflow.ReceiveChannel) error {
+	var confirmed entity.OfferInterestConfirmed
+	for confirmedChannel.ReceiveAsync(&confirmed) {
+		if err := createLoanPackage(ctx, confirmed.LoanPackageOfferInterestId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createLoanPackage runs the creation workflow of the savings service as a child that outlives this workflow,
+// a line whose creation cannot start is released back to PENDING so the investor can confirm it again,
+// a child failing after it started is settled by ReconcileCreatingLoanPackages like any other creation
+func createLoanPackage(ctx workflow.Context, loanPackageOfferInterestId int64) error {
+	var a *Activities
+	var state entity.AssignmentState
+	err := workflow.ExecuteActivity(ctx, a.PrepareLoanPackageCreation, loanPackageOfferInterestId).Get(ctx, &state)
+	if err == nil {
+		childCtx := workflow.WithChildOptions(
+			ctx, workflow.ChildWorkflowOptions{
+				WorkflowID:        entity.LoanPackageCreationWorkflowId(loanPackageOfferInterestId),
+				TaskQueue:         config.SavingsTaskQueueName,
+				ParentClosePolicy: enums.PARENT_CLOSE_POLICY_ABANDON,
+			},
+		)
+		err = workflow.ExecuteChildWorkflow(
+			childCtx, entity.CreateAndAssignLoanPackageWorkflowName, state,
+		).GetChildWorkflowExecution().Get(ctx, nil)
+		if temporal.IsWorkflowExecutionAlreadyStartedError(err) {
+			return nil
+		}
+	}
+	if err == nil {
+		return nil
+	}
+	return workflow.ExecuteActivity(
+		ctx, a.ReleaseLoanPackageCreation, loanPackageOfferInterestId, err.Error(),
+	).Get(ctx, nil)
+}
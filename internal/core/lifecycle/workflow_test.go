@@ -0,0 +1,150 @@
+package lifecycle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"financing-offer/internal/core/entity"
+)
+
+func TestLoanRequestLifecycleWorkflow(t *testing.T) {
+	t.Parallel()
+	var a *Activities
+	startTime := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	input := entity.LoanRequestLifecycleInput{LoanPackageRequestId: 1, RecheckInterval: 6 * time.Hour}
+	waiting := entity.LoanRequestLifecycle{
+		LoanPackageRequestId: 1,
+		RequestStatus:        entity.LoanPackageRequestStatusConfirmed,
+		OfferId:              2,
+		OfferExpiredAt:       startTime.Add(48 * time.Hour),
+	}
+	done := entity.LoanRequestLifecycle{
+		LoanPackageRequestId: 1,
+		RequestStatus:        entity.LoanPackageRequestStatusConfirmed,
+		OfferId:              2,
+		Done:                 true,
+	}
+	state := entity.AssignmentState{
+		Submission: entity.Submission{Symbol: "HPG", LoanPackageOfferInterestId: 7, LoanPackageRequestId: 1},
+	}
+	newEnv := func() *testsuite.TestWorkflowEnvironment {
+		suite := testsuite.WorkflowTestSuite{}
+		env := suite.NewTestWorkflowEnvironment()
+		env.SetStartTime(startTime)
+		env.RegisterActivity(&Activities{})
+		env.RegisterWorkflowWithOptions(
+			func(ctx workflow.Context, state entity.AssignmentState) error {
+				return nil
+			},
+			workflow.RegisterOptions{Name: entity.CreateAndAssignLoanPackageWorkflowName},
+		)
+		return env
+	}
+
+	t.Run(
+		"request already settled", func(t *testing.T) {
+			env := newEnv()
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(done, nil).Once()
+			env.ExecuteWorkflow(LoanRequestLifecycleWorkflow, input)
+			assert.True(t, env.IsWorkflowCompleted())
+			assert.Nil(t, env.GetWorkflowError())
+			env.AssertExpectations(t)
+		},
+	)
+
+	t.Run(
+		"expire offer on timer", func(t *testing.T) {
+			env := newEnv()
+			expiring := waiting
+			expiring.OfferExpiredAt = startTime.Add(time.Hour)
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(expiring, nil).Once()
+			env.OnActivity(a.ExpireLoanOffer, testifyMock.Anything, int64(2)).Return(nil).Once()
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(done, nil).Once()
+			env.ExecuteWorkflow(LoanRequestLifecycleWorkflow, input)
+			assert.True(t, env.IsWorkflowCompleted())
+			assert.Nil(t, env.GetWorkflowError())
+			assert.Equal(t, startTime.Add(time.Hour), env.Now().UTC())
+			env.AssertExpectations(t)
+		},
+	)
+
+	t.Run(
+		"recheck without expiring", func(t *testing.T) {
+			env := newEnv()
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(waiting, nil).Once()
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(done, nil).Once()
+			env.ExecuteWorkflow(LoanRequestLifecycleWorkflow, input)
+			assert.True(t, env.IsWorkflowCompleted())
+			assert.Nil(t, env.GetWorkflowError())
+			assert.Equal(t, startTime.Add(6*time.Hour), env.Now().UTC())
+			env.AssertActivityNotCalled(t, "ExpireLoanOffer", testifyMock.Anything, testifyMock.Anything)
+			env.AssertExpectations(t)
+		},
+	)
+
+	t.Run(
+		"create loan package of confirmed line", func(t *testing.T) {
+			env := newEnv()
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(waiting, nil).Once()
+			env.OnActivity(a.PrepareLoanPackageCreation, testifyMock.Anything, int64(7)).Return(state, nil).Once()
+			env.OnWorkflow(
+				entity.CreateAndAssignLoanPackageWorkflowName, testifyMock.Anything,
+				testifyMock.MatchedBy(
+					func(s entity.AssignmentState) bool {
+						return s.Submission.Symbol == "HPG" && s.Submission.LoanPackageOfferInterestId == 7
+					},
+				),
+			).Return(nil).Once()
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(done, nil).Once()
+			env.RegisterDelayedCallback(
+				func() {
+					env.SignalWorkflow(
+						entity.OfferInterestConfirmedSignal,
+						entity.OfferInterestConfirmed{LoanPackageOfferInterestId: 7},
+					)
+				}, time.Minute,
+			)
+			env.ExecuteWorkflow(LoanRequestLifecycleWorkflow, input)
+			assert.True(t, env.IsWorkflowCompleted())
+			assert.Nil(t, env.GetWorkflowError())
+			env.AssertActivityNotCalled(t, "ExpireLoanOffer", testifyMock.Anything, testifyMock.Anything)
+			env.AssertActivityNotCalled(
+				t, "ReleaseLoanPackageCreation", testifyMock.Anything, testifyMock.Anything, testifyMock.Anything,
+			)
+			env.AssertExpectations(t)
+		},
+	)
+
+	t.Run(
+		"release line when creation cannot start", func(t *testing.T) {
+			env := newEnv()
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(waiting, nil).Once()
+			env.OnActivity(a.PrepareLoanPackageCreation, testifyMock.Anything, int64(7)).
+				Return(entity.AssignmentState{}, temporal.NewNonRetryableApplicationError("invalid status", "", nil)).
+				Once()
+			env.OnActivity(a.ReleaseLoanPackageCreation, testifyMock.Anything, int64(7), testifyMock.Anything).
+				Return(nil).
+				Once()
+			env.OnActivity(a.LoadLoanRequestLifecycle, testifyMock.Anything, int64(1)).Return(done, nil).Once()
+			env.RegisterDelayedCallback(
+				func() {
+					env.SignalWorkflow(
+						entity.OfferInterestConfirmedSignal,
+						entity.OfferInterestConfirmed{LoanPackageOfferInterestId: 7},
+					)
+				}, time.Minute,
+			)
+			env.ExecuteWorkflow(LoanRequestLifecycleWorkflow, input)
+			assert.True(t, env.IsWorkflowCompleted())
+			assert.Nil(t, env.GetWorkflowError())
+			env.AssertWorkflowNotCalled(t, entity.CreateAndAssignLoanPackageWorkflowName, testifyMock.Anything, testifyMock.Anything)
+			env.AssertExpectations(t)
+		},
+	)
+}
@@ -64,6 +64,19 @@ func (r *LoanContractRepository) GetById(ctx context.Context, id int64, opts ...
 	return MapLoanContractDbToEntity(loanContract), nil
 }
 
+func (r *LoanContractRepository) GetByLoanOfferInterestId(ctx context.Context, loanOfferInterestId int64) (entity.LoanContract, error) {
+	loanContract := model.LoanContract{}
+	if err := table.LoanContract.
+		SELECT(table.LoanContract.AllColumns).
+		WHERE(table.LoanContract.LoanOfferInterestID.EQ(postgres.Int64(loanOfferInterestId))).
+		ORDER_BY(table.LoanContract.ID.DESC()).
+		LIMIT(1).
+		QueryContext(ctx, r.getDbFunc(ctx), &loanContract); err != nil {
+		return entity.LoanContract{}, fmt.Errorf("LoanContractRepository GetByLoanOfferInterestId %w", err)
+	}
+	return MapLoanContractDbToEntity(loanContract), nil
+}
+
 func (r *LoanContractRepository) Create(ctx context.Context, loanContract entity.LoanContract) (entity.LoanContract, error) {
 	toCreate := MapLoanContractEntityToDb(loanContract)
 	created := model.LoanContract{}
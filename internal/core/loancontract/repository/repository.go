@@ -11,5 +11,6 @@ type LoanContractPersistenceRepository interface {
 	Create(ctx context.Context, loanContract entity.LoanContract) (entity.LoanContract, error)
 	BulkCreate(ctx context.Context, loanContracts []entity.LoanContract) error
 	GetById(ctx context.Context, id int64, opts ...querymod.GetOption) (entity.LoanContract, error)
+	GetByLoanOfferInterestId(ctx context.Context, loanOfferInterestId int64) (entity.LoanContract, error)
 	GetInvestorActiveContract(ctx context.Context, investorId string, symbolId int64) (entity.LoanContract, error)
 }
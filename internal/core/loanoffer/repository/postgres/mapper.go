@@ -121,5 +121,8 @@ func ApplyFilter(filter entity.LoanPackageOfferFilter) postgres.BoolExpression {
 	if filter.AssetType.IsPresent() {
 		expr = expr.AND(table.LoanPackageRequest.AssetType.EQ(postgres.NewEnumValue(filter.AssetType.Get().String())))
 	}
+	if filter.LoanPackageRequestId.IsPresent() {
+		expr = expr.AND(table.LoanPackageOffer.LoanPackageRequestID.EQ(postgres.Int64(filter.LoanPackageRequestId.Get())))
+	}
 	return expr
 }
@@ -153,7 +153,7 @@ func (h *LoanPackageOfferHandler) InvestorGetById(ctx *gin.Context) {
 // ManualTriggerExpireLoanOffers godoc
 //
 //	@Summary		Manual trigger expire loan offers
-//	@Description	Manual trigger expire loan offers
+//	@Description	Manual trigger expire loan offers, deprecated in favor of triggering the ExpireLoanOffers scheduler job
 //	@Tags			loan package offer,admin,internal
 //	@Accept			json
 //	@Produce		json
@@ -161,6 +161,7 @@ func (h *LoanPackageOfferHandler) InvestorGetById(ctx *gin.Context) {
 //	@Failure		400	{object}	handler.ErrorResponse
 //	@Failure		500	{object}	handler.ErrorResponse
 //	@Security		BearerAuth
+//	@Deprecated
 //	@Router			/internal/loan-package-offers/expire [get]
 func (h *LoanPackageOfferHandler) ManualTriggerExpireLoanOffers(ctx *gin.Context) {
 	if err := h.useCase.ExpireLoanOffers(ctx); err != nil {
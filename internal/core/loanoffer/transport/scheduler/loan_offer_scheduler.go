@@ -6,6 +6,7 @@ import (
 
 	"financing-offer/internal/apperrors"
 	"financing-offer/internal/core/loanoffer"
+	"financing-offer/internal/core/scheduler"
 )
 
 type LoanOfferScheduler struct {
@@ -22,11 +23,15 @@ func NewLoanOfferScheduler(logger *slog.Logger, useCase loanoffer.UseCase, error
 	}
 }
 
-func (s *LoanOfferScheduler) ExpireLoanOffers() {
-	if err := s.useCase.ExpireLoanOffers(context.Background()); err != nil {
+func (s *LoanOfferScheduler) ExpireLoanOffers(ctx context.Context) error {
+	if err := s.useCase.ExpireLoanOffers(ctx); err != nil {
 		s.logger.Error("ExpireLoanOffers", slog.String("error", err.Error()))
-		if err := s.errorService.NotifyError(context.Background(), err); err != nil {
-			s.logger.Error("ExpireLoanOffers NotifyError", slog.String("error", err.Error()))
+		if scheduler.FinalAttempt(ctx) {
+			if err := s.errorService.NotifyError(ctx, err); err != nil {
+				s.logger.Error("ExpireLoanOffers NotifyError", slog.String("error", err.Error()))
+			}
 		}
+		return err
 	}
+	return nil
 }
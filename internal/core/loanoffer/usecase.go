@@ -3,6 +3,7 @@ package loanoffer
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"financing-offer/internal/apperrors"
@@ -11,7 +12,10 @@ import (
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/loanoffer/repository"
 	loanOfferInterestRepo "financing-offer/internal/core/loanofferinterest/repository"
+	"financing-offer/internal/core/tradingcalendar"
+	webhookRepo "financing-offer/internal/core/webhook/repository"
 	"financing-offer/internal/funcs"
+	"financing-offer/pkg/optional"
 )
 
 type UseCase interface {
@@ -20,6 +24,8 @@ type UseCase interface {
 	InvestorCancel(ctx context.Context, investorId string, loanOfferId int64) error
 	InvestorGetById(ctx context.Context, loanOfferId int64, investorId string) (entity.LoanPackageOffer, error)
 	ExpireLoanOffers(ctx context.Context) error
+	ExpireLoanOffer(ctx context.Context, loanOfferId int64) error
+	GetLatestByRequestId(ctx context.Context, loanPackageRequestId int64) (optional.Optional[entity.LoanPackageOffer], error)
 }
 
 type loanPackageOfferUseCase struct {
@@ -27,17 +33,28 @@ type loanPackageOfferUseCase struct {
 	loanConfig                  config.LoanRequestConfig
 	loanOfferInterestRepository loanOfferInterestRepo.LoanPackageOfferInterestRepository
 	atomicExecutor              atomicity.AtomicExecutor
+	tradingCalendar             tradingcalendar.Calendar
+	webhookEventRepository      webhookRepo.WebhookEventRepository
 }
 
 func (u *loanPackageOfferUseCase) FindAllForInvestor(ctx context.Context, filter entity.LoanPackageOfferFilter) ([]entity.LoanPackageOffer, error) {
-	declinedRequestDisplayPeriod := -time.Duration(24*u.loanConfig.DeclinedRequestDisplayPeriod) * time.Hour
+	errorTemplate := "loanPackageOfferUseCase FindAll %w"
 	res, err := u.repository.FindAllForInvestorWithRequestAndLine(ctx, filter)
 	if err != nil {
-		return res, fmt.Errorf("loanPackageOfferUseCase FindAll %w", err)
+		return res, fmt.Errorf(errorTemplate, err)
 	}
+	now := time.Now()
+	// a declined request is displayed until the cut-off of its n-th trading day on the exchange of its symbol
+	declinedDisplayedSince := make(map[int64]time.Time)
 	offers := make([]entity.LoanPackageOffer, 0, len(res))
 	for _, offer := range res {
-		if !shouldIncludeOffer(offer, declinedRequestDisplayPeriod) {
+		symbolId := offer.LoanPackageRequest.SymbolId
+		if _, ok := declinedDisplayedSince[symbolId]; !ok && len(offer.LoanPackageOfferInterests) == 0 {
+			if declinedDisplayedSince[symbolId], err = u.declinedDisplayedSince(ctx, symbolId, now); err != nil {
+				return nil, fmt.Errorf(errorTemplate, err)
+			}
+		}
+		if !shouldIncludeOffer(offer, declinedDisplayedSince[symbolId]) {
 			continue
 		}
 		offers = append(offers, offer)
@@ -45,10 +62,18 @@ func (u *loanPackageOfferUseCase) FindAllForInvestor(ctx context.Context, filter
 	return offers, nil
 }
 
-func shouldIncludeOffer(offer entity.LoanPackageOffer, displayPeriod time.Duration) bool {
+func (u *loanPackageOfferUseCase) declinedDisplayedSince(ctx context.Context, symbolId int64, now time.Time) (time.Time, error) {
+	stockExchangeCode, err := u.tradingCalendar.StockExchangeCode(ctx, symbolId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return u.tradingCalendar.LastCutOff(ctx, stockExchangeCode, now, u.loanConfig.DeclinedRequestDisplayPeriod)
+}
+
+func shouldIncludeOffer(offer entity.LoanPackageOffer, declinedDisplayedSince time.Time) bool {
 	interests := offer.LoanPackageOfferInterests
-	// if request is declined, only show offer for n days
-	if len(interests) == 0 && offer.CreatedAt.Before(time.Now().Add(displayPeriod)) {
+	// if request is declined, only show offer for n trading days
+	if len(interests) == 0 && offer.CreatedAt.Before(declinedDisplayedSince) {
 		return false
 	}
 	if offer.IsExpired() {
@@ -109,27 +134,116 @@ func (u *loanPackageOfferUseCase) ExpireLoanOffers(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("loanPackageOfferUseCase ExpireLoanOffers %w", err)
 	}
-	expiredOfferIds := funcs.Map(
-		offers, func(offer entity.LoanPackageOffer) int64 {
-			return offer.Id
+	// an offer is returned once for each of its pending lines
+	seenOfferIds := make(map[int64]bool, len(offers))
+	offers = funcs.Filter(
+		offers, func(offer entity.LoanPackageOffer, _ int) bool {
+			if seenOfferIds[offer.Id] {
+				return false
+			}
+			seenOfferIds[offer.Id] = true
+			return true
 		},
 	)
-	if err := u.loanOfferInterestRepository.CancelExpiredOfferInterests(ctx, expiredOfferIds); err != nil {
+	if err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			for _, offer := range offers {
+				if err := u.expireOffer(tc, offer); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	); err != nil {
 		return fmt.Errorf("loanPackageOfferUseCase ExpireLoanOffers %w", err)
 	}
 	return nil
 }
 
+// ExpireLoanOffer cancels the pending lines of the offer once it has expired, an offer that has not expired yet is left untouched
+func (u *loanPackageOfferUseCase) ExpireLoanOffer(ctx context.Context, loanOfferId int64) error {
+	offer, err := u.repository.FindByIdWithRequest(ctx, loanOfferId)
+	if err != nil {
+		return fmt.Errorf("loanPackageOfferUseCase ExpireLoanOffer %w", err)
+	}
+	if !offer.IsExpired() {
+		return nil
+	}
+	if err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			return u.expireOffer(tc, offer)
+		},
+	); err != nil {
+		return fmt.Errorf("loanPackageOfferUseCase ExpireLoanOffer %w", err)
+	}
+	return nil
+}
+
+// expireOffer cancels the pending lines of an expired offer while holding their locks, so an offer expired
+// by both its lifecycle workflow and the ExpireLoanOffers job is cancelled and published once
+func (u *loanPackageOfferUseCase) expireOffer(ctx context.Context, offer entity.LoanPackageOffer) error {
+	lines, err := u.loanOfferInterestRepository.GetByOfferIdWithLock(ctx, offer.Id)
+	if err != nil {
+		return err
+	}
+	// the offer was already expired when none of its lines is pending
+	if !slices.ContainsFunc(
+		lines, func(line entity.LoanPackageOfferInterest) bool {
+			return line.Status == entity.LoanPackageOfferInterestStatusPending
+		},
+	) {
+		return nil
+	}
+	if err := u.loanOfferInterestRepository.CancelExpiredOfferInterests(ctx, []int64{offer.Id}); err != nil {
+		return err
+	}
+	return u.publishOfferExpired(ctx, offer)
+}
+
+func (u *loanPackageOfferUseCase) publishOfferExpired(ctx context.Context, offer entity.LoanPackageOffer) error {
+	return u.webhookEventRepository.Publish(
+		ctx, entity.WebhookEventTypeOfferExpired, entity.WebhookOfferExpiredData{
+			LoanPackageOfferId:   offer.Id,
+			LoanPackageRequestId: offer.LoanPackageRequestId,
+			ExpiredAt:            offer.ExpiredAt,
+		},
+	)
+}
+
+// GetLatestByRequestId returns the latest offer made on the request with its lines
+func (u *loanPackageOfferUseCase) GetLatestByRequestId(ctx context.Context, loanPackageRequestId int64) (optional.Optional[entity.LoanPackageOffer], error) {
+	offers, err := u.repository.FindAllForInvestorWithRequestAndLine(
+		ctx, entity.LoanPackageOfferFilter{LoanPackageRequestId: optional.Some(loanPackageRequestId)},
+	)
+	if err != nil {
+		return optional.None[entity.LoanPackageOffer](), fmt.Errorf("loanPackageOfferUseCase GetLatestByRequestId %w", err)
+	}
+	if len(offers) == 0 {
+		return optional.None[entity.LoanPackageOffer](), nil
+	}
+	latest := offers[0]
+	for _, offer := range offers[1:] {
+		if offer.Id > latest.Id {
+			latest = offer
+		}
+	}
+	return optional.Some(latest), nil
+}
+
 func NewUseCase(
 	repository repository.LoanPackageOfferRepository,
 	loanConfig config.LoanRequestConfig,
 	loanOfferInterestRepository loanOfferInterestRepo.LoanPackageOfferInterestRepository,
 	atomicExecutor atomicity.AtomicExecutor,
+	tradingCalendar tradingcalendar.Calendar,
+	webhookEventRepository webhookRepo.WebhookEventRepository,
 ) UseCase {
 	return &loanPackageOfferUseCase{
 		repository:                  repository,
 		loanConfig:                  loanConfig,
 		loanOfferInterestRepository: loanOfferInterestRepository,
 		atomicExecutor:              atomicExecutor,
+		tradingCalendar:             tradingCalendar,
+		webhookEventRepository:      webhookEventRepository,
 	}
 }
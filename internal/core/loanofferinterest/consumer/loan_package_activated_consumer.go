@@ -0,0 +1,37 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/loanofferinterest"
+	"financing-offer/internal/event"
+)
+
+// NewLoanPackageActivatedHandler moves the offer line to PACKAGE_CREATED with its loan contract as soon as financial product
+// activates the loan package, instead of waiting for the assign-loan-contract callback or SyncLoanPackageData
+func NewLoanPackageActivatedHandler(
+	cfg config.KafkaConsumerConfig,
+	logger *slog.Logger,
+	useCase loanofferinterest.UseCase,
+) event.Handler {
+	return event.NewJsonHandler(
+		cfg.LoanPackageActivatedTopic, func(ctx context.Context, _ string, activated entity.LoanPackageActivatedEvent) error {
+			if activated.LoanPackageOfferInterestId == 0 || activated.LoanPackageId == 0 {
+				return event.Poison(errors.New("loanPackageOfferInterestId and loanPackageId are required"))
+			}
+			if err := useCase.HandleLoanPackageActivated(ctx, activated); err != nil {
+				return err
+			}
+			logger.Info(
+				"loan package activated",
+				slog.Int64("loanPackageOfferInterestId", activated.LoanPackageOfferInterestId),
+				slog.Int64("loanPackageId", activated.LoanPackageId),
+			)
+			return nil
+		},
+	)
+}
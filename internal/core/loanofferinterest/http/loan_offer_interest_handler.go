@@ -117,6 +117,38 @@ func (h *LoanOfferInterestHandler) InvestorConfirmLoanPackageInterest(ctx *gin.C
 	ctx.JSON(http.StatusOK, handler.BaseResponse[string]{Data: "ok"})
 }
 
+// InvestorGetLoanPackageCreationStatus godoc
+//
+//	@Summary		Investor get loan package creation status
+//	@Description	Poll the creation of the loan package started when confirming the offer line
+//	@Tags			loan package offer line,investor
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	handler.BaseResponse[entity.LoanPackageCreationStatus]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/my-loan-offer-interests/{id}/loan-package-status [get]
+func (h *LoanOfferInterestHandler) InvestorGetLoanPackageCreationStatus(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderBadRequest(ctx, err.Error())
+		return
+	}
+	investorId, err := h.InvestorId(ctx)
+	if err != nil {
+		h.RenderBadRequest(ctx, err.Error())
+		return
+	}
+	res, err := h.useCase.InvestorGetLoanPackageCreationStatus(ctx, id, investorId)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.LoanPackageCreationStatus]{Data: res})
+}
+
 // InvestorConfirmMultipleLoanPackageInterest godoc
 //
 //	@Summary		Investor confirm multiple loan package offer interest
@@ -150,6 +182,7 @@ func (h *LoanOfferInterestHandler) InvestorConfirmMultipleLoanPackageInterest(ct
 	ctx.JSON(http.StatusOK, handler.BaseResponse[string]{Data: "ok"})
 }
 
+// FillWithLoanPackageData is deprecated in favor of triggering the SyncLoanPackageData scheduler job
 func (h *LoanOfferInterestHandler) FillWithLoanPackageData(ctx *gin.Context) {
 	total, err := h.useCase.SyncLoanPackageData(ctx)
 	if err != nil {
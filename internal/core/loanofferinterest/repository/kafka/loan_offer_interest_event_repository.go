@@ -2,19 +2,23 @@ package kafka
 
 import (
 	"context"
-	"financing-offer/internal/apperrors"
+	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/segmentio/kafka-go"
-	"github.com/shopspring/decimal"
 	"gitlab.com/enCapital/models"
 	"gitlab.com/enCapital/models/dnse"
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/loanofferinterest/repository"
+	"financing-offer/internal/core/notificationtemplate"
 	"financing-offer/internal/event"
 	"financing-offer/pkg/pb"
 )
@@ -25,18 +29,41 @@ type LoanOfferInterestEventPublisher struct {
 	config         config.KafkaConfig
 	publisher      event.Publisher
 	temporalClient client.Client
+	renderer       notificationtemplate.Renderer
+	logger         *slog.Logger
+	errorService   apperrors.Service
 }
 
-func (l *LoanOfferInterestEventPublisher) NotifyLoanPackageOfferReady(_ context.Context, data entity.LoanPackageOfferReadyNotify) error {
+func (l *LoanOfferInterestEventPublisher) NotifyLoanPackageOfferReady(ctx context.Context, data entity.LoanPackageOfferReadyNotify) error {
+	rendered := make(map[entity.NotificationTemplateKey]string, 3)
+	for _, key := range []entity.NotificationTemplateKey{
+		entity.NotificationTemplateKeyLoanPackageReadyLoanRate,
+		entity.NotificationTemplateKeyLoanPackageReadyLoanType,
+		entity.NotificationTemplateKeyLoanPackageReadyInterest,
+	} {
+		content, err := l.renderer.Render(ctx, data.InvestorId, key, data)
+		if err != nil {
+			// a missing or broken template only drops the notification, the loan package it reports stays created
+			l.logger.Error(
+				"LoanOfferInterestEventPublisher NotifyLoanPackageOfferReady render",
+				slog.String("investorId", data.InvestorId),
+				slog.String("templateKey", string(key)),
+				slog.String("error", err.Error()),
+			)
+			_ = l.errorService.NotifyError(ctx, fmt.Errorf("LoanOfferInterestEventPublisher NotifyLoanPackageOfferReady %w", err))
+			return nil
+		}
+		rendered[key] = content
+	}
 	payload := dnse.FinancingOfferLoanPackageReady{
 		InvestorId:    data.InvestorId,
 		RequestName:   data.RequestName,
 		AccountNo:     data.AccountNo,
 		AccountNoDesc: data.AccountNoDesc,
 		Symbol:        data.Symbol,
-		LoanRate:      data.LoanRate.Mul(decimal.NewFromInt(100)).String() + "%",
-		LoanType:      data.LoanType.StringNotify(),
-		InterestRate:  data.InterestRate.Mul(decimal.NewFromInt(100)).String() + "%/năm",
+		LoanRate:      rendered[entity.NotificationTemplateKeyLoanPackageReadyLoanRate],
+		LoanType:      rendered[entity.NotificationTemplateKeyLoanPackageReadyLoanType],
+		InterestRate:  rendered[entity.NotificationTemplateKeyLoanPackageReadyInterest],
 		LoanPackageId: data.LoanPackageId,
 		CreatedAt:     timestamppb.New(data.CreatedAt),
 	}
@@ -45,6 +72,7 @@ func (l *LoanOfferInterestEventPublisher) NotifyLoanPackageOfferReady(_ context.
 		return fmt.Errorf("LoanOfferInterestEventPublisher NotifyLoanPackageOfferReady %w", err)
 	}
 	if err := l.publisher.Publish(
+		ctx,
 		kafka.Message{
 			Topic: l.config.NotificationTopic,
 			Value: message,
@@ -56,7 +84,7 @@ func (l *LoanOfferInterestEventPublisher) NotifyLoanPackageOfferReady(_ context.
 	return nil
 }
 
-func (l *LoanOfferInterestEventPublisher) NotifyDerivativeLoanPackageOfferReady(_ context.Context, data entity.DerivativeLoanPackageOfferReadyNotify) error {
+func (l *LoanOfferInterestEventPublisher) NotifyDerivativeLoanPackageOfferReady(ctx context.Context, data entity.DerivativeLoanPackageOfferReadyNotify) error {
 	payload := dnse.FinancingOfferDerivativeLoanPackageReady{
 		InvestorId:    data.InvestorId,
 		RequestName:   data.RequestName,
@@ -72,6 +100,7 @@ func (l *LoanOfferInterestEventPublisher) NotifyDerivativeLoanPackageOfferReady(
 		return fmt.Errorf("LoanOfferInterestEventPublisher NotifyDerivativeLoanPackageOfferReady %w", err)
 	}
 	if err := l.publisher.Publish(
+		ctx,
 		kafka.Message{
 			Topic: l.config.NotificationTopic,
 			Value: message,
@@ -83,29 +112,66 @@ func (l *LoanOfferInterestEventPublisher) NotifyDerivativeLoanPackageOfferReady(
 	return nil
 }
 
-func (l *LoanOfferInterestEventPublisher) CreateMarginLoanPackage(ctx context.Context, state entity.AssignmentState) error {
-	workflowId := fmt.Sprintf("auto-create-loan-package-v3-%d", state.Submission.LoanPackageOfferInterestId)
+func (l *LoanOfferInterestEventPublisher) CreateMarginLoanPackage(ctx context.Context, state entity.AssignmentState) (string, error) {
 	workflowOptions := client.StartWorkflowOptions{
-		ID:                                       workflowId,
+		ID:                                       entity.LoanPackageCreationWorkflowId(state.Submission.LoanPackageOfferInterestId),
 		TaskQueue:                                config.SavingsTaskQueueName,
 		WorkflowExecutionErrorWhenAlreadyStarted: false, // do not return error if workflow is already started
 	}
 	workflowRun, err := l.temporalClient.ExecuteWorkflow(
-		ctx, workflowOptions, "CreateAndAssignLoanPackageWorkflow", state,
+		ctx, workflowOptions, entity.CreateAndAssignLoanPackageWorkflowName, state,
 	)
 	if err != nil {
-		return fmt.Errorf("SavingsTemporalRepository SettleSavingsAccount: %w", err)
+		return "", fmt.Errorf("LoanOfferInterestEventPublisher CreateMarginLoanPackage %w", err)
 	}
-	if err := workflowRun.Get(ctx, nil); err != nil {
-		return apperrors.ErrorCreateAndAssignLoanPackageWorkflow
+	return workflowRun.GetID(), nil
+}
+
+func (l *LoanOfferInterestEventPublisher) GetLoanPackageWorkflowStatus(ctx context.Context, workflowId string) (entity.LoanPackageWorkflowStatus, error) {
+	res, err := l.temporalClient.DescribeWorkflowExecution(ctx, workflowId, "")
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return entity.LoanPackageWorkflowStatusNotFound, nil
+		}
+		return "", fmt.Errorf("LoanOfferInterestEventPublisher GetLoanPackageWorkflowStatus %w", err)
 	}
-	return nil
+	switch res.GetWorkflowExecutionInfo().GetStatus() {
+	case enums.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		return entity.LoanPackageWorkflowStatusCompleted, nil
+	case enums.WORKFLOW_EXECUTION_STATUS_FAILED,
+		enums.WORKFLOW_EXECUTION_STATUS_CANCELED,
+		enums.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+		enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:
+		return entity.LoanPackageWorkflowStatusFailed, nil
+	default:
+		// running or continued as new, the latest run has not closed yet
+		return entity.LoanPackageWorkflowStatusRunning, nil
+	}
+}
+
+func (l *LoanOfferInterestEventPublisher) GetLoanPackageWorkflowResult(ctx context.Context, workflowId string) (entity.LoanPackageCreationResult, error) {
+	var result entity.LoanPackageCreationResult
+	if err := l.temporalClient.GetWorkflow(ctx, workflowId, "").Get(ctx, &result); err != nil {
+		return entity.LoanPackageCreationResult{}, fmt.Errorf("LoanOfferInterestEventPublisher GetLoanPackageWorkflowResult %w", err)
+	}
+	return result, nil
 }
 
-func NewLoanOfferInterestEventPublisher(config config.KafkaConfig, publisher event.Publisher, temporalClient client.Client) *LoanOfferInterestEventPublisher {
+func NewLoanOfferInterestEventPublisher(
+	config config.KafkaConfig,
+	publisher event.Publisher,
+	temporalClient client.Client,
+	renderer notificationtemplate.Renderer,
+	logger *slog.Logger,
+	errorService apperrors.Service,
+) *LoanOfferInterestEventPublisher {
 	return &LoanOfferInterestEventPublisher{
 		config:         config,
 		publisher:      publisher,
 		temporalClient: temporalClient,
+		renderer:       renderer,
+		logger:         logger,
+		errorService:   errorService,
 	}
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"financing-offer/internal/core/entity"
 	"financing-offer/pkg/querymod"
@@ -15,6 +16,10 @@ type LoanPackageOfferInterestRepository interface {
 	GetById(ctx context.Context, id int64, opts ...querymod.GetOption) (entity.LoanPackageOfferInterest, error)
 	GetByIds(ctx context.Context, ids []int64, opts ...querymod.GetOption) ([]entity.LoanPackageOfferInterest, error)
 	UpdateStatus(ctx context.Context, ids []int64, status entity.LoanPackageOfferInterestStatus) error
+	// UpdateStatusFrom moves the line to status to only if it still has status from, reporting whether it moved
+	UpdateStatusFrom(ctx context.Context, id int64, from, to entity.LoanPackageOfferInterestStatus) (bool, error)
+	UpdateWorkflowId(ctx context.Context, id int64, workflowId string) error
+	GetCreatingUpdatedBefore(ctx context.Context, updatedBefore time.Time, limit int64) ([]entity.LoanPackageOfferInterest, error)
 	Update(ctx context.Context, offerInterest entity.LoanPackageOfferInterest) (entity.LoanPackageOfferInterest, error)
 	CancelByOfferId(ctx context.Context, offerId int64, cancelledBy string, cancelledReason entity.CancelledReason) error
 	CancelExpiredOfferInterests(ctx context.Context, offerIds []int64) error
@@ -25,5 +30,9 @@ type LoanPackageOfferInterestRepository interface {
 type LoanPackageOfferInterestEventRepository interface {
 	NotifyLoanPackageOfferReady(ctx context.Context, data entity.LoanPackageOfferReadyNotify) error
 	NotifyDerivativeLoanPackageOfferReady(ctx context.Context, data entity.DerivativeLoanPackageOfferReadyNotify) error
-	CreateMarginLoanPackage(ctx context.Context, state entity.AssignmentState) error
+	// CreateMarginLoanPackage starts the workflow creating and assigning the loan package without waiting for it and returns its id
+	CreateMarginLoanPackage(ctx context.Context, state entity.AssignmentState) (string, error)
+	GetLoanPackageWorkflowStatus(ctx context.Context, workflowId string) (entity.LoanPackageWorkflowStatus, error)
+	// GetLoanPackageWorkflowResult returns the loan package created by a completed creation workflow
+	GetLoanPackageWorkflowResult(ctx context.Context, workflowId string) (entity.LoanPackageCreationResult, error)
 }
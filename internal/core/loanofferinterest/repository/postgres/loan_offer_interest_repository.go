@@ -124,6 +124,55 @@ func (l *LoanPackageOfferInterestPostgresRepository) UpdateStatus(ctx context.Co
 	return nil
 }
 
+func (l *LoanPackageOfferInterestPostgresRepository) UpdateStatusFrom(ctx context.Context, id int64, from, to entity.LoanPackageOfferInterestStatus) (bool, error) {
+	res, err := table.LoanPackageOfferInterest.
+		UPDATE(table.LoanPackageOfferInterest.Status).
+		SET(postgres.String(to.String())).
+		WHERE(
+			table.LoanPackageOfferInterest.ID.EQ(postgres.Int64(id)).
+				AND(table.LoanPackageOfferInterest.Status.EQ(postgres.String(from.String()))),
+		).
+		ExecContext(ctx, l.getDbFunc(ctx))
+	if err != nil {
+		return false, fmt.Errorf("LoanPackageOfferInterestPostgresRepository UpdateStatusFrom %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("LoanPackageOfferInterestPostgresRepository UpdateStatusFrom %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (l *LoanPackageOfferInterestPostgresRepository) UpdateWorkflowId(ctx context.Context, id int64, workflowId string) error {
+	if _, err := table.LoanPackageOfferInterest.
+		UPDATE(table.LoanPackageOfferInterest.WorkflowID).
+		SET(postgres.String(workflowId)).
+		WHERE(table.LoanPackageOfferInterest.ID.EQ(postgres.Int64(id))).
+		ExecContext(ctx, l.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("LoanPackageOfferInterestPostgresRepository UpdateWorkflowId %w", err)
+	}
+	return nil
+}
+
+func (l *LoanPackageOfferInterestPostgresRepository) GetCreatingUpdatedBefore(ctx context.Context, updatedBefore time.Time, limit int64) ([]entity.LoanPackageOfferInterest, error) {
+	dest := make([]model.LoanPackageOfferInterest, 0)
+	if err := table.LoanPackageOfferInterest.
+		SELECT(table.LoanPackageOfferInterest.AllColumns).
+		WHERE(
+			table.LoanPackageOfferInterest.Status.EQ(postgres.String(entity.LoanPackageOfferInterestStatusCreatingLoanPackage.String())).
+				AND(table.LoanPackageOfferInterest.UpdatedAt.LT(postgres.TimestampT(updatedBefore))),
+		).
+		ORDER_BY(table.LoanPackageOfferInterest.UpdatedAt.ASC()).
+		LIMIT(limit).
+		QueryContext(ctx, l.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []entity.LoanPackageOfferInterest{}, nil
+		}
+		return nil, fmt.Errorf("LoanPackageOfferInterestPostgresRepository GetCreatingUpdatedBefore %w", err)
+	}
+	return MapLoanPackageOfferInterestsDbToEntity(dest), nil
+}
+
 func (l *LoanPackageOfferInterestPostgresRepository) GetById(ctx context.Context, id int64, opts ...querymod.GetOption) (entity.LoanPackageOfferInterest, error) {
 	loanPackageOfferInterest := model.LoanPackageOfferInterest{}
 	getQm := querymod.GetQm{}
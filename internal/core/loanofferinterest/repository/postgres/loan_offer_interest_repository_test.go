@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -39,3 +40,58 @@ func TestLoanPackageOfferInterestPostgresRepository_CancelExpiredOfferInterests(
 		assert.Equal(t, "LoanPackageOfferInterestPostgresRepository CancelByOfferId test", err.Error())
 	})
 }
+
+func TestLoanPackageOfferInterestPostgresRepository_LoanPackageCreation(t *testing.T) {
+	t.Parallel()
+	db, mock, err := dbtest.New()
+	if err != nil {
+		return
+	}
+	repo := NewLoanPackageOfferInterestPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	t.Run("UpdateStatusFromMoved", func(t *testing.T) {
+		mock.ExpectExec("UPDATE public.loan_package_offer_interest").
+			WithArgs(entity.LoanPackageOfferInterestStatusPending, 1, entity.LoanPackageOfferInterestStatusCreatingLoanPackage).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		moved, err := repo.UpdateStatusFrom(
+			context.Background(), 1, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			entity.LoanPackageOfferInterestStatusPending,
+		)
+		assert.Nil(t, err)
+		assert.True(t, moved)
+	})
+
+	t.Run("UpdateStatusFromAlreadyMoved", func(t *testing.T) {
+		mock.ExpectExec("UPDATE public.loan_package_offer_interest").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		moved, err := repo.UpdateStatusFrom(
+			context.Background(), 1, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			entity.LoanPackageOfferInterestStatusPending,
+		)
+		assert.Nil(t, err)
+		assert.False(t, moved)
+	})
+
+	t.Run("UpdateWorkflowIdFail", func(t *testing.T) {
+		mock.ExpectExec("UPDATE public.loan_package_offer_interest").WithArgs("workflow-1", 1).
+			WillReturnError(errors.New("test"))
+		err := repo.UpdateWorkflowId(context.Background(), 1, "workflow-1")
+		assert.Equal(t, "LoanPackageOfferInterestPostgresRepository UpdateWorkflowId test", err.Error())
+	})
+
+	t.Run("GetCreatingUpdatedBeforeSuccess", func(t *testing.T) {
+		mock.ExpectQuery(`(?s)SELECT .+ FROM public.loan_package_offer_interest .+ORDER BY loan_package_offer_interest.updated_at ASC`).
+			WillReturnRows(
+				mock.NewRows([]string{"loan_package_offer_interest.id", "loan_package_offer_interest.status", "loan_package_offer_interest.workflow_id"}).
+					AddRow(1, "PACKAGE_CREATING", "workflow-1"),
+			)
+		offerLines, err := repo.GetCreatingUpdatedBefore(context.Background(), time.Now(), 10)
+		assert.Nil(t, err)
+		assert.Len(t, offerLines, 1)
+		assert.Equal(t, "workflow-1", offerLines[0].WorkflowId)
+		assert.Equal(t, entity.LoanPackageOfferInterestStatusCreatingLoanPackage, offerLines[0].Status)
+	})
+}
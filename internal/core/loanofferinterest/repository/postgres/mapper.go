@@ -76,6 +76,9 @@ func MapLoanPackageOfferInterestDbToEntity(l model.LoanPackageOfferInterest) ent
 	if l.SubmissionSheetDetailID != nil {
 		res.SubmissionSheetDetailId = *l.SubmissionSheetDetailID
 	}
+	if l.WorkflowID != nil {
+		res.WorkflowId = *l.WorkflowID
+	}
 
 	return res
 }
@@ -108,6 +111,9 @@ func MapLoanPackageOfferInterestEntityToDb(l entity.LoanPackageOfferInterest) mo
 	if l.SubmissionSheetDetailId != 0 {
 		res.SubmissionSheetDetailID = &l.SubmissionSheetDetailId
 	}
+	if l.WorkflowId != "" {
+		res.WorkflowID = &l.WorkflowId
+	}
 	return res
 }
 
@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/loanofferinterest"
+	"financing-offer/internal/core/scheduler"
+)
+
+type LoanOfferInterestScheduler struct {
+	logger       *slog.Logger
+	useCase      loanofferinterest.UseCase
+	errorService apperrors.Service
+}
+
+func NewLoanOfferInterestScheduler(logger *slog.Logger, useCase loanofferinterest.UseCase, errorService apperrors.Service) *LoanOfferInterestScheduler {
+	return &LoanOfferInterestScheduler{
+		logger:       logger,
+		useCase:      useCase,
+		errorService: errorService,
+	}
+}
+
+func (s *LoanOfferInterestScheduler) ReconcileCreatingLoanPackages(ctx context.Context) error {
+	reconciled, err := s.useCase.ReconcileCreatingLoanPackages(ctx)
+	if err != nil {
+		s.logger.Error("ReconcileCreatingLoanPackages", slog.String("error", err.Error()))
+		if scheduler.FinalAttempt(ctx) {
+			if err := s.errorService.NotifyError(ctx, err); err != nil {
+				s.logger.Error("ReconcileCreatingLoanPackages NotifyError", slog.String("error", err.Error()))
+			}
+		}
+	}
+	if reconciled > 0 {
+		s.logger.Info("ReconcileCreatingLoanPackages", slog.Int("reconciled", reconciled))
+	}
+	scheduler.Track(ctx, "reconciled", reconciled)
+	return err
+}
+
+func (s *LoanOfferInterestScheduler) SyncLoanPackageData(ctx context.Context) error {
+	synced, err := s.useCase.SyncLoanPackageData(ctx)
+	if err != nil {
+		s.logger.Error("SyncLoanPackageData", slog.String("error", err.Error()))
+		if scheduler.FinalAttempt(ctx) {
+			if err := s.errorService.NotifyError(ctx, err); err != nil {
+				s.logger.Error("SyncLoanPackageData NotifyError", slog.String("error", err.Error()))
+			}
+		}
+	}
+	s.logger.Info("SyncLoanPackageData", slog.Int("synced", synced))
+	scheduler.Track(ctx, "synced", synced)
+	return err
+}
@@ -2,6 +2,7 @@ package loanofferinterest
 
 import (
 	"context"
+	"errors"
 	"financing-offer/internal/config"
 	"fmt"
 	"slices"
@@ -16,12 +17,14 @@ import (
 	"financing-offer/internal/core"
 	"financing-offer/internal/core/entity"
 	financialProductRepo "financing-offer/internal/core/financialproduct/repository"
+	lifecycleRepo "financing-offer/internal/core/lifecycle/repository"
 	loanContractRepo "financing-offer/internal/core/loancontract/repository"
 	loanPackageOfferRepo "financing-offer/internal/core/loanoffer/repository"
 	"financing-offer/internal/core/loanofferinterest/repository"
 	loanPolicyRepo "financing-offer/internal/core/loanpolicytemplate/repository"
 	submissionSheetRepo "financing-offer/internal/core/submissionsheet/repository"
 	symbolRepo "financing-offer/internal/core/symbol/repository"
+	webhookRepo "financing-offer/internal/core/webhook/repository"
 	"financing-offer/internal/funcs"
 	"financing-offer/pkg/querymod"
 )
@@ -32,7 +35,12 @@ type UseCase interface {
 	AdminAssignLoanIdByOfferId(ctx context.Context, offerId int64, loanId int64) error
 	AdminCancelLoanPackageInterestByOfferId(ctx context.Context, offerId int64, canceler string) error
 	InvestorCancelLoanPackageInterest(ctx context.Context, id int64, investorId string) error
+	InvestorGetLoanPackageCreationStatus(ctx context.Context, id int64, investorId string) (entity.LoanPackageCreationStatus, error)
+	ReconcileCreatingLoanPackages(ctx context.Context) (int, error)
+	PrepareLoanPackageCreation(ctx context.Context, loanPackageOfferInterestId int64) (entity.AssignmentState, error)
+	ReleaseLoanPackageCreation(ctx context.Context, loanPackageOfferInterestId int64, cause string) error
 	SyncLoanPackageData(ctx context.Context) (int, error)
+	HandleLoanPackageActivated(ctx context.Context, event entity.LoanPackageActivatedEvent) error
 	CreateAssignedLoanOfferInterestLoanContract(
 		ctx context.Context,
 		loanPackageOfferInterestId,
@@ -54,6 +62,8 @@ type useCase struct {
 	submissionSheetRepository  submissionSheetRepo.SubmissionSheetRepository
 	policyTemplateRepository   loanPolicyRepo.LoanPolicyTemplateRepository
 	appConfig                  config.AppConfig
+	lifecycleRepository        lifecycleRepo.LoanRequestLifecycleRepository
+	webhookEventRepository     webhookRepo.WebhookEventRepository
 }
 
 func (u *useCase) CreateAssignedLoanOfferInterestLoanContract(ctx context.Context, loanPackageOfferInterestId, loanPackageAccountId, loanProductIdRef int64, loanPackage entity.FinancialProductLoanPackage) (entity.LoanContract, error) {
@@ -62,10 +72,17 @@ func (u *useCase) CreateAssignedLoanOfferInterestLoanContract(ctx context.Contex
 		request             entity.LoanPackageRequest
 	)
 	transactionFunc := func(ctx context.Context) error {
-		loanOfferInterest, err := u.repository.GetById(ctx, loanPackageOfferInterestId)
+		loanOfferInterest, err := u.repository.GetById(ctx, loanPackageOfferInterestId, querymod.WithLock())
 		if err != nil {
 			return err
 		}
+		// the workflow callback and the activation event can both report the same loan package, the later one
+		// finds the line already holding it and returns its contract unchanged
+		if loanOfferInterest.Status == entity.LoanPackageOfferInterestStatusLoanPackageCreated &&
+			loanOfferInterest.LoanID == loanPackage.Id {
+			createdLoanContract, err = u.loanContractRepository.GetByLoanOfferInterestId(ctx, loanOfferInterest.Id)
+			return err
+		}
 		offerWithRequest, err := u.loanOfferRepository.FindByIdWithRequest(ctx, loanOfferInterest.LoanPackageOfferId)
 		if err != nil {
 			return err
@@ -101,26 +118,21 @@ func (u *useCase) CreateAssignedLoanOfferInterestLoanContract(ctx context.Contex
 		if err != nil {
 			return err
 		}
-		return nil
+		return u.NotifyLoanPackageReady(
+			ctx, request, AssignedLoanPackageAccount{
+				LoanPackageOfferInterestId: loanPackageOfferInterestId,
+				LoanPackageId:              loanPackage.Id,
+				InterestRate:               loanPackage.InterestRate,
+				LoanRate:                   decimal.NewFromInt(1).Sub(loanPackage.InitialRate),
+				InitialRate:                loanPackage.InitialRate,
+			},
+		)
 	}
 	if err := u.atomicExecutor.Execute(ctx, transactionFunc); err != nil {
 		return entity.LoanContract{}, fmt.Errorf(
 			"loanContractUseCase CreateAssignedLoanOfferInterestLoanContract %w", err,
 		)
 	}
-	u.errorService.Go(
-		ctx, func() error {
-			return u.NotifyLoanPackageReady(
-				atomicity.WithIgnoreTx(ctx), request, AssignedLoanPackageAccount{
-					LoanPackageOfferInterestId: loanPackageOfferInterestId,
-					LoanPackageId:              loanPackage.Id,
-					InterestRate:               loanPackage.InterestRate,
-					LoanRate:                   decimal.NewFromInt(1).Sub(loanPackage.InitialRate),
-					InitialRate:                loanPackage.InitialRate,
-				},
-			)
-		},
-	)
 	return createdLoanContract, nil
 }
 
@@ -232,16 +244,8 @@ func (u *useCase) AdminAssignLoanIdByOfferId(ctx context.Context, offerId int64,
 			if _, err := u.loanContractRepository.Create(tc, contract); err != nil {
 				return err
 			}
-			return nil
-		},
-	)
-	if txErr != nil {
-		return fmt.Errorf("loanOfferInterestUseCase AdminAssignLoanId %w", txErr)
-	}
-	u.errorService.Go(
-		ctx, func() error {
 			return u.NotifyLoanPackageReady(
-				atomicity.WithIgnoreTx(ctx), request, AssignedLoanPackageAccount{
+				tc, request, AssignedLoanPackageAccount{
 					LoanPackageOfferInterestId: assignedOfferLine.Id,
 					LoanPackageId:              assignedOfferLine.LoanID,
 					InterestRate:               assignedOfferLine.InterestRate,
@@ -251,6 +255,9 @@ func (u *useCase) AdminAssignLoanIdByOfferId(ctx context.Context, offerId int64,
 			)
 		},
 	)
+	if txErr != nil {
+		return fmt.Errorf("loanOfferInterestUseCase AdminAssignLoanId %w", txErr)
+	}
 	return nil
 }
 
@@ -312,32 +319,38 @@ func (u *useCase) fillUnderlyingLoanPackageToOfferLine(
 	return offerLine, nil
 }
 
-func (u *useCase) NotifyLoanPackageReady(
-	ctx context.Context,
-	request entity.LoanPackageRequest,
-	assignedLoanPackageAccount AssignedLoanPackageAccount,
-) error {
-	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
-	if err != nil {
-		return err
-	}
-	// only include accountNo if investor has more than 1 account
-	accountNoDesc := ""
+// getAccountNoDesc only includes accountNo if investor has more than 1 account.
+// The description is cosmetic, so a failed lookup must not roll back the transaction that enqueues the notification
+func (u *useCase) getAccountNoDesc(ctx context.Context, request entity.LoanPackageRequest) string {
 	accounts, err := u.financialProductRepository.GetAllAccountDetail(ctx, request.InvestorId)
 	if err != nil {
-		return err
+		_ = u.errorService.NotifyError(ctx, fmt.Errorf("loanOfferInterestUseCase getAccountNoDesc %w", err))
+		return ""
 	}
 	if len(accounts) > 1 {
 		for _, account := range accounts {
 			if account.AccountNo == request.AccountNo {
-				accountNoDesc = account.AccountTypeName
-				break
+				return account.AccountTypeName
 			}
 		}
 	}
+	return ""
+}
+
+// NotifyLoanPackageReady runs in the transaction assigning the loan package, the webhook event is published with it
+func (u *useCase) NotifyLoanPackageReady(
+	ctx context.Context,
+	request entity.LoanPackageRequest,
+	assignedLoanPackageAccount AssignedLoanPackageAccount,
+) error {
+	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
+	if err != nil {
+		return err
+	}
+	accountNoDesc := u.getAccountNoDesc(ctx, request)
 	switch request.AssetType {
 	case entity.AssetTypeUnderlying:
-		return u.eventRepository.NotifyLoanPackageOfferReady(
+		err = u.eventRepository.NotifyLoanPackageOfferReady(
 			ctx, entity.LoanPackageOfferReadyNotify{
 				InvestorId:    request.InvestorId,
 				RequestName:   fmt.Sprintf("%s-%d", symbol.Symbol, request.Id),
@@ -352,7 +365,7 @@ func (u *useCase) NotifyLoanPackageReady(
 			},
 		)
 	case entity.AssetTypeDerivative:
-		return u.eventRepository.NotifyDerivativeLoanPackageOfferReady(
+		err = u.eventRepository.NotifyDerivativeLoanPackageOfferReady(
 			ctx, entity.DerivativeLoanPackageOfferReadyNotify{
 				InvestorId:    request.InvestorId,
 				RequestName:   fmt.Sprintf("%s-%d", symbol.Symbol, request.Id),
@@ -367,6 +380,22 @@ func (u *useCase) NotifyLoanPackageReady(
 	default:
 		return apperrors.ErrMismatchAssetType
 	}
+	if err != nil {
+		return err
+	}
+	return u.webhookEventRepository.Publish(
+		ctx, entity.WebhookEventTypeOfferReady, entity.WebhookOfferReadyData{
+			LoanPackageRequestId:       request.Id,
+			LoanPackageOfferInterestId: assignedLoanPackageAccount.LoanPackageOfferInterestId,
+			InvestorId:                 request.InvestorId,
+			AccountNo:                  request.AccountNo,
+			Symbol:                     symbol.Symbol,
+			AssetType:                  request.AssetType,
+			LoanPackageId:              assignedLoanPackageAccount.LoanPackageId,
+			LoanRate:                   assignedLoanPackageAccount.LoanRate,
+			InterestRate:               assignedLoanPackageAccount.InterestRate,
+		},
+	)
 }
 
 func (u *useCase) InvestorConfirmLoanPackageInterest(ctx context.Context, ids []int64, investorId string) error {
@@ -382,69 +411,274 @@ func (u *useCase) InvestorConfirmLoanPackageInterest(ctx context.Context, ids []
 		return err
 	}
 	request := *offer.LoanPackageRequest
+	// the event is published with the status change of the lines, so it is only delivered once they are saved
+	publishSigned := func(tc context.Context) error {
+		return u.webhookEventRepository.Publish(
+			tc, entity.WebhookEventTypeOfferInterestSigned, entity.WebhookOfferInterestSignedData{
+				LoanPackageRequestId:        request.Id,
+				LoanPackageOfferId:          offer.Id,
+				LoanPackageOfferInterestIds: ids,
+				InvestorId:                  investorId,
+			},
+		)
+	}
 	if existedMoLoanPackage {
-		return u.assignExistedLoanPackages(ctx, request, offer, offerLines, investorId)
-	} else {
-		return u.createAndAssignNewLoanPackage(ctx, request, offerLines[0])
+		return u.assignExistedLoanPackages(ctx, request, offer, offerLines, investorId, publishSigned)
 	}
+	return u.createAndAssignNewLoanPackage(ctx, request, offerLines[0], publishSigned)
 }
 
+// createAndAssignNewLoanPackage starts the workflow creating the loan package without waiting for it,
+// the workflow moves the line to PACKAGE_CREATED through CreateAssignedLoanOfferInterestLoanContract
 func (u *useCase) createAndAssignNewLoanPackage(
 	ctx context.Context,
 	request entity.LoanPackageRequest,
 	offerLine entity.LoanPackageOfferInterest,
+	onConfirmed func(ctx context.Context) error,
 ) error {
-	if err := u.repository.UpdateStatus(
-		ctx, []int64{offerLine.Id}, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+	state, err := u.buildAssignmentState(ctx, request, offerLine)
+	if err != nil {
+		return err
+	}
+	if err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			if err := u.repository.UpdateStatus(
+				tc, []int64{offerLine.Id}, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			); err != nil {
+				return err
+			}
+			return onConfirmed(tc)
+		},
 	); err != nil {
 		return err
 	}
-	submissionSheet, err := u.submissionSheetRepository.GetDetailById(ctx, offerLine.SubmissionSheetDetailId)
+	workflowId, err := u.startLoanPackageCreation(ctx, state)
 	if err != nil {
+		// the workflow never started, release the line so the investor can confirm it again
+		if _, revertErr := u.repository.UpdateStatusFrom(
+			ctx, offerLine.Id, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			entity.LoanPackageOfferInterestStatusPending,
+		); revertErr != nil {
+			return errors.Join(err, revertErr)
+		}
 		return err
 	}
+	return u.repository.UpdateWorkflowId(ctx, offerLine.Id, workflowId)
+}
 
-	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
+// startLoanPackageCreation hands the creation to the lifecycle workflow of the request when it runs,
+// otherwise it starts the creation workflow directly
+func (u *useCase) startLoanPackageCreation(ctx context.Context, state entity.AssignmentState) (string, error) {
+	submission := state.Submission
+	handed, err := u.lifecycleRepository.OfferInterestConfirmed(
+		ctx, submission.LoanPackageRequestId, submission.LoanPackageOfferInterestId,
+	)
 	if err != nil {
-		return err
+		return "", err
+	}
+	if handed {
+		return entity.LoanPackageCreationWorkflowId(submission.LoanPackageOfferInterestId), nil
 	}
+	return u.eventRepository.CreateMarginLoanPackage(ctx, state)
+}
 
-	if err := u.eventRepository.CreateMarginLoanPackage(
-		ctx, entity.AssignmentState{
-			Submission: entity.Submission{
-				Symbol:                     symbol.Symbol,
-				LoanPackageOfferInterestId: offerLine.Id,
-				LoanPackageRequestId:       request.Id,
-				AccountNo:                  request.AccountNo,
-				LoanRate:                   submissionSheet.LoanRate,
-				Templates: funcs.Map(
-					submissionSheet.LoanPolicies, func(policy entity.LoanPolicySnapShot) entity.TemplateWithProductRate {
-						return entity.TemplateWithProductRate{
-							LoanPolicySnapShot:       policy,
-							AllowedOverdueLoanInDays: policy.AllowedOverdueLoanInDays,
-							ProductRate:              policy.InitialRate,
-							ProductRateForWithdraw:   policy.InitialRateForWithdraw,
-						}
-					},
-				),
-				FirmBuyingFeeRate:  submissionSheet.FirmBuyingFee,
-				FirmSellingFeeRate: submissionSheet.FirmSellingFee,
-				TransferFee:        submissionSheet.TransferFee,
-				ProductCategoryId:  u.appConfig.ProductCategoryId,
-			},
+func (u *useCase) buildAssignmentState(
+	ctx context.Context,
+	request entity.LoanPackageRequest,
+	offerLine entity.LoanPackageOfferInterest,
+) (entity.AssignmentState, error) {
+	submissionSheet, err := u.submissionSheetRepository.GetDetailById(ctx, offerLine.SubmissionSheetDetailId)
+	if err != nil {
+		return entity.AssignmentState{}, err
+	}
+	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
+	if err != nil {
+		return entity.AssignmentState{}, err
+	}
+	return entity.AssignmentState{
+		Submission: entity.Submission{
+			Symbol:                     symbol.Symbol,
+			LoanPackageOfferInterestId: offerLine.Id,
+			LoanPackageRequestId:       request.Id,
+			AccountNo:                  request.AccountNo,
+			LoanRate:                   submissionSheet.LoanRate,
+			Templates: funcs.Map(
+				submissionSheet.LoanPolicies, func(policy entity.LoanPolicySnapShot) entity.TemplateWithProductRate {
+					return entity.TemplateWithProductRate{
+						LoanPolicySnapShot:       policy,
+						AllowedOverdueLoanInDays: policy.AllowedOverdueLoanInDays,
+						ProductRate:              policy.InitialRate,
+						ProductRateForWithdraw:   policy.InitialRateForWithdraw,
+					}
+				},
+			),
+			FirmBuyingFeeRate:  submissionSheet.FirmBuyingFee,
+			FirmSellingFeeRate: submissionSheet.FirmSellingFee,
+			TransferFee:        submissionSheet.TransferFee,
+			ProductCategoryId:  u.appConfig.ProductCategoryId,
 		},
-	); err != nil {
-		return err
+	}, nil
+}
+
+// PrepareLoanPackageCreation builds the input of the workflow creating the loan package of an offer line the investor confirmed
+func (u *useCase) PrepareLoanPackageCreation(ctx context.Context, loanPackageOfferInterestId int64) (entity.AssignmentState, error) {
+	errorTemplate := "loanOfferInterestUseCase PrepareLoanPackageCreation %w"
+	offerLine, err := u.repository.GetById(ctx, loanPackageOfferInterestId)
+	if err != nil {
+		return entity.AssignmentState{}, fmt.Errorf(errorTemplate, err)
+	}
+	if offerLine.Status != entity.LoanPackageOfferInterestStatusCreatingLoanPackage {
+		return entity.AssignmentState{}, fmt.Errorf(errorTemplate, apperrors.ErrInvalidLoanPackageOfferInterestStatus)
+	}
+	offer, err := u.loanOfferRepository.FindByIdWithRequest(ctx, offerLine.LoanPackageOfferId)
+	if err != nil {
+		return entity.AssignmentState{}, fmt.Errorf(errorTemplate, err)
+	}
+	state, err := u.buildAssignmentState(ctx, *offer.LoanPackageRequest, offerLine)
+	if err != nil {
+		return entity.AssignmentState{}, fmt.Errorf(errorTemplate, err)
+	}
+	return state, nil
+}
+
+// ReleaseLoanPackageCreation moves an offer line whose loan package could not be created back to PENDING and raises an alert
+func (u *useCase) ReleaseLoanPackageCreation(ctx context.Context, loanPackageOfferInterestId int64, cause string) error {
+	if _, err := u.releaseCreatingLoanPackage(ctx, loanPackageOfferInterestId, cause); err != nil {
+		return fmt.Errorf("loanOfferInterestUseCase ReleaseLoanPackageCreation %w", err)
 	}
 	return nil
 }
 
+// releaseCreatingLoanPackage reports false when the line already left PACKAGE_CREATING
+func (u *useCase) releaseCreatingLoanPackage(ctx context.Context, loanPackageOfferInterestId int64, cause string) (bool, error) {
+	moved, err := u.repository.UpdateStatusFrom(
+		ctx, loanPackageOfferInterestId, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+		entity.LoanPackageOfferInterestStatusPending,
+	)
+	if err != nil || !moved {
+		return false, err
+	}
+	return true, u.errorService.NotifyError(
+		ctx, fmt.Errorf(
+			"loan package offer interest %d moved back to %s, %s: %w",
+			loanPackageOfferInterestId, entity.LoanPackageOfferInterestStatusPending, cause,
+			apperrors.ErrorCreateAndAssignLoanPackageWorkflow,
+		),
+	)
+}
+
+func (u *useCase) InvestorGetLoanPackageCreationStatus(ctx context.Context, id int64, investorId string) (entity.LoanPackageCreationStatus, error) {
+	errorTemplate := "loanOfferInterestUseCase InvestorGetLoanPackageCreationStatus %w"
+	offerLine, err := u.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.LoanPackageCreationStatus{}, fmt.Errorf(errorTemplate, err)
+	}
+	offer, err := u.loanOfferRepository.FindByIdWithRequest(ctx, offerLine.LoanPackageOfferId)
+	if err != nil {
+		return entity.LoanPackageCreationStatus{}, fmt.Errorf(errorTemplate, err)
+	}
+	if offer.LoanPackageRequest.InvestorId != investorId {
+		return entity.LoanPackageCreationStatus{}, apperrors.ErrInvalidInvestorId
+	}
+	res := entity.LoanPackageCreationStatus{
+		LoanPackageOfferInterestId: offerLine.Id,
+		Status:                     offerLine.Status,
+		WorkflowId:                 offerLine.WorkflowId,
+		LoanId:                     offerLine.LoanID,
+	}
+	if offerLine.Status == entity.LoanPackageOfferInterestStatusCreatingLoanPackage {
+		res.WorkflowId = creationWorkflowId(offerLine)
+		workflowStatus, err := u.eventRepository.GetLoanPackageWorkflowStatus(ctx, res.WorkflowId)
+		if err != nil {
+			return entity.LoanPackageCreationStatus{}, fmt.Errorf(errorTemplate, err)
+		}
+		res.WorkflowStatus = workflowStatus
+	}
+	return res, nil
+}
+
+// ReconcileCreatingLoanPackages settles the offer lines left creating their loan package from the outcome of their workflow,
+// a completed workflow creates the loan contract of the line from the workflow result the way HandleLoanPackageActivated does,
+// a failed or missing one moves it back to PENDING and raises an alert
+func (u *useCase) ReconcileCreatingLoanPackages(ctx context.Context) (int, error) {
+	errorTemplate := "loanOfferInterestUseCase ReconcileCreatingLoanPackages %w"
+	cfg := u.appConfig.LoanPackageCreation
+	offerLines, err := u.repository.GetCreatingUpdatedBefore(ctx, time.Now().Add(-cfg.StaleAfter), cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	reconciled := 0
+	var errs []error
+	for _, offerLine := range offerLines {
+		workflowId := creationWorkflowId(offerLine)
+		workflowStatus, err := u.eventRepository.GetLoanPackageWorkflowStatus(ctx, workflowId)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		switch workflowStatus {
+		case entity.LoanPackageWorkflowStatusRunning:
+			continue
+		case entity.LoanPackageWorkflowStatusCompleted:
+			if err := u.settleCompletedLoanPackageCreation(ctx, offerLine.Id, workflowId); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			reconciled++
+		default:
+			moved, err := u.releaseCreatingLoanPackage(
+				ctx, offerLine.Id, fmt.Sprintf("workflow %q %s", workflowId, workflowStatus),
+			)
+			if moved {
+				reconciled++
+			}
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return reconciled, fmt.Errorf(errorTemplate, errors.Join(errs...))
+	}
+	return reconciled, nil
+}
+
+// settleCompletedLoanPackageCreation creates the loan contract of a line whose creation workflow completed,
+// the activation event of the same loan package arriving later finds the line created and only refreshes it
+func (u *useCase) settleCompletedLoanPackageCreation(ctx context.Context, loanPackageOfferInterestId int64, workflowId string) error {
+	result, err := u.eventRepository.GetLoanPackageWorkflowResult(ctx, workflowId)
+	if err != nil {
+		return err
+	}
+	if result.LoanPackageId == 0 {
+		return fmt.Errorf("workflow %q completed without a loan package", workflowId)
+	}
+	return u.HandleLoanPackageActivated(
+		ctx, entity.LoanPackageActivatedEvent{
+			LoanPackageOfferInterestId: loanPackageOfferInterestId,
+			LoanPackageId:              result.LoanPackageId,
+			LoanPackageAccountId:       result.LoanPackageAccountId,
+			LoanProductIdRef:           result.LoanProductIdRef,
+		},
+	)
+}
+
+// creationWorkflowId is the workflow creating the loan package of the line, a line whose workflow id was never stored
+// may still have its workflow running under the deterministic id it was started with
+func creationWorkflowId(offerLine entity.LoanPackageOfferInterest) string {
+	if offerLine.WorkflowId != "" {
+		return offerLine.WorkflowId
+	}
+	return entity.LoanPackageCreationWorkflowId(offerLine.Id)
+}
+
 func (u *useCase) assignExistedLoanPackages(
 	ctx context.Context,
 	request entity.LoanPackageRequest,
 	offer entity.LoanPackageOffer,
 	offerLines []entity.LoanPackageOfferInterest,
 	investorId string,
+	onConfirmed func(ctx context.Context) error,
 ) error {
 	ids := funcs.Map(
 		offerLines, func(offerLine entity.LoanPackageOfferInterest) int64 {
@@ -477,16 +711,11 @@ func (u *useCase) assignExistedLoanPackages(
 			}
 		}
 		for _, assignedLoanPackage := range assignedLoanPackages {
-			assignedLoanPackage := assignedLoanPackage
-			u.errorService.Go(
-				ctx, func() error {
-					return u.NotifyLoanPackageReady(
-						atomicity.WithIgnoreTx(ctx), request, assignedLoanPackage,
-					)
-				},
-			)
+			if err := u.NotifyLoanPackageReady(ctx, request, assignedLoanPackage); err != nil {
+				return err
+			}
 		}
-		return nil
+		return onConfirmed(ctx)
 	}
 	if txErr := u.atomicExecutor.Execute(ctx, txFunc); txErr != nil {
 		return fmt.Errorf("loanOfferInterestUseCase assignExistedLoanPackages %w", txErr)
@@ -648,6 +877,22 @@ func (u *useCase) SyncLoanPackageData(ctx context.Context) (int, error) {
 	return succeededLineCount, nil
 }
 
+// HandleLoanPackageActivated creates the loan contract of the offer line whose loan package became active,
+// an event delivered again for a line already holding that loan package changes nothing
+func (u *useCase) HandleLoanPackageActivated(ctx context.Context, event entity.LoanPackageActivatedEvent) error {
+	errorTemplate := "loanOfferInterestUseCase HandleLoanPackageActivated %w"
+	loanPackage, err := u.financialProductRepository.GetLoanPackageDetail(ctx, event.LoanPackageId)
+	if err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	if _, err := u.CreateAssignedLoanOfferInterestLoanContract(
+		ctx, event.LoanPackageOfferInterestId, event.LoanPackageAccountId, event.LoanProductIdRef, loanPackage,
+	); err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	return nil
+}
+
 func NewUseCase(
 	repository repository.LoanPackageOfferInterestRepository,
 	atomicExecutor atomicity.AtomicExecutor,
@@ -660,6 +905,8 @@ func NewUseCase(
 	submissionSheetRepository submissionSheetRepo.SubmissionSheetRepository,
 	policyTemplateRepository loanPolicyRepo.LoanPolicyTemplateRepository,
 	appConfig config.AppConfig,
+	lifecycleRepository lifecycleRepo.LoanRequestLifecycleRepository,
+	webhookEventRepository webhookRepo.WebhookEventRepository,
 ) UseCase {
 	return &useCase{
 		atomicExecutor:             atomicExecutor,
@@ -673,5 +920,7 @@ func NewUseCase(
 		submissionSheetRepository:  submissionSheetRepository,
 		policyTemplateRepository:   policyTemplateRepository,
 		appConfig:                  appConfig,
+		lifecycleRepository:        lifecycleRepository,
+		webhookEventRepository:     webhookEventRepository,
 	}
 }
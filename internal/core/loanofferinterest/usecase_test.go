@@ -49,6 +49,8 @@ func TestLoanPackageOfferInterestUseCase(t *testing.T) {
 				submissionSheetRepo,
 				policyTemplateRepo,
 				appConfig,
+				&mock.LoanRequestLifecycleRepository{},
+				&mock.WebhookEventRepository{},
 			)
 			sqlMock.ExpectBegin()
 			sqlMock.ExpectCommit()
@@ -179,6 +181,7 @@ func TestLoanPackageOfferInterestUseCase(t *testing.T) {
 			submissionSheetRepo := mock.NewMockSubmissionSheetRepository(t)
 			policyTemplateRepo := mock.NewMockLoanPolicyTemplateRepository(t)
 			appConfig := config.AppConfig{}
+			webhookEventRepository := mock.NewMockWebhookEventRepository(t)
 			useCase := NewUseCase(
 				loanPackageOfferInterestRepository,
 				&atomicity.DbAtomicExecutor{
@@ -193,6 +196,8 @@ func TestLoanPackageOfferInterestUseCase(t *testing.T) {
 				submissionSheetRepo,
 				policyTemplateRepo,
 				appConfig,
+				&mock.LoanRequestLifecycleRepository{},
+				webhookEventRepository,
 			)
 			sqlMock.ExpectBegin()
 			sqlMock.ExpectCommit()
@@ -267,6 +272,22 @@ func TestLoanPackageOfferInterestUseCase(t *testing.T) {
 			financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, testifyMock.Anything).Return([]entity.FinancialAccountDetail{financialProductDetail}, nil)
 			loanPackageRequestEventRepository.EXPECT().NotifyLoanPackageOfferReady(testifyMock.Anything, testifyMock.Anything).Return(nil)
 
+			inTransaction := testifyMock.MatchedBy(
+				func(ctx context.Context) bool {
+					return ctx.Value(atomicity.TxKey) != nil
+				},
+			)
+			webhookEventRepository.EXPECT().Publish(inTransaction, entity.WebhookEventTypeOfferReady, testifyMock.Anything).
+				Return(nil)
+			webhookEventRepository.EXPECT().Publish(
+				inTransaction, entity.WebhookEventTypeOfferInterestSigned, entity.WebhookOfferInterestSignedData{
+					LoanPackageRequestId:        1,
+					LoanPackageOfferId:          1,
+					LoanPackageOfferInterestIds: []int64{1},
+					InvestorId:                  "1",
+				},
+			).Return(nil)
+
 			err = useCase.InvestorConfirmLoanPackageInterest(context.Background(), []int64{1}, "1")
 			assert.Nil(t, err)
 		})
@@ -299,6 +320,8 @@ func TestLoanPackageOfferInterestUseCase(t *testing.T) {
 			submissionSheetRepo,
 			policyTemplateRepo,
 			appConfig,
+			&mock.LoanRequestLifecycleRepository{},
+			&mock.WebhookEventRepository{},
 		)
 		sqlMock.ExpectBegin()
 		sqlMock.ExpectRollback()
@@ -357,3 +380,506 @@ func TestLoanPackageOfferInterestUseCase(t *testing.T) {
 		assert.ErrorIs(t, err, apperrors.ErrorLoanPackageOfferInterestIsCreating)
 	})
 }
+
+func TestLoanPackageOfferInterestUseCase_LoanPackageCreation(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		repository         *mock.MockLoanPackageOfferInterestRepository
+		offerRepository    *mock.MockLoanPackageOfferRepository
+		contractRepository *mock.MockLoanContractPersistenceRepository
+		financialProduct   *mock.MockFinancialProductRepository
+		eventRepository    *mock.MockLoanPackageOfferInterestEventRepository
+		symbolRepo         *mock.MockSymbolRepository
+		submissionSheet    *mock.MockSubmissionSheetRepository
+		lifecycle          *mock.MockLoanRequestLifecycleRepository
+	}
+	newUseCase := func(t *testing.T) (UseCase, mocks) {
+		m := mocks{
+			repository:         mock.NewMockLoanPackageOfferInterestRepository(t),
+			offerRepository:    mock.NewMockLoanPackageOfferRepository(t),
+			contractRepository: mock.NewMockLoanContractPersistenceRepository(t),
+			financialProduct:   mock.NewMockFinancialProductRepository(t),
+			eventRepository:    mock.NewMockLoanPackageOfferInterestEventRepository(t),
+			symbolRepo:         mock.NewMockSymbolRepository(t),
+			submissionSheet:    mock.NewMockSubmissionSheetRepository(t),
+			lifecycle:          mock.NewMockLoanRequestLifecycleRepository(t),
+		}
+		useCase := NewUseCase(
+			m.repository,
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+			m.offerRepository,
+			m.contractRepository,
+			m.financialProduct,
+			m.eventRepository,
+			mock.ErrReporter{},
+			m.symbolRepo,
+			m.submissionSheet,
+			mock.NewMockLoanPolicyTemplateRepository(t),
+			config.AppConfig{
+				LoanPackageCreation: config.LoanPackageCreationConfig{StaleAfter: 10 * time.Minute, BatchSize: 100},
+			},
+			m.lifecycle,
+			&mock.WebhookEventRepository{},
+		)
+		return useCase, m
+	}
+	offer := entity.LoanPackageOffer{
+		Id:        1,
+		ExpiredAt: time.Now().AddDate(0, 0, 1),
+		FlowType:  entity.FlowTypeDnseOnline,
+		LoanPackageRequest: &entity.LoanPackageRequest{
+			Id:         1,
+			SymbolId:   1,
+			InvestorId: "1",
+			AccountNo:  "1",
+			AssetType:  entity.AssetTypeUnderlying,
+		},
+	}
+	pendingLine := entity.LoanPackageOfferInterest{
+		Id:                      1,
+		LoanPackageOfferId:      1,
+		SubmissionSheetDetailId: 1,
+		Status:                  entity.LoanPackageOfferInterestStatusPending,
+	}
+	creatingLine := func(id int64, workflowId string) entity.LoanPackageOfferInterest {
+		return entity.LoanPackageOfferInterest{
+			Id:                 id,
+			LoanPackageOfferId: 1,
+			Status:             entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			WorkflowId:         workflowId,
+		}
+	}
+
+	t.Run(
+		"InvestorConfirmLoanPackageInterest stores the workflow id without waiting", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetByIds(testifyMock.Anything, []int64{1}).Return([]entity.LoanPackageOfferInterest{pendingLine}, nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(offer, nil)
+			m.submissionSheet.EXPECT().GetDetailById(testifyMock.Anything, int64(1)).Return(entity.SubmissionSheetDetail{Id: 1}, nil)
+			m.symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(entity.Symbol{Id: 1, Symbol: "VIB"}, nil)
+			m.repository.EXPECT().UpdateStatus(
+				testifyMock.Anything, []int64{1}, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			).Return(nil)
+			m.lifecycle.EXPECT().OfferInterestConfirmed(testifyMock.Anything, int64(1), int64(1)).Return(false, nil)
+			m.eventRepository.EXPECT().CreateMarginLoanPackage(testifyMock.Anything, testifyMock.Anything).Return("workflow-1", nil)
+			m.repository.EXPECT().UpdateWorkflowId(testifyMock.Anything, int64(1), "workflow-1").Return(nil)
+
+			err := useCase.InvestorConfirmLoanPackageInterest(context.Background(), []int64{1}, "1")
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"InvestorConfirmLoanPackageInterest publishes the signed event with the status change", func(t *testing.T) {
+			db, sqlMock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("%v", err)
+			}
+			m := mocks{
+				repository:      mock.NewMockLoanPackageOfferInterestRepository(t),
+				offerRepository: mock.NewMockLoanPackageOfferRepository(t),
+				eventRepository: mock.NewMockLoanPackageOfferInterestEventRepository(t),
+				symbolRepo:      mock.NewMockSymbolRepository(t),
+				submissionSheet: mock.NewMockSubmissionSheetRepository(t),
+				lifecycle:       mock.NewMockLoanRequestLifecycleRepository(t),
+			}
+			webhookEventRepository := mock.NewMockWebhookEventRepository(t)
+			useCase := NewUseCase(
+				m.repository,
+				&atomicity.DbAtomicExecutor{DB: db},
+				m.offerRepository,
+				mock.NewMockLoanContractPersistenceRepository(t),
+				mock.NewMockFinancialProductRepository(t),
+				m.eventRepository,
+				mock.ErrReporter{},
+				m.symbolRepo,
+				m.submissionSheet,
+				mock.NewMockLoanPolicyTemplateRepository(t),
+				config.AppConfig{},
+				m.lifecycle,
+				webhookEventRepository,
+			)
+			inTransaction := testifyMock.MatchedBy(
+				func(ctx context.Context) bool {
+					return ctx.Value(atomicity.TxKey) != nil
+				},
+			)
+			m.repository.EXPECT().GetByIds(testifyMock.Anything, []int64{1}).Return([]entity.LoanPackageOfferInterest{pendingLine}, nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(offer, nil)
+			m.submissionSheet.EXPECT().GetDetailById(testifyMock.Anything, int64(1)).Return(entity.SubmissionSheetDetail{Id: 1}, nil)
+			m.symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(entity.Symbol{Id: 1, Symbol: "VIB"}, nil)
+			sqlMock.ExpectBegin()
+			m.repository.EXPECT().UpdateStatus(
+				inTransaction, []int64{1}, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			).Return(nil)
+			webhookEventRepository.EXPECT().Publish(
+				inTransaction, entity.WebhookEventTypeOfferInterestSigned, testifyMock.Anything,
+			).Return(nil)
+			sqlMock.ExpectCommit()
+			m.lifecycle.EXPECT().OfferInterestConfirmed(testifyMock.Anything, int64(1), int64(1)).Return(false, nil)
+			m.eventRepository.EXPECT().CreateMarginLoanPackage(testifyMock.Anything, testifyMock.Anything).Return("workflow-1", nil)
+			m.repository.EXPECT().UpdateWorkflowId(testifyMock.Anything, int64(1), "workflow-1").Return(nil)
+
+			err = useCase.InvestorConfirmLoanPackageInterest(context.Background(), []int64{1}, "1")
+			assert.Nil(t, err)
+			assert.Nil(t, sqlMock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"InvestorConfirmLoanPackageInterest releases the line when the workflow does not start", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetByIds(testifyMock.Anything, []int64{1}).Return([]entity.LoanPackageOfferInterest{pendingLine}, nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(offer, nil)
+			m.submissionSheet.EXPECT().GetDetailById(testifyMock.Anything, int64(1)).Return(entity.SubmissionSheetDetail{Id: 1}, nil)
+			m.symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(entity.Symbol{Id: 1, Symbol: "VIB"}, nil)
+			m.repository.EXPECT().UpdateStatus(
+				testifyMock.Anything, []int64{1}, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			).Return(nil)
+			m.lifecycle.EXPECT().OfferInterestConfirmed(testifyMock.Anything, int64(1), int64(1)).Return(false, nil)
+			m.eventRepository.EXPECT().CreateMarginLoanPackage(testifyMock.Anything, testifyMock.Anything).Return("", assert.AnError)
+			m.repository.EXPECT().UpdateStatusFrom(
+				testifyMock.Anything, int64(1), entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+				entity.LoanPackageOfferInterestStatusPending,
+			).Return(true, nil)
+
+			err := useCase.InvestorConfirmLoanPackageInterest(context.Background(), []int64{1}, "1")
+			assert.ErrorIs(t, err, assert.AnError)
+		},
+	)
+
+	t.Run(
+		"InvestorConfirmLoanPackageInterest hands the creation to the lifecycle workflow", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetByIds(testifyMock.Anything, []int64{1}).Return([]entity.LoanPackageOfferInterest{pendingLine}, nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(offer, nil)
+			m.submissionSheet.EXPECT().GetDetailById(testifyMock.Anything, int64(1)).Return(entity.SubmissionSheetDetail{Id: 1}, nil)
+			m.symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(entity.Symbol{Id: 1, Symbol: "VIB"}, nil)
+			m.repository.EXPECT().UpdateStatus(
+				testifyMock.Anything, []int64{1}, entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			).Return(nil)
+			m.lifecycle.EXPECT().OfferInterestConfirmed(testifyMock.Anything, int64(1), int64(1)).Return(true, nil)
+			m.repository.EXPECT().UpdateWorkflowId(
+				testifyMock.Anything, int64(1), entity.LoanPackageCreationWorkflowId(1),
+			).Return(nil)
+
+			err := useCase.InvestorConfirmLoanPackageInterest(context.Background(), []int64{1}, "1")
+			assert.Nil(t, err)
+			m.eventRepository.AssertNotCalled(t, "CreateMarginLoanPackage", testifyMock.Anything, testifyMock.Anything)
+		},
+	)
+
+	t.Run(
+		"PrepareLoanPackageCreation", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			line := creatingLine(1, "")
+			line.SubmissionSheetDetailId = 1
+			m.repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(line, nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(offer, nil)
+			m.submissionSheet.EXPECT().GetDetailById(testifyMock.Anything, int64(1)).Return(entity.SubmissionSheetDetail{Id: 1}, nil)
+			m.symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(entity.Symbol{Id: 1, Symbol: "VIB"}, nil)
+
+			state, err := useCase.PrepareLoanPackageCreation(context.Background(), 1)
+			assert.Nil(t, err)
+			assert.Equal(t, "VIB", state.Submission.Symbol)
+			assert.Equal(t, int64(1), state.Submission.LoanPackageOfferInterestId)
+			assert.Equal(t, int64(1), state.Submission.LoanPackageRequestId)
+			assert.Equal(t, "1", state.Submission.AccountNo)
+		},
+	)
+
+	t.Run(
+		"PrepareLoanPackageCreation line not creating", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(pendingLine, nil)
+
+			_, err := useCase.PrepareLoanPackageCreation(context.Background(), 1)
+			assert.ErrorIs(t, err, apperrors.ErrInvalidLoanPackageOfferInterestStatus)
+		},
+	)
+
+	t.Run(
+		"ReleaseLoanPackageCreation", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().UpdateStatusFrom(
+				testifyMock.Anything, int64(1), entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+				entity.LoanPackageOfferInterestStatusPending,
+			).Return(true, nil)
+
+			assert.Nil(t, useCase.ReleaseLoanPackageCreation(context.Background(), 1, "child workflow failed"))
+		},
+	)
+
+	t.Run(
+		"InvestorGetLoanPackageCreationStatus creating", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetById(testifyMock.Anything, int64(2), testifyMock.Anything).Return(creatingLine(2, "workflow-2"), nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(offer, nil)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowStatus(testifyMock.Anything, "workflow-2").
+				Return(entity.LoanPackageWorkflowStatusRunning, nil)
+
+			res, err := useCase.InvestorGetLoanPackageCreationStatus(context.Background(), 2, "1")
+			assert.Nil(t, err)
+			assert.Equal(
+				t, entity.LoanPackageCreationStatus{
+					LoanPackageOfferInterestId: 2,
+					Status:                     entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+					WorkflowId:                 "workflow-2",
+					WorkflowStatus:             entity.LoanPackageWorkflowStatusRunning,
+				}, res,
+			)
+		},
+	)
+
+	t.Run(
+		"InvestorGetLoanPackageCreationStatus other investor", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetById(testifyMock.Anything, int64(2), testifyMock.Anything).Return(creatingLine(2, "workflow-2"), nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(offer, nil)
+
+			_, err := useCase.InvestorGetLoanPackageCreationStatus(context.Background(), 2, "2")
+			assert.ErrorIs(t, err, apperrors.ErrInvalidInvestorId)
+		},
+	)
+
+	t.Run(
+		"ReconcileCreatingLoanPackages", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetCreatingUpdatedBefore(testifyMock.Anything, testifyMock.AnythingOfType("time.Time"), int64(100)).
+				Return(
+					[]entity.LoanPackageOfferInterest{
+						creatingLine(1, "workflow-1"),
+						creatingLine(2, "workflow-2"),
+						creatingLine(3, "workflow-3"),
+						creatingLine(4, ""),
+					}, nil,
+				)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowStatus(testifyMock.Anything, "workflow-1").
+				Return(entity.LoanPackageWorkflowStatusRunning, nil)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowStatus(testifyMock.Anything, "workflow-2").
+				Return(entity.LoanPackageWorkflowStatusCompleted, nil)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowStatus(testifyMock.Anything, "workflow-3").
+				Return(entity.LoanPackageWorkflowStatusFailed, nil)
+			// the line never stored its workflow id, the workflow is looked up by the id it was started with
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowStatus(testifyMock.Anything, entity.LoanPackageCreationWorkflowId(4)).
+				Return(entity.LoanPackageWorkflowStatusNotFound, nil)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowResult(testifyMock.Anything, "workflow-2").Return(
+				entity.LoanPackageCreationResult{LoanPackageId: 100, LoanPackageAccountId: 200, LoanProductIdRef: 300}, nil,
+			)
+			m.repository.EXPECT().GetById(testifyMock.Anything, int64(2), testifyMock.Anything).Return(creatingLine(2, "workflow-2"), nil)
+			m.financialProduct.EXPECT().GetLoanPackageDetail(testifyMock.Anything, int64(100)).Return(
+				entity.FinancialProductLoanPackage{Id: 100, InitialRate: decimal.NewFromFloat(0.4)}, nil,
+			)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(offer, nil)
+			m.repository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(line entity.LoanPackageOfferInterest) bool {
+						return line.Id == 2 && line.LoanID == 100 &&
+							line.Status == entity.LoanPackageOfferInterestStatusLoanPackageCreated
+					},
+				),
+			).Return(entity.LoanPackageOfferInterest{}, nil)
+			m.contractRepository.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(contract entity.LoanContract) bool {
+						return contract.LoanOfferInterestId == 2 && contract.LoanId == 100 &&
+							contract.LoanPackageAccountId == 200 && contract.LoanProductIdRef == 300
+					},
+				),
+			).Return(entity.LoanContract{Id: 1}, nil)
+			m.symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(entity.Symbol{Id: 1, Symbol: "VIB"}, nil)
+			m.financialProduct.EXPECT().GetAllAccountDetail(testifyMock.Anything, "1").Return(nil, nil)
+			m.eventRepository.EXPECT().NotifyLoanPackageOfferReady(testifyMock.Anything, testifyMock.Anything).Return(nil)
+			m.repository.EXPECT().UpdateStatusFrom(
+				testifyMock.Anything, int64(3), entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+				entity.LoanPackageOfferInterestStatusPending,
+			).Return(true, nil)
+			m.repository.EXPECT().UpdateStatusFrom(
+				testifyMock.Anything, int64(4), entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+				entity.LoanPackageOfferInterestStatusPending,
+			).Return(false, nil)
+
+			reconciled, err := useCase.ReconcileCreatingLoanPackages(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 2, reconciled)
+		},
+	)
+
+	t.Run(
+		"ReconcileCreatingLoanPackages continues past a failed workflow lookup", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetCreatingUpdatedBefore(testifyMock.Anything, testifyMock.AnythingOfType("time.Time"), int64(100)).
+				Return([]entity.LoanPackageOfferInterest{creatingLine(1, "workflow-1"), creatingLine(2, "workflow-2")}, nil)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowStatus(testifyMock.Anything, "workflow-1").
+				Return("", assert.AnError)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowStatus(testifyMock.Anything, "workflow-2").
+				Return(entity.LoanPackageWorkflowStatusFailed, nil)
+			m.repository.EXPECT().UpdateStatusFrom(
+				testifyMock.Anything, int64(2), entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+				entity.LoanPackageOfferInterestStatusPending,
+			).Return(true, nil)
+
+			reconciled, err := useCase.ReconcileCreatingLoanPackages(context.Background())
+			assert.ErrorIs(t, err, assert.AnError)
+			assert.Equal(t, 1, reconciled)
+		},
+	)
+
+	t.Run(
+		"ReconcileCreatingLoanPackages keeps a completed line without a loan package creating", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			m.repository.EXPECT().GetCreatingUpdatedBefore(testifyMock.Anything, testifyMock.AnythingOfType("time.Time"), int64(100)).
+				Return([]entity.LoanPackageOfferInterest{creatingLine(1, "workflow-1")}, nil)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowStatus(testifyMock.Anything, "workflow-1").
+				Return(entity.LoanPackageWorkflowStatusCompleted, nil)
+			m.eventRepository.EXPECT().GetLoanPackageWorkflowResult(testifyMock.Anything, "workflow-1").
+				Return(entity.LoanPackageCreationResult{}, nil)
+
+			reconciled, err := useCase.ReconcileCreatingLoanPackages(context.Background())
+			assert.ErrorContains(t, err, "completed without a loan package")
+			assert.Equal(t, 0, reconciled)
+		},
+	)
+}
+
+func TestLoanPackageOfferInterestUseCase_HandleLoanPackageActivated(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		repository         *mock.MockLoanPackageOfferInterestRepository
+		offerRepository    *mock.MockLoanPackageOfferRepository
+		contractRepository *mock.MockLoanContractPersistenceRepository
+		financialProduct   *mock.MockFinancialProductRepository
+		eventRepository    *mock.MockLoanPackageOfferInterestEventRepository
+		symbolRepo         *mock.MockSymbolRepository
+	}
+	newUseCase := func(t *testing.T) (UseCase, mocks) {
+		m := mocks{
+			repository:         mock.NewMockLoanPackageOfferInterestRepository(t),
+			offerRepository:    mock.NewMockLoanPackageOfferRepository(t),
+			contractRepository: mock.NewMockLoanContractPersistenceRepository(t),
+			financialProduct:   mock.NewMockFinancialProductRepository(t),
+			eventRepository:    mock.NewMockLoanPackageOfferInterestEventRepository(t),
+			symbolRepo:         mock.NewMockSymbolRepository(t),
+		}
+		useCase := NewUseCase(
+			m.repository,
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+			m.offerRepository,
+			m.contractRepository,
+			m.financialProduct,
+			m.eventRepository,
+			mock.ErrReporter{},
+			m.symbolRepo,
+			mock.NewMockSubmissionSheetRepository(t),
+			mock.NewMockLoanPolicyTemplateRepository(t),
+			config.AppConfig{},
+			mock.NewMockLoanRequestLifecycleRepository(t),
+			&mock.WebhookEventRepository{},
+		)
+		return useCase, m
+	}
+	activated := entity.LoanPackageActivatedEvent{
+		LoanPackageOfferInterestId: 1,
+		LoanPackageId:              100,
+		LoanPackageAccountId:       200,
+		LoanProductIdRef:           300,
+	}
+	loanPackage := entity.FinancialProductLoanPackage{
+		Id:            100,
+		InitialRate:   decimal.NewFromFloat(0.4),
+		InterestRate:  decimal.NewFromFloat(0.12),
+		BuyingFeeRate: decimal.NewFromFloat(0.001),
+		Term:          180,
+	}
+
+	t.Run(
+		"HandleLoanPackageActivated creates the loan contract", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			creatingLine := entity.LoanPackageOfferInterest{
+				Id:                 1,
+				LoanPackageOfferId: 1,
+				Status:             entity.LoanPackageOfferInterestStatusCreatingLoanPackage,
+			}
+			m.repository.EXPECT().GetById(testifyMock.Anything, int64(1), testifyMock.Anything).Return(creatingLine, nil)
+			m.financialProduct.EXPECT().GetLoanPackageDetail(testifyMock.Anything, int64(100)).Return(loanPackage, nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(
+				entity.LoanPackageOffer{
+					Id:       1,
+					FlowType: entity.FlowTypeDnseOnline,
+					LoanPackageRequest: &entity.LoanPackageRequest{
+						Id: 1, SymbolId: 1, InvestorId: "1", AccountNo: "1", AssetType: entity.AssetTypeUnderlying,
+					},
+				}, nil,
+			)
+			m.repository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(line entity.LoanPackageOfferInterest) bool {
+						return line.Status == entity.LoanPackageOfferInterestStatusLoanPackageCreated &&
+							line.LoanID == 100 &&
+							line.LoanRate.Equal(decimal.NewFromFloat(0.6))
+					},
+				),
+			).Return(entity.LoanPackageOfferInterest{}, nil)
+			m.contractRepository.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(contract entity.LoanContract) bool {
+						return contract.LoanOfferInterestId == 1 &&
+							contract.LoanId == 100 &&
+							contract.LoanPackageAccountId == 200 &&
+							contract.LoanProductIdRef == 300
+					},
+				),
+			).Return(entity.LoanContract{Id: 1}, nil)
+			m.symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(entity.Symbol{Id: 1, Symbol: "VIB"}, nil)
+			m.financialProduct.EXPECT().GetAllAccountDetail(testifyMock.Anything, "1").Return(nil, nil)
+			m.eventRepository.EXPECT().NotifyLoanPackageOfferReady(testifyMock.Anything, testifyMock.Anything).Return(nil)
+
+			err := useCase.HandleLoanPackageActivated(context.Background(), activated)
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"HandleLoanPackageActivated delivered again returns the existing contract", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			createdLine := entity.LoanPackageOfferInterest{
+				Id:                 1,
+				LoanPackageOfferId: 1,
+				LoanID:             100,
+				Status:             entity.LoanPackageOfferInterestStatusLoanPackageCreated,
+			}
+			m.repository.EXPECT().GetById(testifyMock.Anything, int64(1), testifyMock.Anything).Return(createdLine, nil)
+			m.financialProduct.EXPECT().GetLoanPackageDetail(testifyMock.Anything, int64(100)).Return(loanPackage, nil)
+			m.contractRepository.EXPECT().GetByLoanOfferInterestId(testifyMock.Anything, int64(1)).
+				Return(entity.LoanContract{Id: 1, LoanOfferInterestId: 1, LoanId: 100}, nil)
+
+			err := useCase.HandleLoanPackageActivated(context.Background(), activated)
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"HandleLoanPackageActivated rejects a line in another status", func(t *testing.T) {
+			useCase, m := newUseCase(t)
+			cancelledLine := entity.LoanPackageOfferInterest{
+				Id:                 1,
+				LoanPackageOfferId: 1,
+				Status:             entity.LoanPackageOfferInterestStatusCancelled,
+			}
+			m.repository.EXPECT().GetById(testifyMock.Anything, int64(1), testifyMock.Anything).Return(cancelledLine, nil)
+			m.financialProduct.EXPECT().GetLoanPackageDetail(testifyMock.Anything, int64(100)).Return(loanPackage, nil)
+			m.offerRepository.EXPECT().FindByIdWithRequest(testifyMock.Anything, int64(1)).Return(
+				entity.LoanPackageOffer{
+					Id:                 1,
+					FlowType:           entity.FlowTypeDnseOnline,
+					LoanPackageRequest: &entity.LoanPackageRequest{Id: 1},
+				}, nil,
+			)
+
+			err := useCase.HandleLoanPackageActivated(context.Background(), activated)
+			assert.ErrorIs(t, err, apperrors.ErrInvalidLoanPackageOfferInterestStatus)
+		},
+	)
+}
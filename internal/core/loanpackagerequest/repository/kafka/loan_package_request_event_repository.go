@@ -30,7 +30,7 @@ func NewLoanPackageRequestEventPublisher(config config.KafkaConfig, publisher ev
 	}
 }
 
-func (p *LoanPackageRequestEventPublisher) NotifyOfflineConfirmation(_ context.Context, data entity.RequestOfflineConfirmation) error {
+func (p *LoanPackageRequestEventPublisher) NotifyOfflineConfirmation(ctx context.Context, data entity.RequestOfflineConfirmation) error {
 	payload := dnse.FinancingOfferOfflineConfirmation{
 		InvestorId:    data.InvestorId,
 		RequestName:   data.RequestName,
@@ -44,6 +44,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyOfflineConfirmation(_ context.C
 		return fmt.Errorf("LoanPackageRequestEventPublisher NotifyOfflineConfirmation %w", err)
 	}
 	if err := p.publisher.Publish(
+		ctx,
 		kafka.Message{
 			Topic: p.config.NotificationTopic,
 			Value: message,
@@ -55,7 +56,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyOfflineConfirmation(_ context.C
 	return nil
 }
 
-func (p *LoanPackageRequestEventPublisher) NotifyOnlineConfirmation(_ context.Context, data entity.RequestOnlineConfirmationNotify) error {
+func (p *LoanPackageRequestEventPublisher) NotifyOnlineConfirmation(ctx context.Context, data entity.RequestOnlineConfirmationNotify) error {
 	payload := dnse.FinancingOfferOnlineConfirmation{
 		InvestorId:      data.InvestorId,
 		RequestName:     data.RequestName,
@@ -73,6 +74,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyOnlineConfirmation(_ context.Co
 		return fmt.Errorf("LoanPackageRequestEventPublisher NotifyOnlineConfirmation %w", err)
 	}
 	if err := p.publisher.Publish(
+		ctx,
 		kafka.Message{
 			Topic: p.config.NotificationTopic,
 			Value: message,
@@ -84,7 +86,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyOnlineConfirmation(_ context.Co
 	return nil
 }
 
-func (p *LoanPackageRequestEventPublisher) NotifyRequestDeclined(_ context.Context, data entity.LoanPackageRequestDeclinedNotify) error {
+func (p *LoanPackageRequestEventPublisher) NotifyRequestDeclined(ctx context.Context, data entity.LoanPackageRequestDeclinedNotify) error {
 	payload := dnse.FinancingOfferRequestDeclined{
 		InvestorId:    data.InvestorId,
 		RequestName:   data.RequestName,
@@ -98,6 +100,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyRequestDeclined(_ context.Conte
 		return fmt.Errorf("LoanPackageRequestEventPublisher NotifyRequestDeclined %w", err)
 	}
 	if err := p.publisher.Publish(
+		ctx,
 		kafka.Message{
 			Topic: p.config.NotificationTopic,
 			Value: message,
@@ -109,7 +112,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyRequestDeclined(_ context.Conte
 	return nil
 }
 
-func (p *LoanPackageRequestEventPublisher) NotifyDerivativeRequestDeclined(_ context.Context, data entity.LoanPackageDerivativeRequestDeclinedNotify) error {
+func (p *LoanPackageRequestEventPublisher) NotifyDerivativeRequestDeclined(ctx context.Context, data entity.LoanPackageDerivativeRequestDeclinedNotify) error {
 	payload := dnse.FinancingOfferDerivativeRequestDeclined{
 		InvestorId:    data.InvestorId,
 		RequestName:   data.RequestName,
@@ -124,6 +127,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyDerivativeRequestDeclined(_ con
 		return fmt.Errorf("LoanPackageRequestEventPublisher NotifyDerivativeRequestDeclined %w", err)
 	}
 	if err := p.publisher.Publish(
+		ctx,
 		kafka.Message{
 			Topic: p.config.NotificationTopic,
 			Value: message,
@@ -135,7 +139,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyDerivativeRequestDeclined(_ con
 	return nil
 }
 
-func (p *LoanPackageRequestEventPublisher) NotifyDerivativeOfflineConfirmation(_ context.Context, data entity.DerivativeRequestOfflineConfirmation) error {
+func (p *LoanPackageRequestEventPublisher) NotifyDerivativeOfflineConfirmation(ctx context.Context, data entity.DerivativeRequestOfflineConfirmation) error {
 	payload := dnse.FinancingOfferDerivativeOfflineConfirmation{
 		InvestorId:    data.InvestorId,
 		RequestName:   data.RequestName,
@@ -150,6 +154,7 @@ func (p *LoanPackageRequestEventPublisher) NotifyDerivativeOfflineConfirmation(_
 		return fmt.Errorf("LoanPackageRequestEventPublisher NotifyDerivativeOfflineConfirmation %w", err)
 	}
 	if err := p.publisher.Publish(
+		ctx,
 		kafka.Message{
 			Topic: p.config.NotificationTopic,
 			Value: message,
@@ -3,8 +3,6 @@ package repository
 import (
 	"context"
 
-	"github.com/shopspring/decimal"
-
 	"financing-offer/internal/core/entity"
 	"financing-offer/pkg/querymod"
 )
@@ -18,10 +16,12 @@ type LoanPackageRequestRepository interface {
 	Update(ctx context.Context, loanPackageRequest entity.LoanPackageRequest) (entity.LoanPackageRequest, error)
 	Delete(ctx context.Context, id int64) error
 	SaveLoggedRequest(ctx context.Context, request entity.LoggedRequest) (entity.LoggedRequest, error)
-	LockAllPendingRequestByMaxPercent(ctx context.Context, maximumLoanRate decimal.Decimal) ([]entity.LoanPackageRequest, error)
+	GetAllPendingDeclineCandidates(ctx context.Context, opts ...querymod.GetOption) ([]entity.LoanRequestDeclineCandidate, error)
 	UpdateStatusByLoanRequestIds(ctx context.Context, loanRequestIds []int64, status entity.LoanPackageRequestStatus) ([]entity.LoanPackageRequest, error)
 	LockAndReturnAllPendingRequestBySymbolId(ctx context.Context, symbolId int64) ([]entity.LoanPackageRequest, error)
 	UpdateStatusById(ctx context.Context, id int64, status entity.LoanPackageRequestStatus) (entity.LoanPackageRequest, error)
+	CreateStatusHistories(ctx context.Context, histories []entity.LoanPackageRequestStatusHistory) error
+	GetStatusHistories(ctx context.Context, loanPackageRequestId int64) ([]entity.LoanPackageRequestStatusHistory, error)
 }
 
 type LoanPackageRequestEventRepository interface {
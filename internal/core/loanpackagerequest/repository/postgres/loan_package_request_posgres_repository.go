@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"github.com/go-jet/jet/v2/postgres"
 	"github.com/go-jet/jet/v2/qrm"
-	"github.com/shopspring/decimal"
 
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/loanpackagerequest/repository"
@@ -175,28 +174,34 @@ func (r *LoanPackageRequestPostgresRepository) Delete(ctx context.Context, id in
 	return nil
 }
 
-func (r *LoanPackageRequestPostgresRepository) LockAllPendingRequestByMaxPercent(
+// GetAllPendingDeclineCandidates returns the pending requests with the stock exchange of their symbol,
+// WithLock locks the requests only and skips the ones another transaction holds
+func (r *LoanPackageRequestPostgresRepository) GetAllPendingDeclineCandidates(
 	ctx context.Context,
-	maximumLoanRate decimal.Decimal,
-) ([]entity.LoanPackageRequest, error) {
-	dest := make([]model.LoanPackageRequest, 0)
-	if err := table.LoanPackageRequest.
-		SELECT(table.LoanPackageRequest.AllColumns).
-		WHERE(
-			table.LoanPackageRequest.LoanRate.
-				GT_EQ(postgres.Decimal(maximumLoanRate.String())).
-				AND(
-					table.LoanPackageRequest.Status.
-						EQ(postgres.String(entity.LoanPackageRequestStatusPending.String())),
-				),
+	opts ...querymod.GetOption,
+) ([]entity.LoanRequestDeclineCandidate, error) {
+	getQm := querymod.GetQm{}
+	for _, opt := range opts {
+		opt(&getQm)
+	}
+	dest := make([]DeclineCandidate, 0)
+	stockExchangeCode := postgres.SELECT(table.StockExchange.Code).
+		FROM(table.Symbol.INNER_JOIN(table.StockExchange, table.StockExchange.ID.EQ(table.Symbol.StockExchangeID))).
+		WHERE(table.Symbol.ID.EQ(table.LoanPackageRequest.SymbolID))
+	stm := table.LoanPackageRequest.
+		SELECT(
+			table.LoanPackageRequest.AllColumns,
+			stockExchangeCode.AS("decline_candidate.stock_exchange_code"),
 		).
-		FOR(postgres.UPDATE().SKIP_LOCKED()).
-		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
-		return []entity.LoanPackageRequest{}, fmt.Errorf(
-			"LoanPackageRequestPostgresRepository LockAllPendingRequestByMaxPercent: %w", err,
-		)
+		WHERE(table.LoanPackageRequest.Status.EQ(postgres.String(entity.LoanPackageRequestStatusPending.String()))).
+		ORDER_BY(table.LoanPackageRequest.ID)
+	if getQm.ForUpdate {
+		stm = stm.FOR(postgres.UPDATE().SKIP_LOCKED())
 	}
-	return MapLoanPackageRequestsDbToEntity(dest), nil
+	if err := stm.QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return nil, fmt.Errorf("LoanPackageRequestPostgresRepository GetAllPendingDeclineCandidates: %w", err)
+	}
+	return MapDeclineCandidatesDbToEntity(dest), nil
 }
 
 func (r *LoanPackageRequestPostgresRepository) SaveLoggedRequest(ctx context.Context, request entity.LoggedRequest) (entity.LoggedRequest, error) {
@@ -256,6 +261,31 @@ func (r *LoanPackageRequestPostgresRepository) UpdateStatusById(ctx context.Cont
 	return MapLoanPackageRequestDbToEntity(updated), nil
 }
 
+func (r *LoanPackageRequestPostgresRepository) CreateStatusHistories(ctx context.Context, histories []entity.LoanPackageRequestStatusHistory) error {
+	if len(histories) == 0 {
+		return nil
+	}
+	if _, err := table.LoanPackageRequestStatusHistory.
+		INSERT(table.LoanPackageRequestStatusHistory.MutableColumns).
+		MODELS(MapLoanPackageRequestStatusHistoriesEntityToDb(histories)).
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("LoanPackageRequestPostgresRepository CreateStatusHistories: %w", err)
+	}
+	return nil
+}
+
+func (r *LoanPackageRequestPostgresRepository) GetStatusHistories(ctx context.Context, loanPackageRequestId int64) ([]entity.LoanPackageRequestStatusHistory, error) {
+	dest := make([]model.LoanPackageRequestStatusHistory, 0)
+	if err := table.LoanPackageRequestStatusHistory.
+		SELECT(table.LoanPackageRequestStatusHistory.AllColumns).
+		WHERE(table.LoanPackageRequestStatusHistory.LoanPackageRequestID.EQ(postgres.Int64(loanPackageRequestId))).
+		ORDER_BY(table.LoanPackageRequestStatusHistory.ID.ASC()).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return nil, fmt.Errorf("LoanPackageRequestPostgresRepository GetStatusHistories: %w", err)
+	}
+	return MapLoanPackageRequestStatusHistoriesDbToEntity(dest), nil
+}
+
 func NewLoanPackageRequestPostgresRepository(getDbFunc database.GetDbFunc) *LoanPackageRequestPostgresRepository {
 	return &LoanPackageRequestPostgresRepository{
 		getDbFunc: getDbFunc,
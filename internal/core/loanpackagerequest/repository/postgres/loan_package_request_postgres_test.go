@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
 	"financing-offer/pkg/dbtest"
+	"financing-offer/pkg/querymod"
 )
 
 func TestLoanPackageRequestPostgres(t *testing.T) {
@@ -25,7 +30,7 @@ func TestLoanPackageRequestPostgres(t *testing.T) {
 		},
 	)
 
-	t.Run("LockByInvestorAndSymbolSuccess", func(t *testing.T) {
+	t.Run("GetAllPendingDeclineCandidatesSuccess", func(t *testing.T) {
 		e := entity.LoanPackageRequest{
 			Id:          1,
 			SymbolId:    1,
@@ -36,7 +41,7 @@ func TestLoanPackageRequestPostgres(t *testing.T) {
 			Type:        entity.LoanPackageRequestTypeFlexible,
 			Status:      entity.LoanPackageRequestStatusPending,
 		}
-		mock.ExpectQuery("SELECT").WillReturnRows(
+		mock.ExpectQuery("SELECT .* FROM public.loan_package_request .* FOR UPDATE SKIP LOCKED").WillReturnRows(
 			mock.NewRows(
 				[]string{
 					"loan_package_request.id",
@@ -44,23 +49,23 @@ func TestLoanPackageRequestPostgres(t *testing.T) {
 					"loan_package_request.investor_id",
 					"loan_package_request.account_no",
 					"loan_package_request.loan_rate",
-					"loan_package_request.limitAmount",
+					"loan_package_request.limit_amount",
 					"loan_package_request.type",
 					"loan_package_request.status",
-					"loan_package_request.updated_at",
-					"loan_package_request.update_at",
-				}).AddRow(e.Id, e.SymbolId, e.InvestorId, e.AccountNo, e.LoanRate, e.LimitAmount, e.Type, e.Status, e.CreatedAt, e.UpdatedAt),
+					"decline_candidate.stock_exchange_code",
+				}).AddRow(e.Id, e.SymbolId, e.InvestorId, e.AccountNo, e.LoanRate, e.LimitAmount, e.Type, e.Status, "HOSE"),
 		)
-		requests, err := repo.LockAllPendingRequestByMaxPercent(context.Background(), decimal.NewFromFloat(0.3))
+		candidates, err := repo.GetAllPendingDeclineCandidates(context.Background(), querymod.WithLock())
 		assert.Nil(t, err)
-		assert.Equal(t, int64(1), requests[0].Id)
-		assert.Equal(t, decimal.NewFromFloat(0.3), requests[0].LoanRate)
+		assert.Equal(t, int64(1), candidates[0].Id)
+		assert.Equal(t, decimal.NewFromFloat(0.3), candidates[0].LoanRate)
+		assert.Equal(t, "HOSE", candidates[0].StockExchangeCode)
 	})
 
-	t.Run("LockByInvestorAndSymbolFailure", func(t *testing.T) {
+	t.Run("GetAllPendingDeclineCandidatesFailure", func(t *testing.T) {
 		mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("test"))
-		_, err := repo.LockAllPendingRequestByMaxPercent(context.Background(), decimal.NewFromFloat(0.3))
-		assert.Equal(t, "LoanPackageRequestPostgresRepository LockAllPendingRequestByMaxPercent: jet: test", err.Error())
+		_, err := repo.GetAllPendingDeclineCandidates(context.Background())
+		assert.Equal(t, "LoanPackageRequestPostgresRepository GetAllPendingDeclineCandidates: jet: test", err.Error())
 	})
 
 	t.Run("UpdateStatusByLoanRequestIdsSuccess", func(t *testing.T) {
@@ -140,4 +145,94 @@ func TestLoanPackageRequestPostgres(t *testing.T) {
 		_, err := repo.LockAndReturnAllPendingRequestBySymbolId(context.Background(), 123)
 		assert.ErrorIs(t, err, assert.AnError)
 	})
+
+	t.Run("CreateStatusHistories success", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO public.loan_package_request_status_history").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		err := repo.CreateStatusHistories(
+			context.Background(), []entity.LoanPackageRequestStatusHistory{
+				{
+					LoanPackageRequestId: 1,
+					ToStatus:             entity.LoanPackageRequestStatusPending,
+					Actor:                "0001000115",
+					Reason:               entity.LoanPackageRequestStatusReasonInvestorRequest,
+				},
+				{
+					LoanPackageRequestId: 1,
+					FromStatus:           entity.LoanPackageRequestStatusPending,
+					ToStatus:             entity.LoanPackageRequestStatusDeclined,
+					Actor:                "admin",
+					Reason:               entity.LoanPackageRequestStatusReasonAdminDeclined,
+				},
+			},
+		)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateStatusHistories empty", func(t *testing.T) {
+		err := repo.CreateStatusHistories(context.Background(), nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateStatusHistories failure", func(t *testing.T) {
+		mock.ExpectExec("INSERT").WillReturnError(fmt.Errorf("error"))
+		err := repo.CreateStatusHistories(
+			context.Background(), []entity.LoanPackageRequestStatusHistory{{LoanPackageRequestId: 1}},
+		)
+		assert.Equal(t, "LoanPackageRequestPostgresRepository CreateStatusHistories: error", err.Error())
+	})
+
+	t.Run("GetStatusHistories success", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)SELECT .+ FROM public.loan_package_request_status_history .+ORDER BY`).
+			WillReturnRows(
+				mock.NewRows(
+					[]string{
+						"loan_package_request_status_history.id",
+						"loan_package_request_status_history.loan_package_request_id",
+						"loan_package_request_status_history.from_status",
+						"loan_package_request_status_history.to_status",
+						"loan_package_request_status_history.actor",
+						"loan_package_request_status_history.reason",
+						"loan_package_request_status_history.created_at",
+					},
+				).
+					AddRow(1, 1, nil, "PENDING", "0001000115", "INVESTOR_REQUEST", now).
+					AddRow(2, 1, "PENDING", "CANCELLED", "admin", "SYMBOL_CANCELLED", now),
+			)
+		histories, err := repo.GetStatusHistories(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(histories))
+		assert.Equal(t, entity.LoanPackageRequestStatus(""), histories[0].FromStatus)
+		assert.Equal(t, entity.LoanPackageRequestStatusPending, histories[1].FromStatus)
+		assert.Equal(t, entity.LoanPackageRequestStatusCancelled, histories[1].ToStatus)
+	})
+
+	t.Run("GetStatusHistories failure", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.GetStatusHistories(context.Background(), 1)
+		assert.Equal(t, "LoanPackageRequestPostgresRepository GetStatusHistories: jet: error", err.Error())
+	})
+}
+
+func TestApplyFilter(t *testing.T) {
+	t.Parallel()
+	confirmed := []entity.LoanPackageRequestStatus{entity.LoanPackageRequestStatusConfirmed}
+	assertMatchesProcessedRequests := func(t *testing.T, where postgres.BoolExpression) {
+		sql := postgres.SELECT(table.LoanPackageRequest.ID).FROM(table.LoanPackageRequest).WHERE(where).DebugSql()
+		for _, status := range []string{"CONFIRMED", "DECLINED", "CANCELLED", "EXPIRED"} {
+			assert.Contains(t, sql, "'"+status+"'")
+		}
+		assert.NotContains(t, sql, "'PENDING'")
+	}
+
+	t.Run("confirmed requests include the declined ones", func(t *testing.T) {
+		assertMatchesProcessedRequests(t, ApplyFilter(entity.LoanPackageFilter{Statuses: confirmed}))
+	})
+
+	t.Run("confirmed underlying requests include the declined ones", func(t *testing.T) {
+		assertMatchesProcessedRequests(
+			t, ApplyUnderlyingFilter(entity.UnderlyingLoanPackageFilter{Statuses: confirmed}, nil),
+		)
+	})
 }
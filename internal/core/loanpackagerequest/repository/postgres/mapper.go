@@ -110,7 +110,9 @@ func ApplyFilter(filter entity.LoanPackageFilter) postgres.BoolExpression {
 	if len(filter.Statuses) > 0 {
 		statuses := make([]string, len(filter.Statuses))
 		for _, t := range filter.Statuses {
-			statuses = append(statuses, t.String())
+			for _, status := range t.MatchingStatuses() {
+				statuses = append(statuses, status.String())
+			}
 		}
 		expr = expr.AND(table.LoanPackageRequest.Status.IN(querymod.In(statuses)...))
 	}
@@ -195,3 +197,68 @@ func MapLoggedRequestEntityToDb(loggedRequest entity.LoggedRequest) model.Logged
 		CreatedAt:  loggedRequest.CreatedAt,
 	}
 }
+
+func MapLoanPackageRequestStatusHistoriesEntityToDb(histories []entity.LoanPackageRequestStatusHistory) []model.LoanPackageRequestStatusHistory {
+	dest := make([]model.LoanPackageRequestStatusHistory, 0, len(histories))
+	for _, history := range histories {
+		dest = append(dest, MapLoanPackageRequestStatusHistoryEntityToDb(history))
+	}
+	return dest
+}
+
+func MapLoanPackageRequestStatusHistoryEntityToDb(history entity.LoanPackageRequestStatusHistory) model.LoanPackageRequestStatusHistory {
+	res := model.LoanPackageRequestStatusHistory{
+		ID:                   history.Id,
+		LoanPackageRequestID: history.LoanPackageRequestId,
+		ToStatus:             history.ToStatus.String(),
+		Actor:                history.Actor,
+		Reason:               history.Reason,
+		CreatedAt:            history.CreatedAt,
+	}
+	if history.FromStatus != "" {
+		fromStatus := history.FromStatus.String()
+		res.FromStatus = &fromStatus
+	}
+	return res
+}
+
+func MapLoanPackageRequestStatusHistoriesDbToEntity(histories []model.LoanPackageRequestStatusHistory) []entity.LoanPackageRequestStatusHistory {
+	dest := make([]entity.LoanPackageRequestStatusHistory, 0, len(histories))
+	for _, history := range histories {
+		dest = append(dest, MapLoanPackageRequestStatusHistoryDbToEntity(history))
+	}
+	return dest
+}
+
+func MapLoanPackageRequestStatusHistoryDbToEntity(history model.LoanPackageRequestStatusHistory) entity.LoanPackageRequestStatusHistory {
+	res := entity.LoanPackageRequestStatusHistory{
+		Id:                   history.ID,
+		LoanPackageRequestId: history.LoanPackageRequestID,
+		ToStatus:             entity.LoanPackageRequestStatusFromString(history.ToStatus),
+		Actor:                history.Actor,
+		Reason:               history.Reason,
+		CreatedAt:            history.CreatedAt,
+	}
+	if history.FromStatus != nil {
+		res.FromStatus = entity.LoanPackageRequestStatusFromString(*history.FromStatus)
+	}
+	return res
+}
+
+type DeclineCandidate struct {
+	model.LoanPackageRequest
+	StockExchangeCode string `alias:"decline_candidate.stock_exchange_code"`
+}
+
+func MapDeclineCandidatesDbToEntity(candidates []DeclineCandidate) []entity.LoanRequestDeclineCandidate {
+	dest := make([]entity.LoanRequestDeclineCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		dest = append(
+			dest, entity.LoanRequestDeclineCandidate{
+				LoanPackageRequest: MapLoanPackageRequestDbToEntity(candidate.LoanPackageRequest),
+				StockExchangeCode:  candidate.StockExchangeCode,
+			},
+		)
+	}
+	return dest
+}
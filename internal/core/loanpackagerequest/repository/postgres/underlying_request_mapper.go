@@ -61,7 +61,9 @@ func ApplyUnderlyingFilter(filter entity.UnderlyingLoanPackageFilter, otherExpre
 	if len(filter.Statuses) > 0 {
 		statuses := make([]string, len(filter.Statuses))
 		for _, t := range filter.Statuses {
-			statuses = append(statuses, t.String())
+			for _, status := range t.MatchingStatuses() {
+				statuses = append(statuses, status.String())
+			}
 		}
 		expr = expr.AND(table.LoanPackageRequest.Status.IN(querymod.In(statuses)...))
 	}
@@ -136,6 +136,33 @@ func (h *LoanPackageRequestHandler) AdminGetById(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.LoanPackageRequest]{Data: res})
 }
 
+// AdminGetStatusHistories godoc
+//
+//	@Summary		Get loan package request status history
+//	@Description	Get every status transition of a loan package request (admin)
+//	@Tags			loan package request,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int64	true	"id"
+//	@Success		200	{object}	handler.BaseResponse[[]entity.LoanPackageRequestStatusHistory]
+//	@Success		400	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/loan-package-requests/{id}/history [get]
+func (h *LoanPackageRequestHandler) AdminGetStatusHistories(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	res, err := h.useCase.GetStatusHistories(ctx, id)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[[]entity.LoanPackageRequestStatusHistory]{Data: res})
+}
+
 // AdminConfirmUserRequest godoc
 //
 //	@Summary		Admin confirm user request
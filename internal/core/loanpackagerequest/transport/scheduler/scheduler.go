@@ -30,17 +30,28 @@ func NewLoanRequestScheduler(
 	}
 }
 
-func (s *LoanRequestScheduler) DeclineLoanRequests() {
-	ctx := context.Background()
+func (s *LoanRequestScheduler) DeclineLoanRequests(ctx context.Context) error {
+	// the config in effect is the latest one whose affectedFrom has passed, so a future config activates by itself
 	loanRequestSchedulerConfig, err := s.schedulerUseCase.GetCurrentLoanRequestSchedulerConfig(ctx)
-	err = s.useCase.SystemDeclineRiskLoanRequests(ctx, loanRequestSchedulerConfig.MaximumLoanRate)
+	if err != nil {
+		// declining against a zero config would decline every pending request
+		s.logger.Error("DeclineLoanRequests GetCurrentLoanRequestSchedulerConfig", slog.String("error", err.Error()))
+		s.notifyError(ctx, err)
+		return err
+	}
+	err = s.useCase.SystemDeclineRiskLoanRequests(ctx, loanRequestSchedulerConfig)
 	if err != nil {
 		s.logger.Error("DeclineLoanRequests", slog.String("error", err.Error()))
 		s.notifyError(ctx, err)
 	}
+	return err
 }
 
+// notifyError notifies the failure of the final attempt only, the earlier ones are retried by the runner
 func (s *LoanRequestScheduler) notifyError(ctx context.Context, err error) {
+	if !scheduler.FinalAttempt(ctx) {
+		return
+	}
 	err = s.errorService.NotifyError(ctx, err)
 	if err != nil {
 		s.logger.Error("DeclineLoanRequests NotifyError", slog.String("error", err.Error()))
@@ -19,8 +19,8 @@ import (
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
 	financialProductRepo "financing-offer/internal/core/financialproduct/repository"
-	financingRepo "financing-offer/internal/core/financing/repository"
 	investorRepo "financing-offer/internal/core/investor/repository"
+	lifecycleRepo "financing-offer/internal/core/lifecycle/repository"
 	loanContractRepo "financing-offer/internal/core/loancontract/repository"
 	loanPackageOfferRepo "financing-offer/internal/core/loanoffer/repository"
 	loanPackageOfferInterestRepo "financing-offer/internal/core/loanofferinterest/repository"
@@ -31,6 +31,8 @@ import (
 	scoreGroupInterestRepo "financing-offer/internal/core/scoregroupinterest/repository"
 	submissionSheetRepo "financing-offer/internal/core/submissionsheet/repository"
 	symbolRepo "financing-offer/internal/core/symbol/repository"
+	"financing-offer/internal/core/tradingcalendar"
+	webhookRepo "financing-offer/internal/core/webhook/repository"
 	"financing-offer/internal/funcs"
 	"financing-offer/pkg/optional"
 	"financing-offer/pkg/querymod"
@@ -41,15 +43,23 @@ type UseCase interface {
 	GetAllUnderlyingRequests(ctx context.Context, filter entity.UnderlyingLoanPackageFilter) ([]entity.UnderlyingLoanPackageRequest, error)
 	InvestorGetAll(ctx context.Context, filter entity.LoanPackageFilter) ([]entity.LoanPackageRequest, error)
 	GetById(ctx context.Context, id int64, filter entity.LoanPackageFilter) (entity.LoanPackageRequest, error)
+	GetStatusHistories(ctx context.Context, id int64) ([]entity.LoanPackageRequestStatusHistory, error)
 	InvestorRequest(ctx context.Context, loanPackageRequest entity.LoanPackageRequest, investor entity.Investor) (entity.LoanPackageRequest, error)
 	InvestorRequestDerivative(ctx context.Context, loanPackageRequest entity.LoanPackageRequest) (entity.LoanPackageRequest, error)
+	AdminCreateRequests(
+		ctx context.Context,
+		loanPackageRequests []entity.LoanPackageRequest,
+		creator string,
+		afterCreate func(ctx context.Context, created []entity.LoanPackageRequest) error,
+	) ([]entity.LoanPackageRequest, error)
 	Update(ctx context.Context, loanPackageRequest entity.LoanPackageRequest) (entity.LoanPackageRequest, error)
 	Delete(ctx context.Context, id int64) error
 	AdminConfirmLoanRequest(ctx context.Context, id int64, creator string, loanId int64) (entity.LoanPackageRequest, error)
 	AdminCancelLoanRequest(ctx context.Context, id int64, creator string, loanIds []int64) (entity.LoanPackageRequest, error)
 	AdminSubmitSubmission(ctx context.Context, submissionSheetRequest entity.SubmissionSheetShorten) (entity.LoanPackageRequest, error)
 	SaveExistedLoanRateRequest(ctx context.Context, investorId string, loanPackageRequest entity.LoanPackageRequest) (entity.LoggedRequest, error)
-	SystemDeclineRiskLoanRequests(ctx context.Context, maximumLoanRate decimal.Decimal) error
+	SystemDeclineRiskLoanRequests(ctx context.Context, config entity.LoanRequestSchedulerConfig) error
+	PreviewDeclineRiskLoanRequests(ctx context.Context, config entity.LoanRequestSchedulerConfig) ([]entity.LoanRequestDecline, error)
 	CancelAllLoanPackageRequestBySymbolId(ctx context.Context, symbolId int64, creator string) ([]entity.LoanPackageRequest, error)
 }
 
@@ -65,7 +75,7 @@ type loanPackageRequestUseCase struct {
 	financialProductRepository         financialProductRepo.FinancialProductRepository
 	appConfig                          config.AppConfig
 	logger                             *slog.Logger
-	financingRepository                financingRepo.FinancingRepository
+	tradingCalendar                    tradingcalendar.Calendar
 	schedulerJobRepository             schedulerRepo.SchedulerJobRepository
 	errorService                       apperrors.Service
 	investorRepository                 investorRepo.InvestorPersistenceRepository
@@ -74,6 +84,9 @@ type loanPackageRequestUseCase struct {
 	marginOperationRepository          marginOperationRepo.MarginOperationRepository
 	configurationPersistenceRepo       configRepo.ConfigurationPersistenceRepository
 	odooServiceRepository              odooServiceRepo.OdooServiceRepository
+	odooLoanApprovalRepository         odooServiceRepo.OdooLoanApprovalRepository
+	lifecycleRepository                lifecycleRepo.LoanRequestLifecycleRepository
+	webhookEventRepository             webhookRepo.WebhookEventRepository
 }
 
 func (u *loanPackageRequestUseCase) InvestorGetAll(ctx context.Context, filter entity.LoanPackageFilter) ([]entity.LoanPackageRequest, error) {
@@ -129,6 +142,42 @@ func (u *loanPackageRequestUseCase) GetById(ctx context.Context, id int64, filte
 	return res, nil
 }
 
+func (u *loanPackageRequestUseCase) GetStatusHistories(ctx context.Context, id int64) ([]entity.LoanPackageRequestStatusHistory, error) {
+	errorTemplate := "loanPackageRequestUseCase GetStatusHistories %w"
+	if _, err := u.repository.GetById(ctx, id, entity.LoanPackageFilter{}); err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	histories, err := u.repository.GetStatusHistories(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	return histories, nil
+}
+
+// transitionStatus rejects moves the request state machine does not allow and records the allowed ones
+func (u *loanPackageRequestUseCase) transitionStatus(
+	ctx context.Context,
+	request entity.LoanPackageRequest,
+	status entity.LoanPackageRequestStatus,
+	actor string,
+	reason string,
+) error {
+	if !request.Status.CanTransitionTo(status) {
+		return apperrors.ErrInvalidRequestStatusTransition(request.Status, status)
+	}
+	return u.repository.CreateStatusHistories(
+		ctx, []entity.LoanPackageRequestStatusHistory{
+			{
+				LoanPackageRequestId: request.Id,
+				FromStatus:           request.Status,
+				ToStatus:             status,
+				Actor:                actor,
+				Reason:               reason,
+			},
+		},
+	)
+}
+
 func (u *loanPackageRequestUseCase) InvestorRequest(ctx context.Context, loanPackageRequest entity.LoanPackageRequest, investor entity.Investor) (entity.LoanPackageRequest, error) {
 	errorTemplate := "loanPackageRequestUseCase InvestorRequest %w"
 	if err := u.verifyAccountNumber(ctx, loanPackageRequest.InvestorId, loanPackageRequest.AccountNo); err != nil {
@@ -142,7 +191,7 @@ func (u *loanPackageRequestUseCase) InvestorRequest(ctx context.Context, loanPac
 	if err := u.investorRepository.CreateIfNotExist(ctx, investor); err != nil {
 		return entity.LoanPackageRequest{}, fmt.Errorf(errorTemplate, err)
 	}
-	res, err := u.repository.Create(ctx, loanPackageRequest)
+	res, err := u.createRequest(ctx, loanPackageRequest)
 	if err != nil {
 		return res, fmt.Errorf(errorTemplate, err)
 	}
@@ -163,13 +212,107 @@ func (u *loanPackageRequestUseCase) InvestorRequestDerivative(ctx context.Contex
 			errorTemplate, apperrors.ErrMismatchAssetType,
 		)
 	}
-	res, err := u.repository.Create(ctx, loanPackageRequest)
+	res, err := u.createRequest(ctx, loanPackageRequest)
 	if err != nil {
 		return res, fmt.Errorf(errorTemplate, err)
 	}
 	return res, nil
 }
 
+// AdminCreateRequests creates underlying requests on behalf of an investor, like the ones converted from a suggested offer.
+// afterCreate runs in the same transaction so the caller can link the created requests to its own records
+func (u *loanPackageRequestUseCase) AdminCreateRequests(
+	ctx context.Context,
+	loanPackageRequests []entity.LoanPackageRequest,
+	creator string,
+	afterCreate func(ctx context.Context, created []entity.LoanPackageRequest) error,
+) ([]entity.LoanPackageRequest, error) {
+	errorTemplate := "loanPackageRequestUseCase AdminCreateRequests %w"
+	verifiedAccounts := make(map[string]bool)
+	for _, loanPackageRequest := range loanPackageRequests {
+		if loanPackageRequest.Type == entity.LoanPackageRequestTypeGuaranteed &&
+			loanPackageRequest.GuaranteedDuration > u.appConfig.LoanRequest.MaxGuaranteedDuration {
+			return nil, apperrors.ErrInvalidGuaranteedDuration
+		}
+		accountKey := loanPackageRequest.InvestorId + "/" + loanPackageRequest.AccountNo
+		if verifiedAccounts[accountKey] {
+			continue
+		}
+		if err := u.verifyAccountNumber(ctx, loanPackageRequest.InvestorId, loanPackageRequest.AccountNo); err != nil {
+			return nil, fmt.Errorf(errorTemplate, err)
+		}
+		verifiedAccounts[accountKey] = true
+	}
+	res, err := u.createRequests(
+		ctx, loanPackageRequests, creator, entity.LoanPackageRequestStatusReasonSuggestedOffer, afterCreate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+// createRequest persists a new request together with its initial PENDING history entry
+func (u *loanPackageRequestUseCase) createRequest(ctx context.Context, loanPackageRequest entity.LoanPackageRequest) (entity.LoanPackageRequest, error) {
+	res, err := u.createRequests(
+		ctx, []entity.LoanPackageRequest{loanPackageRequest}, loanPackageRequest.InvestorId,
+		entity.LoanPackageRequestStatusReasonInvestorRequest, nil,
+	)
+	if err != nil {
+		return entity.LoanPackageRequest{}, err
+	}
+	return res[0], nil
+}
+
+// createRequests persists the requests with their initial history entries in one transaction
+// and wakes their lifecycle workflows up once it is committed
+func (u *loanPackageRequestUseCase) createRequests(
+	ctx context.Context,
+	loanPackageRequests []entity.LoanPackageRequest,
+	actor string,
+	reason string,
+	afterCreate func(ctx context.Context, created []entity.LoanPackageRequest) error,
+) ([]entity.LoanPackageRequest, error) {
+	res := make([]entity.LoanPackageRequest, 0, len(loanPackageRequests))
+	txErr := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			for _, loanPackageRequest := range loanPackageRequests {
+				created, err := u.repository.Create(tc, loanPackageRequest)
+				if err != nil {
+					return err
+				}
+				res = append(res, created)
+				if err := u.repository.CreateStatusHistories(
+					tc, []entity.LoanPackageRequestStatusHistory{
+						{
+							LoanPackageRequestId: created.Id,
+							ToStatus:             created.Status,
+							Actor:                actor,
+							Reason:               reason,
+						},
+					},
+				); err != nil {
+					return err
+				}
+				if err := u.webhookEventRepository.Publish(tc, entity.WebhookEventTypeRequestCreated, created); err != nil {
+					return err
+				}
+			}
+			if afterCreate != nil {
+				return afterCreate(tc, res)
+			}
+			return nil
+		},
+	)
+	if txErr != nil {
+		return nil, txErr
+	}
+	for _, created := range res {
+		u.notifyLifecycle(ctx, created.Id)
+	}
+	return res, nil
+}
+
 func (u *loanPackageRequestUseCase) verifyAccountNumber(ctx context.Context, investorId, accountNo string) error {
 	accounts, err := u.financialProductRepository.GetAllAccountDetail(ctx, investorId)
 	if err != nil {
@@ -226,13 +369,23 @@ func (u *loanPackageRequestUseCase) AdminConfirmLoanRequest(ctx context.Context,
 		return entity.LoanPackageRequest{}, fmt.Errorf(errorTemplate, err)
 	}
 
-	offerExpireTime, err := u.financingRepository.GetDateAfter(time.Now(), u.appConfig.LoanRequest.ExpireDays)
+	offerExpireTime, err := tradingcalendar.AddTradingDaysForSymbol(
+		ctx, u.tradingCalendar, request.SymbolId, time.Now(), u.appConfig.LoanRequest.ExpireDays,
+	)
 	if err != nil {
 		return entity.LoanPackageRequest{}, fmt.Errorf(errorTemplate, err)
 	}
+	accountNoDesc := u.getAccountNoDesc(ctx, request)
 
 	txErr := u.atomicExecutor.Execute(
 		ctx, func(tc context.Context) error {
+			err = u.transitionStatus(
+				tc, request, entity.LoanPackageRequestStatusConfirmed, creator,
+				entity.LoanPackageRequestStatusReasonAdminConfirmed,
+			)
+			if err != nil {
+				return err
+			}
 			request, err = u.repository.UpdateStatusById(tc, request.Id, entity.LoanPackageRequestStatusConfirmed)
 			if err != nil {
 				return err
@@ -275,27 +428,16 @@ func (u *loanPackageRequestUseCase) AdminConfirmLoanRequest(ctx context.Context,
 			if err != nil {
 				return err
 			}
-			return nil
+			if flowType == entity.FlowTypeDnseOnline {
+				return u.notifyRequestOnlineConfirmation(tc, request, accountNoDesc, offerInterest.Id, offer.Id)
+			}
+			return u.notifyRequestOfflineConfirmation(tc, request, accountNoDesc)
 		},
 	)
 	if txErr != nil {
 		return entity.LoanPackageRequest{}, fmt.Errorf(errorTemplate, txErr)
 	}
-	if flowType == entity.FlowTypeDnseOnline {
-		u.errorService.Go(
-			ctx, func() error {
-				return u.notifyRequestOnlineConfirmation(
-					atomicity.WithIgnoreTx(ctx), request, offerInterest.Id, offer.Id,
-				)
-			},
-		)
-	} else {
-		u.errorService.Go(
-			ctx, func() error {
-				return u.notifyRequestOfflineConfirmation(atomicity.WithIgnoreTx(ctx), request)
-			},
-		)
-	}
+	u.notifyLifecycle(ctx, request.Id)
 	return request, nil
 }
 
@@ -332,33 +474,20 @@ func (u *loanPackageRequestUseCase) AdminSubmitSubmission(ctx context.Context, s
 			if err != nil {
 				return err
 			}
-			var formattedSource string
-			for index, loanPolicy := range submissionSheet.Detail.LoanPolicies {
-				formattedLoanRate := loanPolicy.InterestRate.Mul(decimal.NewFromInt(100))
-				if index == 0 {
-					formattedSource = fmt.Sprintf("%s: %s%%", loanPolicy.Source, formattedLoanRate)
-				}
-				formattedSource = fmt.Sprintf("%s, %s: %s%%", formattedSource, loanPolicy.Source, formattedLoanRate)
+			recordId, err := u.odooServiceRepository.SendLoanApprovalRequest(
+				entity.NewLoanApprovalRequest(request, submissionSheet, symbol.Symbol, u.appConfig.OdooCategoryId),
+			)
+			if err != nil {
+				return err
 			}
-			interestRate := submissionSheet.Detail.LoanPolicies[0].InterestRate // All loan policy share the same interest rate
-			term := submissionSheet.Detail.LoanPolicies[0].Term
-			formattedLoanRate := decimal.NewFromInt(1).Sub(loanRate.InitialRate).Mul(decimal.NewFromInt(100))
-			err = u.odooServiceRepository.SendLoanApprovalRequest(entity.LoanApprovalRequest{
-				LoanRequestId:      request.Id,
-				CreateAt:           request.CreatedAt,
-				SubmissionId:       submissionSheet.Metadata.Id,
-				SubmissionBy:       submissionSheet.Metadata.Creator,
-				SubmissionCreateAt: submissionSheet.Metadata.CreatedAt,
-				InvestorId:         request.InvestorId,
-				AccountNo:          request.AccountNo,
-				Symbol:             symbol.Symbol,
-				LoanRate:           formattedLoanRate,
-				Source:             formattedSource,
-				InterestRate:       interestRate,
-				BuyingFee:          submissionSheet.Detail.FirmBuyingFee,
-				Term:               term,
-				Description:        submissionSheet.Detail.Comment,
-			})
+			_, err = u.odooLoanApprovalRepository.Upsert(
+				tc, entity.OdooLoanApproval{
+					SubmissionSheetId: submissionSheet.Metadata.Id,
+					OdooRecordId:      recordId,
+					SyncStatus:        entity.OdooLoanApprovalSyncStatusPending,
+					NextAttemptAt:     time.Now(),
+				},
+			)
 			if err != nil {
 				return err
 			}
@@ -423,27 +552,13 @@ func (u *loanPackageRequestUseCase) AdminCancelLoanRequest(ctx context.Context,
 		if err != nil {
 			return res, fmt.Errorf("loanPackageRequestUseCase AdminCancelLoanRequest %w", err)
 		}
-		u.errorService.Go(
-			ctx, func() error {
-				return u.notifyRequestDeclined(atomicity.WithIgnoreTx(ctx), res)
-			},
-		)
 		return res, nil
 	}
 	// decline with alternative options
-	res, notifyLoanOfferInterestId, offerId, err := u.AdminDeclineLoanRequestWithAlternativeOptions(
-		ctx, id, creator, loanIds,
-	)
+	res, _, _, err := u.AdminDeclineLoanRequestWithAlternativeOptions(ctx, id, creator, loanIds)
 	if err != nil {
 		return res, fmt.Errorf("loanPackageRequestUseCase AdminCancelLoanRequest %w", err)
 	}
-	u.errorService.Go(
-		ctx, func() error {
-			return u.notifyRequestOnlineConfirmation(
-				atomicity.WithIgnoreTx(ctx), res, notifyLoanOfferInterestId, offerId,
-			)
-		},
-	)
 	return res, nil
 }
 
@@ -454,14 +569,22 @@ func (u *loanPackageRequestUseCase) AdminDeclineLoanRequestWithNoAlternativeOpti
 ) (entity.LoanPackageRequest, error) {
 	errorTemplate := "loanPackageRequestUseCase AdminDeclineLoanRequestWithNoAlternativeOption %w"
 	res := entity.LoanPackageRequest{}
+	accountNoDesc, err := u.getAccountNoDescForTransition(ctx, id, entity.LoanPackageRequestStatusDeclined)
+	if err != nil {
+		return res, fmt.Errorf(errorTemplate, err)
+	}
 	txErr := u.atomicExecutor.Execute(
 		ctx, func(tc context.Context) error {
 			request, err := u.repository.GetById(tc, id, entity.LoanPackageFilter{}, querymod.WithLock())
 			if err != nil {
 				return err
 			}
-			if request.Status != entity.LoanPackageRequestStatusPending {
-				return apperrors.ErrInvalidRequestStatus
+			err = u.transitionStatus(
+				tc, request, entity.LoanPackageRequestStatusDeclined, creator,
+				entity.LoanPackageRequestStatusReasonAdminDeclined,
+			)
+			if err != nil {
+				return err
 			}
 
 			_, err = u.loanPackageOfferRepository.Create(
@@ -473,18 +596,19 @@ func (u *loanPackageRequestUseCase) AdminDeclineLoanRequestWithNoAlternativeOpti
 			if err != nil {
 				return err
 			}
-			request.Status = entity.LoanPackageRequestStatusConfirmed
+			request.Status = entity.LoanPackageRequestStatusDeclined
 			_, err = u.repository.Update(tc, request)
 			if err != nil {
 				return err
 			}
 			res = request
-			return nil
+			return u.notifyRequestDeclined(tc, request, accountNoDesc)
 		},
 	)
 	if txErr != nil {
 		return res, fmt.Errorf(errorTemplate, txErr)
 	}
+	u.notifyLifecycle(ctx, res.Id)
 	return res, nil
 }
 
@@ -505,10 +629,19 @@ func (u *loanPackageRequestUseCase) AdminDeclineLoanRequestWithAlternativeOption
 	if len(financialProductLoanPackages) != len(loanIds) {
 		return res, 0, 0, apperrors.ErrLoanPackageIdsInvalid
 	}
+	accountNoDesc, err := u.getAccountNoDescForTransition(ctx, id, entity.LoanPackageRequestStatusConfirmed)
+	if err != nil {
+		return res, 0, 0, fmt.Errorf(errorTemplate, err)
+	}
 	txErr := u.atomicExecutor.Execute(
 		ctx, func(tc context.Context) error {
-			request, err := u.prepareAndPersistLoanPackageRequest(tc, id)
-			expireTime, err := u.financingRepository.GetDateAfter(time.Now(), u.appConfig.LoanRequest.ExpireDays)
+			request, err := u.prepareAndPersistLoanPackageRequest(tc, id, creator)
+			if err != nil {
+				return err
+			}
+			expireTime, err := tradingcalendar.AddTradingDaysForSymbol(
+				tc, u.tradingCalendar, request.SymbolId, time.Now(), u.appConfig.LoanRequest.ExpireDays,
+			)
 			if err != nil {
 				return err
 			}
@@ -565,26 +698,44 @@ func (u *loanPackageRequestUseCase) AdminDeclineLoanRequestWithAlternativeOption
 			}
 			createdLoanPackageOfferInterest = createdLoanOfferInterests[0].Id
 			createdOfferId = newOffer.Id
-			return nil
+			return u.notifyRequestOnlineConfirmation(
+				tc, request, accountNoDesc, createdLoanPackageOfferInterest, createdOfferId,
+			)
 		},
 	)
 	if txErr != nil {
 		return res, 0, 0, fmt.Errorf(errorTemplate, txErr)
 	}
+	u.notifyLifecycle(ctx, res.Id)
 	return res, createdLoanPackageOfferInterest, createdOfferId, nil
 }
 
-func (u *loanPackageRequestUseCase) prepareAndPersistLoanPackageRequest(atomicContext context.Context, id int64) (entity.LoanPackageRequest, error) {
+// notifyLifecycle wakes the lifecycle workflow of the request up once its change is committed,
+// the workflow reloads the request on its own schedule so a failed signal is only reported
+func (u *loanPackageRequestUseCase) notifyLifecycle(ctx context.Context, loanPackageRequestId int64) {
+	ctx = context.WithoutCancel(ctx)
+	u.errorService.Go(
+		ctx, func() error {
+			return u.lifecycleRepository.LoanRequestUpdated(ctx, loanPackageRequestId)
+		},
+	)
+}
+
+func (u *loanPackageRequestUseCase) prepareAndPersistLoanPackageRequest(atomicContext context.Context, id int64, creator string) (entity.LoanPackageRequest, error) {
 	request, err := u.repository.GetById(atomicContext, id, entity.LoanPackageFilter{}, querymod.WithLock())
 	if err != nil {
 		return request, err
 	}
-	if request.Status != entity.LoanPackageRequestStatusPending {
-		return request, apperrors.ErrInvalidRequestStatus
-	}
 	if request.AssetType == entity.AssetTypeDerivative {
 		return request, apperrors.ErrInvalidInput("derivative requests must be confirmed offline")
 	}
+	err = u.transitionStatus(
+		atomicContext, request, entity.LoanPackageRequestStatusConfirmed, creator,
+		entity.LoanPackageRequestStatusReasonAlternativeOption,
+	)
+	if err != nil {
+		return request, err
+	}
 	request.Status = entity.LoanPackageRequestStatusConfirmed
 	_, err = u.repository.Update(atomicContext, request)
 	if err != nil {
@@ -593,27 +744,50 @@ func (u *loanPackageRequestUseCase) prepareAndPersistLoanPackageRequest(atomicCo
 	return request, nil
 }
 
-func (u *loanPackageRequestUseCase) SystemDeclineRiskLoanRequests(ctx context.Context, maximumLoanRate decimal.Decimal) error {
+func (u *loanPackageRequestUseCase) SystemDeclineRiskLoanRequests(ctx context.Context, config entity.LoanRequestSchedulerConfig) error {
 	errorTemplate := "SystemDeclineRiskLoanRequests %w"
 	loanRequests := make([]entity.LoanPackageRequest, 0)
+	// the candidates are read once without locks to look their account descriptions up outside the transaction,
+	// a request that only shows up once locked is notified without one
+	unlockedCandidates, err := u.repository.GetAllPendingDeclineCandidates(ctx)
+	if err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	now := time.Now()
+	tradingDays := make(map[string]bool)
+	accountNoDescs := u.getAccountNoDescs(
+		ctx, funcs.Map(
+			unlockedCandidates, func(c entity.LoanRequestDeclineCandidate) entity.LoanPackageRequest {
+				return c.LoanPackageRequest
+			},
+		),
+	)
 	txErr := u.atomicExecutor.Execute(
 		ctx, func(ctx context.Context) error {
-			pendingRequests, err := u.repository.LockAllPendingRequestByMaxPercent(ctx, maximumLoanRate)
+			candidates, err := u.repository.GetAllPendingDeclineCandidates(ctx, querymod.WithLock())
 			if err != nil {
 				return fmt.Errorf("SystemDeclineRiskLoanRequests cannot lock pending request %w", err)
 			}
-			if len(pendingRequests) == 0 {
+			candidates, err = u.candidatesOnTradingDay(ctx, candidates, now, tradingDays)
+			if err != nil {
+				return fmt.Errorf(errorTemplate, err)
+			}
+			declines := declineRiskLoanRequests(config, candidates, now)
+			if len(declines) == 0 {
 				return nil
 			}
-			loanRequestIds := funcs.Map(
-				pendingRequests, func(r entity.LoanPackageRequest) int64 {
-					return r.Id
-				},
-			)
-			if err = u.systemDeclineLoanRequestIds(ctx, loanRequestIds); err != nil {
+			if err = u.systemDeclineLoanRequests(ctx, declines); err != nil {
 				return fmt.Errorf(errorTemplate, err)
 			}
-			loanRequests = append(loanRequests, pendingRequests...)
+			for _, decline := range declines {
+				err = u.notifyRequestDeclined(
+					ctx, decline.LoanPackageRequest, accountNoDescs[decline.LoanPackageRequest.Id],
+				)
+				if err != nil {
+					return fmt.Errorf(errorTemplate, err)
+				}
+				loanRequests = append(loanRequests, decline.LoanPackageRequest)
+			}
 			return nil
 		},
 	)
@@ -623,34 +797,109 @@ func (u *loanPackageRequestUseCase) SystemDeclineRiskLoanRequests(ctx context.Co
 	if txErr != nil {
 		return fmt.Errorf(errorTemplate, txErr)
 	}
-	for _, loanRequest := range loanRequests {
-		loanRequest := loanRequest
-		u.errorService.Go(
-			ctx, func() error {
-				return u.notifyRequestDeclined(atomicity.WithIgnoreTx(ctx), loanRequest)
+	return nil
+}
+
+// candidatesOnTradingDay keeps the candidates whose stock exchange trades at now, the others wait for its next trading day
+// when admins can still confirm them. tradingDays caches the answer of the calendar by stock exchange
+func (u *loanPackageRequestUseCase) candidatesOnTradingDay(
+	ctx context.Context,
+	candidates []entity.LoanRequestDeclineCandidate,
+	now time.Time,
+	tradingDays map[string]bool,
+) ([]entity.LoanRequestDeclineCandidate, error) {
+	res := make([]entity.LoanRequestDeclineCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		tradingDay, ok := tradingDays[candidate.StockExchangeCode]
+		if !ok {
+			session, err := u.tradingCalendar.Session(ctx, candidate.StockExchangeCode, now)
+			if err != nil {
+				return nil, err
+			}
+			tradingDay = session.TradingDay
+			tradingDays[candidate.StockExchangeCode] = tradingDay
+		}
+		if tradingDay {
+			res = append(res, candidate)
+		}
+	}
+	return res, nil
+}
+
+// PreviewDeclineRiskLoanRequests lists the pending requests config would decline once it takes effect, nothing is declined
+func (u *loanPackageRequestUseCase) PreviewDeclineRiskLoanRequests(
+	ctx context.Context,
+	config entity.LoanRequestSchedulerConfig,
+) ([]entity.LoanRequestDecline, error) {
+	if err := config.Validate(); err != nil {
+		return nil, apperrors.ErrInvalidInput(err.Error())
+	}
+	candidates, err := u.repository.GetAllPendingDeclineCandidates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("PreviewDeclineRiskLoanRequests %w", err)
+	}
+	evaluatedAt := time.Now()
+	if config.AffectedFrom.After(evaluatedAt) {
+		evaluatedAt = config.AffectedFrom
+	}
+	return declineRiskLoanRequests(config, candidates, evaluatedAt), nil
+}
+
+func declineRiskLoanRequests(
+	config entity.LoanRequestSchedulerConfig,
+	candidates []entity.LoanRequestDeclineCandidate,
+	now time.Time,
+) []entity.LoanRequestDecline {
+	declines := make([]entity.LoanRequestDecline, 0)
+	for _, candidate := range candidates {
+		reason, declined := config.DeclineReason(candidate, now)
+		if !declined {
+			continue
+		}
+		declines = append(
+			declines, entity.LoanRequestDecline{
+				LoanPackageRequest: candidate.LoanPackageRequest,
+				StockExchangeCode:  candidate.StockExchangeCode,
+				Reason:             reason,
 			},
 		)
 	}
-	return nil
+	return declines
 }
 
-func (u *loanPackageRequestUseCase) systemDeclineLoanRequestIds(ctx context.Context, loanRequestIds []int64) error {
-	_, err := u.repository.UpdateStatusByLoanRequestIds(ctx, loanRequestIds, entity.LoanPackageRequestStatusConfirmed)
-	if err != nil {
-		return fmt.Errorf("systemDeclineLoanRequestIds UpdateStatusByLoanRequestIds %w", err)
+// systemDeclineLoanRequests closes the declined requests, the ones declined for their age expire
+func (u *loanPackageRequestUseCase) systemDeclineLoanRequests(ctx context.Context, declines []entity.LoanRequestDecline) error {
+	idsByStatus := make(map[entity.LoanPackageRequestStatus][]int64)
+	statuses := make([]entity.LoanPackageRequestStatus, 0, 2)
+	for _, decline := range declines {
+		status := entity.LoanPackageRequestStatusDeclined
+		if decline.Reason == entity.LoanPackageRequestStatusReasonRequestExpired {
+			status = entity.LoanPackageRequestStatusExpired
+		}
+		if err := u.transitionStatus(ctx, decline.LoanPackageRequest, status, "system", decline.Reason); err != nil {
+			return fmt.Errorf("systemDeclineLoanRequests transitionStatus %w", err)
+		}
+		if _, ok := idsByStatus[status]; !ok {
+			statuses = append(statuses, status)
+		}
+		idsByStatus[status] = append(idsByStatus[status], decline.LoanPackageRequest.Id)
 	}
-	newOffers := make([]entity.LoanPackageOffer, 0, len(loanRequestIds))
-	for _, loanRequestId := range loanRequestIds {
+	for _, status := range statuses {
+		if _, err := u.repository.UpdateStatusByLoanRequestIds(ctx, idsByStatus[status], status); err != nil {
+			return fmt.Errorf("systemDeclineLoanRequests UpdateStatusByLoanRequestIds %w", err)
+		}
+	}
+	newOffers := make([]entity.LoanPackageOffer, 0, len(declines))
+	for _, decline := range declines {
 		newOffers = append(
 			newOffers, entity.LoanPackageOffer{
-				LoanPackageRequestId: loanRequestId,
+				LoanPackageRequestId: decline.LoanPackageRequest.Id,
 				OfferedBy:            "system",
 			},
 		)
 	}
-	_, err = u.loanPackageOfferRepository.BulkCreate(ctx, newOffers)
-	if err != nil {
-		return fmt.Errorf("systemDeclineLoanRequestIds BulkCreate LoanOffer %w", err)
+	if _, err := u.loanPackageOfferRepository.BulkCreate(ctx, newOffers); err != nil {
+		return fmt.Errorf("systemDeclineLoanRequests BulkCreate LoanOffer %w", err)
 	}
 	return nil
 }
@@ -690,9 +939,59 @@ func (u *loanPackageRequestUseCase) appendJobTrackingData(ctx context.Context, l
 	return nil
 }
 
+// getAccountNoDesc only includes accountNo if investor has more than 1 account.
+// The description is cosmetic, it is looked up before the transaction that enqueues the notification so that
+// the transaction does not wait on the financial product service, and a failed lookup leaves it empty
+func (u *loanPackageRequestUseCase) getAccountNoDesc(ctx context.Context, request entity.LoanPackageRequest) string {
+	return u.getAccountNoDescs(ctx, []entity.LoanPackageRequest{request})[request.Id]
+}
+
+// getAccountNoDescs looks the account descriptions of requests up once per investor, keyed by request id
+func (u *loanPackageRequestUseCase) getAccountNoDescs(ctx context.Context, requests []entity.LoanPackageRequest) map[int64]string {
+	accountsByInvestor := make(map[string][]entity.FinancialAccountDetail)
+	res := make(map[int64]string, len(requests))
+	for _, request := range requests {
+		accounts, ok := accountsByInvestor[request.InvestorId]
+		if !ok {
+			var err error
+			accounts, err = u.financialProductRepository.GetAllAccountDetail(ctx, request.InvestorId)
+			if err != nil {
+				_ = u.errorService.NotifyError(ctx, fmt.Errorf("loanPackageRequestUseCase getAccountNoDescs %w", err))
+			}
+			accountsByInvestor[request.InvestorId] = accounts
+		}
+		if len(accounts) > 1 {
+			for _, account := range accounts {
+				if account.AccountNo == request.AccountNo {
+					res[request.Id] = account.AccountTypeName
+				}
+			}
+		}
+	}
+	return res
+}
+
+// getAccountNoDescForTransition reads the request without locking it to look its account description up before
+// the transaction that locks it, a request that cannot move to status is rejected without the lookup
+func (u *loanPackageRequestUseCase) getAccountNoDescForTransition(
+	ctx context.Context,
+	id int64,
+	status entity.LoanPackageRequestStatus,
+) (string, error) {
+	request, err := u.repository.GetById(ctx, id, entity.LoanPackageFilter{})
+	if err != nil {
+		return "", err
+	}
+	if !request.Status.CanTransitionTo(status) {
+		return "", apperrors.ErrInvalidRequestStatusTransition(request.Status, status)
+	}
+	return u.getAccountNoDesc(ctx, request), nil
+}
+
 func (u *loanPackageRequestUseCase) notifyRequestOnlineConfirmation(
 	ctx context.Context,
 	request entity.LoanPackageRequest,
+	accountNoDesc string,
 	offerInterestId int64,
 	offerId int64,
 ) error {
@@ -700,20 +999,6 @@ func (u *loanPackageRequestUseCase) notifyRequestOnlineConfirmation(
 	if err != nil {
 		return err
 	}
-	// only include accountNo if investor has more than 1 account
-	accountNoDesc := ""
-	accounts, err := u.financialProductRepository.GetAllAccountDetail(ctx, request.InvestorId)
-	if err != nil {
-		return err
-	}
-	if len(accounts) > 1 {
-		for _, account := range accounts {
-			if account.AccountNo == request.AccountNo {
-				accountNoDesc = account.AccountTypeName
-				break
-			}
-		}
-	}
 	return u.loanPackageRequestEventRepository.NotifyOnlineConfirmation(
 		ctx, entity.RequestOnlineConfirmationNotify{
 			InvestorId:      request.InvestorId,
@@ -728,25 +1013,15 @@ func (u *loanPackageRequestUseCase) notifyRequestOnlineConfirmation(
 	)
 }
 
-func (u *loanPackageRequestUseCase) notifyRequestOfflineConfirmation(ctx context.Context, request entity.LoanPackageRequest) error {
+func (u *loanPackageRequestUseCase) notifyRequestOfflineConfirmation(
+	ctx context.Context,
+	request entity.LoanPackageRequest,
+	accountNoDesc string,
+) error {
 	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
 	if err != nil {
 		return err
 	}
-	// only include accountNo if investor has more than 1 account
-	accountNoDesc := ""
-	accounts, err := u.financialProductRepository.GetAllAccountDetail(ctx, request.InvestorId)
-	if err != nil {
-		return err
-	}
-	if len(accounts) > 1 {
-		for _, account := range accounts {
-			if account.AccountNo == request.AccountNo {
-				accountNoDesc = account.AccountTypeName
-				break
-			}
-		}
-	}
 	switch request.AssetType {
 	case entity.AssetTypeUnderlying:
 		return u.loanPackageRequestEventRepository.NotifyOfflineConfirmation(
@@ -776,25 +1051,15 @@ func (u *loanPackageRequestUseCase) notifyRequestOfflineConfirmation(ctx context
 	}
 }
 
-func (u *loanPackageRequestUseCase) notifyRequestDeclined(ctx context.Context, request entity.LoanPackageRequest) error {
+func (u *loanPackageRequestUseCase) notifyRequestDeclined(
+	ctx context.Context,
+	request entity.LoanPackageRequest,
+	accountNoDesc string,
+) error {
 	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
 	if err != nil {
 		return err
 	}
-	// only include accountNo if investor has more than 1 account
-	accountNoDesc := ""
-	accounts, err := u.financialProductRepository.GetAllAccountDetail(ctx, request.InvestorId)
-	if err != nil {
-		return err
-	}
-	if len(accounts) > 1 {
-		for _, account := range accounts {
-			if account.AccountNo == request.AccountNo {
-				accountNoDesc = account.AccountTypeName
-				break
-			}
-		}
-	}
 	switch request.AssetType {
 	case entity.AssetTypeUnderlying:
 		return u.loanPackageRequestEventRepository.NotifyRequestDeclined(
@@ -843,6 +1108,20 @@ func (u *loanPackageRequestUseCase) Delete(ctx context.Context, id int64) error
 func (u *loanPackageRequestUseCase) CancelAllLoanPackageRequestBySymbolId(ctx context.Context, symbolId int64, creator string) ([]entity.LoanPackageRequest, error) {
 	errorTemplate := "CancelAllSymbolLoanPackageRequest %w"
 	loanRequests := make([]entity.LoanPackageRequest, 0)
+	symbol, err := u.symbolRepository.GetById(ctx, symbolId)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	unlockedRequests, err := u.repository.GetAll(
+		ctx, entity.LoanPackageFilter{
+			Symbols:  []string{symbol.Symbol},
+			Statuses: []entity.LoanPackageRequestStatus{entity.LoanPackageRequestStatusPending},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	accountNoDescs := u.getAccountNoDescs(ctx, unlockedRequests)
 	txErr := u.atomicExecutor.Execute(
 		ctx, func(ctx context.Context) error {
 			pendingRequests, err := u.repository.LockAndReturnAllPendingRequestBySymbolId(ctx, symbolId)
@@ -850,6 +1129,13 @@ func (u *loanPackageRequestUseCase) CancelAllLoanPackageRequestBySymbolId(ctx co
 				return fmt.Errorf(errorTemplate, err)
 			}
 			for _, request := range pendingRequests {
+				err = u.transitionStatus(
+					ctx, request, entity.LoanPackageRequestStatusCancelled, creator,
+					entity.LoanPackageRequestStatusReasonSymbolCancelled,
+				)
+				if err != nil {
+					return fmt.Errorf(errorTemplate, err)
+				}
 				_, err = u.loanPackageOfferRepository.Create(
 					ctx, entity.LoanPackageOffer{
 						LoanPackageRequestId: request.Id,
@@ -859,11 +1145,14 @@ func (u *loanPackageRequestUseCase) CancelAllLoanPackageRequestBySymbolId(ctx co
 				if err != nil {
 					return fmt.Errorf(errorTemplate, err)
 				}
-				request.Status = entity.LoanPackageRequestStatusConfirmed
+				request.Status = entity.LoanPackageRequestStatusCancelled
 				request, err = u.repository.Update(ctx, request)
 				if err != nil {
 					return fmt.Errorf(errorTemplate, err)
 				}
+				if err = u.notifyRequestDeclined(ctx, request, accountNoDescs[request.Id]); err != nil {
+					return fmt.Errorf(errorTemplate, err)
+				}
 				loanRequests = append(loanRequests, request)
 			}
 			return nil
@@ -872,14 +1161,6 @@ func (u *loanPackageRequestUseCase) CancelAllLoanPackageRequestBySymbolId(ctx co
 	if txErr != nil {
 		return nil, fmt.Errorf(errorTemplate, txErr)
 	}
-	for _, request := range loanRequests {
-		req := request
-		u.errorService.Go(
-			ctx, func() error {
-				return u.notifyRequestDeclined(atomicity.WithIgnoreTx(ctx), req)
-			},
-		)
-	}
 	return loanRequests, nil
 }
 
@@ -1059,7 +1340,7 @@ func NewUseCase(
 	appConfig config.AppConfig,
 	loanPolicyRepository loanPolicyTemplateRepo.LoanPolicyTemplateRepository,
 	logger *slog.Logger,
-	financingRepository financingRepo.FinancingRepository,
+	tradingCalendar tradingcalendar.Calendar,
 	schedulerJobRepository schedulerRepo.SchedulerJobRepository,
 	errorService apperrors.Service,
 	investorRepository investorRepo.InvestorPersistenceRepository,
@@ -1067,6 +1348,9 @@ func NewUseCase(
 	marginOperationRepository marginOperationRepo.MarginOperationRepository,
 	configurationPersistenceRepo configRepo.ConfigurationPersistenceRepository,
 	odooServiceRepository odooServiceRepo.OdooServiceRepository,
+	odooLoanApprovalRepository odooServiceRepo.OdooLoanApprovalRepository,
+	lifecycleRepository lifecycleRepo.LoanRequestLifecycleRepository,
+	webhookEventRepository webhookRepo.WebhookEventRepository,
 ) UseCase {
 	return &loanPackageRequestUseCase{
 		repository:                         loanPackageRequestRepo,
@@ -1081,7 +1365,7 @@ func NewUseCase(
 		appConfig:                          appConfig,
 		loanPolicyRepository:               loanPolicyRepository,
 		logger:                             logger,
-		financingRepository:                financingRepository,
+		tradingCalendar:                    tradingCalendar,
 		schedulerJobRepository:             schedulerJobRepository,
 		errorService:                       errorService,
 		investorRepository:                 investorRepository,
@@ -1089,5 +1373,8 @@ func NewUseCase(
 		marginOperationRepository:          marginOperationRepository,
 		configurationPersistenceRepo:       configurationPersistenceRepo,
 		odooServiceRepository:              odooServiceRepository,
+		odooLoanApprovalRepository:         odooLoanApprovalRepository,
+		lifecycleRepository:                lifecycleRepository,
+		webhookEventRepository:             webhookEventRepository,
 	}
 }
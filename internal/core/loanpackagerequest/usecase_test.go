@@ -2,6 +2,7 @@ package loanpackagerequest
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -9,18 +10,22 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-jet/jet/v2/qrm"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	testifyMock "github.com/stretchr/testify/mock"
 
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/atomicity"
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/optional"
 	"financing-offer/test/mock"
 )
 
 func TestLoanRequestUseCase(t *testing.T) {
 	t.Parallel()
+	declineConfig := entity.LoanRequestSchedulerConfig{MaximumLoanRate: decimal.NewFromFloat(0.3)}
 
 	t.Run(
 		"SystemDeclineRiskLoanRequestsSuccess", func(t *testing.T) {
@@ -36,7 +41,9 @@ func TestLoanRequestUseCase(t *testing.T) {
 			symbolRepo := mock.NewMockSymbolRepository(t)
 			loanContractRepo := mock.NewMockLoanContractPersistenceRepository(t)
 			financialProductRepo := mock.NewMockFinancialProductRepository(t)
-			financingRepo := mock.NewMockFinancingRepository(t)
+			tradingCalendar := mock.NewMockCalendar(t)
+			tradingCalendar.EXPECT().Session(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).
+				Return(entity.TradingSession{TradingDay: true}, nil)
 			schedulerJobRepo := mock.NewMockSchedulerJobRepository(t)
 			investorRepo := mock.NewMockInvestorPersistenceRepository(t)
 			loanPolicyTemplateRepo := mock.NewMockLoanPolicyTemplateRepository(t)
@@ -44,6 +51,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 			marginOperationRepo := mock.NewMockMarginOperationRepository(t)
 			configurationRepo := mock.NewMockConfigurationPersistenceRepository(t)
 			odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+			odooLoanApprovalRepo := mock.NewMockOdooLoanApprovalRepository(t)
 			useCase := NewUseCase(
 				loanPackageRequestRepo,
 				&atomicity.DbAtomicExecutor{
@@ -59,7 +67,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 				config.AppConfig{},
 				loanPolicyTemplateRepo,
 				slog.New(slog.NewJSONHandler(os.Stdout, nil)),
-				financingRepo,
+				tradingCalendar,
 				schedulerJobRepo,
 				mock.ErrReporter{},
 				investorRepo,
@@ -67,31 +75,36 @@ func TestLoanRequestUseCase(t *testing.T) {
 				marginOperationRepo,
 				configurationRepo,
 				odooServiceRepo,
+				odooLoanApprovalRepo,
+				&mock.LoanRequestLifecycleRepository{},
+				&mock.WebhookEventRepository{},
 			)
 			sqlMock.ExpectBegin()
 			sqlMock.ExpectCommit()
 			loanPackageRequestRepo.On(
-				"LockAllPendingRequestByMaxPercent", testifyMock.Anything, decimal.NewFromFloat(0.3),
-				testifyMock.Anything,
+				"GetAllPendingDeclineCandidates", testifyMock.Anything, testifyMock.Anything,
 			).
 				Return(
-					[]entity.LoanPackageRequest{
+					[]entity.LoanRequestDeclineCandidate{
 						{
-							Id:          1,
-							SymbolId:    1,
-							InvestorId:  "test",
-							AccountNo:   "accNo",
-							LoanRate:    decimal.NewFromFloat(0.3),
-							LimitAmount: decimal.NewFromFloat(300000.0),
-							Type:        entity.LoanPackageRequestTypeFlexible,
-							Status:      entity.LoanPackageRequestStatusPending,
-							AssetType:   entity.AssetTypeUnderlying,
+							LoanPackageRequest: entity.LoanPackageRequest{
+								Id:          1,
+								SymbolId:    1,
+								InvestorId:  "test",
+								AccountNo:   "accNo",
+								LoanRate:    decimal.NewFromFloat(0.3),
+								LimitAmount: decimal.NewFromFloat(300000.0),
+								Type:        entity.LoanPackageRequestTypeFlexible,
+								Status:      entity.LoanPackageRequestStatusPending,
+								AssetType:   entity.AssetTypeUnderlying,
+							},
+							StockExchangeCode: "HOSE",
 						},
 					}, nil,
 				)
 			loanPackageRequestRepo.On(
 				"UpdateStatusByLoanRequestIds", testifyMock.Anything, []int64{1},
-				entity.LoanPackageRequestStatusConfirmed,
+				entity.LoanPackageRequestStatusDeclined,
 			).
 				Return(
 					[]entity.LoanPackageRequest{
@@ -123,6 +136,17 @@ func TestLoanRequestUseCase(t *testing.T) {
 					}, nil,
 				)
 
+			loanPackageRequestRepo.On(
+				"CreateStatusHistories", testifyMock.Anything, []entity.LoanPackageRequestStatusHistory{
+					{
+						LoanPackageRequestId: 1,
+						FromStatus:           entity.LoanPackageRequestStatusPending,
+						ToStatus:             entity.LoanPackageRequestStatusDeclined,
+						Actor:                "system",
+						Reason:               entity.LoanPackageRequestStatusReasonHighLoanRate,
+					},
+				},
+			).Return(nil)
 			schedulerJobRepo.On("Create", testifyMock.Anything, testifyMock.Anything).
 				Return(nil)
 
@@ -146,7 +170,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 			loanPackageRequestEventRepository.On("NotifyRequestDeclined", testifyMock.Anything, testifyMock.Anything).
 				Return(nil)
 
-			err = useCase.SystemDeclineRiskLoanRequests(context.Background(), decimal.NewFromFloat(0.3))
+			err = useCase.SystemDeclineRiskLoanRequests(context.Background(), declineConfig)
 			assert.Nil(t, err)
 		},
 	)
@@ -165,7 +189,9 @@ func TestLoanRequestUseCase(t *testing.T) {
 			symbolRepo := mock.NewMockSymbolRepository(t)
 			loanContractRepo := mock.NewMockLoanContractPersistenceRepository(t)
 			financialProductRepo := mock.NewMockFinancialProductRepository(t)
-			financingRepo := mock.NewMockFinancingRepository(t)
+			tradingCalendar := mock.NewMockCalendar(t)
+			tradingCalendar.EXPECT().Session(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).
+				Return(entity.TradingSession{TradingDay: true}, nil)
 			schedulerJobRepo := mock.NewMockSchedulerJobRepository(t)
 			investorRepo := mock.NewMockInvestorPersistenceRepository(t)
 			loanPolicyTemplateRepo := mock.NewMockLoanPolicyTemplateRepository(t)
@@ -173,6 +199,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 			marginOperationRepo := mock.NewMockMarginOperationRepository(t)
 			configurationRepo := mock.NewMockConfigurationPersistenceRepository(t)
 			odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+			odooLoanApprovalRepo := mock.NewMockOdooLoanApprovalRepository(t)
 			useCase := NewUseCase(
 				loanPackageRequestRepo,
 				&atomicity.DbAtomicExecutor{
@@ -188,7 +215,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 				config.AppConfig{},
 				loanPolicyTemplateRepo,
 				slog.New(slog.NewJSONHandler(os.Stdout, nil)),
-				financingRepo,
+				tradingCalendar,
 				schedulerJobRepo,
 				mock.ErrReporter{},
 				investorRepo,
@@ -196,30 +223,38 @@ func TestLoanRequestUseCase(t *testing.T) {
 				marginOperationRepo,
 				configurationRepo,
 				odooServiceRepo,
+				odooLoanApprovalRepo,
+				&mock.LoanRequestLifecycleRepository{},
+				&mock.WebhookEventRepository{},
 			)
 			sqlMock.ExpectBegin()
 			sqlMock.ExpectRollback()
+			financialProductRepo.On("GetAllAccountDetail", testifyMock.Anything, "test").
+				Return([]entity.FinancialAccountDetail{{Id: "1", InvestorId: "test"}}, nil)
 			loanPackageRequestRepo.On(
-				"LockAllPendingRequestByMaxPercent", testifyMock.Anything, decimal.NewFromFloat(0.3),
-				testifyMock.Anything,
+				"GetAllPendingDeclineCandidates", testifyMock.Anything, testifyMock.Anything,
 			).
 				Return(
-					[]entity.LoanPackageRequest{
+					[]entity.LoanRequestDeclineCandidate{
 						{
-							Id:          1,
-							SymbolId:    1,
-							InvestorId:  "test",
-							AccountNo:   "accNo",
-							LoanRate:    decimal.NewFromFloat(0.3),
-							LimitAmount: decimal.NewFromFloat(300000.0),
-							Type:        entity.LoanPackageRequestTypeFlexible,
-							Status:      entity.LoanPackageRequestStatusPending,
+							LoanPackageRequest: entity.LoanPackageRequest{
+								Id:          1,
+								SymbolId:    1,
+								InvestorId:  "test",
+								AccountNo:   "accNo",
+								LoanRate:    decimal.NewFromFloat(0.3),
+								LimitAmount: decimal.NewFromFloat(300000.0),
+								Type:        entity.LoanPackageRequestTypeFlexible,
+								Status:      entity.LoanPackageRequestStatusPending,
+							},
+							StockExchangeCode: "HOSE",
 						},
 					}, nil,
 				)
+			loanPackageRequestRepo.On("CreateStatusHistories", testifyMock.Anything, testifyMock.Anything).Return(nil)
 			loanPackageRequestRepo.On(
 				"UpdateStatusByLoanRequestIds", testifyMock.Anything, []int64{1},
-				entity.LoanPackageRequestStatusConfirmed,
+				entity.LoanPackageRequestStatusDeclined,
 			).
 				Return([]entity.LoanPackageRequest{}, fmt.Errorf("test"))
 			schedulerJobRepo.On(
@@ -227,13 +262,13 @@ func TestLoanRequestUseCase(t *testing.T) {
 					JobType:      entity.JobTypeDeclineHighRiskLoanRequest,
 					JobStatus:    entity.JobStatusFail,
 					TriggerBy:    "system",
-					TrackingData: "{\"error\":\"SystemDeclineRiskLoanRequests systemDeclineLoanRequestIds UpdateStatusByLoanRequestIds test\"}",
+					TrackingData: "{\"error\":\"SystemDeclineRiskLoanRequests systemDeclineLoanRequests UpdateStatusByLoanRequestIds test\"}",
 				},
 			).Return(nil)
-			err = useCase.SystemDeclineRiskLoanRequests(context.Background(), decimal.NewFromFloat(0.3))
+			err = useCase.SystemDeclineRiskLoanRequests(context.Background(), declineConfig)
 			assert.Equal(
 				t,
-				"SystemDeclineRiskLoanRequests SystemDeclineRiskLoanRequests systemDeclineLoanRequestIds UpdateStatusByLoanRequestIds test",
+				"SystemDeclineRiskLoanRequests SystemDeclineRiskLoanRequests systemDeclineLoanRequests UpdateStatusByLoanRequestIds test",
 				err.Error(),
 			)
 		},
@@ -253,7 +288,9 @@ func TestLoanRequestUseCase(t *testing.T) {
 			symbolRepo := mock.NewMockSymbolRepository(t)
 			loanContractRepo := mock.NewMockLoanContractPersistenceRepository(t)
 			financialProductRepo := mock.NewMockFinancialProductRepository(t)
-			financingRepo := mock.NewMockFinancingRepository(t)
+			tradingCalendar := mock.NewMockCalendar(t)
+			tradingCalendar.EXPECT().Session(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).
+				Return(entity.TradingSession{TradingDay: true}, nil)
 			schedulerJobRepo := mock.NewMockSchedulerJobRepository(t)
 			investorRepo := mock.NewMockInvestorPersistenceRepository(t)
 			loanPolicyTemplateRepo := mock.NewMockLoanPolicyTemplateRepository(t)
@@ -261,6 +298,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 			marginOperationRepo := mock.NewMockMarginOperationRepository(t)
 			configurationRepo := mock.NewMockConfigurationPersistenceRepository(t)
 			odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+			odooLoanApprovalRepo := mock.NewMockOdooLoanApprovalRepository(t)
 			useCase := NewUseCase(
 				loanPackageRequestRepo,
 				&atomicity.DbAtomicExecutor{
@@ -276,7 +314,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 				config.AppConfig{},
 				loanPolicyTemplateRepo,
 				slog.New(slog.NewJSONHandler(os.Stdout, nil)),
-				financingRepo,
+				tradingCalendar,
 				schedulerJobRepo,
 				mock.ErrReporter{},
 				investorRepo,
@@ -284,30 +322,37 @@ func TestLoanRequestUseCase(t *testing.T) {
 				marginOperationRepo,
 				configurationRepo,
 				odooServiceRepo,
+				odooLoanApprovalRepo,
+				&mock.LoanRequestLifecycleRepository{},
+				&mock.WebhookEventRepository{},
 			)
 			sqlMock.ExpectBegin()
 			sqlMock.ExpectRollback()
+			financialProductRepo.On("GetAllAccountDetail", testifyMock.Anything, "test").
+				Return([]entity.FinancialAccountDetail{{Id: "1", InvestorId: "test"}}, nil)
 			loanPackageRequestRepo.On(
-				"LockAllPendingRequestByMaxPercent", testifyMock.Anything, decimal.NewFromFloat(0.3),
-				testifyMock.Anything,
+				"GetAllPendingDeclineCandidates", testifyMock.Anything, testifyMock.Anything,
 			).
 				Return(
-					[]entity.LoanPackageRequest{
+					[]entity.LoanRequestDeclineCandidate{
 						{
-							Id:          1,
-							SymbolId:    1,
-							InvestorId:  "test",
-							AccountNo:   "accNo",
-							LoanRate:    decimal.NewFromFloat(0.3),
-							LimitAmount: decimal.NewFromFloat(300000.0),
-							Type:        entity.LoanPackageRequestTypeFlexible,
-							Status:      entity.LoanPackageRequestStatusPending,
+							LoanPackageRequest: entity.LoanPackageRequest{
+								Id:          1,
+								SymbolId:    1,
+								InvestorId:  "test",
+								AccountNo:   "accNo",
+								LoanRate:    decimal.NewFromFloat(0.3),
+								LimitAmount: decimal.NewFromFloat(300000.0),
+								Type:        entity.LoanPackageRequestTypeFlexible,
+								Status:      entity.LoanPackageRequestStatusPending,
+							},
+							StockExchangeCode: "HOSE",
 						},
 					}, nil,
 				)
 			loanPackageRequestRepo.On(
 				"UpdateStatusByLoanRequestIds", testifyMock.Anything, []int64{1},
-				entity.LoanPackageRequestStatusConfirmed,
+				entity.LoanPackageRequestStatusDeclined,
 			).
 				Return(
 					[]entity.LoanPackageRequest{
@@ -323,6 +368,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 						},
 					}, nil,
 				)
+			loanPackageRequestRepo.On("CreateStatusHistories", testifyMock.Anything, testifyMock.Anything).Return(nil)
 
 			loanPackageOfferRepository.On("BulkCreate", testifyMock.Anything, testifyMock.Anything).
 				Return([]entity.LoanPackageOffer{}, fmt.Errorf("test"))
@@ -331,15 +377,15 @@ func TestLoanRequestUseCase(t *testing.T) {
 					JobType:      entity.JobTypeDeclineHighRiskLoanRequest,
 					JobStatus:    entity.JobStatusFail,
 					TriggerBy:    "system",
-					TrackingData: "{\"error\":\"SystemDeclineRiskLoanRequests systemDeclineLoanRequestIds BulkCreate LoanOffer test\"}",
+					TrackingData: "{\"error\":\"SystemDeclineRiskLoanRequests systemDeclineLoanRequests BulkCreate LoanOffer test\"}",
 				},
 			).
 				Return(nil)
 
-			err = useCase.SystemDeclineRiskLoanRequests(context.Background(), decimal.NewFromFloat(0.3))
+			err = useCase.SystemDeclineRiskLoanRequests(context.Background(), declineConfig)
 			assert.Equal(
 				t,
-				"SystemDeclineRiskLoanRequests SystemDeclineRiskLoanRequests systemDeclineLoanRequestIds BulkCreate LoanOffer test",
+				"SystemDeclineRiskLoanRequests SystemDeclineRiskLoanRequests systemDeclineLoanRequests BulkCreate LoanOffer test",
 				err.Error(),
 			)
 		},
@@ -359,7 +405,9 @@ func TestLoanRequestUseCase(t *testing.T) {
 			symbolRepo := mock.NewMockSymbolRepository(t)
 			loanContractRepo := mock.NewMockLoanContractPersistenceRepository(t)
 			financialProductRepo := mock.NewMockFinancialProductRepository(t)
-			financingRepo := mock.NewMockFinancingRepository(t)
+			tradingCalendar := mock.NewMockCalendar(t)
+			tradingCalendar.EXPECT().Session(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).
+				Return(entity.TradingSession{TradingDay: true}, nil)
 			schedulerJobRepo := mock.NewMockSchedulerJobRepository(t)
 			investorRepo := mock.NewMockInvestorPersistenceRepository(t)
 			loanPolicyTemplateRepo := mock.NewMockLoanPolicyTemplateRepository(t)
@@ -367,6 +415,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 			marginOperationRepo := mock.NewMockMarginOperationRepository(t)
 			configurationRepo := mock.NewMockConfigurationPersistenceRepository(t)
 			odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+			odooLoanApprovalRepo := mock.NewMockOdooLoanApprovalRepository(t)
 			useCase := NewUseCase(
 				loanPackageRequestRepo,
 				&atomicity.DbAtomicExecutor{
@@ -382,7 +431,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 				config.AppConfig{},
 				loanPolicyTemplateRepo,
 				slog.New(slog.NewJSONHandler(os.Stdout, nil)),
-				financingRepo,
+				tradingCalendar,
 				schedulerJobRepo,
 				mock.ErrReporter{},
 				investorRepo,
@@ -390,31 +439,36 @@ func TestLoanRequestUseCase(t *testing.T) {
 				marginOperationRepo,
 				configurationRepo,
 				odooServiceRepo,
+				odooLoanApprovalRepo,
+				&mock.LoanRequestLifecycleRepository{},
+				&mock.WebhookEventRepository{},
 			)
 			sqlMock.ExpectBegin()
 			sqlMock.ExpectCommit()
 			loanPackageRequestRepo.On(
-				"LockAllPendingRequestByMaxPercent", testifyMock.Anything, decimal.NewFromFloat(0.3),
-				testifyMock.Anything,
+				"GetAllPendingDeclineCandidates", testifyMock.Anything, testifyMock.Anything,
 			).
 				Return(
-					[]entity.LoanPackageRequest{
+					[]entity.LoanRequestDeclineCandidate{
 						{
-							Id:          1,
-							SymbolId:    1,
-							InvestorId:  "test",
-							AccountNo:   "accNo",
-							LoanRate:    decimal.NewFromFloat(0.3),
-							LimitAmount: decimal.NewFromFloat(300000.0),
-							Type:        entity.LoanPackageRequestTypeFlexible,
-							Status:      entity.LoanPackageRequestStatusPending,
-							AssetType:   entity.AssetTypeDerivative,
+							LoanPackageRequest: entity.LoanPackageRequest{
+								Id:          1,
+								SymbolId:    1,
+								InvestorId:  "test",
+								AccountNo:   "accNo",
+								LoanRate:    decimal.NewFromFloat(0.3),
+								LimitAmount: decimal.NewFromFloat(300000.0),
+								Type:        entity.LoanPackageRequestTypeFlexible,
+								Status:      entity.LoanPackageRequestStatusPending,
+								AssetType:   entity.AssetTypeDerivative,
+							},
+							StockExchangeCode: "HOSE",
 						},
 					}, nil,
 				)
 			loanPackageRequestRepo.On(
 				"UpdateStatusByLoanRequestIds", testifyMock.Anything, []int64{1},
-				entity.LoanPackageRequestStatusConfirmed,
+				entity.LoanPackageRequestStatusDeclined,
 			).
 				Return(
 					[]entity.LoanPackageRequest{
@@ -446,6 +500,17 @@ func TestLoanRequestUseCase(t *testing.T) {
 					}, nil,
 				)
 
+			loanPackageRequestRepo.On(
+				"CreateStatusHistories", testifyMock.Anything, []entity.LoanPackageRequestStatusHistory{
+					{
+						LoanPackageRequestId: 1,
+						FromStatus:           entity.LoanPackageRequestStatusPending,
+						ToStatus:             entity.LoanPackageRequestStatusDeclined,
+						Actor:                "system",
+						Reason:               entity.LoanPackageRequestStatusReasonHighLoanRate,
+					},
+				},
+			).Return(nil)
 			schedulerJobRepo.On("Create", testifyMock.Anything, testifyMock.Anything).
 				Return(nil)
 
@@ -471,7 +536,7 @@ func TestLoanRequestUseCase(t *testing.T) {
 			).
 				Return(nil)
 
-			err = useCase.SystemDeclineRiskLoanRequests(context.Background(), decimal.NewFromFloat(0.3))
+			err = useCase.SystemDeclineRiskLoanRequests(context.Background(), declineConfig)
 			assert.Nil(t, err)
 		},
 	)
@@ -487,7 +552,7 @@ func TestLoanPackageRequestUseCase_GetAllUnderlyingRequests(t *testing.T) {
 	symbolRepo := mock.NewMockSymbolRepository(t)
 	loanContractRepo := mock.NewMockLoanContractPersistenceRepository(t)
 	financialProductRepo := mock.NewMockFinancialProductRepository(t)
-	financingRepo := mock.NewMockFinancingRepository(t)
+	tradingCalendar := mock.NewMockCalendar(t)
 	schedulerJobRepo := mock.NewMockSchedulerJobRepository(t)
 	investorRepo := mock.NewMockInvestorPersistenceRepository(t)
 	loanPolicyTemplateRepo := mock.NewMockLoanPolicyTemplateRepository(t)
@@ -495,6 +560,7 @@ func TestLoanPackageRequestUseCase_GetAllUnderlyingRequests(t *testing.T) {
 	marginOperationRepo := mock.NewMockMarginOperationRepository(t)
 	configurationRepo := mock.NewMockConfigurationPersistenceRepository(t)
 	odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+	odooLoanApprovalRepo := mock.NewMockOdooLoanApprovalRepository(t)
 	atomicExecutor := mock.NewMockAtomicExecutorExecutePassthrough(t)
 	useCase := NewUseCase(
 		loanPackageRequestRepo,
@@ -509,7 +575,7 @@ func TestLoanPackageRequestUseCase_GetAllUnderlyingRequests(t *testing.T) {
 		config.AppConfig{},
 		loanPolicyTemplateRepo,
 		slog.New(slog.NewJSONHandler(os.Stdout, nil)),
-		financingRepo,
+		tradingCalendar,
 		schedulerJobRepo,
 		mock.ErrReporter{},
 		investorRepo,
@@ -517,6 +583,9 @@ func TestLoanPackageRequestUseCase_GetAllUnderlyingRequests(t *testing.T) {
 		marginOperationRepo,
 		configurationRepo,
 		odooServiceRepo,
+		odooLoanApprovalRepo,
+		&mock.LoanRequestLifecycleRepository{},
+		&mock.WebhookEventRepository{},
 	)
 	t.Run(
 		"GetAllUnderlyingRequests_success", func(t *testing.T) {
@@ -542,3 +611,433 @@ func TestLoanPackageRequestUseCase_GetAllUnderlyingRequests(t *testing.T) {
 			assert.ErrorIs(t, err, assert.AnError)
 		})
 }
+
+func TestLoanPackageRequestUseCase_StatusTransition(t *testing.T) {
+	t.Parallel()
+	type dependencies struct {
+		loanPackageRequestRepo            *mock.MockLoanPackageRequestRepository
+		loanPackageOfferRepository        *mock.MockLoanPackageOfferRepository
+		loanPackageRequestEventRepository *mock.MockLoanPackageRequestEventRepository
+		symbolRepo                        *mock.MockSymbolRepository
+		financialProductRepo              *mock.MockFinancialProductRepository
+	}
+	newUseCase := func(t *testing.T) (UseCase, dependencies) {
+		deps := dependencies{
+			loanPackageRequestRepo:            mock.NewMockLoanPackageRequestRepository(t),
+			loanPackageOfferRepository:        mock.NewMockLoanPackageOfferRepository(t),
+			loanPackageRequestEventRepository: mock.NewMockLoanPackageRequestEventRepository(t),
+			symbolRepo:                        mock.NewMockSymbolRepository(t),
+			financialProductRepo:              mock.NewMockFinancialProductRepository(t),
+		}
+		useCase := NewUseCase(
+			deps.loanPackageRequestRepo,
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+			mock.NewMockScoreGroupInterestRepository(t),
+			deps.loanPackageOfferRepository,
+			mock.NewMockLoanPackageOfferInterestRepository(t),
+			deps.loanPackageRequestEventRepository,
+			deps.symbolRepo,
+			mock.NewMockLoanContractPersistenceRepository(t),
+			deps.financialProductRepo,
+			config.AppConfig{},
+			mock.NewMockLoanPolicyTemplateRepository(t),
+			slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			mock.NewMockCalendar(t),
+			mock.NewMockSchedulerJobRepository(t),
+			mock.ErrReporter{},
+			mock.NewMockInvestorPersistenceRepository(t),
+			mock.NewMockSubmissionSheetRepository(t),
+			mock.NewMockMarginOperationRepository(t),
+			mock.NewMockConfigurationPersistenceRepository(t),
+			mock.NewMockOdooServiceRepository(t),
+			mock.NewMockOdooLoanApprovalRepository(t),
+			&mock.LoanRequestLifecycleRepository{},
+			&mock.WebhookEventRepository{},
+		)
+		return useCase, deps
+	}
+	pendingRequest := entity.LoanPackageRequest{
+		Id:          1,
+		SymbolId:    2,
+		InvestorId:  "0001000115",
+		AccountNo:   "0001000115",
+		LoanRate:    decimal.NewFromFloat(0.5),
+		LimitAmount: decimal.NewFromInt(1000000),
+		Type:        entity.LoanPackageRequestTypeFlexible,
+		Status:      entity.LoanPackageRequestStatusPending,
+		AssetType:   entity.AssetTypeUnderlying,
+	}
+	expectDeclinedNotification := func(deps dependencies) {
+		deps.symbolRepo.EXPECT().GetById(testifyMock.Anything, pendingRequest.SymbolId).
+			Return(entity.Symbol{Id: pendingRequest.SymbolId, Symbol: "HPG"}, nil)
+		deps.financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, pendingRequest.InvestorId).
+			Return([]entity.FinancialAccountDetail{{AccountNo: pendingRequest.AccountNo}}, nil)
+		deps.loanPackageRequestEventRepository.EXPECT().NotifyRequestDeclined(testifyMock.Anything, testifyMock.Anything).
+			Return(nil)
+	}
+
+	t.Run(
+		"AdminCancelLoanRequest_declined", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			deps.loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, pendingRequest.Id, entity.LoanPackageFilter{}, testifyMock.Anything).
+				Return(pendingRequest, nil)
+			deps.loanPackageRequestRepo.EXPECT().CreateStatusHistories(
+				testifyMock.Anything, []entity.LoanPackageRequestStatusHistory{
+					{
+						LoanPackageRequestId: pendingRequest.Id,
+						FromStatus:           entity.LoanPackageRequestStatusPending,
+						ToStatus:             entity.LoanPackageRequestStatusDeclined,
+						Actor:                "admin",
+						Reason:               entity.LoanPackageRequestStatusReasonAdminDeclined,
+					},
+				},
+			).Return(nil)
+			deps.loanPackageOfferRepository.EXPECT().Create(testifyMock.Anything, testifyMock.Anything).
+				Return(entity.LoanPackageOffer{Id: 3, LoanPackageRequestId: pendingRequest.Id}, nil)
+			declinedRequest := pendingRequest
+			declinedRequest.Status = entity.LoanPackageRequestStatusDeclined
+			deps.loanPackageRequestRepo.EXPECT().Update(testifyMock.Anything, declinedRequest).Return(declinedRequest, nil)
+			expectDeclinedNotification(deps)
+
+			res, err := useCase.AdminCancelLoanRequest(context.Background(), pendingRequest.Id, "admin", nil)
+			assert.Nil(t, err)
+			assert.Equal(t, entity.LoanPackageRequestStatusDeclined, res.Status)
+		},
+	)
+
+	t.Run(
+		"AdminCancelLoanRequest_illegal_transition", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			confirmedRequest := pendingRequest
+			confirmedRequest.Status = entity.LoanPackageRequestStatusConfirmed
+			deps.loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, pendingRequest.Id, entity.LoanPackageFilter{}, testifyMock.Anything).
+				Return(confirmedRequest, nil)
+
+			_, err := useCase.AdminCancelLoanRequest(context.Background(), pendingRequest.Id, "admin", nil)
+			appErr := apperrors.AppError{}
+			assert.True(t, errors.As(err, &appErr))
+			assert.Equal(t, apperrors.ErrInvalidRequestStatus.Code, appErr.Code)
+			assert.Equal(t, "invalid request status transition from CONFIRMED to DECLINED", appErr.Message)
+		},
+	)
+
+	t.Run(
+		"CancelAllLoanPackageRequestBySymbolId_cancelled", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			deps.loanPackageRequestRepo.EXPECT().GetAll(
+				testifyMock.Anything, entity.LoanPackageFilter{
+					Symbols:  []string{"HPG"},
+					Statuses: []entity.LoanPackageRequestStatus{entity.LoanPackageRequestStatusPending},
+				},
+			).Return([]entity.LoanPackageRequest{pendingRequest}, nil)
+			deps.loanPackageRequestRepo.EXPECT().LockAndReturnAllPendingRequestBySymbolId(testifyMock.Anything, pendingRequest.SymbolId).
+				Return([]entity.LoanPackageRequest{pendingRequest}, nil)
+			deps.loanPackageRequestRepo.EXPECT().CreateStatusHistories(
+				testifyMock.Anything, []entity.LoanPackageRequestStatusHistory{
+					{
+						LoanPackageRequestId: pendingRequest.Id,
+						FromStatus:           entity.LoanPackageRequestStatusPending,
+						ToStatus:             entity.LoanPackageRequestStatusCancelled,
+						Actor:                "admin",
+						Reason:               entity.LoanPackageRequestStatusReasonSymbolCancelled,
+					},
+				},
+			).Return(nil)
+			deps.loanPackageOfferRepository.EXPECT().Create(testifyMock.Anything, testifyMock.Anything).
+				Return(entity.LoanPackageOffer{Id: 3, LoanPackageRequestId: pendingRequest.Id}, nil)
+			cancelledRequest := pendingRequest
+			cancelledRequest.Status = entity.LoanPackageRequestStatusCancelled
+			deps.loanPackageRequestRepo.EXPECT().Update(testifyMock.Anything, cancelledRequest).Return(cancelledRequest, nil)
+			expectDeclinedNotification(deps)
+
+			res, err := useCase.CancelAllLoanPackageRequestBySymbolId(context.Background(), pendingRequest.SymbolId, "admin")
+			assert.Nil(t, err)
+			assert.Equal(t, []entity.LoanPackageRequest{cancelledRequest}, res)
+		},
+	)
+
+	t.Run(
+		"AdminCreateRequests_created_with_after_create", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			toCreate := pendingRequest
+			toCreate.Id = 0
+			deps.financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, pendingRequest.InvestorId).
+				Return([]entity.FinancialAccountDetail{{AccountNo: pendingRequest.AccountNo}}, nil).Once()
+			deps.loanPackageRequestRepo.EXPECT().Create(testifyMock.Anything, toCreate).Return(pendingRequest, nil).Twice()
+			deps.loanPackageRequestRepo.EXPECT().CreateStatusHistories(
+				testifyMock.Anything, []entity.LoanPackageRequestStatusHistory{
+					{
+						LoanPackageRequestId: pendingRequest.Id,
+						ToStatus:             entity.LoanPackageRequestStatusPending,
+						Actor:                "admin",
+						Reason:               entity.LoanPackageRequestStatusReasonSuggestedOffer,
+					},
+				},
+			).Return(nil).Twice()
+			var linked []entity.LoanPackageRequest
+
+			res, err := useCase.AdminCreateRequests(
+				context.Background(), []entity.LoanPackageRequest{toCreate, toCreate}, "admin",
+				func(_ context.Context, created []entity.LoanPackageRequest) error {
+					linked = created
+					return nil
+				},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, []entity.LoanPackageRequest{pendingRequest, pendingRequest}, res)
+			assert.Equal(t, res, linked)
+		},
+	)
+
+	t.Run(
+		"AdminCreateRequests_invalid_account", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			deps.financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, pendingRequest.InvestorId).
+				Return([]entity.FinancialAccountDetail{{AccountNo: "0001000116"}}, nil)
+
+			_, err := useCase.AdminCreateRequests(context.Background(), []entity.LoanPackageRequest{pendingRequest}, "admin", nil)
+			assert.ErrorIs(t, err, apperrors.ErrAccountNoInvalid)
+		},
+	)
+
+	t.Run(
+		"GetStatusHistories_success", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			histories := []entity.LoanPackageRequestStatusHistory{
+				{Id: 1, LoanPackageRequestId: pendingRequest.Id, ToStatus: entity.LoanPackageRequestStatusPending},
+			}
+			deps.loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, pendingRequest.Id, entity.LoanPackageFilter{}).
+				Return(pendingRequest, nil)
+			deps.loanPackageRequestRepo.EXPECT().GetStatusHistories(testifyMock.Anything, pendingRequest.Id).Return(histories, nil)
+
+			res, err := useCase.GetStatusHistories(context.Background(), pendingRequest.Id)
+			assert.Nil(t, err)
+			assert.Equal(t, histories, res)
+		},
+	)
+
+	t.Run(
+		"GetStatusHistories_request_not_found", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			deps.loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, pendingRequest.Id, entity.LoanPackageFilter{}).
+				Return(entity.LoanPackageRequest{}, qrm.ErrNoRows)
+
+			_, err := useCase.GetStatusHistories(context.Background(), pendingRequest.Id)
+			assert.True(t, apperrors.IsNotFoundError(err))
+		},
+	)
+}
+
+func TestLoanPackageRequestUseCase_DeclineRules(t *testing.T) {
+	t.Parallel()
+	type dependencies struct {
+		loanPackageRequestRepo            *mock.MockLoanPackageRequestRepository
+		loanPackageOfferRepository        *mock.MockLoanPackageOfferRepository
+		loanPackageRequestEventRepository *mock.MockLoanPackageRequestEventRepository
+		symbolRepo                        *mock.MockSymbolRepository
+		financialProductRepo              *mock.MockFinancialProductRepository
+		schedulerJobRepo                  *mock.MockSchedulerJobRepository
+		tradingCalendar                   *mock.MockCalendar
+	}
+	newUseCase := func(t *testing.T) (UseCase, dependencies) {
+		deps := dependencies{
+			loanPackageRequestRepo:            mock.NewMockLoanPackageRequestRepository(t),
+			loanPackageOfferRepository:        mock.NewMockLoanPackageOfferRepository(t),
+			loanPackageRequestEventRepository: mock.NewMockLoanPackageRequestEventRepository(t),
+			symbolRepo:                        mock.NewMockSymbolRepository(t),
+			financialProductRepo:              mock.NewMockFinancialProductRepository(t),
+			schedulerJobRepo:                  mock.NewMockSchedulerJobRepository(t),
+			tradingCalendar:                   mock.NewMockCalendar(t),
+		}
+		useCase := NewUseCase(
+			deps.loanPackageRequestRepo,
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+			mock.NewMockScoreGroupInterestRepository(t),
+			deps.loanPackageOfferRepository,
+			mock.NewMockLoanPackageOfferInterestRepository(t),
+			deps.loanPackageRequestEventRepository,
+			deps.symbolRepo,
+			mock.NewMockLoanContractPersistenceRepository(t),
+			deps.financialProductRepo,
+			config.AppConfig{},
+			mock.NewMockLoanPolicyTemplateRepository(t),
+			slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			deps.tradingCalendar,
+			deps.schedulerJobRepo,
+			mock.ErrReporter{},
+			mock.NewMockInvestorPersistenceRepository(t),
+			mock.NewMockSubmissionSheetRepository(t),
+			mock.NewMockMarginOperationRepository(t),
+			mock.NewMockConfigurationPersistenceRepository(t),
+			mock.NewMockOdooServiceRepository(t),
+			mock.NewMockOdooLoanApprovalRepository(t),
+			&mock.LoanRequestLifecycleRepository{},
+			&mock.WebhookEventRepository{},
+		)
+		return useCase, deps
+	}
+	candidate := func(id int64, stockExchangeCode string, assetType entity.AssetType, limitAmount int64, age time.Duration) entity.LoanRequestDeclineCandidate {
+		return entity.LoanRequestDeclineCandidate{
+			LoanPackageRequest: entity.LoanPackageRequest{
+				Id:          id,
+				SymbolId:    id,
+				InvestorId:  "0001000115",
+				AccountNo:   "0001000115",
+				LoanRate:    decimal.NewFromFloat(0.5),
+				LimitAmount: decimal.NewFromInt(limitAmount),
+				Type:        entity.LoanPackageRequestTypeFlexible,
+				Status:      entity.LoanPackageRequestStatusPending,
+				AssetType:   assetType,
+				CreatedAt:   time.Now().Add(-age),
+			},
+			StockExchangeCode: stockExchangeCode,
+		}
+	}
+	declineConfig := entity.LoanRequestSchedulerConfig{
+		MaximumLoanRate: decimal.NewFromFloat(0.9),
+		Rules: []entity.LoanRequestDeclineRule{
+			{MaximumAgeHours: optional.Some(int64(72))},
+			{
+				AssetType:         optional.Some(entity.AssetTypeUnderlying),
+				StockExchangeCode: optional.Some("HOSE"),
+				MaximumLoanRate:   optional.Some(decimal.NewFromFloat(0.4)),
+			},
+			{StockExchangeCode: optional.Some("HNX"), MinimumLimitAmount: optional.Some(decimal.NewFromInt(500000))},
+		},
+	}
+	candidates := []entity.LoanRequestDeclineCandidate{
+		candidate(1, "HOSE", entity.AssetTypeUnderlying, 1000000, time.Hour),
+		candidate(2, "HNX", entity.AssetTypeUnderlying, 100000, time.Hour),
+		candidate(3, "UPCOM", entity.AssetTypeUnderlying, 1000000, 100*time.Hour),
+		candidate(4, "HOSE", entity.AssetTypeDerivative, 1000000, 48*time.Hour),
+		// the HNX rule replaces the age rule for its requests
+		candidate(5, "HNX", entity.AssetTypeUnderlying, 1000000, 100*time.Hour),
+	}
+
+	t.Run(
+		"SystemDeclineRiskLoanRequests_declined_by_matching_rule", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			deps.loanPackageRequestRepo.EXPECT().GetAllPendingDeclineCandidates(testifyMock.Anything, testifyMock.Anything).
+				Return(candidates, nil)
+			// the calendar is asked once per stock exchange
+			for _, code := range []string{"HOSE", "HNX", "UPCOM"} {
+				deps.tradingCalendar.EXPECT().Session(testifyMock.Anything, code, testifyMock.Anything).
+					Return(entity.TradingSession{TradingDay: true}, nil).Once()
+			}
+			// the request declined for its age expires
+			deps.loanPackageRequestRepo.EXPECT().UpdateStatusByLoanRequestIds(
+				testifyMock.Anything, []int64{1, 2}, entity.LoanPackageRequestStatusDeclined,
+			).Return(nil, nil)
+			deps.loanPackageRequestRepo.EXPECT().UpdateStatusByLoanRequestIds(
+				testifyMock.Anything, []int64{3}, entity.LoanPackageRequestStatusExpired,
+			).Return(nil, nil)
+			deps.loanPackageOfferRepository.EXPECT().BulkCreate(testifyMock.Anything, testifyMock.Anything).Return(nil, nil)
+			for id, history := range []struct {
+				status entity.LoanPackageRequestStatus
+				reason string
+			}{
+				{status: entity.LoanPackageRequestStatusDeclined, reason: entity.LoanPackageRequestStatusReasonHighLoanRate},
+				{status: entity.LoanPackageRequestStatusDeclined, reason: entity.LoanPackageRequestStatusReasonLowLimitAmount},
+				{status: entity.LoanPackageRequestStatusExpired, reason: entity.LoanPackageRequestStatusReasonRequestExpired},
+			} {
+				deps.loanPackageRequestRepo.EXPECT().CreateStatusHistories(
+					testifyMock.Anything, []entity.LoanPackageRequestStatusHistory{
+						{
+							LoanPackageRequestId: int64(id + 1),
+							FromStatus:           entity.LoanPackageRequestStatusPending,
+							ToStatus:             history.status,
+							Actor:                "system",
+							Reason:               history.reason,
+						},
+					},
+				).Return(nil)
+			}
+			deps.symbolRepo.EXPECT().GetById(testifyMock.Anything, testifyMock.Anything).
+				Return(entity.Symbol{Symbol: "HPG"}, nil).Times(3)
+			// the accounts of an investor are looked up once for all of its requests
+			deps.financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, "0001000115").
+				Return([]entity.FinancialAccountDetail{{AccountNo: "0001000115"}}, nil).Once()
+			deps.loanPackageRequestEventRepository.EXPECT().NotifyRequestDeclined(testifyMock.Anything, testifyMock.Anything).
+				Return(nil).Times(3)
+			deps.schedulerJobRepo.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(job entity.SchedulerJob) bool {
+						return job.JobStatus == entity.JobStatusSuccess && job.TrackingData == `{"loanRequestIds":[1,2,3]}`
+					},
+				),
+			).Return(nil)
+
+			err := useCase.SystemDeclineRiskLoanRequests(context.Background(), declineConfig)
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"SystemDeclineRiskLoanRequests_kept_on_a_holiday_of_their_exchange", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			deps.loanPackageRequestRepo.EXPECT().GetAllPendingDeclineCandidates(testifyMock.Anything, testifyMock.Anything).
+				Return(candidates, nil)
+			deps.tradingCalendar.EXPECT().Session(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).RunAndReturn(
+				func(ctx context.Context, stockExchangeCode string, date time.Time) (entity.TradingSession, error) {
+					return entity.TradingSession{TradingDay: stockExchangeCode == "HNX"}, nil
+				},
+			)
+			deps.loanPackageRequestRepo.EXPECT().UpdateStatusByLoanRequestIds(
+				testifyMock.Anything, []int64{2}, entity.LoanPackageRequestStatusDeclined,
+			).Return(nil, nil)
+			deps.loanPackageOfferRepository.EXPECT().BulkCreate(testifyMock.Anything, testifyMock.Anything).Return(nil, nil)
+			deps.loanPackageRequestRepo.EXPECT().CreateStatusHistories(testifyMock.Anything, testifyMock.Anything).Return(nil)
+			deps.symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(2)).Return(entity.Symbol{Symbol: "SHS"}, nil)
+			deps.financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, "0001000115").
+				Return([]entity.FinancialAccountDetail{{AccountNo: "0001000115"}}, nil)
+			deps.loanPackageRequestEventRepository.EXPECT().NotifyRequestDeclined(testifyMock.Anything, testifyMock.Anything).
+				Return(nil).Once()
+			deps.schedulerJobRepo.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(job entity.SchedulerJob) bool {
+						return job.TrackingData == `{"loanRequestIds":[2]}`
+					},
+				),
+			).Return(nil)
+
+			err := useCase.SystemDeclineRiskLoanRequests(context.Background(), declineConfig)
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"PreviewDeclineRiskLoanRequests_evaluated_when_config_takes_effect", func(t *testing.T) {
+			useCase, deps := newUseCase(t)
+			deps.loanPackageRequestRepo.EXPECT().GetAllPendingDeclineCandidates(testifyMock.Anything).Return(candidates, nil)
+			proposed := declineConfig
+			proposed.AffectedFrom = time.Now().Add(30 * time.Hour)
+
+			declines, err := useCase.PreviewDeclineRiskLoanRequests(context.Background(), proposed)
+			assert.Nil(t, err)
+			ids := make([]int64, 0, len(declines))
+			for _, decline := range declines {
+				ids = append(ids, decline.LoanPackageRequest.Id)
+			}
+			// request 4 is 78 hours old once the config takes effect
+			assert.Equal(t, []int64{1, 2, 3, 4}, ids)
+			assert.Equal(t, entity.LoanPackageRequestStatusReasonRequestExpired, declines[3].Reason)
+			assert.Equal(t, "HOSE", declines[3].StockExchangeCode)
+		},
+	)
+
+	t.Run(
+		"PreviewDeclineRiskLoanRequests_invalid_rule", func(t *testing.T) {
+			useCase, _ := newUseCase(t)
+			proposed := declineConfig
+			proposed.Rules = append(
+				[]entity.LoanRequestDeclineRule{}, declineConfig.Rules[2], entity.LoanRequestDeclineRule{
+					StockExchangeCode:  optional.Some("HNX"),
+					MaximumLimitAmount: optional.Some(decimal.NewFromInt(1)),
+				},
+			)
+
+			_, err := useCase.PreviewDeclineRiskLoanRequests(context.Background(), proposed)
+			assert.Equal(t, apperrors.ErrInvalidInput("rules[1]: duplicated rule for assetType and stockExchangeCode"), err)
+		},
+	)
+}
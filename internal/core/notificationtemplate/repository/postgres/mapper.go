@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/pkg/querymod"
+)
+
+func MapNotificationTemplateDbToEntity(template model.NotificationTemplate) entity.NotificationTemplate {
+	return entity.NotificationTemplate{
+		Id:          template.ID,
+		Key:         entity.NotificationTemplateKey(template.Key),
+		Locale:      entity.Locale(template.Locale),
+		Content:     template.Content,
+		Description: template.Description,
+		CreatedBy:   template.CreatedBy,
+		UpdatedBy:   template.UpdatedBy,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+}
+
+func MapNotificationTemplatesDbToEntity(templates []model.NotificationTemplate) []entity.NotificationTemplate {
+	res := make([]entity.NotificationTemplate, 0, len(templates))
+	for _, template := range templates {
+		res = append(res, MapNotificationTemplateDbToEntity(template))
+	}
+	return res
+}
+
+func MapNotificationTemplateEntityToDb(template entity.NotificationTemplate) model.NotificationTemplate {
+	return model.NotificationTemplate{
+		ID:          template.Id,
+		Key:         template.Key.String(),
+		Locale:      template.Locale.String(),
+		Content:     template.Content,
+		Description: template.Description,
+		CreatedBy:   template.CreatedBy,
+		UpdatedBy:   template.UpdatedBy,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+}
+
+func ApplyNotificationTemplateFilter(filter entity.NotificationTemplateFilter) postgres.BoolExpression {
+	expr := postgres.Bool(true)
+	if len(filter.Keys) > 0 {
+		keys := make([]string, 0, len(filter.Keys))
+		for _, key := range filter.Keys {
+			keys = append(keys, key.String())
+		}
+		expr = expr.AND(table.NotificationTemplate.Key.IN(querymod.In(keys)...))
+	}
+	if len(filter.Locales) > 0 {
+		locales := make([]string, 0, len(filter.Locales))
+		for _, locale := range filter.Locales {
+			locales = append(locales, locale.String())
+		}
+		expr = expr.AND(table.NotificationTemplate.Locale.IN(querymod.In(locales)...))
+	}
+	return expr
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/notificationtemplate/repository"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+)
+
+var _ repository.NotificationTemplateRepository = (*NotificationTemplatePostgresRepository)(nil)
+
+type NotificationTemplatePostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewNotificationTemplatePostgresRepository(getDbFunc database.GetDbFunc) *NotificationTemplatePostgresRepository {
+	return &NotificationTemplatePostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *NotificationTemplatePostgresRepository) GetAll(ctx context.Context, filter entity.NotificationTemplateFilter) ([]entity.NotificationTemplate, error) {
+	dest := make([]model.NotificationTemplate, 0)
+	if err := table.NotificationTemplate.SELECT(table.NotificationTemplate.AllColumns).
+		WHERE(ApplyNotificationTemplateFilter(filter)).
+		ORDER_BY(table.NotificationTemplate.Key, table.NotificationTemplate.Locale).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return nil, fmt.Errorf("NotificationTemplatePostgresRepository GetAll %w", err)
+	}
+	return MapNotificationTemplatesDbToEntity(dest), nil
+}
+
+func (r *NotificationTemplatePostgresRepository) GetById(ctx context.Context, id int64) (entity.NotificationTemplate, error) {
+	var dest model.NotificationTemplate
+	if err := table.NotificationTemplate.SELECT(table.NotificationTemplate.AllColumns).
+		WHERE(table.NotificationTemplate.ID.EQ(postgres.Int64(id))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return entity.NotificationTemplate{}, fmt.Errorf("NotificationTemplatePostgresRepository GetById %w", err)
+	}
+	return MapNotificationTemplateDbToEntity(dest), nil
+}
+
+func (r *NotificationTemplatePostgresRepository) Create(ctx context.Context, template entity.NotificationTemplate) (entity.NotificationTemplate, error) {
+	created := model.NotificationTemplate{}
+	if err := table.NotificationTemplate.INSERT(
+		table.NotificationTemplate.Key,
+		table.NotificationTemplate.Locale,
+		table.NotificationTemplate.Content,
+		table.NotificationTemplate.Description,
+		table.NotificationTemplate.CreatedBy,
+		table.NotificationTemplate.UpdatedBy,
+	).
+		MODEL(MapNotificationTemplateEntityToDb(template)).
+		RETURNING(table.NotificationTemplate.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &created); err != nil {
+		return entity.NotificationTemplate{}, fmt.Errorf("NotificationTemplatePostgresRepository Create %w", err)
+	}
+	return MapNotificationTemplateDbToEntity(created), nil
+}
+
+func (r *NotificationTemplatePostgresRepository) Update(ctx context.Context, template entity.NotificationTemplate) (entity.NotificationTemplate, error) {
+	updated := model.NotificationTemplate{}
+	if err := table.NotificationTemplate.UPDATE(
+		table.NotificationTemplate.Content,
+		table.NotificationTemplate.Description,
+		table.NotificationTemplate.UpdatedBy,
+	).
+		MODEL(MapNotificationTemplateEntityToDb(template)).
+		WHERE(table.NotificationTemplate.ID.EQ(postgres.Int64(template.Id))).
+		RETURNING(table.NotificationTemplate.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &updated); err != nil {
+		return entity.NotificationTemplate{}, fmt.Errorf("NotificationTemplatePostgresRepository Update %w", err)
+	}
+	return MapNotificationTemplateDbToEntity(updated), nil
+}
+
+func (r *NotificationTemplatePostgresRepository) Delete(ctx context.Context, id int64) error {
+	if _, err := table.NotificationTemplate.DELETE().
+		WHERE(table.NotificationTemplate.ID.EQ(postgres.Int64(id))).
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("NotificationTemplatePostgresRepository Delete %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financing-offer/internal/core/entity"
+)
+
+type NotificationTemplateRepository interface {
+	GetAll(ctx context.Context, filter entity.NotificationTemplateFilter) ([]entity.NotificationTemplate, error)
+	GetById(ctx context.Context, id int64) (entity.NotificationTemplate, error)
+	Create(ctx context.Context, template entity.NotificationTemplate) (entity.NotificationTemplate, error)
+	Update(ctx context.Context, template entity.NotificationTemplate) (entity.NotificationTemplate, error)
+	Delete(ctx context.Context, id int64) error
+}
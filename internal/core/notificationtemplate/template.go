@@ -0,0 +1,51 @@
+package notificationtemplate
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/shopspring/decimal"
+)
+
+var funcs = template.FuncMap{
+	"percent": percent,
+}
+
+// Execute renders content with data, content referencing a field data does not have is an error
+func Execute(content string, data any) (string, error) {
+	tmpl, err := template.New("notification").Funcs(funcs).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// percent formats a ratio as a percentage number, 0.099 becomes 9.9.
+// Besides decimals it takes the numbers and strings of previewed json data
+func percent(value any) (string, error) {
+	var d decimal.Decimal
+	switch v := value.(type) {
+	case decimal.Decimal:
+		d = v
+	case float64:
+		d = decimal.NewFromFloat(v)
+	case int:
+		d = decimal.NewFromInt(int64(v))
+	case int64:
+		d = decimal.NewFromInt(v)
+	case string:
+		parsed, err := decimal.NewFromString(v)
+		if err != nil {
+			return "", err
+		}
+		d = parsed
+	default:
+		return "", fmt.Errorf("percent: unsupported value %v", value)
+	}
+	return d.Mul(decimal.NewFromInt(100)).String(), nil
+}
@@ -0,0 +1,230 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/notificationtemplate"
+	"financing-offer/internal/handler"
+)
+
+type NotificationTemplateHandler struct {
+	handler.BaseHandler
+	logger  *slog.Logger
+	useCase notificationtemplate.UseCase
+}
+
+func NewNotificationTemplateHandler(
+	baseHandler handler.BaseHandler,
+	logger *slog.Logger,
+	useCase notificationtemplate.UseCase,
+) *NotificationTemplateHandler {
+	return &NotificationTemplateHandler{BaseHandler: baseHandler, logger: logger, useCase: useCase}
+}
+
+// GetAll godoc
+//
+//	@Summary		Get notification templates
+//	@Description	Get the notification templates of every locale
+//	@Tags			notification template,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			keys	query		[]string	false	"keys"
+//	@Param			locales	query		[]string	false	"locales"
+//	@Success		200		{object}	handler.BaseResponse[[]entity.NotificationTemplate]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/notification-templates [get]
+func (h *NotificationTemplateHandler) GetAll(ctx *gin.Context) {
+	req := GetNotificationTemplatesRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		h.RenderBadRequest(ctx, "parse query")
+		return
+	}
+	templates, err := h.useCase.GetAll(ctx, req.toEntity())
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[[]entity.NotificationTemplate]{Data: templates})
+}
+
+// GetById godoc
+//
+//	@Summary		Get notification template
+//	@Description	Get a notification template
+//	@Tags			notification template,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	handler.BaseResponse[entity.NotificationTemplate]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		404	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/notification-templates/{id} [get]
+func (h *NotificationTemplateHandler) GetById(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	template, err := h.useCase.GetById(ctx, id)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.NotificationTemplate]{Data: template})
+}
+
+// Create godoc
+//
+//	@Summary		Create notification template
+//	@Description	Create the wording of a notification field in a locale, the content is a go text/template
+//	@Description	rendered with the notify data of the key
+//	@Tags			notification template,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			template	body		CreateNotificationTemplateRequest	true	"notification template"
+//	@Success		201			{object}	handler.BaseResponse[entity.NotificationTemplate]
+//	@Failure		400			{object}	handler.ErrorResponse
+//	@Failure		500			{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/notification-templates [post]
+func (h *NotificationTemplateHandler) Create(ctx *gin.Context) {
+	req := CreateNotificationTemplateRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("create notification template", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	created, err := h.useCase.Create(ctx, req.toEntity(h.UserSubOrEmpty(ctx)))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, handler.BaseResponse[entity.NotificationTemplate]{Data: created})
+}
+
+// Update godoc
+//
+//	@Summary		Update notification template
+//	@Description	Update the content of a notification template, it is used by the next notification
+//	@Tags			notification template,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int									true	"id"
+//	@Param			template	body		UpdateNotificationTemplateRequest	true	"notification template"
+//	@Success		200			{object}	handler.BaseResponse[entity.NotificationTemplate]
+//	@Failure		400			{object}	handler.ErrorResponse
+//	@Failure		404			{object}	handler.ErrorResponse
+//	@Failure		500			{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/notification-templates/{id} [patch]
+func (h *NotificationTemplateHandler) Update(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	req := UpdateNotificationTemplateRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("update notification template", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	updated, err := h.useCase.Update(ctx, req.toEntity(id, h.UserSubOrEmpty(ctx)))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.NotificationTemplate]{Data: updated})
+}
+
+// Delete godoc
+//
+//	@Summary		Delete notification template
+//	@Description	Delete a notification template, the default locale is used for investors preferring its locale
+//	@Tags			notification template,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		204	{object}	handler.BaseResponse[string]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/notification-templates/{id} [delete]
+func (h *NotificationTemplateHandler) Delete(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	if err := h.useCase.Delete(ctx, id); err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusNoContent, handler.BaseResponse[string]{Data: "ok"})
+}
+
+// Preview godoc
+//
+//	@Summary		Preview notification template
+//	@Description	Render a template content with the given data, or with sample notify data of the key when data is empty
+//	@Tags			notification template,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			preview	body		PreviewNotificationTemplateRequest	true	"preview"
+//	@Success		200		{object}	handler.BaseResponse[string]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/notification-templates/preview [post]
+func (h *NotificationTemplateHandler) Preview(ctx *gin.Context) {
+	req := PreviewNotificationTemplateRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	rendered, err := h.useCase.Preview(ctx, req.toEntity())
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[string]{Data: rendered})
+}
+
+// SetPreferredLocale godoc
+//
+//	@Summary		Set notification locale
+//	@Description	Set the locale of the notifications sent to the investor
+//	@Tags			notification template,investor
+//	@Accept			json
+//	@Produce		json
+//	@Param			locale	body		SetPreferredLocaleRequest	true	"locale"
+//	@Success		200		{object}	handler.BaseResponse[string]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/my-notification-locale [put]
+func (h *NotificationTemplateHandler) SetPreferredLocale(ctx *gin.Context) {
+	req := SetPreferredLocaleRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.RenderBadRequest(ctx, err.Error())
+		return
+	}
+	investor, err := h.Investor(ctx)
+	if err != nil {
+		h.RenderUnauthenticated(ctx, err.Error())
+		return
+	}
+	if err := h.useCase.SetPreferredLocale(ctx, investor, req.Locale); err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[string]{Data: req.Locale.String()})
+}
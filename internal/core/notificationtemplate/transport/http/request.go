@@ -0,0 +1,68 @@
+package http
+
+import (
+	"financing-offer/internal/core/entity"
+)
+
+type GetNotificationTemplatesRequest struct {
+	Keys    []string `form:"keys"`
+	Locales []string `form:"locales"`
+}
+
+func (r GetNotificationTemplatesRequest) toEntity() entity.NotificationTemplateFilter {
+	keys := make([]entity.NotificationTemplateKey, 0, len(r.Keys))
+	for _, key := range r.Keys {
+		keys = append(keys, entity.NotificationTemplateKey(key))
+	}
+	locales := make([]entity.Locale, 0, len(r.Locales))
+	for _, locale := range r.Locales {
+		locales = append(locales, entity.Locale(locale))
+	}
+	return entity.NotificationTemplateFilter{Keys: keys, Locales: locales}
+}
+
+type CreateNotificationTemplateRequest struct {
+	Key         entity.NotificationTemplateKey `json:"key" binding:"required"`
+	Locale      entity.Locale                  `json:"locale" binding:"required"`
+	Content     string                         `json:"content" binding:"required"`
+	Description string                         `json:"description"`
+}
+
+func (r CreateNotificationTemplateRequest) toEntity(creator string) entity.NotificationTemplate {
+	return entity.NotificationTemplate{
+		Key:         r.Key,
+		Locale:      r.Locale,
+		Content:     r.Content,
+		Description: r.Description,
+		CreatedBy:   creator,
+		UpdatedBy:   creator,
+	}
+}
+
+type UpdateNotificationTemplateRequest struct {
+	Content     string `json:"content" binding:"required"`
+	Description string `json:"description"`
+}
+
+func (r UpdateNotificationTemplateRequest) toEntity(id int64, updater string) entity.NotificationTemplate {
+	return entity.NotificationTemplate{
+		Id:          id,
+		Content:     r.Content,
+		Description: r.Description,
+		UpdatedBy:   updater,
+	}
+}
+
+type PreviewNotificationTemplateRequest struct {
+	Key     entity.NotificationTemplateKey `json:"key"`
+	Content string                         `json:"content" binding:"required"`
+	Data    map[string]any                 `json:"data"`
+}
+
+func (r PreviewNotificationTemplateRequest) toEntity() entity.NotificationTemplatePreview {
+	return entity.NotificationTemplatePreview{Key: r.Key, Content: r.Content, Data: r.Data}
+}
+
+type SetPreferredLocaleRequest struct {
+	Locale entity.Locale `json:"locale" binding:"required,oneof=vi en"`
+}
@@ -0,0 +1,194 @@
+package notificationtemplate
+
+import (
+	"context"
+	"fmt"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/atomicity"
+	"financing-offer/internal/core/entity"
+	investorRepo "financing-offer/internal/core/investor/repository"
+	"financing-offer/internal/core/notificationtemplate/repository"
+)
+
+// Renderer renders the wording of a notification field in the preferred locale of the investor
+type Renderer interface {
+	Render(ctx context.Context, investorId string, key entity.NotificationTemplateKey, data any) (string, error)
+}
+
+type UseCase interface {
+	Renderer
+	GetAll(ctx context.Context, filter entity.NotificationTemplateFilter) ([]entity.NotificationTemplate, error)
+	GetById(ctx context.Context, id int64) (entity.NotificationTemplate, error)
+	Create(ctx context.Context, template entity.NotificationTemplate) (entity.NotificationTemplate, error)
+	Update(ctx context.Context, template entity.NotificationTemplate) (entity.NotificationTemplate, error)
+	Delete(ctx context.Context, id int64) error
+	Preview(ctx context.Context, preview entity.NotificationTemplatePreview) (string, error)
+	SetPreferredLocale(ctx context.Context, investor entity.Investor, locale entity.Locale) error
+}
+
+type useCase struct {
+	repository         repository.NotificationTemplateRepository
+	investorRepository investorRepo.InvestorPersistenceRepository
+	atomicExecutor     atomicity.AtomicExecutor
+}
+
+func NewUseCase(
+	repository repository.NotificationTemplateRepository,
+	investorRepository investorRepo.InvestorPersistenceRepository,
+	atomicExecutor atomicity.AtomicExecutor,
+) UseCase {
+	return &useCase{
+		repository:         repository,
+		investorRepository: investorRepository,
+		atomicExecutor:     atomicExecutor,
+	}
+}
+
+// Render falls back to the default locale when the key has no template in the preferred locale of the investor
+func (u *useCase) Render(ctx context.Context, investorId string, key entity.NotificationTemplateKey, data any) (string, error) {
+	errorTemplate := "notificationTemplateUseCase Render %w"
+	locale, err := u.investorRepository.GetPreferredLocale(ctx, investorId)
+	if err != nil {
+		return "", fmt.Errorf(errorTemplate, err)
+	}
+	templates, err := u.repository.GetAll(
+		ctx, entity.NotificationTemplateFilter{
+			Keys:    []entity.NotificationTemplateKey{key},
+			Locales: []entity.Locale{locale, entity.DefaultLocale},
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf(errorTemplate, err)
+	}
+	var content *string
+	for i, template := range templates {
+		if template.Locale == locale {
+			content = &templates[i].Content
+			break
+		}
+		content = &templates[i].Content
+	}
+	if content == nil {
+		return "", fmt.Errorf(errorTemplate, fmt.Errorf("no %s template for %s", key, locale))
+	}
+	res, err := Execute(*content, data)
+	if err != nil {
+		return "", fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (u *useCase) GetAll(ctx context.Context, filter entity.NotificationTemplateFilter) ([]entity.NotificationTemplate, error) {
+	res, err := u.repository.GetAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("notificationTemplateUseCase GetAll %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) GetById(ctx context.Context, id int64) (entity.NotificationTemplate, error) {
+	res, err := u.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.NotificationTemplate{}, fmt.Errorf("notificationTemplateUseCase GetById %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) Create(ctx context.Context, template entity.NotificationTemplate) (entity.NotificationTemplate, error) {
+	if !template.Locale.IsValid() {
+		return entity.NotificationTemplate{}, apperrors.ErrInvalidInput(fmt.Sprintf("unsupported locale %s", template.Locale))
+	}
+	if err := validateContent(template.Key, template.Content); err != nil {
+		return entity.NotificationTemplate{}, err
+	}
+	var res entity.NotificationTemplate
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.repository.Create(tc, template)
+			return err
+		},
+	)
+	if err != nil {
+		return entity.NotificationTemplate{}, fmt.Errorf("notificationTemplateUseCase Create %w", err)
+	}
+	return res, nil
+}
+
+// Update changes the content and description, the key and locale of a template are fixed
+func (u *useCase) Update(ctx context.Context, template entity.NotificationTemplate) (entity.NotificationTemplate, error) {
+	errorTemplate := "notificationTemplateUseCase Update %w"
+	existing, err := u.repository.GetById(ctx, template.Id)
+	if err != nil {
+		return entity.NotificationTemplate{}, fmt.Errorf(errorTemplate, err)
+	}
+	if err := validateContent(existing.Key, template.Content); err != nil {
+		return entity.NotificationTemplate{}, err
+	}
+	var res entity.NotificationTemplate
+	err = u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.repository.Update(tc, template)
+			return err
+		},
+	)
+	if err != nil {
+		return entity.NotificationTemplate{}, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (u *useCase) Delete(ctx context.Context, id int64) error {
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			return u.repository.Delete(tc, id)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("notificationTemplateUseCase Delete %w", err)
+	}
+	return nil
+}
+
+func (u *useCase) Preview(_ context.Context, preview entity.NotificationTemplatePreview) (string, error) {
+	var data any = preview.Data
+	if len(preview.Data) == 0 {
+		sample, ok := preview.Key.SampleData()
+		if !ok {
+			return "", apperrors.ErrInvalidInput(fmt.Sprintf("unknown notification template key %s", preview.Key))
+		}
+		data = sample
+	}
+	res, err := Execute(preview.Content, data)
+	if err != nil {
+		return "", apperrors.ErrInvalidInput(err.Error())
+	}
+	return res, nil
+}
+
+func (u *useCase) SetPreferredLocale(ctx context.Context, investor entity.Investor, locale entity.Locale) error {
+	errorTemplate := "notificationTemplateUseCase SetPreferredLocale %w"
+	if !locale.IsValid() {
+		return apperrors.ErrInvalidInput(fmt.Sprintf("unsupported locale %s", locale))
+	}
+	investor.PreferredLocale = locale
+	if err := u.investorRepository.CreateIfNotExist(ctx, investor); err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	if err := u.investorRepository.UpdatePreferredLocale(ctx, investor.InvestorId, locale); err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	return nil
+}
+
+// validateContent renders content with the sample data of key so a template referencing unknown fields is refused
+func validateContent(key entity.NotificationTemplateKey, content string) error {
+	sample, ok := key.SampleData()
+	if !ok {
+		return apperrors.ErrInvalidInput(fmt.Sprintf("unknown notification template key %s", key))
+	}
+	if _, err := Execute(content, sample); err != nil {
+		return apperrors.ErrInvalidInput(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package notificationtemplate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestNotificationTemplateUseCase_Render(t *testing.T) {
+	t.Parallel()
+	key := entity.NotificationTemplateKeyLoanPackageReadyInterest
+	data := entity.LoanPackageOfferReadyNotify{InterestRate: decimal.NewFromFloat(0.099)}
+	viTemplate := entity.NotificationTemplate{Key: key, Locale: entity.LocaleVi, Content: "{{percent .InterestRate}}%/năm"}
+	enTemplate := entity.NotificationTemplate{Key: key, Locale: entity.LocaleEn, Content: "{{percent .InterestRate}}%/year"}
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockNotificationTemplateRepository, *mock.MockInvestorPersistenceRepository) {
+		repository := mock.NewMockNotificationTemplateRepository(t)
+		investorRepository := mock.NewMockInvestorPersistenceRepository(t)
+		useCase := NewUseCase(repository, investorRepository, mock.NewMockAtomicExecutorExecutePassthrough(t))
+		return useCase, repository, investorRepository
+	}
+
+	t.Run("render in preferred locale", func(t *testing.T) {
+		useCase, repository, investorRepository := newUseCase(t)
+		investorRepository.EXPECT().GetPreferredLocale(testifyMock.Anything, "investorId").Return(entity.LocaleEn, nil)
+		repository.EXPECT().GetAll(
+			testifyMock.Anything, entity.NotificationTemplateFilter{
+				Keys:    []entity.NotificationTemplateKey{key},
+				Locales: []entity.Locale{entity.LocaleEn, entity.DefaultLocale},
+			},
+		).Return([]entity.NotificationTemplate{viTemplate, enTemplate}, nil)
+
+		res, err := useCase.Render(context.Background(), "investorId", key, data)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "9.9%/year", res)
+	})
+
+	t.Run("fall back to default locale", func(t *testing.T) {
+		useCase, repository, investorRepository := newUseCase(t)
+		investorRepository.EXPECT().GetPreferredLocale(testifyMock.Anything, "investorId").Return(entity.LocaleEn, nil)
+		repository.EXPECT().GetAll(testifyMock.Anything, testifyMock.Anything).Return([]entity.NotificationTemplate{viTemplate}, nil)
+
+		res, err := useCase.Render(context.Background(), "investorId", key, data)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "9.9%/năm", res)
+	})
+
+	t.Run("no template", func(t *testing.T) {
+		useCase, repository, investorRepository := newUseCase(t)
+		investorRepository.EXPECT().GetPreferredLocale(testifyMock.Anything, "investorId").Return(entity.LocaleVi, nil)
+		repository.EXPECT().GetAll(testifyMock.Anything, testifyMock.Anything).Return(nil, nil)
+
+		_, err := useCase.Render(context.Background(), "investorId", key, data)
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("get preferred locale error", func(t *testing.T) {
+		useCase, _, investorRepository := newUseCase(t)
+		investorRepository.EXPECT().GetPreferredLocale(testifyMock.Anything, "investorId").Return("", errors.New("db error"))
+
+		_, err := useCase.Render(context.Background(), "investorId", key, data)
+
+		assert.Equal(t, "notificationTemplateUseCase Render db error", err.Error())
+	})
+}
+
+func TestNotificationTemplateUseCase_Create(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create success", func(t *testing.T) {
+		repository := mock.NewMockNotificationTemplateRepository(t)
+		useCase := NewUseCase(
+			repository, mock.NewMockInvestorPersistenceRepository(t), mock.NewMockAtomicExecutorExecutePassthrough(t),
+		)
+		template := entity.NotificationTemplate{
+			Key:     entity.NotificationTemplateKeyLoanPackageReadyLoanRate,
+			Locale:  entity.LocaleEn,
+			Content: "{{percent .LoanRate}}%",
+		}
+		repository.EXPECT().Create(testifyMock.Anything, template).Return(entity.NotificationTemplate{Id: 1}, nil)
+
+		res, err := useCase.Create(context.Background(), template)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Id)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		useCase := NewUseCase(
+			mock.NewMockNotificationTemplateRepository(t), mock.NewMockInvestorPersistenceRepository(t),
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+		)
+
+		_, err := useCase.Create(
+			context.Background(), entity.NotificationTemplate{
+				Key:     entity.NotificationTemplateKeyLoanPackageReadyLoanRate,
+				Locale:  entity.LocaleEn,
+				Content: "{{.Rate}}",
+			},
+		)
+
+		var appErr apperrors.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, apperrors.ErrInvalidInput("").Code, appErr.Code)
+	})
+
+	t.Run("unsupported locale", func(t *testing.T) {
+		useCase := NewUseCase(
+			mock.NewMockNotificationTemplateRepository(t), mock.NewMockInvestorPersistenceRepository(t),
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+		)
+
+		_, err := useCase.Create(
+			context.Background(), entity.NotificationTemplate{
+				Key:     entity.NotificationTemplateKeyLoanPackageReadyLoanRate,
+				Locale:  "fr",
+				Content: "{{percent .LoanRate}}%",
+			},
+		)
+
+		var appErr apperrors.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, apperrors.ErrInvalidInput("").Code, appErr.Code)
+	})
+}
+
+func TestNotificationTemplateUseCase_Preview(t *testing.T) {
+	t.Parallel()
+	useCase := NewUseCase(
+		mock.NewMockNotificationTemplateRepository(t), mock.NewMockInvestorPersistenceRepository(t),
+		mock.NewMockAtomicExecutorExecutePassthrough(t),
+	)
+
+	t.Run("preview with sample data", func(t *testing.T) {
+		res, err := useCase.Preview(
+			context.Background(), entity.NotificationTemplatePreview{
+				Key:     entity.NotificationTemplateKeySuggestedOfferCreatedTitle,
+				Content: `{{if eq .Config.ValueType "INTEREST_RATE"}}Margin offer feedback {{percent .Config.Value}}%/year{{end}}`,
+			},
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Margin offer feedback 9.9%/year", res)
+	})
+
+	t.Run("preview with given data", func(t *testing.T) {
+		res, err := useCase.Preview(
+			context.Background(), entity.NotificationTemplatePreview{
+				Key:     entity.NotificationTemplateKeyLoanPackageReadyLoanRate,
+				Content: "{{percent .LoanRate}}%",
+				Data:    map[string]any{"LoanRate": "0.45"},
+			},
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "45%", res)
+	})
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financing-offer/internal/core/entity"
+)
+
+type OdooLoanApprovalRepository interface {
+	// Upsert records the Odoo record of a submission sheet, replacing the one of an earlier submission of the same sheet
+	Upsert(ctx context.Context, approval entity.OdooLoanApproval) (entity.OdooLoanApproval, error)
+	GetBySubmissionId(ctx context.Context, submissionId int64) (entity.OdooLoanApproval, error)
+	// GetDue returns the records in syncStatus whose next attempt is due at the given time
+	GetDue(ctx context.Context, syncStatus entity.OdooLoanApprovalSyncStatus, at time.Time, limit int64) ([]entity.OdooLoanApproval, error)
+	Update(ctx context.Context, approval entity.OdooLoanApproval) error
+}
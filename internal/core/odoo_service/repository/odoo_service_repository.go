@@ -5,5 +5,11 @@ import (
 )
 
 type OdooServiceRepository interface {
-	SendLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) error
+	// SendLoanApprovalRequest creates a loan approval record and returns its Odoo id
+	SendLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) (int64, error)
+	// GetLoanApprovalDecisions returns the records among recordIds that were approved or refused in Odoo
+	GetLoanApprovalDecisions(recordIds []int64) ([]entity.OdooLoanApprovalDecision, error)
+	UpdateLoanApprovalState(recordId int64, state entity.OdooLoanApprovalState) error
+	// PreviewLoanApprovalRequest validates and renders the create call of SendLoanApprovalRequest without sending it
+	PreviewLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) (entity.OdooPayloadPreview, error)
 }
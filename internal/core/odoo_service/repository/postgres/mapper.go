@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func MapOdooLoanApprovalEntityToDb(approval entity.OdooLoanApproval) model.OdooLoanApproval {
+	return model.OdooLoanApproval{
+		ID:                approval.Id,
+		SubmissionSheetID: approval.SubmissionSheetId,
+		OdooRecordID:      approval.OdooRecordId,
+		SyncStatus:        string(approval.SyncStatus),
+		OdooState:         string(approval.OdooState),
+		Attempts:          approval.Attempts,
+		LastError:         approval.LastError,
+		NextAttemptAt:     approval.NextAttemptAt,
+		CreatedAt:         approval.CreatedAt,
+		UpdatedAt:         approval.UpdatedAt,
+	}
+}
+
+func MapOdooLoanApprovalDbToEntity(approval model.OdooLoanApproval) entity.OdooLoanApproval {
+	return entity.OdooLoanApproval{
+		Id:                approval.ID,
+		SubmissionSheetId: approval.SubmissionSheetID,
+		OdooRecordId:      approval.OdooRecordID,
+		SyncStatus:        entity.OdooLoanApprovalSyncStatus(approval.SyncStatus),
+		OdooState:         entity.OdooLoanApprovalState(approval.OdooState),
+		Attempts:          approval.Attempts,
+		LastError:         approval.LastError,
+		NextAttemptAt:     approval.NextAttemptAt,
+		CreatedAt:         approval.CreatedAt,
+		UpdatedAt:         approval.UpdatedAt,
+	}
+}
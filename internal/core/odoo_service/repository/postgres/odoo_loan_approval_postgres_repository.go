@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/odoo_service/repository"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+)
+
+var _ repository.OdooLoanApprovalRepository = (*OdooLoanApprovalPostgresRepository)(nil)
+
+type OdooLoanApprovalPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewOdooLoanApprovalPostgresRepository(getDbFunc database.GetDbFunc) *OdooLoanApprovalPostgresRepository {
+	return &OdooLoanApprovalPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *OdooLoanApprovalPostgresRepository) Upsert(ctx context.Context, approval entity.OdooLoanApproval) (entity.OdooLoanApproval, error) {
+	upserted := model.OdooLoanApproval{}
+	err := table.OdooLoanApproval.INSERT(table.OdooLoanApproval.MutableColumns).
+		MODEL(MapOdooLoanApprovalEntityToDb(approval)).
+		ON_CONFLICT(table.OdooLoanApproval.SubmissionSheetID).
+		DO_UPDATE(
+			postgres.SET(
+				table.OdooLoanApproval.OdooRecordID.SET(table.OdooLoanApproval.EXCLUDED.OdooRecordID),
+				table.OdooLoanApproval.SyncStatus.SET(table.OdooLoanApproval.EXCLUDED.SyncStatus),
+				table.OdooLoanApproval.OdooState.SET(table.OdooLoanApproval.EXCLUDED.OdooState),
+				table.OdooLoanApproval.Attempts.SET(table.OdooLoanApproval.EXCLUDED.Attempts),
+				table.OdooLoanApproval.LastError.SET(table.OdooLoanApproval.EXCLUDED.LastError),
+				table.OdooLoanApproval.NextAttemptAt.SET(table.OdooLoanApproval.EXCLUDED.NextAttemptAt),
+			),
+		).
+		RETURNING(table.OdooLoanApproval.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &upserted)
+	if err != nil {
+		return entity.OdooLoanApproval{}, fmt.Errorf("OdooLoanApprovalPostgresRepository Upsert: %w", err)
+	}
+	return MapOdooLoanApprovalDbToEntity(upserted), nil
+}
+
+func (r *OdooLoanApprovalPostgresRepository) GetBySubmissionId(ctx context.Context, submissionId int64) (entity.OdooLoanApproval, error) {
+	dest := model.OdooLoanApproval{}
+	err := table.OdooLoanApproval.SELECT(table.OdooLoanApproval.AllColumns).
+		WHERE(table.OdooLoanApproval.SubmissionSheetID.EQ(postgres.Int64(submissionId))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return entity.OdooLoanApproval{}, fmt.Errorf("OdooLoanApprovalPostgresRepository GetBySubmissionId: %w", err)
+	}
+	return MapOdooLoanApprovalDbToEntity(dest), nil
+}
+
+func (r *OdooLoanApprovalPostgresRepository) GetDue(ctx context.Context, syncStatus entity.OdooLoanApprovalSyncStatus, at time.Time, limit int64) ([]entity.OdooLoanApproval, error) {
+	dest := make([]model.OdooLoanApproval, 0)
+	err := table.OdooLoanApproval.SELECT(table.OdooLoanApproval.AllColumns).
+		WHERE(
+			table.OdooLoanApproval.SyncStatus.EQ(postgres.String(string(syncStatus))).
+				AND(table.OdooLoanApproval.NextAttemptAt.LT_EQ(postgres.TimestampT(at))),
+		).
+		ORDER_BY(table.OdooLoanApproval.NextAttemptAt.ASC()).
+		LIMIT(limit).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return nil, fmt.Errorf("OdooLoanApprovalPostgresRepository GetDue: %w", err)
+	}
+	res := make([]entity.OdooLoanApproval, 0, len(dest))
+	for _, approval := range dest {
+		res = append(res, MapOdooLoanApprovalDbToEntity(approval))
+	}
+	return res, nil
+}
+
+func (r *OdooLoanApprovalPostgresRepository) Update(ctx context.Context, approval entity.OdooLoanApproval) error {
+	_, err := table.OdooLoanApproval.UPDATE(
+		table.OdooLoanApproval.SyncStatus,
+		table.OdooLoanApproval.OdooState,
+		table.OdooLoanApproval.Attempts,
+		table.OdooLoanApproval.LastError,
+		table.OdooLoanApproval.NextAttemptAt,
+	).
+		MODEL(MapOdooLoanApprovalEntityToDb(approval)).
+		WHERE(table.OdooLoanApproval.ID.EQ(postgres.Int64(approval.Id))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("OdooLoanApprovalPostgresRepository Update: %w", err)
+	}
+	return nil
+}
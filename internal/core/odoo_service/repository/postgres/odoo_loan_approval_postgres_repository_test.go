@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestOdooLoanApprovalPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, _ := dbtest.New()
+	repo := NewOdooLoanApprovalPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	columns := []string{
+		"odoo_loan_approval.id",
+		"odoo_loan_approval.submission_sheet_id",
+		"odoo_loan_approval.odoo_record_id",
+		"odoo_loan_approval.sync_status",
+		"odoo_loan_approval.odoo_state",
+		"odoo_loan_approval.attempts",
+		"odoo_loan_approval.last_error",
+		"odoo_loan_approval.next_attempt_at",
+		"odoo_loan_approval.created_at",
+		"odoo_loan_approval.updated_at",
+	}
+
+	t.Run("UpsertSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)INSERT INTO public.odoo_loan_approval .+ON CONFLICT .+DO UPDATE .+RETURNING`).
+			WillReturnRows(mock.NewRows(columns).AddRow(1, 11, 101, "PENDING", "", 0, "", now, now, now))
+		upserted, err := repo.Upsert(
+			context.Background(), entity.OdooLoanApproval{
+				SubmissionSheetId: 11,
+				OdooRecordId:      101,
+				SyncStatus:        entity.OdooLoanApprovalSyncStatusPending,
+				NextAttemptAt:     now,
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(101), upserted.OdooRecordId)
+		assert.Equal(t, entity.OdooLoanApprovalSyncStatusPending, upserted.SyncStatus)
+	})
+
+	t.Run("GetBySubmissionIdNotFound", func(t *testing.T) {
+		mock.ExpectQuery(`(?s)SELECT .+FROM public.odoo_loan_approval`).WillReturnRows(mock.NewRows(columns))
+		_, err := repo.GetBySubmissionId(context.Background(), 11)
+		assert.ErrorIs(t, err, qrm.ErrNoRows)
+	})
+
+	t.Run("GetDueSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)SELECT .+FROM public.odoo_loan_approval.+ORDER BY .+LIMIT`).
+			WillReturnRows(
+				mock.NewRows(columns).
+					AddRow(1, 11, 101, "PENDING", "", 0, "", now, now, now).
+					AddRow(2, 12, 102, "PENDING", "", 2, "timeout", now, now, now),
+			)
+		pending, err := repo.GetDue(context.Background(), entity.OdooLoanApprovalSyncStatusPending, now, 10)
+		assert.Nil(t, err)
+		assert.Len(t, pending, 2)
+		assert.Equal(t, int32(2), pending[1].Attempts)
+	})
+
+	t.Run("UpdateSuccess", func(t *testing.T) {
+		mock.ExpectExec("UPDATE public.odoo_loan_approval").WillReturnResult(sqlmock.NewResult(0, 1))
+		err := repo.Update(context.Background(), entity.OdooLoanApproval{Id: 1, SyncStatus: entity.OdooLoanApprovalSyncStatusSynced})
+		assert.Nil(t, err)
+	})
+
+	t.Run("UpdateError", func(t *testing.T) {
+		mock.ExpectExec("UPDATE").WillReturnError(fmt.Errorf("error"))
+		err := repo.Update(context.Background(), entity.OdooLoanApproval{Id: 1})
+		assert.Equal(t, "OdooLoanApprovalPostgresRepository Update: error", err.Error())
+	})
+}
@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/outbox/repository"
+	"financing-offer/internal/event"
+	"financing-offer/internal/tracing"
+)
+
+var _ event.Publisher = (*Publisher)(nil)
+
+// Publisher stores messages in the outbox table instead of sending them to kafka directly,
+// so a message is only relayed when the transaction carried by ctx commits
+type Publisher struct {
+	repository repository.OutboxMessageRepository
+}
+
+func NewPublisher(repository repository.OutboxMessageRepository) *Publisher {
+	return &Publisher{repository: repository}
+}
+
+func (p *Publisher) Publish(ctx context.Context, message kafka.Message) error {
+	if _, err := p.repository.Create(
+		ctx, entity.OutboxMessage{
+			Topic:         message.Topic,
+			MessageKey:    string(message.Key),
+			Payload:       message.Value,
+			Status:        entity.OutboxMessageStatusPending,
+			NextAttemptAt: time.Now(),
+			TraceContext:  tracing.Inject(ctx),
+		},
+	); err != nil {
+		return fmt.Errorf("outbox Publisher Publish: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"financing-offer/internal/core/entity"
+)
+
+type OutboxMessageRepository interface {
+	Create(ctx context.Context, message entity.OutboxMessage) (entity.OutboxMessage, error)
+	// GetDeliverableForUpdate locks pending messages that are due and are the oldest pending message of their key
+	GetDeliverableForUpdate(ctx context.Context, limit int64) ([]entity.OutboxMessage, error)
+	Update(ctx context.Context, message entity.OutboxMessage) error
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func MapOutboxMessageDbToEntity(message model.OutboxMessage) entity.OutboxMessage {
+	// an unreadable trace context only costs the link of the relayed message to its trace
+	traceContext := make(map[string]string)
+	_ = json.Unmarshal([]byte(message.TraceContext), &traceContext)
+	return entity.OutboxMessage{
+		Id:            message.ID,
+		Topic:         message.Topic,
+		MessageKey:    message.MessageKey,
+		Payload:       message.Payload,
+		Status:        entity.OutboxMessageStatus(message.Status),
+		Attempts:      message.Attempts,
+		NextAttemptAt: message.NextAttemptAt,
+		LastError:     message.LastError,
+		SentAt:        message.SentAt,
+		CreatedAt:     message.CreatedAt,
+		UpdatedAt:     message.UpdatedAt,
+		TraceContext:  traceContext,
+	}
+}
+
+func MapOutboxMessageEntityToDb(message entity.OutboxMessage) model.OutboxMessage {
+	traceContext, _ := json.Marshal(message.TraceContext)
+	return model.OutboxMessage{
+		ID:            message.Id,
+		Topic:         message.Topic,
+		MessageKey:    message.MessageKey,
+		Payload:       message.Payload,
+		Status:        string(message.Status),
+		Attempts:      message.Attempts,
+		NextAttemptAt: message.NextAttemptAt,
+		LastError:     message.LastError,
+		SentAt:        message.SentAt,
+		CreatedAt:     message.CreatedAt,
+		UpdatedAt:     message.UpdatedAt,
+		TraceContext:  string(traceContext),
+	}
+}
+
+func MapOutboxMessagesDbToEntity(messages []model.OutboxMessage) []entity.OutboxMessage {
+	res := make([]entity.OutboxMessage, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, MapOutboxMessageDbToEntity(message))
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/outbox/repository"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+)
+
+var _ repository.OutboxMessageRepository = (*OutboxMessagePostgresRepository)(nil)
+
+type OutboxMessagePostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewOutboxMessagePostgresRepository(getDbFunc database.GetDbFunc) *OutboxMessagePostgresRepository {
+	return &OutboxMessagePostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *OutboxMessagePostgresRepository) Create(ctx context.Context, message entity.OutboxMessage) (entity.OutboxMessage, error) {
+	created := model.OutboxMessage{}
+	err := table.OutboxMessage.INSERT(table.OutboxMessage.MutableColumns).
+		MODEL(MapOutboxMessageEntityToDb(message)).
+		RETURNING(table.OutboxMessage.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &created)
+	if err != nil {
+		return entity.OutboxMessage{}, fmt.Errorf("OutboxMessagePostgresRepository Create: %w", err)
+	}
+	return MapOutboxMessageDbToEntity(created), nil
+}
+
+func (r *OutboxMessagePostgresRepository) GetDeliverableForUpdate(ctx context.Context, limit int64) ([]entity.OutboxMessage, error) {
+	pending := postgres.String(string(entity.OutboxMessageStatusPending))
+	earlier := table.OutboxMessage.AS("earlier_message")
+	dest := make([]model.OutboxMessage, 0)
+	err := table.OutboxMessage.SELECT(table.OutboxMessage.AllColumns).
+		WHERE(
+			table.OutboxMessage.Status.EQ(pending).
+				AND(table.OutboxMessage.NextAttemptAt.LT_EQ(postgres.TimestampT(time.Now()))).
+				AND(
+					postgres.NOT(
+						postgres.EXISTS(
+							earlier.SELECT(earlier.ID).WHERE(
+								earlier.MessageKey.EQ(table.OutboxMessage.MessageKey).
+									AND(earlier.Status.EQ(pending)).
+									AND(earlier.ID.LT(table.OutboxMessage.ID)),
+							),
+						),
+					),
+				),
+		).
+		ORDER_BY(table.OutboxMessage.ID.ASC()).
+		LIMIT(limit).
+		FOR(postgres.UPDATE().SKIP_LOCKED()).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return nil, fmt.Errorf("OutboxMessagePostgresRepository GetDeliverableForUpdate: %w", err)
+	}
+	return MapOutboxMessagesDbToEntity(dest), nil
+}
+
+func (r *OutboxMessagePostgresRepository) Update(ctx context.Context, message entity.OutboxMessage) error {
+	updateModel := MapOutboxMessageEntityToDb(message)
+	_, err := table.OutboxMessage.UPDATE(
+		table.OutboxMessage.Status,
+		table.OutboxMessage.Attempts,
+		table.OutboxMessage.NextAttemptAt,
+		table.OutboxMessage.LastError,
+		table.OutboxMessage.SentAt,
+	).
+		MODEL(updateModel).
+		WHERE(table.OutboxMessage.ID.EQ(postgres.Int64(message.Id))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("OutboxMessagePostgresRepository Update: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestOutboxMessagePostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, _ := dbtest.New()
+	repo := NewOutboxMessagePostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	columns := []string{
+		"outbox_message.id",
+		"outbox_message.topic",
+		"outbox_message.message_key",
+		"outbox_message.payload",
+		"outbox_message.status",
+		"outbox_message.attempts",
+		"outbox_message.next_attempt_at",
+		"outbox_message.last_error",
+		"outbox_message.sent_at",
+		"outbox_message.created_at",
+		"outbox_message.updated_at",
+	}
+
+	t.Run("CreateSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery("INSERT INTO public.outbox_message").WillReturnRows(
+			mock.NewRows(columns).AddRow(
+				1, "notification", "investorId", []byte("payload"), "PENDING", 0, now, "", nil, now, now,
+			),
+		)
+		created, err := repo.Create(context.Background(), entity.OutboxMessage{
+			Topic:      "notification",
+			MessageKey: "investorId",
+			Payload:    []byte("payload"),
+			Status:     entity.OutboxMessageStatusPending,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), created.Id)
+		assert.Equal(t, entity.OutboxMessageStatusPending, created.Status)
+		assert.Equal(t, []byte("payload"), created.Payload)
+	})
+
+	t.Run("CreateFailure", func(t *testing.T) {
+		mock.ExpectQuery("INSERT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.Create(context.Background(), entity.OutboxMessage{})
+		assert.Equal(t, "OutboxMessagePostgresRepository Create: jet: error", err.Error())
+	})
+
+	t.Run("GetDeliverableForUpdateSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)SELECT .+ FROM public.outbox_message .+NOT \(EXISTS .+FOR UPDATE SKIP LOCKED`).
+			WillReturnRows(
+				mock.NewRows(columns).
+					AddRow(1, "notification", "investor-1", []byte("a"), "PENDING", 0, now, "", nil, now, now).
+					AddRow(2, "notification", "investor-2", []byte("b"), "PENDING", 1, now, "error", nil, now, now),
+			)
+		messages, err := repo.GetDeliverableForUpdate(context.Background(), 10)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(messages))
+		assert.Equal(t, "investor-2", messages[1].MessageKey)
+		assert.Equal(t, int32(1), messages[1].Attempts)
+	})
+
+	t.Run("GetDeliverableForUpdateFailure", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.GetDeliverableForUpdate(context.Background(), 10)
+		assert.Equal(t, "OutboxMessagePostgresRepository GetDeliverableForUpdate: jet: error", err.Error())
+	})
+
+	t.Run("UpdateSuccess", func(t *testing.T) {
+		mock.ExpectExec("UPDATE public.outbox_message").WillReturnResult(sqlmock.NewResult(0, 1))
+		err := repo.Update(context.Background(), entity.OutboxMessage{Id: 1, Status: entity.OutboxMessageStatusSent})
+		assert.Nil(t, err)
+	})
+
+	t.Run("UpdateFailure", func(t *testing.T) {
+		mock.ExpectExec("UPDATE").WillReturnError(fmt.Errorf("error"))
+		err := repo.Update(context.Background(), entity.OutboxMessage{Id: 1})
+		assert.Equal(t, "OutboxMessagePostgresRepository Update: error", err.Error())
+	})
+}
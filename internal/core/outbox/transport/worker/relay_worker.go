@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/outbox"
+)
+
+type RelayWorker struct {
+	cfg          config.OutboxConfig
+	logger       *slog.Logger
+	useCase      outbox.UseCase
+	errorService apperrors.Service
+}
+
+func NewRelayWorker(
+	cfg config.OutboxConfig,
+	logger *slog.Logger,
+	useCase outbox.UseCase,
+	errorService apperrors.Service,
+) *RelayWorker {
+	return &RelayWorker{
+		cfg:          cfg,
+		logger:       logger,
+		useCase:      useCase,
+		errorService: errorService,
+	}
+}
+
+// Run relays outbox messages every poll interval until ctx is cancelled
+func (w *RelayWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.relay(ctx)
+		}
+	}
+}
+
+// relay keeps draining full batches so a backlog does not wait for the next tick
+func (w *RelayWorker) relay(ctx context.Context) {
+	for ctx.Err() == nil {
+		relayed, err := w.useCase.RelayPendingMessages(ctx)
+		if err != nil {
+			w.logger.Error("RelayWorker relay", slog.String("error", err.Error()))
+			if notifyErr := w.errorService.NotifyError(ctx, err); notifyErr != nil {
+				w.logger.Error("RelayWorker NotifyError", slog.String("error", notifyErr.Error()))
+			}
+			return
+		}
+		if int64(relayed) < w.cfg.BatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/atomicity"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/outbox/repository"
+	"financing-offer/internal/event"
+	"financing-offer/internal/tracing"
+)
+
+type UseCase interface {
+	// RelayPendingMessages publishes the next batch of deliverable messages and returns the batch size
+	RelayPendingMessages(ctx context.Context) (int, error)
+}
+
+type useCase struct {
+	cfg            config.OutboxConfig
+	logger         *slog.Logger
+	repository     repository.OutboxMessageRepository
+	publisher      event.Publisher
+	atomicExecutor atomicity.AtomicExecutor
+	errorService   apperrors.Service
+}
+
+func NewUseCase(
+	cfg config.OutboxConfig,
+	logger *slog.Logger,
+	repository repository.OutboxMessageRepository,
+	publisher event.Publisher,
+	atomicExecutor atomicity.AtomicExecutor,
+	errorService apperrors.Service,
+) UseCase {
+	return &useCase{
+		cfg:            cfg,
+		logger:         logger,
+		repository:     repository,
+		publisher:      publisher,
+		atomicExecutor: atomicExecutor,
+		errorService:   errorService,
+	}
+}
+
+func (u *useCase) RelayPendingMessages(ctx context.Context) (int, error) {
+	errorTemplate := "outbox RelayPendingMessages %w"
+	deadMessages := make([]entity.OutboxMessage, 0)
+	relayed := 0
+	if err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			messages, err := u.repository.GetDeliverableForUpdate(tc, u.cfg.BatchSize)
+			if err != nil {
+				return err
+			}
+			relayed = len(messages)
+			for _, message := range messages {
+				message = u.deliver(ctx, message)
+				if message.Status == entity.OutboxMessageStatusDead {
+					deadMessages = append(deadMessages, message)
+				}
+				if err := u.repository.Update(tc, message); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	for _, message := range deadMessages {
+		deadErr := fmt.Errorf(
+			"outbox message %d (topic %s, key %s) is dead after %d attempts: %s",
+			message.Id, message.Topic, message.MessageKey, message.Attempts, message.LastError,
+		)
+		if err := u.errorService.NotifyError(ctx, deadErr); err != nil {
+			u.logger.Error("outbox RelayPendingMessages NotifyError", slog.String("error", err.Error()))
+		}
+	}
+	return relayed, nil
+}
+
+// deliver publishes a message and returns it with the delivery outcome applied
+func (u *useCase) deliver(ctx context.Context, message entity.OutboxMessage) entity.OutboxMessage {
+	now := time.Now()
+	err := u.publisher.Publish(
+		tracing.Extract(ctx, message.TraceContext), kafka.Message{
+			Topic: message.Topic,
+			Key:   []byte(message.MessageKey),
+			Value: message.Payload,
+		},
+	)
+	if err == nil {
+		message.Status = entity.OutboxMessageStatusSent
+		message.SentAt = &now
+		message.LastError = ""
+		return message
+	}
+	message.Attempts++
+	message.LastError = err.Error()
+	if message.Attempts >= u.cfg.MaxAttempts {
+		message.Status = entity.OutboxMessageStatusDead
+		return message
+	}
+	message.NextAttemptAt = now.Add(u.retryBackoff(message.Attempts))
+	return message
+}
+
+// retryBackoff doubles the configured backoff for every failed attempt, up to MaxRetryBackoff
+func (u *useCase) retryBackoff(attempts int32) time.Duration {
+	backoff := u.cfg.RetryBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if u.cfg.MaxRetryBackoff > 0 && backoff >= u.cfg.MaxRetryBackoff {
+			return u.cfg.MaxRetryBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,188 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/trace"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestOutboxUseCase_RelayPendingMessages(t *testing.T) {
+	t.Parallel()
+	cfg := config.OutboxConfig{
+		BatchSize:       10,
+		MaxAttempts:     3,
+		RetryBackoff:    time.Second,
+		MaxRetryBackoff: 3 * time.Second,
+	}
+	pendingMessage := entity.OutboxMessage{
+		Id:         1,
+		Topic:      "notification",
+		MessageKey: "investorId",
+		Payload:    []byte("payload"),
+		Status:     entity.OutboxMessageStatusPending,
+	}
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockOutboxMessageRepository, *mock.MockPublisher) {
+		repository := mock.NewMockOutboxMessageRepository(t)
+		publisher := mock.NewMockPublisher(t)
+		useCase := NewUseCase(
+			cfg,
+			slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			repository,
+			publisher,
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+			mock.ErrReporter{},
+		)
+		return useCase, repository, publisher
+	}
+
+	t.Run(
+		"RelayPendingMessages_sent", func(t *testing.T) {
+			useCase, repository, publisher := newUseCase(t)
+			repository.EXPECT().GetDeliverableForUpdate(testifyMock.Anything, cfg.BatchSize).
+				Return([]entity.OutboxMessage{pendingMessage}, nil)
+			publisher.EXPECT().Publish(
+				testifyMock.Anything, kafka.Message{
+					Topic: pendingMessage.Topic,
+					Key:   []byte(pendingMessage.MessageKey),
+					Value: pendingMessage.Payload,
+				},
+			).Return(nil)
+			repository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(message entity.OutboxMessage) bool {
+						return message.Status == entity.OutboxMessageStatusSent && message.SentAt != nil
+					},
+				),
+			).Return(nil)
+			relayed, err := useCase.RelayPendingMessages(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 1, relayed)
+		},
+	)
+
+	t.Run(
+		"RelayPendingMessages_retry_later", func(t *testing.T) {
+			useCase, repository, publisher := newUseCase(t)
+			repository.EXPECT().GetDeliverableForUpdate(testifyMock.Anything, cfg.BatchSize).
+				Return([]entity.OutboxMessage{pendingMessage}, nil)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(errors.New("kafka down"))
+			repository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(message entity.OutboxMessage) bool {
+						return message.Status == entity.OutboxMessageStatusPending &&
+							message.Attempts == 1 &&
+							message.LastError == "kafka down" &&
+							message.NextAttemptAt.After(time.Now())
+					},
+				),
+			).Return(nil)
+			_, err := useCase.RelayPendingMessages(context.Background())
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"RelayPendingMessages_dead_after_max_attempts", func(t *testing.T) {
+			useCase, repository, publisher := newUseCase(t)
+			exhaustedMessage := pendingMessage
+			exhaustedMessage.Attempts = cfg.MaxAttempts - 1
+			repository.EXPECT().GetDeliverableForUpdate(testifyMock.Anything, cfg.BatchSize).
+				Return([]entity.OutboxMessage{exhaustedMessage}, nil)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(errors.New("kafka down"))
+			repository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(message entity.OutboxMessage) bool {
+						return message.Status == entity.OutboxMessageStatusDead && message.Attempts == cfg.MaxAttempts
+					},
+				),
+			).Return(nil)
+			_, err := useCase.RelayPendingMessages(context.Background())
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"RelayPendingMessages_repository_error", func(t *testing.T) {
+			useCase, repository, _ := newUseCase(t)
+			repository.EXPECT().GetDeliverableForUpdate(testifyMock.Anything, cfg.BatchSize).
+				Return(nil, assert.AnError)
+			_, err := useCase.RelayPendingMessages(context.Background())
+			assert.ErrorIs(t, err, assert.AnError)
+		},
+	)
+}
+
+func TestOutboxUseCase_retryBackoff(t *testing.T) {
+	t.Parallel()
+	u := &useCase{cfg: config.OutboxConfig{RetryBackoff: time.Second, MaxRetryBackoff: 5 * time.Second}}
+	assert.Equal(t, time.Second, u.retryBackoff(1))
+	assert.Equal(t, 2*time.Second, u.retryBackoff(2))
+	assert.Equal(t, 4*time.Second, u.retryBackoff(3))
+	assert.Equal(t, 5*time.Second, u.retryBackoff(4))
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	t.Parallel()
+	repository := mock.NewMockOutboxMessageRepository(t)
+	publisher := NewPublisher(repository)
+
+	t.Run(
+		"Publish_success", func(t *testing.T) {
+			repository.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(message entity.OutboxMessage) bool {
+						return message.Status == entity.OutboxMessageStatusPending &&
+							message.Topic == "notification" &&
+							message.MessageKey == "investorId"
+					},
+				),
+			).Return(entity.OutboxMessage{Id: 1}, nil).Once()
+			err := publisher.Publish(
+				context.Background(), kafka.Message{Topic: "notification", Key: []byte("investorId"), Value: []byte("payload")},
+			)
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"Publish_keeps_trace_context", func(t *testing.T) {
+			traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+			spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+			ctx := trace.ContextWithSpanContext(
+				context.Background(), trace.NewSpanContext(
+					trace.SpanContextConfig{TraceID: traceId, SpanID: spanId, TraceFlags: trace.FlagsSampled},
+				),
+			)
+			repository.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(message entity.OutboxMessage) bool {
+						return message.TraceContext["traceparent"] == "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+					},
+				),
+			).Return(entity.OutboxMessage{Id: 2}, nil).Once()
+			err := publisher.Publish(ctx, kafka.Message{Topic: "notification", Key: []byte("investorId")})
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"Publish_error", func(t *testing.T) {
+			repository.EXPECT().Create(testifyMock.Anything, testifyMock.Anything).
+				Return(entity.OutboxMessage{}, assert.AnError).Once()
+			err := publisher.Publish(context.Background(), kafka.Message{Topic: "notification"})
+			assert.ErrorIs(t, err, assert.AnError)
+		},
+	)
+}
@@ -0,0 +1,132 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"financing-offer/internal/core/entity"
+)
+
+const defaultJobTimeout = 30 * time.Minute
+
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt, a job is run once when it is not greater than 1
+	MaxAttempts int
+	// Backoff is the wait before the second attempt, doubled before each following one
+	Backoff time.Duration
+}
+
+// Job is a unit of scheduled work, a job without Cron only runs when an admin triggers it
+type Job struct {
+	Type    entity.JobType
+	Cron    string
+	Timeout time.Duration
+	Retry   RetryPolicy
+	// RunOnStart also runs the job once when the scheduler starts, on the instance taking its lock
+	RunOnStart bool
+	Run        func(ctx context.Context) error
+}
+
+func (j Job) attempts() int {
+	return max(j.Retry.MaxAttempts, 1)
+}
+
+func (j Job) timeout() time.Duration {
+	if j.Timeout <= 0 {
+		return defaultJobTimeout
+	}
+	return j.Timeout
+}
+
+func (j Job) Info() entity.SchedulerJobInfo {
+	return entity.SchedulerJobInfo{
+		Type:         j.Type,
+		Cron:         j.Cron,
+		Scheduled:    j.Cron != "",
+		Timeout:      j.timeout().String(),
+		MaxAttempts:  j.attempts(),
+		RetryBackoff: j.Retry.Backoff.String(),
+	}
+}
+
+// JobRegistry holds the jobs in the order they are registered
+type JobRegistry struct {
+	jobs  map[entity.JobType]Job
+	order []entity.JobType
+}
+
+func NewJobRegistry() *JobRegistry {
+	return &JobRegistry{jobs: make(map[entity.JobType]Job)}
+}
+
+func (r *JobRegistry) Register(job Job) error {
+	if job.Type == "" || job.Run == nil {
+		return fmt.Errorf("JobRegistry Register job %q has no type or run func", job.Type)
+	}
+	if _, ok := r.jobs[job.Type]; ok {
+		return fmt.Errorf("JobRegistry Register job %q is already registered", job.Type)
+	}
+	r.jobs[job.Type] = job
+	r.order = append(r.order, job.Type)
+	return nil
+}
+
+func (r *JobRegistry) Get(jobType entity.JobType) (Job, bool) {
+	job, ok := r.jobs[jobType]
+	return job, ok
+}
+
+func (r *JobRegistry) Jobs() []Job {
+	jobs := make([]Job, 0, len(r.order))
+	for _, jobType := range r.order {
+		jobs = append(jobs, r.jobs[jobType])
+	}
+	return jobs
+}
+
+type finalAttemptKey struct{}
+
+// FinalAttempt reports whether the run of ctx has no attempt left after the current one, so a job notifies
+// its failure once per run. It is true outside a run of the JobRunner
+func FinalAttempt(ctx context.Context) bool {
+	final, ok := ctx.Value(finalAttemptKey{}).(bool)
+	return !ok || final
+}
+
+type trackingKey struct{}
+
+type runTracking struct {
+	mu   sync.Mutex
+	data map[string]any
+}
+
+// Track adds key to the tracking data of the run of ctx, it does nothing outside a run of the JobRunner
+func Track(ctx context.Context, key string, value any) {
+	tracking, ok := ctx.Value(trackingKey{}).(*runTracking)
+	if !ok {
+		return
+	}
+	tracking.mu.Lock()
+	defer tracking.mu.Unlock()
+	tracking.data[key] = value
+}
+
+func withTracking(ctx context.Context) (context.Context, *runTracking) {
+	tracking := &runTracking{data: make(map[string]any)}
+	return context.WithValue(ctx, trackingKey{}, tracking), tracking
+}
+
+func (t *runTracking) snapshot() map[string]any {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.data) == 0 {
+		return nil
+	}
+	data := make(map[string]any, len(t.data))
+	for key, value := range t.data {
+		data[key] = value
+	}
+	return data
+}
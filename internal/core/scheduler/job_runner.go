@@ -0,0 +1,258 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/scheduler/repository"
+	"financing-offer/internal/metrics"
+)
+
+const jobTriggerBy = "system"
+
+// JobRunner runs each job on a single instance at a time and records every run in the scheduler_job table,
+// a cron tick is run once across the instances
+type JobRunner struct {
+	logger         *slog.Logger
+	lockRepository repository.JobLockRepository
+	jobRepository  repository.SchedulerJobRepository
+	instance       string
+	triggered      sync.WaitGroup
+}
+
+func NewJobRunner(
+	logger *slog.Logger,
+	lockRepository repository.JobLockRepository,
+	jobRepository repository.SchedulerJobRepository,
+) *JobRunner {
+	instance, err := os.Hostname()
+	if err != nil {
+		instance = "unknown"
+	}
+	return &JobRunner{
+		logger:         logger,
+		lockRepository: lockRepository,
+		jobRepository:  jobRepository,
+		instance:       instance,
+	}
+}
+
+// Wrap adapts job to a cron func, the parser of the scheduler has a minute resolution
+// so every instance truncates its tick to the same minute
+func (r *JobRunner) Wrap(job Job) func() {
+	return func() {
+		r.Run(context.Background(), job, time.Now().Truncate(time.Minute))
+	}
+}
+
+// Run runs the tick scheduledAt of job when this instance takes its lock and no other instance ran the tick yet,
+// and returns the recorded status. A tick left to another instance is only logged, a failure to take the lock is recorded
+func (r *JobRunner) Run(ctx context.Context, job Job, scheduledAt time.Time) entity.JobStatus {
+	logger := r.jobLogger(job).With(slog.Time("scheduledAt", scheduledAt))
+	release, acquired, err := r.lockRepository.TryAcquire(ctx, job.Type)
+	if err != nil {
+		logger.Error("scheduler job lock failed, run skipped", slog.String("error", err.Error()))
+		metrics.JobRuns.WithLabelValues(string(job.Type), string(entity.JobStatusSkipped)).Inc()
+		r.record(
+			ctx, logger, job.Type, entity.JobStatusSkipped, jobTriggerBy,
+			entity.SchedulerJobRunTracking{Instance: r.instance, Reason: "lock failed: " + err.Error()},
+		)
+		return entity.JobStatusSkipped
+	}
+	if !acquired {
+		logger.Info("scheduler job lock held by another instance, run skipped")
+		metrics.JobRuns.WithLabelValues(string(job.Type), string(entity.JobStatusSkipped)).Inc()
+		return entity.JobStatusSkipped
+	}
+	defer r.release(logger, release)
+	logger.Info("scheduler job lock acquired")
+	run, err := r.jobRepository.StartRun(
+		ctx, entity.SchedulerJob{
+			JobType:      job.Type,
+			TriggerBy:    jobTriggerBy,
+			TrackingData: marshalTracking(entity.SchedulerJobRunTracking{Instance: r.instance}),
+			ScheduledAt:  &scheduledAt,
+		},
+	)
+	if errors.Is(err, apperrors.ErrSchedulerJobTickTaken) {
+		// an instance whose tick fired earlier already ran the job and released the lock
+		logger.Info("scheduler job tick already run by another instance, run skipped")
+		metrics.JobRuns.WithLabelValues(string(job.Type), string(entity.JobStatusSkipped)).Inc()
+		return entity.JobStatusSkipped
+	}
+	if err != nil {
+		// the history is best effort, the job still runs under the lock
+		logger.Error("scheduler job start not recorded", slog.String("error", err.Error()))
+	}
+	return r.execute(ctx, logger, job, run, jobTriggerBy)
+}
+
+// Trigger starts job in the background on behalf of triggerBy and returns its RUNNING run,
+// it fails with ErrSchedulerJobRunning instead of waiting for the instance holding the lock
+func (r *JobRunner) Trigger(ctx context.Context, job Job, triggerBy string) (entity.SchedulerJob, error) {
+	logger := r.jobLogger(job)
+	release, acquired, err := r.lockRepository.TryAcquire(ctx, job.Type)
+	if err != nil {
+		return entity.SchedulerJob{}, fmt.Errorf("JobRunner Trigger %w", err)
+	}
+	if !acquired {
+		logger.Info("scheduler job lock held by another instance, trigger rejected", slog.String("triggerBy", triggerBy))
+		return entity.SchedulerJob{}, apperrors.ErrSchedulerJobRunning
+	}
+	run, err := r.startRun(ctx, job, triggerBy)
+	if err != nil {
+		r.release(logger, release)
+		return entity.SchedulerJob{}, fmt.Errorf("JobRunner Trigger %w", err)
+	}
+	logger.Info("scheduler job triggered", slog.String("triggerBy", triggerBy), slog.Int64("runId", run.Id))
+	r.triggered.Add(1)
+	go func() {
+		defer r.triggered.Done()
+		defer r.release(logger, release)
+		// the run outlives the request that triggered it
+		r.execute(context.WithoutCancel(ctx), logger, job, run, triggerBy)
+	}()
+	return run, nil
+}
+
+// Wait blocks until the triggered runs of this instance are finished
+func (r *JobRunner) Wait() {
+	r.triggered.Wait()
+}
+
+func (r *JobRunner) jobLogger(job Job) *slog.Logger {
+	return r.logger.With(slog.String("job", string(job.Type)), slog.String("instance", r.instance))
+}
+
+func (r *JobRunner) release(logger *slog.Logger, release func() error) {
+	if err := release(); err != nil {
+		logger.Error("scheduler job lock release failed", slog.String("error", err.Error()))
+	}
+}
+
+func (r *JobRunner) startRun(ctx context.Context, job Job, triggerBy string) (entity.SchedulerJob, error) {
+	return r.jobRepository.StartRun(
+		ctx, entity.SchedulerJob{
+			JobType:      job.Type,
+			TriggerBy:    triggerBy,
+			TrackingData: marshalTracking(entity.SchedulerJobRunTracking{Instance: r.instance}),
+		},
+	)
+}
+
+// execute runs job until an attempt succeeds or the attempts of its retry policy are exhausted, then finishes run
+func (r *JobRunner) execute(
+	ctx context.Context,
+	logger *slog.Logger,
+	job Job,
+	run entity.SchedulerJob,
+	triggerBy string,
+) entity.JobStatus {
+	trackedCtx, tracking := withTracking(ctx)
+	startedAt := time.Now()
+	attempts := 0
+	var jobErr error
+	for attempts < job.attempts() {
+		if attempts > 0 {
+			backoff := job.Retry.Backoff << (attempts - 1)
+			logger.Warn(
+				"scheduler job attempt failed, retrying", slog.Int("attempt", attempts),
+				slog.Duration("backoff", backoff), slog.String("error", jobErr.Error()),
+			)
+			if !sleep(ctx, backoff) {
+				break
+			}
+		}
+		attempts++
+		jobErr = attempt(context.WithValue(trackedCtx, finalAttemptKey{}, attempts == job.attempts()), job)
+		if jobErr == nil {
+			break
+		}
+	}
+	result := entity.SchedulerJobRunTracking{
+		Instance:   r.instance,
+		DurationMs: time.Since(startedAt).Milliseconds(),
+		Attempts:   attempts,
+		Data:       tracking.snapshot(),
+	}
+	status := entity.JobStatusSuccess
+	if jobErr != nil {
+		status = entity.JobStatusFail
+		result.Error = jobErr.Error()
+	}
+	logger.Info(
+		"scheduler job finished", slog.String("status", string(status)),
+		slog.Int("attempts", attempts), slog.Int64("durationMs", result.DurationMs),
+	)
+	metrics.JobRuns.WithLabelValues(string(job.Type), string(status)).Inc()
+	metrics.JobDuration.WithLabelValues(string(job.Type), string(status)).Observe(time.Since(startedAt).Seconds())
+	if run.Id == 0 {
+		r.record(ctx, logger, job.Type, status, triggerBy, result)
+		return status
+	}
+	if err := r.jobRepository.FinishRun(ctx, run.Id, status, marshalTracking(result)); err != nil {
+		logger.Error("scheduler job finish not recorded", slog.String("error", err.Error()))
+	}
+	return status
+}
+
+func attempt(ctx context.Context, job Job) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, job.timeout())
+	defer cancel()
+	// a triggered run has no cron recover around it
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panicked: %v", p)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (r *JobRunner) record(
+	ctx context.Context,
+	logger *slog.Logger,
+	jobType entity.JobType,
+	status entity.JobStatus,
+	triggerBy string,
+	tracking entity.SchedulerJobRunTracking,
+) {
+	if err := r.jobRepository.Create(
+		ctx, entity.SchedulerJob{
+			JobType:      jobType,
+			JobStatus:    status,
+			TriggerBy:    triggerBy,
+			TrackingData: marshalTracking(tracking),
+		},
+	); err != nil {
+		logger.Error("scheduler job run not recorded", slog.String("error", err.Error()))
+	}
+}
+
+func marshalTracking(tracking entity.SchedulerJobRunTracking) string {
+	data, err := json.Marshal(tracking)
+	if err != nil {
+		// a value passed to Track cannot be encoded, the run is still recorded without it
+		tracking.Data = nil
+		data, _ = json.Marshal(tracking)
+	}
+	return string(data)
+}
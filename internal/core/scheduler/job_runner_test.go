@@ -0,0 +1,319 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestJobRunner(t *testing.T) {
+	t.Parallel()
+	newRunner := func(t *testing.T) (*JobRunner, *mock.MockJobLockRepository, *mock.MockSchedulerJobRepository) {
+		lockRepository := mock.NewMockJobLockRepository(t)
+		jobRepository := mock.NewMockSchedulerJobRepository(t)
+		runner := NewJobRunner(slog.New(slog.NewJSONHandler(os.Stdout, nil)), lockRepository, jobRepository)
+		runner.instance = "pod-1"
+		return runner, lockRepository, jobRepository
+	}
+	tracking := func(data string) entity.SchedulerJobRunTracking {
+		var t entity.SchedulerJobRunTracking
+		_ = json.Unmarshal([]byte(data), &t)
+		return t
+	}
+	tick := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	mustNotRun := func(t *testing.T) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			t.Fatal("job must not run without the lock")
+			return nil
+		}
+	}
+
+	t.Run(
+		"run job under lock", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			released := false
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeExpireLoanOffers).Return(
+				func() error {
+					released = true
+					return nil
+				}, true, nil,
+			)
+			jobRepository.EXPECT().StartRun(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(job entity.SchedulerJob) bool {
+						return job.JobType == entity.JobTypeExpireLoanOffers && tracking(job.TrackingData).Instance == "pod-1" &&
+							job.ScheduledAt != nil && job.ScheduledAt.Equal(tick)
+					},
+				),
+			).Return(entity.SchedulerJob{Id: 5, JobStatus: entity.JobStatusRunning}, nil)
+			jobRepository.EXPECT().FinishRun(
+				testifyMock.Anything, int64(5), entity.JobStatusSuccess, testifyMock.MatchedBy(
+					func(data string) bool {
+						runTracking := tracking(data)
+						return runTracking.Attempts == 1 && runTracking.Data["expired"] == float64(3)
+					},
+				),
+			).Return(nil)
+			status := runner.Run(
+				context.Background(), Job{
+					Type: entity.JobTypeExpireLoanOffers,
+					Run: func(ctx context.Context) error {
+						Track(ctx, "expired", 3)
+						return nil
+					},
+				}, tick,
+			)
+			assert.Equal(t, entity.JobStatusSuccess, status)
+			assert.True(t, released)
+		},
+	)
+
+	t.Run(
+		"record job failure after retries", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeDeclineLoanRequests).Return(
+				func() error { return nil }, true, nil,
+			)
+			jobRepository.EXPECT().StartRun(testifyMock.Anything, testifyMock.Anything).Return(entity.SchedulerJob{Id: 6}, nil)
+			jobRepository.EXPECT().FinishRun(
+				testifyMock.Anything, int64(6), entity.JobStatusFail, testifyMock.MatchedBy(
+					func(data string) bool {
+						runTracking := tracking(data)
+						return runTracking.Error == "boom" && runTracking.Attempts == 2
+					},
+				),
+			).Return(nil)
+			calls := 0
+			status := runner.Run(
+				context.Background(), Job{
+					Type:  entity.JobTypeDeclineLoanRequests,
+					Retry: RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond},
+					Run: func(ctx context.Context) error {
+						calls++
+						return errors.New("boom")
+					},
+				}, tick,
+			)
+			assert.Equal(t, entity.JobStatusFail, status)
+			assert.Equal(t, 2, calls)
+		},
+	)
+
+	t.Run(
+		"succeed on retry", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeSyncOdooApprovals).Return(
+				func() error { return nil }, true, nil,
+			)
+			jobRepository.EXPECT().StartRun(testifyMock.Anything, testifyMock.Anything).Return(entity.SchedulerJob{Id: 7}, nil)
+			jobRepository.EXPECT().FinishRun(
+				testifyMock.Anything, int64(7), entity.JobStatusSuccess, testifyMock.MatchedBy(
+					func(data string) bool {
+						return tracking(data).Attempts == 2
+					},
+				),
+			).Return(nil)
+			calls := 0
+			status := runner.Run(
+				context.Background(), Job{
+					Type:  entity.JobTypeSyncOdooApprovals,
+					Retry: RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond},
+					Run: func(ctx context.Context) error {
+						calls++
+						if calls == 1 {
+							return errors.New("odoo unavailable")
+						}
+						return nil
+					},
+				}, tick,
+			)
+			assert.Equal(t, entity.JobStatusSuccess, status)
+			assert.Equal(t, 2, calls)
+		},
+	)
+
+	t.Run(
+		"mark only the last attempt as final", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeDeclineLoanRequests).Return(
+				func() error { return nil }, true, nil,
+			)
+			jobRepository.EXPECT().StartRun(testifyMock.Anything, testifyMock.Anything).Return(entity.SchedulerJob{Id: 9}, nil)
+			jobRepository.EXPECT().FinishRun(testifyMock.Anything, int64(9), entity.JobStatusFail, testifyMock.Anything).Return(nil)
+			var finals []bool
+			status := runner.Run(
+				context.Background(), Job{
+					Type:  entity.JobTypeDeclineLoanRequests,
+					Retry: RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond},
+					Run: func(ctx context.Context) error {
+						finals = append(finals, FinalAttempt(ctx))
+						return errors.New("database unavailable")
+					},
+				}, tick,
+			)
+			assert.Equal(t, entity.JobStatusFail, status)
+			assert.Equal(t, []bool{false, false, true}, finals)
+			assert.True(t, FinalAttempt(context.Background()))
+		},
+	)
+
+	t.Run(
+		"fail a panicking job", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeFillInvestorIds).Return(
+				func() error { return nil }, true, nil,
+			)
+			jobRepository.EXPECT().StartRun(testifyMock.Anything, testifyMock.Anything).Return(entity.SchedulerJob{Id: 8}, nil)
+			jobRepository.EXPECT().FinishRun(testifyMock.Anything, int64(8), entity.JobStatusFail, testifyMock.Anything).Return(nil)
+			status := runner.Run(
+				context.Background(), Job{
+					Type: entity.JobTypeFillInvestorIds,
+					Run: func(ctx context.Context) error {
+						panic("nil map")
+					},
+				}, tick,
+			)
+			assert.Equal(t, entity.JobStatusFail, status)
+		},
+	)
+
+	t.Run(
+		"skip without recording when lock is held", func(t *testing.T) {
+			runner, lockRepository, _ := newRunner(t)
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeExpireLoanOffers).Return(nil, false, nil)
+			status := runner.Run(
+				context.Background(), Job{Type: entity.JobTypeExpireLoanOffers, Run: mustNotRun(t)}, tick,
+			)
+			assert.Equal(t, entity.JobStatusSkipped, status)
+		},
+	)
+
+	t.Run(
+		"record skip when lock fails", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeExpireLoanOffers).Return(
+				nil, false, errors.New("connection refused"),
+			)
+			jobRepository.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(job entity.SchedulerJob) bool {
+						return job.JobStatus == entity.JobStatusSkipped &&
+							tracking(job.TrackingData).Reason == "lock failed: connection refused"
+					},
+				),
+			).Return(nil)
+			status := runner.Run(
+				context.Background(), Job{Type: entity.JobTypeExpireLoanOffers, Run: mustNotRun(t)}, tick,
+			)
+			assert.Equal(t, entity.JobStatusSkipped, status)
+		},
+	)
+
+	t.Run(
+		"skip a tick already run by another instance", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			released := false
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeExpireLoanOffers).Return(
+				func() error {
+					released = true
+					return nil
+				}, true, nil,
+			)
+			jobRepository.EXPECT().StartRun(testifyMock.Anything, testifyMock.Anything).Return(
+				entity.SchedulerJob{}, fmt.Errorf("SchedulerJobRepository StartRun: %w", apperrors.ErrSchedulerJobTickTaken),
+			)
+			status := runner.Run(
+				context.Background(), Job{Type: entity.JobTypeExpireLoanOffers, Run: mustNotRun(t)}, tick,
+			)
+			assert.Equal(t, entity.JobStatusSkipped, status)
+			assert.True(t, released)
+		},
+	)
+
+	t.Run(
+		"record run when start is not recorded", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypePurgeIdempotency).Return(
+				func() error { return nil }, true, nil,
+			)
+			jobRepository.EXPECT().StartRun(testifyMock.Anything, testifyMock.Anything).Return(
+				entity.SchedulerJob{}, errors.New("insert failed"),
+			)
+			jobRepository.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(job entity.SchedulerJob) bool {
+						return job.JobStatus == entity.JobStatusSuccess
+					},
+				),
+			).Return(nil)
+			status := runner.Run(
+				context.Background(), Job{
+					Type: entity.JobTypePurgeIdempotency,
+					Run: func(ctx context.Context) error {
+						return nil
+					},
+				}, tick,
+			)
+			assert.Equal(t, entity.JobStatusSuccess, status)
+		},
+	)
+
+	t.Run(
+		"trigger runs job in background", func(t *testing.T) {
+			runner, lockRepository, jobRepository := newRunner(t)
+			released := make(chan struct{})
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeSyncLoanPackageData).Return(
+				func() error {
+					close(released)
+					return nil
+				}, true, nil,
+			)
+			jobRepository.EXPECT().StartRun(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(job entity.SchedulerJob) bool {
+						return job.TriggerBy == "admin"
+					},
+				),
+			).Return(entity.SchedulerJob{Id: 9, JobStatus: entity.JobStatusRunning, TriggerBy: "admin"}, nil)
+			jobRepository.EXPECT().FinishRun(testifyMock.Anything, int64(9), entity.JobStatusSuccess, testifyMock.Anything).Return(nil)
+			ctx, cancel := context.WithCancel(context.Background())
+			run, err := runner.Trigger(
+				ctx, Job{
+					Type: entity.JobTypeSyncLoanPackageData,
+					Run: func(ctx context.Context) error {
+						return ctx.Err()
+					},
+				}, "admin",
+			)
+			// the request ends before the run
+			cancel()
+			assert.Nil(t, err)
+			assert.Equal(t, int64(9), run.Id)
+			runner.Wait()
+			<-released
+		},
+	)
+
+	t.Run(
+		"reject trigger when lock is held", func(t *testing.T) {
+			runner, lockRepository, _ := newRunner(t)
+			lockRepository.EXPECT().TryAcquire(testifyMock.Anything, entity.JobTypeSyncLoanPackageData).Return(nil, false, nil)
+			_, err := runner.Trigger(
+				context.Background(), Job{Type: entity.JobTypeSyncLoanPackageData, Run: mustNotRun(t)}, "admin",
+			)
+			assert.Equal(t, apperrors.ErrSchedulerJobRunning, err)
+		},
+	)
+}
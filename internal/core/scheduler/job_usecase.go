@@ -0,0 +1,104 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/scheduler/repository"
+)
+
+type JobUseCase interface {
+	GetJobs(ctx context.Context) ([]entity.SchedulerJobInfo, error)
+	GetRuns(ctx context.Context, filter entity.SchedulerJobFilter) ([]entity.SchedulerJob, core.PagingMetaData, error)
+	Trigger(ctx context.Context, jobType entity.JobType, triggerBy string) (entity.SchedulerJob, error)
+}
+
+type jobUseCase struct {
+	registry      *JobRegistry
+	runner        *JobRunner
+	jobRepository repository.SchedulerJobRepository
+}
+
+func NewJobUseCase(registry *JobRegistry, runner *JobRunner, jobRepository repository.SchedulerJobRepository) JobUseCase {
+	return &jobUseCase{
+		registry:      registry,
+		runner:        runner,
+		jobRepository: jobRepository,
+	}
+}
+
+func (u *jobUseCase) GetJobs(ctx context.Context) ([]entity.SchedulerJobInfo, error) {
+	jobs := u.registry.Jobs()
+	jobTypes := make([]entity.JobType, 0, len(jobs))
+	for _, job := range jobs {
+		jobTypes = append(jobTypes, job.Type)
+	}
+	latestRuns, err := u.jobRepository.GetLatestByJobTypes(ctx, jobTypes)
+	if err != nil {
+		return nil, fmt.Errorf("jobUseCase GetJobs %w", err)
+	}
+	latestRunByType := make(map[entity.JobType]entity.SchedulerJob, len(latestRuns))
+	for _, run := range latestRuns {
+		latestRunByType[run.JobType] = run
+	}
+	res := make([]entity.SchedulerJobInfo, 0, len(jobs))
+	for _, job := range jobs {
+		info := job.Info()
+		if run, ok := latestRunByType[job.Type]; ok {
+			info.LastRun = &run
+		}
+		res = append(res, info)
+	}
+	return res, nil
+}
+
+func (u *jobUseCase) GetRuns(ctx context.Context, filter entity.SchedulerJobFilter) ([]entity.SchedulerJob, core.PagingMetaData, error) {
+	errorTemplate := "jobUseCase GetRuns %w"
+	if filter.JobType.IsPresent() {
+		if _, ok := u.registry.Get(filter.JobType.Get()); !ok {
+			return nil, core.PagingMetaData{}, fmt.Errorf(errorTemplate, apperrors.ErrSchedulerJobNotFound)
+		}
+	}
+	var (
+		eg             errgroup.Group
+		runs           []entity.SchedulerJob
+		pagingMetaData = core.PagingMetaData{PageSize: filter.Size, PageNumber: filter.Number}
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.jobRepository.GetAll(ctx, filter)
+			runs = res
+			return scopedErr
+		},
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.jobRepository.Count(ctx, filter)
+			pagingMetaData.Total = res
+			pagingMetaData.TotalPages = filter.TotalPages(res)
+			return scopedErr
+		},
+	)
+	if err := eg.Wait(); err != nil {
+		return nil, pagingMetaData, fmt.Errorf(errorTemplate, err)
+	}
+	return runs, pagingMetaData, nil
+}
+
+func (u *jobUseCase) Trigger(ctx context.Context, jobType entity.JobType, triggerBy string) (entity.SchedulerJob, error) {
+	errorTemplate := "jobUseCase Trigger %w"
+	job, ok := u.registry.Get(jobType)
+	if !ok {
+		return entity.SchedulerJob{}, fmt.Errorf(errorTemplate, apperrors.ErrSchedulerJobNotFound)
+	}
+	run, err := u.runner.Trigger(ctx, job, triggerBy)
+	if err != nil {
+		return entity.SchedulerJob{}, fmt.Errorf(errorTemplate, err)
+	}
+	return run, nil
+}
@@ -0,0 +1,120 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/optional"
+	"financing-offer/test/mock"
+)
+
+func TestJobUseCase(t *testing.T) {
+	t.Parallel()
+	noop := func(ctx context.Context) error { return nil }
+	newUseCase := func(t *testing.T) (JobUseCase, *mock.MockSchedulerJobRepository) {
+		registry := NewJobRegistry()
+		assert.Nil(t, registry.Register(Job{Type: entity.JobTypeExpireLoanOffers, Cron: "*/5 * * * *", Run: noop}))
+		assert.Nil(t, registry.Register(Job{Type: entity.JobTypeFillInvestorIds, Run: noop}))
+		jobRepository := mock.NewMockSchedulerJobRepository(t)
+		runner := NewJobRunner(
+			slog.New(slog.NewJSONHandler(os.Stdout, nil)), mock.NewMockJobLockRepository(t), jobRepository,
+		)
+		return NewJobUseCase(registry, runner, jobRepository), jobRepository
+	}
+
+	t.Run(
+		"register job twice", func(t *testing.T) {
+			registry := NewJobRegistry()
+			assert.Nil(t, registry.Register(Job{Type: entity.JobTypeExpireLoanOffers, Run: noop}))
+			assert.NotNil(t, registry.Register(Job{Type: entity.JobTypeExpireLoanOffers, Run: noop}))
+			assert.NotNil(t, registry.Register(Job{Type: entity.JobTypeFillInvestorIds}))
+		},
+	)
+
+	t.Run(
+		"get jobs with latest run", func(t *testing.T) {
+			useCase, jobRepository := newUseCase(t)
+			lastRun := entity.SchedulerJob{Id: 3, JobType: entity.JobTypeExpireLoanOffers, JobStatus: entity.JobStatusSuccess}
+			jobRepository.EXPECT().GetLatestByJobTypes(
+				testifyMock.Anything, []entity.JobType{entity.JobTypeExpireLoanOffers, entity.JobTypeFillInvestorIds},
+			).Return([]entity.SchedulerJob{lastRun}, nil)
+			jobs, err := useCase.GetJobs(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(
+				t, []entity.SchedulerJobInfo{
+					{
+						Type:         entity.JobTypeExpireLoanOffers,
+						Cron:         "*/5 * * * *",
+						Scheduled:    true,
+						Timeout:      "30m0s",
+						MaxAttempts:  1,
+						RetryBackoff: "0s",
+						LastRun:      &lastRun,
+					},
+					{
+						Type:         entity.JobTypeFillInvestorIds,
+						Timeout:      "30m0s",
+						MaxAttempts:  1,
+						RetryBackoff: "0s",
+					},
+				}, jobs,
+			)
+		},
+	)
+
+	t.Run(
+		"get jobs error", func(t *testing.T) {
+			useCase, jobRepository := newUseCase(t)
+			jobRepository.EXPECT().GetLatestByJobTypes(testifyMock.Anything, testifyMock.Anything).Return(
+				nil, errors.New("db down"),
+			)
+			_, err := useCase.GetJobs(context.Background())
+			assert.Equal(t, "jobUseCase GetJobs db down", err.Error())
+		},
+	)
+
+	t.Run(
+		"get runs", func(t *testing.T) {
+			useCase, jobRepository := newUseCase(t)
+			filter := entity.SchedulerJobFilter{
+				Paging:  core.Paging{Size: 10, Number: 1},
+				JobType: optional.Some(entity.JobTypeExpireLoanOffers),
+			}
+			runs := []entity.SchedulerJob{{Id: 3, JobType: entity.JobTypeExpireLoanOffers}}
+			jobRepository.EXPECT().GetAll(testifyMock.Anything, filter).Return(runs, nil)
+			jobRepository.EXPECT().Count(testifyMock.Anything, filter).Return(int64(11), nil)
+			res, meta, err := useCase.GetRuns(context.Background(), filter)
+			assert.Nil(t, err)
+			assert.Equal(t, runs, res)
+			assert.Equal(t, core.PagingMetaData{Total: 11, PageSize: 10, PageNumber: 1, TotalPages: 2}, meta)
+		},
+	)
+
+	t.Run(
+		"get runs of unknown job", func(t *testing.T) {
+			useCase, _ := newUseCase(t)
+			_, _, err := useCase.GetRuns(
+				context.Background(), entity.SchedulerJobFilter{JobType: optional.Some(entity.JobType("Unknown"))},
+			)
+			assert.ErrorAs(t, err, &apperrors.AppError{})
+			assert.Equal(t, "jobUseCase GetRuns scheduler job not found", err.Error())
+		},
+	)
+
+	t.Run(
+		"trigger unknown job", func(t *testing.T) {
+			useCase, _ := newUseCase(t)
+			_, err := useCase.Trigger(context.Background(), "Unknown", "admin")
+			assert.Equal(t, "jobUseCase Trigger scheduler job not found", err.Error())
+		},
+	)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"financing-offer/internal/core/entity"
+)
+
+type JobLockRepository interface {
+	// TryAcquire takes the cluster wide lock of the job without waiting,
+	// release must be called once the run ends when the lock was acquired
+	TryAcquire(ctx context.Context, jobType entity.JobType) (release func() error, acquired bool, err error)
+}
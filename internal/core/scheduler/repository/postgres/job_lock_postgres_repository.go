@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"hash/fnv"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/scheduler/repository"
+)
+
+var _ repository.JobLockRepository = (*JobLockPostgresRepository)(nil)
+
+// JobLockPostgresRepository locks jobs with session level advisory locks,
+// a lock is held by a dedicated connection so it is released when the instance holding it dies
+type JobLockPostgresRepository struct {
+	db *sql.DB
+}
+
+func NewJobLockPostgresRepository(db *sql.DB) *JobLockPostgresRepository {
+	return &JobLockPostgresRepository{db: db}
+}
+
+func (r *JobLockPostgresRepository) TryAcquire(ctx context.Context, jobType entity.JobType) (func() error, bool, error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("JobLockPostgresRepository TryAcquire %w", err)
+	}
+	key := jobLockKey(jobType)
+	acquired := false
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired); err != nil {
+		_ = conn.Close()
+		return nil, false, fmt.Errorf("JobLockPostgresRepository TryAcquire %w", err)
+	}
+	if !acquired {
+		if err := conn.Close(); err != nil {
+			return nil, false, fmt.Errorf("JobLockPostgresRepository TryAcquire %w", err)
+		}
+		return nil, false, nil
+	}
+	release := func() error {
+		defer conn.Close()
+		// the lock must be released on the connection that took it, a fresh context outlives a cancelled run
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			// drop the session instead of returning it to the pool with the lock still held
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+			return fmt.Errorf("JobLockPostgresRepository release %w", err)
+		}
+		return nil
+	}
+	return release, true, nil
+}
+
+func jobLockKey(jobType entity.JobType) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("scheduler_job:" + string(jobType)))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+)
+
+func TestJobLockPostgresRepository_TryAcquire(t *testing.T) {
+	t.Parallel()
+	key := jobLockKey(entity.JobTypeExpireLoanOffers)
+
+	t.Run("acquire and release", func(t *testing.T) {
+		db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		repo := NewJobLockPostgresRepository(db)
+		mock.ExpectQuery("pg_try_advisory_lock").
+			WithArgs(key).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+		mock.ExpectExec("pg_advisory_unlock").
+			WithArgs(key).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		release, acquired, err := repo.TryAcquire(context.Background(), entity.JobTypeExpireLoanOffers)
+		assert.Nil(t, err)
+		assert.True(t, acquired)
+		assert.Nil(t, release())
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("held by another instance", func(t *testing.T) {
+		db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		repo := NewJobLockPostgresRepository(db)
+		mock.ExpectQuery("pg_try_advisory_lock").
+			WithArgs(key).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+		release, acquired, err := repo.TryAcquire(context.Background(), entity.JobTypeExpireLoanOffers)
+		assert.Nil(t, err)
+		assert.False(t, acquired)
+		assert.Nil(t, release)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("lock query error", func(t *testing.T) {
+		db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		repo := NewJobLockPostgresRepository(db)
+		mock.ExpectQuery("pg_try_advisory_lock").WillReturnError(fmt.Errorf("error"))
+		_, acquired, err := repo.TryAcquire(context.Background(), entity.JobTypeExpireLoanOffers)
+		assert.False(t, acquired)
+		assert.Equal(t, "JobLockPostgresRepository TryAcquire error", err.Error())
+	})
+
+	t.Run("keys differ per job", func(t *testing.T) {
+		assert.NotEqual(t, key, jobLockKey(entity.JobTypeDeclineLoanRequests))
+	})
+}
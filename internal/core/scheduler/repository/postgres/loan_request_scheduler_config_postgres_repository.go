@@ -27,20 +27,31 @@ func (r *LoanRequestSchedulerConfigRepository) GetAll(ctx context.Context) ([]en
 	if err != nil {
 		return []entity.LoanRequestSchedulerConfig{}, fmt.Errorf("LoanRequestSchedulerConfigRepository GetAll: %w", err)
 	}
-	return MapLoanRequestSchedulerConfigsDbToEntity(des), nil
+	configs, err := MapLoanRequestSchedulerConfigsDbToEntity(des)
+	if err != nil {
+		return []entity.LoanRequestSchedulerConfig{}, fmt.Errorf("LoanRequestSchedulerConfigRepository GetAll: %w", err)
+	}
+	return configs, nil
 }
 
 func (r *LoanRequestSchedulerConfigRepository) Create(ctx context.Context, config entity.LoanRequestSchedulerConfig) (entity.LoanRequestSchedulerConfig, error) {
-	createdModel := MapLoanRequestSchedulerConfigEntityToDb(config)
+	createdModel, err := MapLoanRequestSchedulerConfigEntityToDb(config)
+	if err != nil {
+		return entity.LoanRequestSchedulerConfig{}, fmt.Errorf("LoanRequestSchedulerConfigRepository Create: %w", err)
+	}
 	created := model.LoanRequestSchedulerConfig{}
-	err := table.LoanRequestSchedulerConfig.INSERT(table.LoanRequestSchedulerConfig.MutableColumns).
+	err = table.LoanRequestSchedulerConfig.INSERT(table.LoanRequestSchedulerConfig.MutableColumns).
 		MODEL(createdModel).
 		RETURNING(table.LoanRequestSchedulerConfig.AllColumns).
 		QueryContext(ctx, r.getDbFunc(ctx), &created)
 	if err != nil {
 		return entity.LoanRequestSchedulerConfig{}, fmt.Errorf("LoanRequestSchedulerConfigRepository Create: %w", err)
 	}
-	return MapLoanRequestSchedulerConfigDbToEntity(created), nil
+	res, err := MapLoanRequestSchedulerConfigDbToEntity(created)
+	if err != nil {
+		return entity.LoanRequestSchedulerConfig{}, fmt.Errorf("LoanRequestSchedulerConfigRepository Create: %w", err)
+	}
+	return res, nil
 }
 
 func (r *LoanRequestSchedulerConfigRepository) GetCurrentConfig(ctx context.Context) (entity.LoanRequestSchedulerConfig, error) {
@@ -53,7 +64,11 @@ func (r *LoanRequestSchedulerConfigRepository) GetCurrentConfig(ctx context.Cont
 	if err != nil {
 		return entity.LoanRequestSchedulerConfig{}, fmt.Errorf("LoanRequestSchedulerConfigRepository GetCurrentConfig: %w", err)
 	}
-	return MapLoanRequestSchedulerConfigDbToEntity(des), nil
+	res, err := MapLoanRequestSchedulerConfigDbToEntity(des)
+	if err != nil {
+		return entity.LoanRequestSchedulerConfig{}, fmt.Errorf("LoanRequestSchedulerConfigRepository GetCurrentConfig: %w", err)
+	}
+	return res, nil
 }
 
 func NewLoanRequestSchedulerConfigRepo(getDbFunction database.GetDbFunc) *LoanRequestSchedulerConfigRepository {
@@ -40,13 +40,17 @@ func TestLoanRequestSchedulerConfigRepository(t *testing.T) {
 					"loan_request_scheduler_config.affected_from",
 					"loan_request_scheduler_config.created_at",
 					"loan_request_scheduler_config.updated_at",
-				}).AddRow(e.ID, e.MaximumLoanRate, e.AffectedFrom, e.CreatedAt, e.UpdatedAt),
+					"loan_request_scheduler_config.rules",
+				}).AddRow(e.ID, e.MaximumLoanRate, e.AffectedFrom, e.CreatedAt, e.UpdatedAt, `[{"stockExchangeCode":"HOSE","maximumLoanRate":"0.5"}]`),
 		)
 		configs, err := repo.GetAll(context.Background())
 		assert.Nil(t, err)
 		assert.Equal(t, int64(1), configs[0].ID)
 		assert.Equal(t, decimal.NewFromFloat(0.2), configs[0].MaximumLoanRate)
 		assert.Equal(t, affectedFrom, configs[0].AffectedFrom)
+		assert.Equal(t, "HOSE", configs[0].Rules[0].StockExchangeCode.Get())
+		assert.Equal(t, "0.5", configs[0].Rules[0].MaximumLoanRate.Get().String())
+		assert.False(t, configs[0].Rules[0].AssetType.IsPresent())
 	})
 	t.Run("GetLoanRequestSchedulerConfigFailure", func(t *testing.T) {
 		mock.ExpectQuery("SELECT").WillReturnError(
@@ -72,7 +76,8 @@ func TestLoanRequestSchedulerConfigRepository(t *testing.T) {
 					"loan_request_scheduler_config.affected_from",
 					"loan_request_scheduler_config.created_at",
 					"loan_request_scheduler_config.updated_at",
-				}).AddRow(e.ID, e.MaximumLoanRate, e.AffectedFrom, e.CreatedAt, e.UpdatedAt),
+					"loan_request_scheduler_config.rules",
+				}).AddRow(e.ID, e.MaximumLoanRate, e.AffectedFrom, e.CreatedAt, e.UpdatedAt, "[]"),
 		)
 		config, err := repo.GetCurrentConfig(context.Background())
 		assert.Nil(t, err)
@@ -81,6 +86,18 @@ func TestLoanRequestSchedulerConfigRepository(t *testing.T) {
 		assert.Equal(t, affectedFrom, config.AffectedFrom)
 	})
 
+	t.Run("GetCurrentLoanRequestSchedulerConfigInvalidRules", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").WillReturnRows(
+			mock.NewRows(
+				[]string{
+					"loan_request_scheduler_config.id",
+					"loan_request_scheduler_config.rules",
+				}).AddRow(1, "{"),
+		)
+		_, err := repo.GetCurrentConfig(context.Background())
+		assert.ErrorContains(t, err, "LoanRequestSchedulerConfigRepository GetCurrentConfig: unexpected end of JSON input")
+	})
+
 	t.Run("GetCurrentLoanRequestSchedulerConfigFailure", func(t *testing.T) {
 		mock.ExpectQuery("SELECT").WillReturnError(
 			fmt.Errorf("error"),
@@ -120,7 +137,8 @@ func TestLoanRequestSchedulerConfigRepository(t *testing.T) {
 					"loan_request_scheduler_config.affected_from",
 					"loan_request_scheduler_config.created_at",
 					"loan_request_scheduler_config.updated_at",
-				}).AddRow(e.ID, e.MaximumLoanRate, e.AffectedFrom, e.CreatedAt, e.UpdatedAt),
+					"loan_request_scheduler_config.rules",
+				}).AddRow(e.ID, e.MaximumLoanRate, e.AffectedFrom, e.CreatedAt, e.UpdatedAt, "[]"),
 		)
 		config, err := repo.Create(context.Background(), entity.LoanRequestSchedulerConfig{
 			ID:              1,
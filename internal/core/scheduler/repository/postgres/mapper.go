@@ -1,36 +1,59 @@
 package postgres
 
 import (
+	"encoding/json"
+
+	"github.com/go-jet/jet/v2/postgres"
+
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
 )
 
-func MapLoanRequestSchedulerConfigDbToEntity(config model.LoanRequestSchedulerConfig) entity.LoanRequestSchedulerConfig {
+func MapLoanRequestSchedulerConfigDbToEntity(config model.LoanRequestSchedulerConfig) (entity.LoanRequestSchedulerConfig, error) {
+	rules := make([]entity.LoanRequestDeclineRule, 0)
+	if err := json.Unmarshal([]byte(config.Rules), &rules); err != nil {
+		return entity.LoanRequestSchedulerConfig{}, err
+	}
 	return entity.LoanRequestSchedulerConfig{
 		ID:              config.ID,
 		MaximumLoanRate: config.MaximumLoanRate,
+		Rules:           rules,
 		AffectedFrom:    config.AffectedFrom,
 		CreatedAt:       config.CreatedAt,
 		UpdatedAt:       config.UpdatedAt,
-	}
+	}, nil
 }
 
-func MapLoanRequestSchedulerConfigEntityToDb(config entity.LoanRequestSchedulerConfig) model.LoanRequestSchedulerConfig {
+func MapLoanRequestSchedulerConfigEntityToDb(config entity.LoanRequestSchedulerConfig) (model.LoanRequestSchedulerConfig, error) {
+	rules := config.Rules
+	if rules == nil {
+		rules = []entity.LoanRequestDeclineRule{}
+	}
+	rulesJSON, err := json.Marshal(rules)
+	if err != nil {
+		return model.LoanRequestSchedulerConfig{}, err
+	}
 	return model.LoanRequestSchedulerConfig{
 		ID:              config.ID,
 		MaximumLoanRate: config.MaximumLoanRate,
+		Rules:           string(rulesJSON),
 		AffectedFrom:    config.AffectedFrom,
 		CreatedAt:       config.CreatedAt,
 		UpdatedAt:       config.UpdatedAt,
-	}
+	}, nil
 }
 
-func MapLoanRequestSchedulerConfigsDbToEntity(configs []model.LoanRequestSchedulerConfig) []entity.LoanRequestSchedulerConfig {
+func MapLoanRequestSchedulerConfigsDbToEntity(configs []model.LoanRequestSchedulerConfig) ([]entity.LoanRequestSchedulerConfig, error) {
 	res := make([]entity.LoanRequestSchedulerConfig, 0, len(configs))
 	for _, config := range configs {
-		res = append(res, MapLoanRequestSchedulerConfigDbToEntity(config))
+		e, err := MapLoanRequestSchedulerConfigDbToEntity(config)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, e)
 	}
-	return res
+	return res, nil
 }
 
 func MapSchedulerJobEntityToDb(e entity.SchedulerJob) model.SchedulerJob {
@@ -40,6 +63,7 @@ func MapSchedulerJobEntityToDb(e entity.SchedulerJob) model.SchedulerJob {
 		JobStatus:    string(e.JobStatus),
 		TriggerBy:    e.TriggerBy,
 		TrackingData: e.TrackingData,
+		ScheduledAt:  e.ScheduledAt,
 		CreatedAt:    e.CreatedAt,
 		UpdatedAt:    e.UpdatedAt,
 	}
@@ -52,7 +76,27 @@ func MapSchedulerJobDbToEntity(m model.SchedulerJob) entity.SchedulerJob {
 		JobType:      entity.JobType(m.JobType),
 		TriggerBy:    m.TriggerBy,
 		TrackingData: m.TrackingData,
+		ScheduledAt:  m.ScheduledAt,
 		CreatedAt:    m.CreatedAt,
 		UpdatedAt:    m.UpdatedAt,
 	}
 }
+
+func MapSchedulerJobsDbToEntity(jobs []model.SchedulerJob) []entity.SchedulerJob {
+	res := make([]entity.SchedulerJob, 0, len(jobs))
+	for _, job := range jobs {
+		res = append(res, MapSchedulerJobDbToEntity(job))
+	}
+	return res
+}
+
+func ApplySchedulerJobFilter(filter entity.SchedulerJobFilter) postgres.BoolExpression {
+	expr := postgres.Bool(true)
+	if filter.JobType.IsPresent() {
+		expr = expr.AND(table.SchedulerJob.JobType.EQ(postgres.String(string(filter.JobType.Get()))))
+	}
+	if filter.JobStatus.IsPresent() {
+		expr = expr.AND(table.SchedulerJob.JobStatus.EQ(postgres.String(string(filter.JobStatus.Get()))))
+	}
+	return expr
+}
@@ -2,12 +2,20 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/scheduler/repository"
 	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
 	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/internal/funcs"
+	"financing-offer/pkg/querymod"
 )
 
 var _ repository.SchedulerJobRepository = (*SchedulerJobRepository)(nil)
@@ -28,6 +36,92 @@ func (s *SchedulerJobRepository) Create(ctx context.Context, job entity.Schedule
 	return nil
 }
 
+func (s *SchedulerJobRepository) StartRun(ctx context.Context, job entity.SchedulerJob) (entity.SchedulerJob, error) {
+	job.JobStatus = entity.JobStatusRunning
+	created := model.SchedulerJob{}
+	err := table.SchedulerJob.INSERT(table.SchedulerJob.MutableColumns).
+		MODEL(MapSchedulerJobEntityToDb(job)).
+		ON_CONFLICT(table.SchedulerJob.JobType, table.SchedulerJob.ScheduledAt).
+		DO_NOTHING().
+		RETURNING(table.SchedulerJob.AllColumns).
+		QueryContext(ctx, s.getDbFunc(ctx), &created)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return entity.SchedulerJob{}, fmt.Errorf("SchedulerJobRepository StartRun: %w", apperrors.ErrSchedulerJobTickTaken)
+		}
+		return entity.SchedulerJob{}, fmt.Errorf("SchedulerJobRepository StartRun: %w", err)
+	}
+	return MapSchedulerJobDbToEntity(created), nil
+}
+
+func (s *SchedulerJobRepository) FinishRun(ctx context.Context, id int64, status entity.JobStatus, trackingData string) error {
+	_, err := table.SchedulerJob.UPDATE(
+		table.SchedulerJob.JobStatus, table.SchedulerJob.TrackingData, table.SchedulerJob.UpdatedAt,
+	).
+		SET(postgres.String(string(status)), postgres.Json(trackingData), postgres.LOCALTIMESTAMP()).
+		WHERE(table.SchedulerJob.ID.EQ(postgres.Int64(id))).
+		ExecContext(ctx, s.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("SchedulerJobRepository FinishRun: %w", err)
+	}
+	return nil
+}
+
+func (s *SchedulerJobRepository) GetAll(ctx context.Context, filter entity.SchedulerJobFilter) ([]entity.SchedulerJob, error) {
+	stm := table.SchedulerJob.SELECT(table.SchedulerJob.AllColumns).
+		WHERE(ApplySchedulerJobFilter(filter)).
+		ORDER_BY(table.SchedulerJob.ID.DESC())
+	if limit := filter.Limit(); limit > 0 {
+		stm = stm.LIMIT(limit).OFFSET(filter.Offset())
+	}
+	dest := make([]model.SchedulerJob, 0)
+	if err := stm.QueryContext(ctx, s.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []entity.SchedulerJob{}, nil
+		}
+		return nil, fmt.Errorf("SchedulerJobRepository GetAll: %w", err)
+	}
+	return MapSchedulerJobsDbToEntity(dest), nil
+}
+
+func (s *SchedulerJobRepository) Count(ctx context.Context, filter entity.SchedulerJobFilter) (int64, error) {
+	dest := struct {
+		Count int64
+	}{}
+	if err := table.SchedulerJob.SELECT(postgres.COUNT(table.SchedulerJob.ID).AS("count")).
+		WHERE(ApplySchedulerJobFilter(filter)).
+		QueryContext(ctx, s.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("SchedulerJobRepository Count: %w", err)
+	}
+	return dest.Count, nil
+}
+
+func (s *SchedulerJobRepository) GetLatestByJobTypes(ctx context.Context, jobTypes []entity.JobType) ([]entity.SchedulerJob, error) {
+	if len(jobTypes) == 0 {
+		return []entity.SchedulerJob{}, nil
+	}
+	types := funcs.Map(
+		jobTypes, func(jobType entity.JobType) string {
+			return string(jobType)
+		},
+	)
+	dest := make([]model.SchedulerJob, 0)
+	if err := table.SchedulerJob.SELECT(table.SchedulerJob.AllColumns).
+		DISTINCT(table.SchedulerJob.JobType).
+		WHERE(table.SchedulerJob.JobType.IN(querymod.In(types)...)).
+		ORDER_BY(table.SchedulerJob.JobType, table.SchedulerJob.ID.DESC()).
+		QueryContext(ctx, s.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []entity.SchedulerJob{}, nil
+		}
+		return nil, fmt.Errorf("SchedulerJobRepository GetLatestByJobTypes: %w", err)
+	}
+	return MapSchedulerJobsDbToEntity(dest), nil
+}
+
 func NewSchedulerJobRepository(getDbFunction database.GetDbFunc) *SchedulerJobRepository {
 	return &SchedulerJobRepository{getDbFunc: getDbFunction}
 }
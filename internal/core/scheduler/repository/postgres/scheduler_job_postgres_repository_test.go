@@ -9,9 +9,12 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core"
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/database"
 	"financing-offer/pkg/dbtest"
+	"financing-offer/pkg/optional"
 )
 
 func TestSchedulerJobRepository(t *testing.T) {
@@ -34,7 +37,7 @@ func TestSchedulerJobRepository(t *testing.T) {
 			UpdatedAt:    time.Now(),
 		}
 		mock.ExpectExec("INSERT").
-			WithArgs(e.JobType, e.JobStatus, e.TriggerBy, e.TrackingData).
+			WithArgs(e.JobType, e.JobStatus, e.TriggerBy, e.TrackingData, nil).
 			WillReturnResult(
 				sqlmock.NewResult(1, 1))
 		err := repo.Create(context.Background(), e)
@@ -55,4 +58,119 @@ func TestSchedulerJobRepository(t *testing.T) {
 		err := repo.Create(context.Background(), e)
 		assert.Equal(t, "SchedulerJobRepository Create: error", err.Error())
 	})
+	t.Run("TestStartRunSuccess", func(t *testing.T) {
+		now := time.Now()
+		scheduledAt := now.Truncate(time.Minute)
+		e := entity.SchedulerJob{
+			JobType:      entity.JobTypeExpireLoanOffers,
+			TriggerBy:    "system",
+			TrackingData: `{"instance":"pod-1"}`,
+			ScheduledAt:  &scheduledAt,
+		}
+		mock.ExpectQuery("INSERT").
+			WithArgs(e.JobType, entity.JobStatusRunning, e.TriggerBy, e.TrackingData, scheduledAt).
+			WillReturnRows(
+				sqlmock.NewRows(
+					[]string{
+						"scheduler_job.id", "scheduler_job.job_type", "scheduler_job.job_status",
+						"scheduler_job.trigger_by", "scheduler_job.tracking_data",
+						"scheduler_job.created_at", "scheduler_job.updated_at", "scheduler_job.scheduled_at",
+					},
+				).AddRow(5, e.JobType, entity.JobStatusRunning, e.TriggerBy, e.TrackingData, now, now, scheduledAt),
+			)
+		run, err := repo.StartRun(context.Background(), e)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), run.Id)
+		assert.Equal(t, entity.JobStatusRunning, run.JobStatus)
+		assert.Equal(t, scheduledAt, *run.ScheduledAt)
+	})
+	t.Run("TestStartRunTickTaken", func(t *testing.T) {
+		mock.ExpectQuery("INSERT").WillReturnRows(sqlmock.NewRows([]string{"scheduler_job.id"}))
+		_, err := repo.StartRun(context.Background(), entity.SchedulerJob{JobType: entity.JobTypeExpireLoanOffers})
+		assert.ErrorIs(t, err, apperrors.ErrSchedulerJobTickTaken)
+	})
+	t.Run("TestStartRunFailure", func(t *testing.T) {
+		mock.ExpectQuery("INSERT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.StartRun(context.Background(), entity.SchedulerJob{JobType: entity.JobTypeExpireLoanOffers})
+		assert.Equal(t, "SchedulerJobRepository StartRun: jet: error", err.Error())
+	})
+	t.Run("TestFinishRunSuccess", func(t *testing.T) {
+		mock.ExpectExec("UPDATE").
+			WithArgs(entity.JobStatusFail, `{"instance":"pod-1","error":"boom"}`, int64(5)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		err := repo.FinishRun(context.Background(), 5, entity.JobStatusFail, `{"instance":"pod-1","error":"boom"}`)
+		assert.Nil(t, err)
+	})
+	t.Run("TestFinishRunFailure", func(t *testing.T) {
+		mock.ExpectExec("UPDATE").WillReturnError(fmt.Errorf("error"))
+		err := repo.FinishRun(context.Background(), 5, entity.JobStatusSuccess, "{}")
+		assert.Equal(t, "SchedulerJobRepository FinishRun: error", err.Error())
+	})
+	t.Run("TestGetAllSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery("SELECT .* FROM public.scheduler_job WHERE .* ORDER BY scheduler_job.id DESC LIMIT .* OFFSET").
+			WithArgs(true, string(entity.JobTypeExpireLoanOffers), int64(10), int64(0)).
+			WillReturnRows(
+				sqlmock.NewRows(
+					[]string{
+						"scheduler_job.id", "scheduler_job.job_type", "scheduler_job.job_status",
+						"scheduler_job.trigger_by", "scheduler_job.tracking_data",
+						"scheduler_job.created_at", "scheduler_job.updated_at",
+					},
+				).AddRow(5, entity.JobTypeExpireLoanOffers, entity.JobStatusSuccess, "system", "{}", now, now),
+			)
+		runs, err := repo.GetAll(
+			context.Background(), entity.SchedulerJobFilter{
+				Paging:  core.Paging{Size: 10, Number: 1},
+				JobType: optional.Some(entity.JobTypeExpireLoanOffers),
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(runs))
+		assert.Equal(t, entity.JobStatusSuccess, runs[0].JobStatus)
+	})
+	t.Run("TestCountSuccess", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT").
+			WithArgs(true, string(entity.JobStatusFail)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		count, err := repo.Count(
+			context.Background(), entity.SchedulerJobFilter{JobStatus: optional.Some(entity.JobStatusFail)},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+	t.Run("TestGetLatestByJobTypesSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery("SELECT DISTINCT ON \\(scheduler_job.job_type\\)").
+			WithArgs(string(entity.JobTypeExpireLoanOffers), string(entity.JobTypeFillInvestorIds)).
+			WillReturnRows(
+				sqlmock.NewRows(
+					[]string{
+						"scheduler_job.id", "scheduler_job.job_type", "scheduler_job.job_status",
+						"scheduler_job.trigger_by", "scheduler_job.tracking_data",
+						"scheduler_job.created_at", "scheduler_job.updated_at",
+					},
+				).AddRow(9, entity.JobTypeFillInvestorIds, entity.JobStatusRunning, "admin", "{}", now, now),
+			)
+		runs, err := repo.GetLatestByJobTypes(
+			context.Background(), []entity.JobType{entity.JobTypeExpireLoanOffers, entity.JobTypeFillInvestorIds},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, []entity.SchedulerJob{
+			{
+				Id:           9,
+				JobType:      entity.JobTypeFillInvestorIds,
+				JobStatus:    entity.JobStatusRunning,
+				TriggerBy:    "admin",
+				TrackingData: "{}",
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			},
+		}, runs)
+	})
+	t.Run("TestGetLatestByJobTypesFailure", func(t *testing.T) {
+		mock.ExpectQuery("SELECT DISTINCT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.GetLatestByJobTypes(context.Background(), []entity.JobType{entity.JobTypeExpireLoanOffers})
+		assert.Equal(t, "SchedulerJobRepository GetLatestByJobTypes: jet: error", err.Error())
+	})
 }
@@ -8,4 +8,12 @@ import (
 
 type SchedulerJobRepository interface {
 	Create(ctx context.Context, job entity.SchedulerJob) error
+	// StartRun records a RUNNING job and returns it with its id,
+	// it fails with apperrors.ErrSchedulerJobTickTaken when a run of the same ScheduledAt is already recorded
+	StartRun(ctx context.Context, job entity.SchedulerJob) (entity.SchedulerJob, error)
+	FinishRun(ctx context.Context, id int64, status entity.JobStatus, trackingData string) error
+	GetAll(ctx context.Context, filter entity.SchedulerJobFilter) ([]entity.SchedulerJob, error)
+	Count(ctx context.Context, filter entity.SchedulerJobFilter) (int64, error)
+	// GetLatestByJobTypes returns the latest run of each job type that has run at least once
+	GetLatestByJobTypes(ctx context.Context, jobTypes []entity.JobType) ([]entity.SchedulerJob, error)
 }
@@ -0,0 +1,107 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/scheduler"
+	"financing-offer/internal/handler"
+)
+
+type JobHandler struct {
+	handler.BaseHandler
+	logger  *slog.Logger
+	useCase scheduler.JobUseCase
+}
+
+func NewJobHandler(baseHandler handler.BaseHandler, logger *slog.Logger, useCase scheduler.JobUseCase) *JobHandler {
+	return &JobHandler{
+		BaseHandler: baseHandler,
+		logger:      logger,
+		useCase:     useCase,
+	}
+}
+
+// GetJobs godoc
+//
+//	@Summary		Get scheduler jobs
+//	@Description	Get the registered jobs with their schedule, retry policy and latest run
+//	@Tags			scheduler,admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	handler.BaseResponse[[]entity.SchedulerJobInfo]
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/schedulers/jobs [get]
+func (h *JobHandler) GetJobs(ctx *gin.Context) {
+	jobs, err := h.useCase.GetJobs(ctx)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[[]entity.SchedulerJobInfo]{Data: jobs})
+}
+
+// GetJobRuns godoc
+//
+//	@Summary		Get scheduler job runs
+//	@Description	Get the runs of a job, latest first
+//	@Tags			scheduler,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			type			path		string	true	"job type"
+//	@Param			jobStatus		query		string	false	"RUNNING, SUCCESS, FAIL or SKIPPED"
+//	@Param			page[size]		query		int		false	"page size"
+//	@Param			page[number]	query		int		false	"page number"
+//	@Success		200				{object}	handler.ResponseWithPaging[[]entity.SchedulerJob]
+//	@Failure		400				{object}	handler.ErrorResponse
+//	@Failure		404				{object}	handler.ErrorResponse
+//	@Failure		500				{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/schedulers/jobs/{type}/runs [get]
+func (h *JobHandler) GetJobRuns(ctx *gin.Context) {
+	req := GetJobRunsRequest{}
+	if err := h.ParseQueryWithPagination(ctx, &req.Paging, &req); err != nil {
+		h.logger.Error("get scheduler job runs", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "parse query")
+		return
+	}
+	runs, meta, err := h.useCase.GetRuns(ctx, req.toFilter(entity.JobType(ctx.Param("type"))))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.ResponseWithPaging[[]entity.SchedulerJob]{
+			Data:     runs,
+			MetaData: meta,
+		},
+	)
+}
+
+// TriggerJob godoc
+//
+//	@Summary		Trigger scheduler job
+//	@Description	Start a run of the job in the background, the run is returned while RUNNING
+//	@Tags			scheduler,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			type	path		string	true	"job type"
+//	@Success		202		{object}	handler.BaseResponse[entity.SchedulerJob]
+//	@Failure		404		{object}	handler.ErrorResponse
+//	@Failure		409		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/schedulers/jobs/{type}/trigger [post]
+func (h *JobHandler) TriggerJob(ctx *gin.Context) {
+	// the run goes on after the response, so it must not hold the pooled gin context
+	run, err := h.useCase.Trigger(ctx.Copy(), entity.JobType(ctx.Param("type")), h.UserSubOrEmpty(ctx))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusAccepted, handler.BaseResponse[entity.SchedulerJob]{Data: run})
+}
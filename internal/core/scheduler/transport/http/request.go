@@ -4,9 +4,35 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/optional"
 )
 
 type LoanPackageSchedulerConfig struct {
-	MaximumLoanRate decimal.Decimal `json:"maximumLoanRate" binding:"required"`
-	AffectedFrom    time.Time       `json:"affectedFrom" binding:"required"`
+	MaximumLoanRate decimal.Decimal                 `json:"maximumLoanRate" binding:"required"`
+	Rules           []entity.LoanRequestDeclineRule `json:"rules"`
+	AffectedFrom    time.Time                       `json:"affectedFrom" binding:"required"`
+}
+
+func (r LoanPackageSchedulerConfig) toEntity() entity.LoanRequestSchedulerConfig {
+	return entity.LoanRequestSchedulerConfig{
+		MaximumLoanRate: r.MaximumLoanRate,
+		Rules:           r.Rules,
+		AffectedFrom:    r.AffectedFrom,
+	}
+}
+
+type GetJobRunsRequest struct {
+	Paging    core.Paging
+	JobStatus entity.JobStatus `form:"jobStatus" binding:"omitempty,oneof=RUNNING SUCCESS FAIL SKIPPED"`
+}
+
+func (r GetJobRunsRequest) toFilter(jobType entity.JobType) entity.SchedulerJobFilter {
+	return entity.SchedulerJobFilter{
+		Paging:    r.Paging,
+		JobType:   optional.Some(jobType),
+		JobStatus: optional.FromValueNonZero(r.JobStatus),
+	}
 }
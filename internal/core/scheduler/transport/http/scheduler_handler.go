@@ -7,25 +7,29 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/loanpackagerequest"
 	"financing-offer/internal/core/scheduler"
 	"financing-offer/internal/handler"
 )
 
 type SchedulerHandler struct {
 	handler.BaseHandler
-	logger  *slog.Logger
-	useCase scheduler.UseCase
+	logger             *slog.Logger
+	useCase            scheduler.UseCase
+	loanRequestUseCase loanpackagerequest.UseCase
 }
 
 func NewSchedulerHandler(
 	baseHandler handler.BaseHandler,
 	logger *slog.Logger,
 	useCase scheduler.UseCase,
+	loanRequestUseCase loanpackagerequest.UseCase,
 ) *SchedulerHandler {
 	return &SchedulerHandler{
-		BaseHandler: baseHandler,
-		logger:      logger,
-		useCase:     useCase,
+		BaseHandler:        baseHandler,
+		logger:             logger,
+		useCase:            useCase,
+		loanRequestUseCase: loanRequestUseCase,
 	}
 }
 
@@ -82,7 +86,7 @@ func (h *SchedulerHandler) GetCurrentLoanRequestSchedulerConfig(ctx *gin.Context
 // CreateLoanRequestSchedulerConfig godoc
 //
 //	@Summary		Create loan request scheduler config
-//	@Description	Create loan request scheduler config
+//	@Description	Create loan request scheduler config, a pending request is checked against the most specific rule matching it only, without the thresholds of broader rules
 //	@Tags			loan request scheduler,admin
 //	@Accept			json
 //	@Produce		json
@@ -99,12 +103,7 @@ func (h *SchedulerHandler) CreateLoanRequestSchedulerConfig(ctx *gin.Context) {
 		h.RenderBadRequest(ctx, "invalid request")
 		return
 	}
-	created, err := h.useCase.CreateLoanRequestSchedulerConfig(
-		ctx, entity.LoanRequestSchedulerConfig{
-			MaximumLoanRate: request.MaximumLoanRate,
-			AffectedFrom:    request.AffectedFrom,
-		},
-	)
+	created, err := h.useCase.CreateLoanRequestSchedulerConfig(ctx, request.toEntity())
 	if err != nil {
 		h.RenderError(ctx, err)
 		return
@@ -115,3 +114,35 @@ func (h *SchedulerHandler) CreateLoanRequestSchedulerConfig(ctx *gin.Context) {
 		},
 	)
 }
+
+// DryRunLoanRequestSchedulerConfig godoc
+//
+//	@Summary		Dry run loan request scheduler config
+//	@Description	List the pending loan requests a proposed config would decline once it takes effect, the config is not saved
+//	@Tags			loan request scheduler,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		LoanPackageSchedulerConfig	true	"request"
+//	@Success		200		{object}	handler.BaseResponse[[]entity.LoanRequestDecline]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/schedulers/loan-request-scheduler-config/dry-run [post]
+func (h *SchedulerHandler) DryRunLoanRequestSchedulerConfig(ctx *gin.Context) {
+	var request LoanPackageSchedulerConfig
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.logger.Error("dry run loan package scheduler config", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid request")
+		return
+	}
+	declines, err := h.loanRequestUseCase.PreviewDeclineRiskLoanRequests(ctx, request.toEntity())
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[[]entity.LoanRequestDecline]{
+			Data: declines,
+		},
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/scheduler/repository"
 )
@@ -36,6 +37,9 @@ func (u *schedulerUseCase) GetAllLoanRequestSchedulerConfig(ctx context.Context)
 }
 
 func (u *schedulerUseCase) CreateLoanRequestSchedulerConfig(ctx context.Context, entity entity.LoanRequestSchedulerConfig) (entity.LoanRequestSchedulerConfig, error) {
+	if err := entity.Validate(); err != nil {
+		return entity, apperrors.ErrInvalidInput(err.Error())
+	}
 	res, err := u.repo.Create(ctx, entity)
 	if err != nil {
 		return res, fmt.Errorf("schedulerUseCase CreateLoanRequestSchedulerConfig %w", err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"financing-offer/internal/atomicity"
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/scoregroup/repository"
 	scoreGroupInterestRepository "financing-offer/internal/core/scoregroupinterest/repository"
@@ -21,13 +22,20 @@ type UseCase interface {
 func NewUseCase(
 	scoreGroupRepo repository.ScoreGroupRepository,
 	scoreGroupInterestRepository scoreGroupInterestRepository.ScoreGroupInterestRepository,
+	atomicExecutor atomicity.AtomicExecutor,
 ) UseCase {
-	return &scoreGroupUseCase{scoreGroupRepository: scoreGroupRepo, scoreGroupInterestRepository: scoreGroupInterestRepository}
+	return &scoreGroupUseCase{
+		scoreGroupRepository:         scoreGroupRepo,
+		scoreGroupInterestRepository: scoreGroupInterestRepository,
+		atomicExecutor:               atomicExecutor,
+	}
 }
 
+// scoreGroupUseCase writes through atomicExecutor, which records the actor of the changes for the audit log
 type scoreGroupUseCase struct {
 	scoreGroupRepository         repository.ScoreGroupRepository
 	scoreGroupInterestRepository scoreGroupInterestRepository.ScoreGroupInterestRepository
+	atomicExecutor               atomicity.AtomicExecutor
 }
 
 func (u *scoreGroupUseCase) GetById(ctx context.Context, id int64) (entity.ScoreGroup, error) {
@@ -47,7 +55,13 @@ func (u *scoreGroupUseCase) GetAll(ctx context.Context) ([]entity.ScoreGroup, er
 }
 
 func (u *scoreGroupUseCase) Create(ctx context.Context, scoreGroup entity.ScoreGroup) (entity.ScoreGroup, error) {
-	res, err := u.scoreGroupRepository.Create(ctx, scoreGroup)
+	var res entity.ScoreGroup
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.scoreGroupRepository.Create(tc, scoreGroup)
+			return err
+		},
+	)
 	if err != nil {
 		return res, fmt.Errorf("scoreGroupUseCase Create %w", err)
 	}
@@ -55,7 +69,13 @@ func (u *scoreGroupUseCase) Create(ctx context.Context, scoreGroup entity.ScoreG
 }
 
 func (u *scoreGroupUseCase) Update(ctx context.Context, scoreGroup entity.ScoreGroup) (entity.ScoreGroup, error) {
-	res, err := u.scoreGroupRepository.Update(ctx, scoreGroup)
+	var res entity.ScoreGroup
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.scoreGroupRepository.Update(tc, scoreGroup)
+			return err
+		},
+	)
 	if err != nil {
 		return res, fmt.Errorf("scoreGroupUseCase Update %w", err)
 	}
@@ -63,7 +83,11 @@ func (u *scoreGroupUseCase) Update(ctx context.Context, scoreGroup entity.ScoreG
 }
 
 func (u *scoreGroupUseCase) Delete(ctx context.Context, id int64) error {
-	err := u.scoreGroupRepository.Delete(ctx, id)
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			return u.scoreGroupRepository.Delete(tc, id)
+		},
+	)
 	if err != nil {
 		return fmt.Errorf("scoreGroupUseCase Delete %w", err)
 	}
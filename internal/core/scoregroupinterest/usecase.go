@@ -7,6 +7,7 @@ import (
 
 	"github.com/shopspring/decimal"
 
+	"financing-offer/internal/atomicity"
 	"financing-offer/internal/core/entity"
 	loanPackageRequestRepo "financing-offer/internal/core/loanpackagerequest/repository"
 	"financing-offer/internal/core/scoregroupinterest/repository"
@@ -26,22 +27,31 @@ type useCase struct {
 	loanPackageRequestRepository loanPackageRequestRepo.LoanPackageRequestRepository
 	repository                   repository.ScoreGroupInterestRepository
 	symbolScoreRepository        symbolScoreRepo.SymbolScoreRepository
+	atomicExecutor               atomicity.AtomicExecutor
 }
 
 func NewUseCase(
 	repository repository.ScoreGroupInterestRepository,
 	loanPackageRequestRepository loanPackageRequestRepo.LoanPackageRequestRepository,
 	symbolScoreRepository symbolScoreRepo.SymbolScoreRepository,
+	atomicExecutor atomicity.AtomicExecutor,
 ) UseCase {
 	return &useCase{
 		repository:                   repository,
 		loanPackageRequestRepository: loanPackageRequestRepository,
 		symbolScoreRepository:        symbolScoreRepository,
+		atomicExecutor:               atomicExecutor,
 	}
 }
 
 func (u *useCase) Create(ctx context.Context, groupRole entity.ScoreGroupInterest) (entity.ScoreGroupInterest, error) {
-	res, err := u.repository.Create(ctx, groupRole)
+	var res entity.ScoreGroupInterest
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.repository.Create(tc, groupRole)
+			return err
+		},
+	)
 	if err != nil {
 		return res, fmt.Errorf("scoreGroupInterestUseCase Create %w", err)
 	}
@@ -49,7 +59,13 @@ func (u *useCase) Create(ctx context.Context, groupRole entity.ScoreGroupInteres
 }
 
 func (u *useCase) Update(ctx context.Context, groupRole entity.ScoreGroupInterest) (entity.ScoreGroupInterest, error) {
-	res, err := u.repository.Update(ctx, groupRole)
+	var res entity.ScoreGroupInterest
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.repository.Update(tc, groupRole)
+			return err
+		},
+	)
 	if err != nil {
 		return res, fmt.Errorf("scoreGroupInterestUseCase Update %w", err)
 	}
@@ -65,7 +81,13 @@ func (u *useCase) GetAll(ctx context.Context) ([]entity.ScoreGroupInterest, erro
 }
 
 func (u *useCase) Delete(ctx context.Context, id int64) (bool, error) {
-	res, err := u.repository.Delete(ctx, id)
+	var res bool
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.repository.Delete(tc, id)
+			return err
+		},
+	)
 	if err != nil {
 		return res, fmt.Errorf("scoreGroupInterestUseCase Delete %w", err)
 	}
@@ -83,3 +83,45 @@ func MapSubmissionSheetDetailDbToEntity(submissionSheetDetail model.SubmissionSh
 		Comment:           submissionSheetDetail.Comment,
 	}, nil
 }
+
+func MapSubmissionSheetApprovalEntityToDb(approval entity.SubmissionSheetApproval) model.SubmissionSheetApproval {
+	return model.SubmissionSheetApproval{
+		ID:                approval.Id,
+		SubmissionSheetID: approval.SubmissionSheetId,
+		Approver:          approval.Approver,
+		OnBehalfOf:        approval.OnBehalfOf,
+		CreatedAt:         approval.CreatedAt,
+		ExpiresAt:         approval.ExpiresAt,
+	}
+}
+
+func MapSubmissionSheetApprovalDbToEntity(approval model.SubmissionSheetApproval) entity.SubmissionSheetApproval {
+	return entity.SubmissionSheetApproval{
+		Id:                approval.ID,
+		SubmissionSheetId: approval.SubmissionSheetID,
+		Approver:          approval.Approver,
+		OnBehalfOf:        approval.OnBehalfOf,
+		CreatedAt:         approval.CreatedAt,
+		ExpiresAt:         approval.ExpiresAt,
+	}
+}
+
+func MapApprovalDelegationEntityToDb(delegation entity.ApprovalDelegation) model.ApprovalDelegation {
+	return model.ApprovalDelegation{
+		ID:        delegation.Id,
+		Delegator: delegation.Delegator,
+		Delegate:  delegation.Delegate,
+		ExpiresAt: delegation.ExpiresAt,
+		CreatedAt: delegation.CreatedAt,
+	}
+}
+
+func MapApprovalDelegationDbToEntity(delegation model.ApprovalDelegation) entity.ApprovalDelegation {
+	return entity.ApprovalDelegation{
+		Id:        delegation.ID,
+		Delegator: delegation.Delegator,
+		Delegate:  delegation.Delegate,
+		ExpiresAt: delegation.ExpiresAt,
+		CreatedAt: delegation.CreatedAt,
+	}
+}
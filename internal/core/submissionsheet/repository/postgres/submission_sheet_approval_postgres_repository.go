@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/submissionsheet/repository"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+)
+
+var _ repository.SubmissionSheetApprovalRepository = (*SubmissionSheetApprovalPostgresRepository)(nil)
+
+type SubmissionSheetApprovalPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewSubmissionSheetApprovalPostgresRepository(getDbFunc database.GetDbFunc) *SubmissionSheetApprovalPostgresRepository {
+	return &SubmissionSheetApprovalPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *SubmissionSheetApprovalPostgresRepository) GetSubmissionStatusForUpdate(ctx context.Context, submissionId int64) (entity.SubmissionSheetStatus, error) {
+	dest := model.SubmissionSheetMetadata{}
+	err := table.SubmissionSheetMetadata.SELECT(table.SubmissionSheetMetadata.ID, table.SubmissionSheetMetadata.Status).
+		WHERE(table.SubmissionSheetMetadata.ID.EQ(postgres.Int64(submissionId))).
+		FOR(postgres.UPDATE()).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return "", fmt.Errorf("SubmissionSheetApprovalPostgresRepository GetSubmissionStatusForUpdate: %w", err)
+	}
+	return entity.SubmissionSheetStatusFromString(dest.Status), nil
+}
+
+func (r *SubmissionSheetApprovalPostgresRepository) CreateApproval(ctx context.Context, approval entity.SubmissionSheetApproval) (entity.SubmissionSheetApproval, error) {
+	created := model.SubmissionSheetApproval{}
+	err := table.SubmissionSheetApproval.INSERT(table.SubmissionSheetApproval.MutableColumns).
+		MODEL(MapSubmissionSheetApprovalEntityToDb(approval)).
+		RETURNING(table.SubmissionSheetApproval.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &created)
+	if err != nil {
+		return entity.SubmissionSheetApproval{}, fmt.Errorf("SubmissionSheetApprovalPostgresRepository CreateApproval: %w", err)
+	}
+	return MapSubmissionSheetApprovalDbToEntity(created), nil
+}
+
+func (r *SubmissionSheetApprovalPostgresRepository) GetApprovalsBySubmissionId(ctx context.Context, submissionId int64) ([]entity.SubmissionSheetApproval, error) {
+	dest := make([]model.SubmissionSheetApproval, 0)
+	err := table.SubmissionSheetApproval.SELECT(table.SubmissionSheetApproval.AllColumns).
+		WHERE(table.SubmissionSheetApproval.SubmissionSheetID.EQ(postgres.Int64(submissionId))).
+		ORDER_BY(table.SubmissionSheetApproval.ID.ASC()).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return nil, fmt.Errorf("SubmissionSheetApprovalPostgresRepository GetApprovalsBySubmissionId: %w", err)
+	}
+	res := make([]entity.SubmissionSheetApproval, 0, len(dest))
+	for _, approval := range dest {
+		res = append(res, MapSubmissionSheetApprovalDbToEntity(approval))
+	}
+	return res, nil
+}
+
+func (r *SubmissionSheetApprovalPostgresRepository) DeleteExpiredApprovals(ctx context.Context, submissionId int64, at time.Time) error {
+	_, err := table.SubmissionSheetApproval.DELETE().
+		WHERE(
+			table.SubmissionSheetApproval.SubmissionSheetID.EQ(postgres.Int64(submissionId)).
+				AND(table.SubmissionSheetApproval.ExpiresAt.LT_EQ(postgres.TimestampT(at))),
+		).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("SubmissionSheetApprovalPostgresRepository DeleteExpiredApprovals: %w", err)
+	}
+	return nil
+}
+
+func (r *SubmissionSheetApprovalPostgresRepository) CreateDelegation(ctx context.Context, delegation entity.ApprovalDelegation) (entity.ApprovalDelegation, error) {
+	created := model.ApprovalDelegation{}
+	err := table.ApprovalDelegation.INSERT(table.ApprovalDelegation.MutableColumns).
+		MODEL(MapApprovalDelegationEntityToDb(delegation)).
+		RETURNING(table.ApprovalDelegation.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &created)
+	if err != nil {
+		return entity.ApprovalDelegation{}, fmt.Errorf("SubmissionSheetApprovalPostgresRepository CreateDelegation: %w", err)
+	}
+	return MapApprovalDelegationDbToEntity(created), nil
+}
+
+func (r *SubmissionSheetApprovalPostgresRepository) GetDelegationById(ctx context.Context, id int64) (entity.ApprovalDelegation, error) {
+	dest := model.ApprovalDelegation{}
+	err := table.ApprovalDelegation.SELECT(table.ApprovalDelegation.AllColumns).
+		WHERE(table.ApprovalDelegation.ID.EQ(postgres.Int64(id))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return entity.ApprovalDelegation{}, fmt.Errorf("SubmissionSheetApprovalPostgresRepository GetDelegationById: %w", err)
+	}
+	return MapApprovalDelegationDbToEntity(dest), nil
+}
+
+func (r *SubmissionSheetApprovalPostgresRepository) GetActiveDelegation(ctx context.Context, delegator string, delegate string, at time.Time) (entity.ApprovalDelegation, error) {
+	dest := model.ApprovalDelegation{}
+	err := table.ApprovalDelegation.SELECT(table.ApprovalDelegation.AllColumns).
+		WHERE(
+			table.ApprovalDelegation.Delegator.EQ(postgres.String(delegator)).
+				AND(table.ApprovalDelegation.Delegate.EQ(postgres.String(delegate))).
+				AND(table.ApprovalDelegation.ExpiresAt.GT(postgres.TimestampT(at))),
+		).
+		ORDER_BY(table.ApprovalDelegation.ExpiresAt.DESC()).
+		LIMIT(1).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return entity.ApprovalDelegation{}, fmt.Errorf("SubmissionSheetApprovalPostgresRepository GetActiveDelegation: %w", err)
+	}
+	return MapApprovalDelegationDbToEntity(dest), nil
+}
+
+func (r *SubmissionSheetApprovalPostgresRepository) DeleteDelegation(ctx context.Context, id int64) error {
+	_, err := table.ApprovalDelegation.DELETE().
+		WHERE(table.ApprovalDelegation.ID.EQ(postgres.Int64(id))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("SubmissionSheetApprovalPostgresRepository DeleteDelegation: %w", err)
+	}
+	return nil
+}
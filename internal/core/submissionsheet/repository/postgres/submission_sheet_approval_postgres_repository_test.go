@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestSubmissionSheetApprovalPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, _ := dbtest.New()
+	repo := NewSubmissionSheetApprovalPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	approvalColumns := []string{
+		"submission_sheet_approval.id",
+		"submission_sheet_approval.submission_sheet_id",
+		"submission_sheet_approval.approver",
+		"submission_sheet_approval.on_behalf_of",
+		"submission_sheet_approval.created_at",
+		"submission_sheet_approval.expires_at",
+	}
+	delegationColumns := []string{
+		"approval_delegation.id",
+		"approval_delegation.delegator",
+		"approval_delegation.delegate",
+		"approval_delegation.expires_at",
+		"approval_delegation.created_at",
+	}
+
+	t.Run("GetSubmissionStatusForUpdateSuccess", func(t *testing.T) {
+		mock.ExpectQuery(`(?s)SELECT .+FROM public.submission_sheet_metadata.+FOR UPDATE`).
+			WillReturnRows(
+				mock.NewRows([]string{"submission_sheet_metadata.id", "submission_sheet_metadata.status"}).AddRow(1, "SUBMITTED"),
+			)
+		status, err := repo.GetSubmissionStatusForUpdate(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, entity.SubmissionSheetStatusSubmitted, status)
+	})
+
+	t.Run("CreateApprovalSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)INSERT INTO public.submission_sheet_approval .+RETURNING`).
+			WillReturnRows(mock.NewRows(approvalColumns).AddRow(1, 1, "delegate", "riskOfficer", now, now.Add(time.Hour)))
+		created, err := repo.CreateApproval(
+			context.Background(), entity.SubmissionSheetApproval{
+				SubmissionSheetId: 1, Approver: "delegate", OnBehalfOf: "riskOfficer", ExpiresAt: now.Add(time.Hour),
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), created.Id)
+		assert.Equal(t, "riskOfficer", created.Principal())
+	})
+
+	t.Run("GetApprovalsBySubmissionIdSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)SELECT .+FROM public.submission_sheet_approval.+ORDER BY`).
+			WillReturnRows(
+				mock.NewRows(approvalColumns).
+					AddRow(1, 1, "approver1", "", now, now.Add(time.Hour)).
+					AddRow(2, 1, "approver2", "", now, now.Add(time.Hour)),
+			)
+		approvals, err := repo.GetApprovalsBySubmissionId(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.Len(t, approvals, 2)
+	})
+
+	t.Run("DeleteExpiredApprovalsError", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM public.submission_sheet_approval").WillReturnError(fmt.Errorf("error"))
+		err := repo.DeleteExpiredApprovals(context.Background(), 1, time.Now())
+		assert.Equal(t, "SubmissionSheetApprovalPostgresRepository DeleteExpiredApprovals: error", err.Error())
+	})
+
+	t.Run("GetActiveDelegationNotFound", func(t *testing.T) {
+		mock.ExpectQuery(`(?s)SELECT .+FROM public.approval_delegation.+LIMIT`).
+			WillReturnRows(mock.NewRows(delegationColumns))
+		_, err := repo.GetActiveDelegation(context.Background(), "riskOfficer", "delegate", time.Now())
+		assert.ErrorIs(t, err, qrm.ErrNoRows)
+	})
+
+	t.Run("CreateDelegationSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)INSERT INTO public.approval_delegation .+RETURNING`).
+			WillReturnRows(mock.NewRows(delegationColumns).AddRow(1, "riskOfficer", "delegate", now.Add(time.Hour), now))
+		created, err := repo.CreateDelegation(
+			context.Background(), entity.ApprovalDelegation{Delegator: "riskOfficer", Delegate: "delegate", ExpiresAt: now.Add(time.Hour)},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), created.Id)
+	})
+
+	t.Run("DeleteDelegationSuccess", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM public.approval_delegation").WillReturnResult(sqlmock.NewResult(0, 1))
+		assert.Nil(t, repo.DeleteDelegation(context.Background(), 1))
+	})
+}
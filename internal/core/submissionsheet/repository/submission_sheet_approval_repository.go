@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financing-offer/internal/core/entity"
+)
+
+type SubmissionSheetApprovalRepository interface {
+	// GetSubmissionStatusForUpdate locks the submission sheet until the transaction ends so concurrent approvals are counted once
+	GetSubmissionStatusForUpdate(ctx context.Context, submissionId int64) (entity.SubmissionSheetStatus, error)
+	CreateApproval(ctx context.Context, approval entity.SubmissionSheetApproval) (entity.SubmissionSheetApproval, error)
+	GetApprovalsBySubmissionId(ctx context.Context, submissionId int64) ([]entity.SubmissionSheetApproval, error)
+	DeleteExpiredApprovals(ctx context.Context, submissionId int64, at time.Time) error
+	CreateDelegation(ctx context.Context, delegation entity.ApprovalDelegation) (entity.ApprovalDelegation, error)
+	GetDelegationById(ctx context.Context, id int64) (entity.ApprovalDelegation, error)
+	GetActiveDelegation(ctx context.Context, delegator string, delegate string, at time.Time) (entity.ApprovalDelegation, error)
+	DeleteDelegation(ctx context.Context, id int64) error
+}
@@ -1,5 +1,7 @@
 package http
 
+import "time"
+
 type ApproveSubmissionRequest struct {
 	SubmissionId int64 `json:"submissionId"`
 }
@@ -7,3 +9,12 @@ type ApproveSubmissionRequest struct {
 type RejectSubmissionRequest struct {
 	SubmissionId int64 `json:"submissionId"`
 }
+
+type ApproveSubmissionSheetRequest struct {
+	OnBehalfOf string `json:"onBehalfOf"`
+}
+
+type CreateApprovalDelegationRequest struct {
+	Delegate  string    `json:"delegate" binding:"required"`
+	ExpiresAt time.Time `json:"expiresAt" binding:"required"`
+}
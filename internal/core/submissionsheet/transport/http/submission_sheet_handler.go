@@ -1,6 +1,8 @@
 package http
 
 import (
+	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 
@@ -51,8 +53,86 @@ func (h *SubmissionSheetHandler) AdminApproveSubmissionSheet(ctx *gin.Context) {
 		h.RenderBadRequest(ctx, "submissionId invalid")
 		return
 	}
-	err = h.UseCase.AdminApproveSubmission(ctx, id)
+	req := ApproveSubmissionSheetRequest{}
+	// the body is optional, it is only needed to approve on behalf of another user
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.RenderParseBodyError(ctx)
+		return
+	}
+	approver := h.UserSubOrEmpty(ctx)
+	if approver == "" {
+		h.RenderUnauthenticated(ctx)
+		return
+	}
+	res, err := h.UseCase.AdminApproveSubmission(ctx, id, approver, req.OnBehalfOf)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.SubmissionSheetApprovalProgress]{Data: res})
+}
+
+func (h *SubmissionSheetHandler) GetApprovals(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderBadRequest(ctx, "submissionId invalid")
+		return
+	}
+	res, err := h.UseCase.GetApprovals(ctx, id)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.SubmissionSheetApprovalProgress]{Data: res})
+}
+
+// PreviewOdooPayload returns the XML-RPC call the submission would send to Odoo, nothing is sent
+func (h *SubmissionSheetHandler) PreviewOdooPayload(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderBadRequest(ctx, "submissionId invalid")
+		return
+	}
+	res, err := h.UseCase.PreviewOdooPayload(ctx, id)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.OdooPayloadPreview]{Data: res})
+}
+
+func (h *SubmissionSheetHandler) CreateDelegation(ctx *gin.Context) {
+	req := CreateApprovalDelegationRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.RenderParseBodyError(ctx)
+		return
+	}
+	delegator := h.UserSubOrEmpty(ctx)
+	if delegator == "" {
+		h.RenderUnauthenticated(ctx)
+		return
+	}
+	res, err := h.UseCase.CreateDelegation(
+		ctx, entity.ApprovalDelegation{
+			Delegator: delegator,
+			Delegate:  req.Delegate,
+			ExpiresAt: req.ExpiresAt,
+		},
+	)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.ApprovalDelegation]{Data: res})
+}
+
+func (h *SubmissionSheetHandler) RevokeDelegation(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
 	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	if err := h.UseCase.RevokeDelegation(ctx, id, h.UserSubOrEmpty(ctx)); err != nil {
 		h.RenderError(ctx, err)
 		return
 	}
@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/scheduler"
+	"financing-offer/internal/core/submissionsheet"
+)
+
+type SubmissionSheetScheduler struct {
+	logger       *slog.Logger
+	useCase      submissionsheet.UseCase
+	errorService apperrors.Service
+}
+
+func NewSubmissionSheetScheduler(logger *slog.Logger, useCase submissionsheet.UseCase, errorService apperrors.Service) *SubmissionSheetScheduler {
+	return &SubmissionSheetScheduler{
+		logger:       logger,
+		useCase:      useCase,
+		errorService: errorService,
+	}
+}
+
+// SyncOdooDecisions writes the decisions made here to Odoo before applying the ones made in Odoo
+func (s *SubmissionSheetScheduler) SyncOdooDecisions(ctx context.Context) error {
+	reported, reportErr := s.useCase.ReportOdooDecisions(ctx)
+	if reportErr != nil {
+		s.logger.Error("ReportOdooDecisions", slog.String("error", reportErr.Error()))
+		if scheduler.FinalAttempt(ctx) {
+			if err := s.errorService.NotifyError(ctx, reportErr); err != nil {
+				s.logger.Error("ReportOdooDecisions NotifyError", slog.String("error", err.Error()))
+			}
+		}
+	}
+	if reported > 0 {
+		s.logger.Info("ReportOdooDecisions", slog.Int("reported", reported))
+	}
+	scheduler.Track(ctx, "reported", reported)
+	applied, err := s.useCase.SyncOdooDecisions(ctx)
+	if err != nil {
+		s.logger.Error("SyncOdooDecisions", slog.String("error", err.Error()))
+		if scheduler.FinalAttempt(ctx) {
+			if err := s.errorService.NotifyError(ctx, err); err != nil {
+				s.logger.Error("SyncOdooDecisions NotifyError", slog.String("error", err.Error()))
+			}
+		}
+	}
+	if applied > 0 {
+		s.logger.Info("SyncOdooDecisions", slog.Int("applied", applied))
+	}
+	scheduler.Track(ctx, "applied", applied)
+	return errors.Join(reportErr, err)
+}
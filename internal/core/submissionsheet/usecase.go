@@ -2,14 +2,17 @@ package submissionsheet
 
 import (
 	"context"
+	"errors"
 	"financing-offer/internal/config"
-	financingRepo "financing-offer/internal/core/financing/repository"
+	lifecycleRepo "financing-offer/internal/core/lifecycle/repository"
 	loanPackageOfferRepo "financing-offer/internal/core/loanoffer/repository"
 	loanOfferInterestRepo "financing-offer/internal/core/loanofferinterest/repository"
 	loanPackageRequestRepo "financing-offer/internal/core/loanpackagerequest/repository"
+	odooServiceRepo "financing-offer/internal/core/odoo_service/repository"
 	symbolRepo "financing-offer/internal/core/symbol/repository"
 	"fmt"
 	"github.com/shopspring/decimal"
+	"strings"
 	"time"
 
 	"financing-offer/internal/apperrors"
@@ -19,7 +22,10 @@ import (
 	loanPolicyTemplateRepo "financing-offer/internal/core/loanpolicytemplate/repository"
 	marginOperationRepo "financing-offer/internal/core/marginoperation/repository"
 	"financing-offer/internal/core/submissionsheet/repository"
+	"financing-offer/internal/core/tradingcalendar"
+	webhookRepo "financing-offer/internal/core/webhook/repository"
 	"financing-offer/internal/funcs"
+	"financing-offer/pkg/querymod"
 )
 
 type UseCase interface {
@@ -27,10 +33,22 @@ type UseCase interface {
 	Update(ctx context.Context, submissionSheetRequest entity.SubmissionSheetShorten, loanRate entity.LoanRate, loanPolicyTemplates []entity.AggregateLoanPolicyTemplate) (entity.SubmissionSheet, error)
 	Create(ctx context.Context, submissionSheetRequest entity.SubmissionSheetShorten, loanRate entity.LoanRate, loanPolicyTemplates []entity.AggregateLoanPolicyTemplate) (entity.SubmissionSheet, error)
 	GetLatestByRequestId(ctx context.Context, id int64) (entity.SubmissionSheet, error)
-	AdminApproveSubmission(ctx context.Context, submissionId int64) error
+	AdminApproveSubmission(ctx context.Context, submissionId int64, approver string, onBehalfOf string) (entity.SubmissionSheetApprovalProgress, error)
 	AdminRejectSubmission(ctx context.Context, submissionId int64) error
+	GetApprovals(ctx context.Context, submissionId int64) (entity.SubmissionSheetApprovalProgress, error)
+	CreateDelegation(ctx context.Context, delegation entity.ApprovalDelegation) (entity.ApprovalDelegation, error)
+	RevokeDelegation(ctx context.Context, id int64, delegator string) error
+	SyncOdooDecisions(ctx context.Context) (int, error)
+	ReportOdooDecisions(ctx context.Context) (int, error)
+	PreviewOdooPayload(ctx context.Context, submissionId int64) (entity.OdooPayloadPreview, error)
 }
 
+const (
+	defaultApprovalTtl = 72 * time.Hour
+	// odooApproverPrefix marks approvals recorded from Odoo decisions
+	odooApproverPrefix = "odoo:"
+)
+
 type submissionSheetUseCase struct {
 	repository                         repository.SubmissionSheetRepository
 	atomicExecutor                     atomicity.AtomicExecutor
@@ -40,11 +58,16 @@ type submissionSheetUseCase struct {
 	loanPackageRequestRepository       loanPackageRequestRepo.LoanPackageRequestRepository
 	loanPackageOfferRepository         loanPackageOfferRepo.LoanPackageOfferRepository
 	loanPackageOfferInterestRepository loanOfferInterestRepo.LoanPackageOfferInterestRepository
-	financingRepository                financingRepo.FinancingRepository
+	tradingCalendar                    tradingcalendar.Calendar
 	appConfig                          config.AppConfig
 	errorService                       apperrors.Service
 	loanPackageRequestEventRepository  loanPackageRequestRepo.LoanPackageRequestEventRepository
 	symbolRepository                   symbolRepo.SymbolRepository
+	approvalRepository                 repository.SubmissionSheetApprovalRepository
+	odooServiceRepository              odooServiceRepo.OdooServiceRepository
+	odooLoanApprovalRepository         odooServiceRepo.OdooLoanApprovalRepository
+	lifecycleRepository                lifecycleRepo.LoanRequestLifecycleRepository
+	webhookEventRepository             webhookRepo.WebhookEventRepository
 }
 
 // Create new submission sheet if submission sheet is not existed or the latest submission sheet is rejected by odoo
@@ -264,107 +287,445 @@ func (u *submissionSheetUseCase) GetLatestByRequestId(ctx context.Context, id in
 	return submissionSheet, nil
 }
 
-func (u *submissionSheetUseCase) AdminApproveSubmission(ctx context.Context, submissionId int64) error {
+// AdminApproveSubmission records the approval of approver, acting for onBehalfOf when set,
+// and confirms the request once the sheet has collected the approvals it requires
+func (u *submissionSheetUseCase) AdminApproveSubmission(ctx context.Context, submissionId int64, approver string, onBehalfOf string) (entity.SubmissionSheetApprovalProgress, error) {
+	return u.approveSubmission(ctx, submissionId, approver, onBehalfOf, false)
+}
+
+// approveSubmission skips reporting the decision back to Odoo when it was made there
+func (u *submissionSheetUseCase) approveSubmission(ctx context.Context, submissionId int64, approver string, onBehalfOf string, fromOdoo bool) (entity.SubmissionSheetApprovalProgress, error) {
 	errorTemplate := "submissionSheetUseCase AdminApproveSubmission %w"
 	submissionSheet, err := u.repository.GetById(ctx, submissionId)
 	if err != nil {
-		return fmt.Errorf(errorTemplate, err)
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, err)
 	}
 	if submissionSheet.Metadata.Status != entity.SubmissionSheetStatusSubmitted {
-		return fmt.Errorf(errorTemplate, apperrors.ErrorInvalidCurrentSubmissionStatus)
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, apperrors.ErrorInvalidCurrentSubmissionStatus)
 	}
 	request, err := u.loanPackageRequestRepository.GetById(ctx, submissionSheet.Metadata.LoanPackageRequestId, entity.LoanPackageFilter{})
 	if err != nil {
-		return fmt.Errorf(errorTemplate, err)
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, err)
 	}
 	if request.Status != entity.LoanPackageRequestStatusPending {
-		return fmt.Errorf(errorTemplate, apperrors.ErrInvalidRequestStatus)
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, apperrors.ErrInvalidRequestStatus)
 	}
-	offerExpireTime, err := u.financingRepository.GetDateAfter(time.Now(), u.appConfig.LoanRequest.ExpireDays)
-	if err != nil {
-		return fmt.Errorf(errorTemplate, err)
+	now := time.Now()
+	if onBehalfOf == approver {
+		onBehalfOf = ""
 	}
-	firstPolicy := submissionSheet.Detail.LoanPolicies[0] //all policy share same attributes such as term, interest rate, etc
-	var (
-		offer               entity.LoanPackageOffer
-		acceptOfferInterest entity.LoanPackageOfferInterest
-	)
+	if onBehalfOf != "" {
+		if _, err := u.approvalRepository.GetActiveDelegation(ctx, onBehalfOf, approver, now); err != nil {
+			if apperrors.IsNotFoundError(err) {
+				return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, apperrors.ErrApprovalDelegationNotFound)
+			}
+			return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, err)
+		}
+	}
+	approval := entity.SubmissionSheetApproval{
+		SubmissionSheetId: submissionId,
+		Approver:          approver,
+		OnBehalfOf:        onBehalfOf,
+		ExpiresAt:         now.Add(u.approvalTtl()),
+	}
+	if approver == "" || sameApprover(approver, submissionSheet.Metadata.Creator) || sameApprover(approval.Principal(), submissionSheet.Metadata.Creator) {
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, apperrors.ErrSubmissionSelfApproval)
+	}
+	progress := entity.SubmissionSheetApprovalProgress{
+		SubmissionSheetId: submissionId,
+		Status:            entity.SubmissionSheetStatusSubmitted,
+		RequiredApprovals: u.requiredApprovals(submissionSheet, request),
+	}
+	// looked up ahead of the transaction in case this approval is the one that confirms the request
+	accountNoDesc := u.getAccountNoDesc(ctx, request)
 	txErr := u.atomicExecutor.Execute(
 		ctx, func(tc context.Context) error {
-			err := u.repository.UpdateMetadataStatusById(
-				tc, submissionSheet.Metadata.Id, entity.SubmissionSheetStatusApproved,
-			)
+			status, err := u.approvalRepository.GetSubmissionStatusForUpdate(tc, submissionId)
 			if err != nil {
 				return err
 			}
-			request, err = u.loanPackageRequestRepository.UpdateStatusById(tc, request.Id, entity.LoanPackageRequestStatusConfirmed)
-			if err != nil {
+			if status != entity.SubmissionSheetStatusSubmitted {
+				return apperrors.ErrorInvalidCurrentSubmissionStatus
+			}
+			if err := u.approvalRepository.DeleteExpiredApprovals(tc, submissionId, now); err != nil {
 				return err
 			}
-			offer, err = u.loanPackageOfferRepository.Create(
-				ctx, entity.LoanPackageOffer{
-					LoanPackageRequestId: request.Id,
-					OfferedBy:            submissionSheet.Metadata.Creator,
-					FlowType:             submissionSheet.Metadata.FlowType,
-					ExpiredAt:            offerExpireTime,
-				},
-			)
+			approvals, err := u.approvalRepository.GetApprovalsBySubmissionId(tc, submissionId)
 			if err != nil {
 				return err
 			}
-			acceptOfferInterest = entity.LoanPackageOfferInterest{
-				LoanPackageOfferId:      offer.Id,
-				SubmissionSheetDetailId: submissionSheet.Detail.Id,
-				LoanID:                  0,
-				Status:                  entity.LoanPackageOfferInterestStatusPending,
-				AssetType:               request.AssetType,
-				LimitAmount:             request.LimitAmount,
-				ContractSize:            request.ContractSize,
-				InitialRate:             request.InitialRate,
-				InterestRate:            firstPolicy.InterestRate,
-				LoanRate:                decimal.NewFromInt(1).Sub(submissionSheet.Detail.LoanRate.InitialRate),
-				FeeRate:                 submissionSheet.Detail.FirmBuyingFee,
-				Term:                    int(firstPolicy.Term),
-			}
-			offerInterests := []entity.LoanPackageOfferInterest{acceptOfferInterest}
-			if submissionSheet.Metadata.ActionType == entity.RejectAndSendOtherProposal {
-				cancelOfferInterest := entity.LoanPackageOfferInterest{
-					LoanPackageOfferId: offer.Id,
-					LimitAmount:        request.LimitAmount,
-					LoanRate:           request.LoanRate,
-					InterestRate:       decimal.Zero,
-					Status:             entity.LoanPackageOfferInterestStatusCancelled,
-					CancelledBy:        submissionSheet.Metadata.Creator,
-					CancelledAt:        time.Now(),
-					FeeRate:            decimal.Zero,
-					CancelledReason:    entity.LoanPackageOfferCancelledReasonAlternativeOption,
-					AssetType:          request.AssetType,
+			for _, existed := range approvals {
+				if sameApprover(existed.Approver, approver) || sameApprover(existed.Principal(), approval.Principal()) {
+					return apperrors.ErrSubmissionAlreadyApproved
 				}
-				offerInterests = append(offerInterests, cancelOfferInterest)
 			}
-
-			_, err = u.loanPackageOfferInterestRepository.BulkCreate(ctx, offerInterests)
+			created, err := u.approvalRepository.CreateApproval(tc, approval)
 			if err != nil {
 				return err
 			}
-			return nil
-		})
-
+			progress.Approvals = append(approvals, created)
+			if len(progress.Approvals) < progress.RequiredApprovals {
+				return nil
+			}
+			progress.Status = entity.SubmissionSheetStatusApproved
+			return u.confirmApprovedSubmission(
+				tc, submissionSheet, request, accountNoDesc, progress.Approvals, fromOdoo,
+			)
+		},
+	)
 	if txErr != nil {
-		return fmt.Errorf(errorTemplate, txErr)
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, txErr)
+	}
+	if progress.Status == entity.SubmissionSheetStatusApproved {
+		// the approval confirmed the request with a new offer, let its lifecycle workflow pick the offer up
+		lifecycleCtx := context.WithoutCancel(ctx)
+		u.errorService.Go(
+			lifecycleCtx, func() error {
+				return u.lifecycleRepository.LoanRequestUpdated(lifecycleCtx, request.Id)
+			},
+		)
+	}
+	return progress, nil
+}
+
+// confirmApprovedSubmission confirms the request with an offer built from the submission sheet
+// and reports the final decision to Odoo, it must run inside the approving transaction
+func (u *submissionSheetUseCase) confirmApprovedSubmission(
+	ctx context.Context,
+	submissionSheet entity.SubmissionSheet,
+	request entity.LoanPackageRequest,
+	accountNoDesc string,
+	approvals []entity.SubmissionSheetApproval,
+	fromOdoo bool,
+) error {
+	offerExpireTime, err := tradingcalendar.AddTradingDaysForSymbol(
+		ctx, u.tradingCalendar, request.SymbolId, time.Now(), u.appConfig.LoanRequest.ExpireDays,
+	)
+	if err != nil {
+		return err
 	}
-	u.errorService.Go(
-		ctx, func() error {
-			return u.notifyRequestOnlineConfirmation(
-				atomicity.WithIgnoreTx(ctx), request, acceptOfferInterest.Id, offer.Id,
-			)
+	firstPolicy := submissionSheet.Detail.LoanPolicies[0] //all policy share same attributes such as term, interest rate, etc
+	err = u.repository.UpdateMetadataStatusById(
+		ctx, submissionSheet.Metadata.Id, entity.SubmissionSheetStatusApproved,
+	)
+	if err != nil {
+		return err
+	}
+	// the request read ahead of the transaction may have been declined or cancelled since
+	request, err = u.loanPackageRequestRepository.GetById(ctx, request.Id, entity.LoanPackageFilter{}, querymod.WithLock())
+	if err != nil {
+		return err
+	}
+	if !request.Status.CanTransitionTo(entity.LoanPackageRequestStatusConfirmed) {
+		return apperrors.ErrInvalidRequestStatusTransition(request.Status, entity.LoanPackageRequestStatusConfirmed)
+	}
+	err = u.loanPackageRequestRepository.CreateStatusHistories(
+		ctx, []entity.LoanPackageRequestStatusHistory{
+			{
+				LoanPackageRequestId: request.Id,
+				FromStatus:           request.Status,
+				ToStatus:             entity.LoanPackageRequestStatusConfirmed,
+				Actor:                approvals[len(approvals)-1].Approver,
+				Reason:               entity.LoanPackageRequestStatusReasonSubmissionApproved,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	request, err = u.loanPackageRequestRepository.UpdateStatusById(ctx, request.Id, entity.LoanPackageRequestStatusConfirmed)
+	if err != nil {
+		return err
+	}
+	offer, err := u.loanPackageOfferRepository.Create(
+		ctx, entity.LoanPackageOffer{
+			LoanPackageRequestId: request.Id,
+			OfferedBy:            submissionSheet.Metadata.Creator,
+			FlowType:             submissionSheet.Metadata.FlowType,
+			ExpiredAt:            offerExpireTime,
 		},
 	)
+	if err != nil {
+		return err
+	}
+	acceptOfferInterest := entity.LoanPackageOfferInterest{
+		LoanPackageOfferId:      offer.Id,
+		SubmissionSheetDetailId: submissionSheet.Detail.Id,
+		LoanID:                  0,
+		Status:                  entity.LoanPackageOfferInterestStatusPending,
+		AssetType:               request.AssetType,
+		LimitAmount:             request.LimitAmount,
+		ContractSize:            request.ContractSize,
+		InitialRate:             request.InitialRate,
+		InterestRate:            firstPolicy.InterestRate,
+		LoanRate:                decimal.NewFromInt(1).Sub(submissionSheet.Detail.LoanRate.InitialRate),
+		FeeRate:                 submissionSheet.Detail.FirmBuyingFee,
+		Term:                    int(firstPolicy.Term),
+	}
+	offerInterests := []entity.LoanPackageOfferInterest{acceptOfferInterest}
+	if submissionSheet.Metadata.ActionType == entity.RejectAndSendOtherProposal {
+		cancelOfferInterest := entity.LoanPackageOfferInterest{
+			LoanPackageOfferId: offer.Id,
+			LimitAmount:        request.LimitAmount,
+			LoanRate:           request.LoanRate,
+			InterestRate:       decimal.Zero,
+			Status:             entity.LoanPackageOfferInterestStatusCancelled,
+			CancelledBy:        submissionSheet.Metadata.Creator,
+			CancelledAt:        time.Now(),
+			FeeRate:            decimal.Zero,
+			CancelledReason:    entity.LoanPackageOfferCancelledReasonAlternativeOption,
+			AssetType:          request.AssetType,
+		}
+		offerInterests = append(offerInterests, cancelOfferInterest)
+	}
+
+	createdOfferInterests, err := u.loanPackageOfferInterestRepository.BulkCreate(ctx, offerInterests)
+	if err != nil {
+		return err
+	}
+	if len(createdOfferInterests) > 0 {
+		acceptOfferInterest = createdOfferInterests[0]
+	}
+	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
+	if err != nil {
+		return err
+	}
+	if err := u.notifyRequestOnlineConfirmation(
+		ctx, request, symbol, accountNoDesc, acceptOfferInterest.Id, offer.Id,
+	); err != nil {
+		return err
+	}
+	if err := u.webhookEventRepository.Publish(
+		ctx, entity.WebhookEventTypeSubmissionApproved, entity.WebhookSubmissionApprovedData{
+			SubmissionSheetId:    submissionSheet.Metadata.Id,
+			LoanPackageRequestId: request.Id,
+			ApprovedBy:           approvals[len(approvals)-1].Approver,
+		},
+	); err != nil {
+		return err
+	}
+	if fromOdoo {
+		return nil
+	}
+	odooApproval, err := u.odooLoanApprovalRepository.GetBySubmissionId(ctx, submissionSheet.Metadata.Id)
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return err
+		}
+		// the sheet never went through Odoo, its record is created there when the decision is reported
+		// so both sides keep the same history
+		odooApproval = entity.OdooLoanApproval{SubmissionSheetId: submissionSheet.Metadata.Id}
+	}
+	return u.queueDecisionForOdoo(ctx, odooApproval, entity.OdooLoanApprovalStateApproved)
+}
 
+// queueDecisionForOdoo records a decision made in this service for ReportOdooDecisions to write to Odoo,
+// the record is stored in the deciding transaction so the decision reaches Odoo once it commits
+func (u *submissionSheetUseCase) queueDecisionForOdoo(ctx context.Context, odooApproval entity.OdooLoanApproval, state entity.OdooLoanApprovalState) error {
+	odooApproval.LastError = ""
+	odooApproval.Attempts = 0
+	odooApproval.NextAttemptAt = time.Now()
+	if odooApproval.OdooRecordId != 0 && odooApproval.OdooState == state {
+		// Odoo already holds the decision, e.g. its approval was the first of a dual approval
+		odooApproval.SyncStatus = entity.OdooLoanApprovalSyncStatusSynced
+	} else {
+		odooApproval.SyncStatus = entity.OdooLoanApprovalSyncStatusReporting
+		odooApproval.OdooState = state
+	}
+	_, err := u.odooLoanApprovalRepository.Upsert(ctx, odooApproval)
+	return err
+}
+
+// ReportOdooDecisions writes the decisions queued by queueDecisionForOdoo to Odoo,
+// a failed report is retried with backoff until the attempts run out
+func (u *submissionSheetUseCase) ReportOdooDecisions(ctx context.Context) (int, error) {
+	errorTemplate := "submissionSheetUseCase ReportOdooDecisions %w"
+	cfg := u.appConfig.OdooSync
+	now := time.Now()
+	due, err := u.odooLoanApprovalRepository.GetDue(ctx, entity.OdooLoanApprovalSyncStatusReporting, now, cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	reported := 0
+	var errs []error
+	for _, odooApproval := range due {
+		recordId, reportErr := u.reportDecisionToOdoo(ctx, odooApproval)
+		odooApproval.OdooRecordId = recordId
+		switch {
+		case reportErr == nil:
+			reported++
+			odooApproval.SyncStatus = entity.OdooLoanApprovalSyncStatusSynced
+			odooApproval.LastError = ""
+		case cfg.MaxAttempts > 0 && odooApproval.Attempts+1 >= cfg.MaxAttempts:
+			odooApproval.Attempts++
+			odooApproval.SyncStatus = entity.OdooLoanApprovalSyncStatusFailed
+			odooApproval.LastError = reportErr.Error()
+			errs = append(errs, reportErr)
+		default:
+			odooApproval.Attempts++
+			odooApproval.LastError = reportErr.Error()
+			odooApproval.NextAttemptAt = now.Add(odooSyncBackoff(cfg, odooApproval.Attempts))
+		}
+		// upserted rather than updated to keep the id of a record created by the report
+		if _, err := u.odooLoanApprovalRepository.Upsert(ctx, odooApproval); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return reported, fmt.Errorf(errorTemplate, errors.Join(errs...))
+	}
+	return reported, nil
+}
+
+// reportDecisionToOdoo sets the state of the sheet's Odoo record to a decision made in this service and returns the record id,
+// a sheet without a record gets one created with its approvers in the description
+func (u *submissionSheetUseCase) reportDecisionToOdoo(ctx context.Context, odooApproval entity.OdooLoanApproval) (int64, error) {
+	if odooApproval.OdooRecordId != 0 {
+		return odooApproval.OdooRecordId, u.odooServiceRepository.UpdateLoanApprovalState(odooApproval.OdooRecordId, odooApproval.OdooState)
+	}
+	submissionSheet, err := u.repository.GetById(ctx, odooApproval.SubmissionSheetId)
+	if err != nil {
+		return 0, err
+	}
+	request, err := u.loanPackageRequestRepository.GetById(ctx, submissionSheet.Metadata.LoanPackageRequestId, entity.LoanPackageFilter{})
+	if err != nil {
+		return 0, err
+	}
+	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
+	if err != nil {
+		return 0, err
+	}
+	approvals, err := u.approvalRepository.GetApprovalsBySubmissionId(ctx, odooApproval.SubmissionSheetId)
+	if err != nil {
+		return 0, err
+	}
+	approvalRequest := entity.NewLoanApprovalRequest(request, submissionSheet, symbol.Symbol, u.appConfig.OdooCategoryId)
+	approvalRequest.Description = fmt.Sprintf("%s (approved by %s)", approvalRequest.Description, approversDescription(approvals))
+	return u.odooServiceRepository.SendLoanApprovalRequest(approvalRequest)
+}
+
+func (u *submissionSheetUseCase) GetApprovals(ctx context.Context, submissionId int64) (entity.SubmissionSheetApprovalProgress, error) {
+	errorTemplate := "submissionSheetUseCase GetApprovals %w"
+	submissionSheet, err := u.repository.GetById(ctx, submissionId)
+	if err != nil {
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, err)
+	}
+	request, err := u.loanPackageRequestRepository.GetById(ctx, submissionSheet.Metadata.LoanPackageRequestId, entity.LoanPackageFilter{})
+	if err != nil {
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, err)
+	}
+	approvals, err := u.approvalRepository.GetApprovalsBySubmissionId(ctx, submissionId)
+	if err != nil {
+		return entity.SubmissionSheetApprovalProgress{}, fmt.Errorf(errorTemplate, err)
+	}
+	now := time.Now()
+	// approvals of a decided sheet are kept as its record, only pending ones lapse
+	if submissionSheet.Metadata.Status == entity.SubmissionSheetStatusSubmitted {
+		approvals = funcs.Filter(approvals, func(a entity.SubmissionSheetApproval, _ int) bool { return a.ExpiresAt.After(now) })
+	}
+	return entity.SubmissionSheetApprovalProgress{
+		SubmissionSheetId: submissionId,
+		Status:            submissionSheet.Metadata.Status,
+		RequiredApprovals: u.requiredApprovals(submissionSheet, request),
+		Approvals:         approvals,
+	}, nil
+}
+
+// PreviewOdooPayload renders the approval request a submission sheet sends to Odoo without sending it
+func (u *submissionSheetUseCase) PreviewOdooPayload(ctx context.Context, submissionId int64) (entity.OdooPayloadPreview, error) {
+	errorTemplate := "submissionSheetUseCase PreviewOdooPayload %w"
+	submissionSheet, err := u.repository.GetById(ctx, submissionId)
+	if err != nil {
+		return entity.OdooPayloadPreview{}, fmt.Errorf(errorTemplate, err)
+	}
+	request, err := u.loanPackageRequestRepository.GetById(ctx, submissionSheet.Metadata.LoanPackageRequestId, entity.LoanPackageFilter{})
+	if err != nil {
+		return entity.OdooPayloadPreview{}, fmt.Errorf(errorTemplate, err)
+	}
+	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
+	if err != nil {
+		return entity.OdooPayloadPreview{}, fmt.Errorf(errorTemplate, err)
+	}
+	preview, err := u.odooServiceRepository.PreviewLoanApprovalRequest(
+		entity.NewLoanApprovalRequest(request, submissionSheet, symbol.Symbol, u.appConfig.OdooCategoryId),
+	)
+	if err != nil {
+		return entity.OdooPayloadPreview{}, fmt.Errorf(errorTemplate, err)
+	}
+	return preview, nil
+}
+
+func (u *submissionSheetUseCase) CreateDelegation(ctx context.Context, delegation entity.ApprovalDelegation) (entity.ApprovalDelegation, error) {
+	errorTemplate := "submissionSheetUseCase CreateDelegation %w"
+	now := time.Now()
+	maxDuration := u.appConfig.SubmissionApproval.MaxDelegationDuration
+	if delegation.Delegator == "" || delegation.Delegate == "" || delegation.Delegator == delegation.Delegate ||
+		!delegation.ExpiresAt.After(now) || (maxDuration > 0 && delegation.ExpiresAt.After(now.Add(maxDuration))) {
+		return entity.ApprovalDelegation{}, fmt.Errorf(errorTemplate, apperrors.ErrInvalidApprovalDelegation)
+	}
+	created, err := u.approvalRepository.CreateDelegation(ctx, delegation)
+	if err != nil {
+		return entity.ApprovalDelegation{}, fmt.Errorf(errorTemplate, err)
+	}
+	return created, nil
+}
+
+// RevokeDelegation removes a delegation, only its delegator may revoke it
+func (u *submissionSheetUseCase) RevokeDelegation(ctx context.Context, id int64, delegator string) error {
+	errorTemplate := "submissionSheetUseCase RevokeDelegation %w"
+	delegation, err := u.approvalRepository.GetDelegationById(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return fmt.Errorf(errorTemplate, apperrors.ErrApprovalDelegationNotFound)
+		}
+		return fmt.Errorf(errorTemplate, err)
+	}
+	if delegation.Delegator != delegator {
+		return fmt.Errorf(errorTemplate, apperrors.ErrApprovalDelegationNotFound)
+	}
+	if err := u.approvalRepository.DeleteDelegation(ctx, id); err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
 	return nil
 }
 
+// requiredApprovals asks for a second approver when the offered loan rate or the requested limit reaches its threshold
+func (u *submissionSheetUseCase) requiredApprovals(submissionSheet entity.SubmissionSheet, request entity.LoanPackageRequest) int {
+	cfg := u.appConfig.SubmissionApproval
+	loanRate := decimal.NewFromInt(1).Sub(submissionSheet.Detail.LoanRate.InitialRate)
+	if cfg.DualApprovalLoanRate > 0 && loanRate.GreaterThanOrEqual(decimal.NewFromFloat(cfg.DualApprovalLoanRate)) {
+		return 2
+	}
+	if cfg.DualApprovalLimitAmount > 0 && request.LimitAmount.GreaterThanOrEqual(decimal.NewFromFloat(cfg.DualApprovalLimitAmount)) {
+		return 2
+	}
+	return 1
+}
+
+func (u *submissionSheetUseCase) approvalTtl() time.Duration {
+	if u.appConfig.SubmissionApproval.ApprovalTtl > 0 {
+		return u.appConfig.SubmissionApproval.ApprovalTtl
+	}
+	return defaultApprovalTtl
+}
+
+func approversDescription(approvals []entity.SubmissionSheetApproval) string {
+	approvers := make([]string, 0, len(approvals))
+	for _, approval := range approvals {
+		if approval.OnBehalfOf != "" {
+			approvers = append(approvers, fmt.Sprintf("%s on behalf of %s", approval.Approver, approval.OnBehalfOf))
+			continue
+		}
+		approvers = append(approvers, approval.Approver)
+	}
+	return strings.Join(approvers, ", ")
+}
+
 func (u *submissionSheetUseCase) AdminRejectSubmission(ctx context.Context, submissionId int64) error {
+	return u.rejectSubmission(ctx, submissionId, false)
+}
+
+func (u *submissionSheetUseCase) rejectSubmission(ctx context.Context, submissionId int64, fromOdoo bool) error {
 	errorTemplate := "submissionSheetUseCase AdminRejectSubmission %w"
 	submissionSheet, err := u.repository.GetById(ctx, submissionId)
 	if err != nil {
@@ -373,39 +734,173 @@ func (u *submissionSheetUseCase) AdminRejectSubmission(ctx context.Context, subm
 	if submissionSheet.Metadata.Status != entity.SubmissionSheetStatusSubmitted {
 		return fmt.Errorf(errorTemplate, apperrors.ErrorInvalidCurrentSubmissionStatus)
 	}
-	err = u.repository.UpdateMetadataStatusById(
-		ctx, submissionId, entity.SubmissionSheetStatusRejected,
+	txErr := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			err := u.repository.UpdateMetadataStatusById(
+				tc, submissionId, entity.SubmissionSheetStatusRejected,
+			)
+			if err != nil {
+				return err
+			}
+			if fromOdoo {
+				return nil
+			}
+			odooApproval, err := u.odooLoanApprovalRepository.GetBySubmissionId(tc, submissionId)
+			if err != nil {
+				if apperrors.IsNotFoundError(err) {
+					return nil
+				}
+				return err
+			}
+			return u.queueDecisionForOdoo(tc, odooApproval, entity.OdooLoanApprovalStateRefused)
+		},
 	)
-	if err != nil {
-		return fmt.Errorf(errorTemplate, err)
+	if txErr != nil {
+		return fmt.Errorf(errorTemplate, txErr)
 	}
 	return nil
 }
 
-func (u *submissionSheetUseCase) notifyRequestOnlineConfirmation(
-	ctx context.Context,
-	request entity.LoanPackageRequest,
-	offerInterestId int64,
-	offerId int64,
-) error {
-	symbol, err := u.symbolRepository.GetById(ctx, request.SymbolId)
+// SyncOdooDecisions applies the decisions made in Odoo on pending approval records,
+// a failed application is retried with backoff until the attempts run out.
+// A record stays pending until the sheet is decided, an Odoo approval that is one of several required
+// is polled again until the remaining approvals are given here
+func (u *submissionSheetUseCase) SyncOdooDecisions(ctx context.Context) (int, error) {
+	errorTemplate := "submissionSheetUseCase SyncOdooDecisions %w"
+	cfg := u.appConfig.OdooSync
+	now := time.Now()
+	pending, err := u.odooLoanApprovalRepository.GetDue(ctx, entity.OdooLoanApprovalSyncStatusPending, now, cfg.BatchSize)
 	if err != nil {
-		return err
+		return 0, fmt.Errorf(errorTemplate, err)
 	}
-	// only include accountNo if investor has more than 1 account
-	accountNoDesc := ""
+	if len(pending) == 0 {
+		return 0, nil
+	}
+	recordIds := funcs.Map(pending, func(a entity.OdooLoanApproval) int64 { return a.OdooRecordId })
+	decisions, err := u.odooServiceRepository.GetLoanApprovalDecisions(recordIds)
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	decisionByRecordId := funcs.AssociateBy[entity.OdooLoanApprovalDecision, int64](
+		decisions, func(d entity.OdooLoanApprovalDecision) int64 {
+			return d.RecordId
+		},
+	)
+	applied := 0
+	var errs []error
+	for _, odooApproval := range pending {
+		decision, ok := decisionByRecordId[odooApproval.OdooRecordId]
+		if !ok {
+			continue
+		}
+		decided, applyErr := u.applyOdooDecision(ctx, odooApproval.SubmissionSheetId, decision)
+		odooApproval.OdooState = decision.State
+		var appErr apperrors.AppError
+		switch {
+		case applyErr == nil && decided:
+			applied++
+			odooApproval.SyncStatus = entity.OdooLoanApprovalSyncStatusSynced
+			odooApproval.LastError = ""
+		case applyErr == nil:
+			odooApproval.LastError = ""
+			odooApproval.NextAttemptAt = now.Add(cfg.RetryBackoff)
+		case errors.As(applyErr, &appErr) || (cfg.MaxAttempts > 0 && odooApproval.Attempts+1 >= cfg.MaxAttempts):
+			// a business rule rejection is final, other errors are retried until the attempts run out
+			odooApproval.Attempts++
+			odooApproval.SyncStatus = entity.OdooLoanApprovalSyncStatusFailed
+			odooApproval.LastError = applyErr.Error()
+			errs = append(errs, applyErr)
+		default:
+			odooApproval.Attempts++
+			odooApproval.LastError = applyErr.Error()
+			odooApproval.NextAttemptAt = now.Add(odooSyncBackoff(cfg, odooApproval.Attempts))
+		}
+		if err := u.odooLoanApprovalRepository.Update(ctx, odooApproval); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return applied, fmt.Errorf(errorTemplate, errors.Join(errs...))
+	}
+	return applied, nil
+}
+
+// applyOdooDecision maps an Odoo decision onto the submission sheet and reports whether the sheet is decided,
+// a sheet that was already decided here is left as is
+func (u *submissionSheetUseCase) applyOdooDecision(ctx context.Context, submissionId int64, decision entity.OdooLoanApprovalDecision) (bool, error) {
+	submissionSheet, err := u.repository.GetById(ctx, submissionId)
+	if err != nil {
+		return false, err
+	}
+	if submissionSheet.Metadata.Status != entity.SubmissionSheetStatusSubmitted {
+		return true, nil
+	}
+	switch decision.State {
+	case entity.OdooLoanApprovalStateApproved:
+		if decision.DecidedBy == "" {
+			// without its decider the approval cannot be checked against the creator and the other approvers
+			return false, apperrors.ErrInvalidInput("odoo approval has no decider")
+		}
+		progress, err := u.approveSubmission(ctx, submissionId, odooApproverPrefix+decision.DecidedBy, "", true)
+		if errors.Is(err, apperrors.ErrSubmissionAlreadyApproved) {
+			// the decider was counted on an earlier poll or approved here, the sheet waits for another approver
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return progress.Status == entity.SubmissionSheetStatusApproved, nil
+	case entity.OdooLoanApprovalStateRefused:
+		return true, u.rejectSubmission(ctx, submissionId, true)
+	default:
+		return false, nil
+	}
+}
+
+// sameApprover tells whether two approvers are the same user, an approval recorded from Odoo carries
+// the name of the Odoo user which is expected to match the user name used here
+func sameApprover(a, b string) bool {
+	return strings.EqualFold(strings.TrimPrefix(a, odooApproverPrefix), strings.TrimPrefix(b, odooApproverPrefix))
+}
+
+func odooSyncBackoff(cfg config.OdooSyncConfig, attempts int32) time.Duration {
+	backoff := cfg.RetryBackoff
+	for i := int32(1); i < attempts && (cfg.MaxRetryBackoff <= 0 || backoff < cfg.MaxRetryBackoff); i++ {
+		backoff *= 2
+	}
+	if cfg.MaxRetryBackoff > 0 && backoff > cfg.MaxRetryBackoff {
+		return cfg.MaxRetryBackoff
+	}
+	return backoff
+}
+
+// getAccountNoDesc only includes accountNo if investor has more than 1 account.
+// The description is cosmetic, it is looked up before the transaction that enqueues the notification so that
+// the transaction does not wait on the financial product service, and a failed lookup leaves it empty
+func (u *submissionSheetUseCase) getAccountNoDesc(ctx context.Context, request entity.LoanPackageRequest) string {
 	accounts, err := u.financialProductRepository.GetAllAccountDetail(ctx, request.InvestorId)
 	if err != nil {
-		return err
+		_ = u.errorService.NotifyError(ctx, fmt.Errorf("submissionSheetUseCase getAccountNoDesc %w", err))
+		return ""
 	}
 	if len(accounts) > 1 {
 		for _, account := range accounts {
 			if account.AccountNo == request.AccountNo {
-				accountNoDesc = account.AccountTypeName
-				break
+				return account.AccountTypeName
 			}
 		}
 	}
+	return ""
+}
+
+func (u *submissionSheetUseCase) notifyRequestOnlineConfirmation(
+	ctx context.Context,
+	request entity.LoanPackageRequest,
+	symbol entity.Symbol,
+	accountNoDesc string,
+	offerInterestId int64,
+	offerId int64,
+) error {
 	return u.loanPackageRequestEventRepository.NotifyOnlineConfirmation(
 		ctx, entity.RequestOnlineConfirmationNotify{
 			InvestorId:      request.InvestorId,
@@ -429,11 +924,16 @@ func NewUseCase(
 	loanPackageRequestRepository loanPackageRequestRepo.LoanPackageRequestRepository,
 	loanPackageOfferRepository loanPackageOfferRepo.LoanPackageOfferRepository,
 	loanOfferInterestRepository loanOfferInterestRepo.LoanPackageOfferInterestRepository,
-	financingRepository financingRepo.FinancingRepository,
+	tradingCalendar tradingcalendar.Calendar,
 	appConfig config.AppConfig,
 	errorService apperrors.Service,
 	loanPackageRequestEventRepository loanPackageRequestRepo.LoanPackageRequestEventRepository,
 	symbolRepository symbolRepo.SymbolRepository,
+	approvalRepository repository.SubmissionSheetApprovalRepository,
+	odooServiceRepository odooServiceRepo.OdooServiceRepository,
+	odooLoanApprovalRepository odooServiceRepo.OdooLoanApprovalRepository,
+	lifecycleRepository lifecycleRepo.LoanRequestLifecycleRepository,
+	webhookEventRepository webhookRepo.WebhookEventRepository,
 ) UseCase {
 	return &submissionSheetUseCase{
 		repository:                         repository,
@@ -444,10 +944,15 @@ func NewUseCase(
 		loanPackageRequestRepository:       loanPackageRequestRepository,
 		loanPackageOfferRepository:         loanPackageOfferRepository,
 		loanPackageOfferInterestRepository: loanOfferInterestRepository,
-		financingRepository:                financingRepository,
+		tradingCalendar:                    tradingCalendar,
 		appConfig:                          appConfig,
 		errorService:                       errorService,
 		loanPackageRequestEventRepository:  loanPackageRequestEventRepository,
 		symbolRepository:                   symbolRepository,
+		approvalRepository:                 approvalRepository,
+		odooServiceRepository:              odooServiceRepository,
+		odooLoanApprovalRepository:         odooLoanApprovalRepository,
+		lifecycleRepository:                lifecycleRepository,
+		webhookEventRepository:             webhookEventRepository,
 	}
 }
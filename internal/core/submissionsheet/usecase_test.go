@@ -6,9 +6,11 @@ import (
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
 	"financing-offer/test/mock"
+	"github.com/go-jet/jet/v2/qrm"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	testifyMock "github.com/stretchr/testify/mock"
+	"strings"
 	"testing"
 	"time"
 )
@@ -26,13 +28,16 @@ func TestLoanPackageRequestUseCase_AdminApproveSubmission(t *testing.T) {
 	loanPackageRequestEventRepository := mock.NewMockLoanPackageRequestEventRepository(t)
 	symbolRepo := mock.NewMockSymbolRepository(t)
 	financialProductRepo := mock.NewMockFinancialProductRepository(t)
-	financingRepo := mock.NewMockFinancingRepository(t)
+	tradingCalendar := mock.NewMockCalendar(t)
 	loanPolicyTemplateRepo := mock.NewMockLoanPolicyTemplateRepository(t)
 	submissionSheetRepo := mock.NewMockSubmissionSheetRepository(t)
 	marginOperationRepo := mock.NewMockMarginOperationRepository(t)
 	atomicExecutor := mock.NewMockAtomicExecutorExecutePassthrough(t)
 	errorService := mock.ErrReporter{}
-	useCase := NewUseCase(submissionSheetRepo, atomicExecutor, loanPolicyTemplateRepo, marginOperationRepo, financialProductRepo, loanPackageRequestRepo, loanPackageOfferRepository, loanPackageOfferInterestRepository, financingRepo, appConfig, errorService, loanPackageRequestEventRepository, symbolRepo)
+	approvalRepo := mock.NewMockSubmissionSheetApprovalRepository(t)
+	odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+	odooLoanApprovalRepo := mock.NewMockOdooLoanApprovalRepository(t)
+	useCase := NewUseCase(submissionSheetRepo, atomicExecutor, loanPolicyTemplateRepo, marginOperationRepo, financialProductRepo, loanPackageRequestRepo, loanPackageOfferRepository, loanPackageOfferInterestRepository, tradingCalendar, appConfig, errorService, loanPackageRequestEventRepository, symbolRepo, approvalRepo, odooServiceRepo, odooLoanApprovalRepo, &mock.LoanRequestLifecycleRepository{}, &mock.WebhookEventRepository{})
 
 	t.Run(
 		"AdminApproveSubmission_RejectAndSendOtherProposal_success", func(t *testing.T) {
@@ -60,6 +65,7 @@ func TestLoanPackageRequestUseCase_AdminApproveSubmission(t *testing.T) {
 					Status:               entity.SubmissionSheetStatusSubmitted,
 					LoanPackageRequestId: request.Id,
 					FlowType:             entity.FlowTypeDnseOnline,
+					Creator:              "creator",
 				},
 				Detail: entity.SubmissionSheetDetail{
 					Id: 1,
@@ -90,16 +96,43 @@ func TestLoanPackageRequestUseCase_AdminApproveSubmission(t *testing.T) {
 			}
 			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, submissionSheet.Metadata.Id).Return(submissionSheet, nil).Once()
 			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything).Return(request, nil).Once()
-			financingRepo.EXPECT().GetDateAfter(testifyMock.Anything, appConfig.LoanRequest.ExpireDays).Return(expireDate, nil).Once()
+			approvalRepo.EXPECT().GetSubmissionStatusForUpdate(testifyMock.Anything, submissionSheet.Metadata.Id).Return(entity.SubmissionSheetStatusSubmitted, nil).Once()
+			approvalRepo.EXPECT().DeleteExpiredApprovals(testifyMock.Anything, submissionSheet.Metadata.Id, testifyMock.Anything).Return(nil).Once()
+			approvalRepo.EXPECT().GetApprovalsBySubmissionId(testifyMock.Anything, submissionSheet.Metadata.Id).Return([]entity.SubmissionSheetApproval{}, nil).Once()
+			approvalRepo.EXPECT().CreateApproval(testifyMock.Anything, testifyMock.Anything).Return(entity.SubmissionSheetApproval{Id: 1, SubmissionSheetId: submissionSheet.Metadata.Id, Approver: "approver"}, nil).Once()
+			tradingCalendar.EXPECT().StockExchangeCode(testifyMock.Anything, request.SymbolId).Return("HNX", nil).Once()
+			tradingCalendar.EXPECT().AddTradingDays(
+				testifyMock.Anything, "HNX", testifyMock.Anything, appConfig.LoanRequest.ExpireDays,
+			).Return(expireDate, nil).Once()
 			submissionSheetRepo.EXPECT().UpdateMetadataStatusById(testifyMock.Anything, submissionSheet.Metadata.Id, entity.SubmissionSheetStatusApproved).Return(nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything, testifyMock.Anything).Return(request, nil).Once()
+			loanPackageRequestRepo.EXPECT().CreateStatusHistories(
+				testifyMock.Anything, []entity.LoanPackageRequestStatusHistory{
+					{
+						LoanPackageRequestId: request.Id,
+						FromStatus:           entity.LoanPackageRequestStatusPending,
+						ToStatus:             entity.LoanPackageRequestStatusConfirmed,
+						Actor:                "approver",
+						Reason:               entity.LoanPackageRequestStatusReasonSubmissionApproved,
+					},
+				},
+			).Return(nil).Once()
 			loanPackageRequestRepo.EXPECT().UpdateStatusById(testifyMock.Anything, request.Id, entity.LoanPackageRequestStatusConfirmed).Return(confirmedRequest, nil).Once()
 			loanPackageOfferRepository.EXPECT().Create(testifyMock.Anything, testifyMock.Anything).Return(offer, nil).Once()
 			loanPackageOfferInterestRepository.EXPECT().BulkCreate(testifyMock.Anything, testifyMock.Anything).Return(offerInterests, nil).Once()
 			symbolRepo.EXPECT().GetById(testifyMock.Anything, request.SymbolId).Return(symbol, nil).Once()
 			financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, request.InvestorId).Return(accounts, nil).Once()
 			loanPackageRequestEventRepository.EXPECT().NotifyOnlineConfirmation(testifyMock.Anything, testifyMock.Anything).Return(nil).Once()
-			err := useCase.AdminApproveSubmission(context.Background(), 1)
+			odooLoanApprovalRepo.EXPECT().GetBySubmissionId(testifyMock.Anything, submissionSheet.Metadata.Id).Return(entity.OdooLoanApproval{}, qrm.ErrNoRows).Once()
+			odooLoanApprovalRepo.EXPECT().Upsert(testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+				return a.SubmissionSheetId == submissionSheet.Metadata.Id && a.OdooRecordId == 0 &&
+					a.SyncStatus == entity.OdooLoanApprovalSyncStatusReporting && a.OdooState == entity.OdooLoanApprovalStateApproved
+			})).Return(entity.OdooLoanApproval{}, nil).Once()
+			progress, err := useCase.AdminApproveSubmission(context.Background(), 1, "approver", "")
 			assert.Nil(t, err)
+			assert.Equal(t, entity.SubmissionSheetStatusApproved, progress.Status)
+			assert.Equal(t, 1, progress.RequiredApprovals)
+			assert.Len(t, progress.Approvals, 1)
 		},
 	)
 
@@ -129,6 +162,7 @@ func TestLoanPackageRequestUseCase_AdminApproveSubmission(t *testing.T) {
 					Status:               entity.SubmissionSheetStatusSubmitted,
 					LoanPackageRequestId: request.Id,
 					FlowType:             entity.FlowTypeDnseOnline,
+					Creator:              "creator",
 				},
 				Detail: entity.SubmissionSheetDetail{
 					Id: 1,
@@ -159,16 +193,88 @@ func TestLoanPackageRequestUseCase_AdminApproveSubmission(t *testing.T) {
 			}
 			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, submissionSheet.Metadata.Id).Return(submissionSheet, nil).Once()
 			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything).Return(request, nil).Once()
-			financingRepo.EXPECT().GetDateAfter(testifyMock.Anything, appConfig.LoanRequest.ExpireDays).Return(expireDate, nil).Once()
+			approvalRepo.EXPECT().GetSubmissionStatusForUpdate(testifyMock.Anything, submissionSheet.Metadata.Id).Return(entity.SubmissionSheetStatusSubmitted, nil).Once()
+			approvalRepo.EXPECT().DeleteExpiredApprovals(testifyMock.Anything, submissionSheet.Metadata.Id, testifyMock.Anything).Return(nil).Once()
+			approvalRepo.EXPECT().GetApprovalsBySubmissionId(testifyMock.Anything, submissionSheet.Metadata.Id).Return([]entity.SubmissionSheetApproval{}, nil).Once()
+			approvalRepo.EXPECT().CreateApproval(testifyMock.Anything, testifyMock.Anything).Return(entity.SubmissionSheetApproval{Id: 1, SubmissionSheetId: submissionSheet.Metadata.Id, Approver: "approver"}, nil).Once()
+			tradingCalendar.EXPECT().StockExchangeCode(testifyMock.Anything, request.SymbolId).Return("HNX", nil).Once()
+			tradingCalendar.EXPECT().AddTradingDays(
+				testifyMock.Anything, "HNX", testifyMock.Anything, appConfig.LoanRequest.ExpireDays,
+			).Return(expireDate, nil).Once()
 			submissionSheetRepo.EXPECT().UpdateMetadataStatusById(testifyMock.Anything, submissionSheet.Metadata.Id, entity.SubmissionSheetStatusApproved).Return(nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything, testifyMock.Anything).Return(request, nil).Once()
+			loanPackageRequestRepo.EXPECT().CreateStatusHistories(
+				testifyMock.Anything, []entity.LoanPackageRequestStatusHistory{
+					{
+						LoanPackageRequestId: request.Id,
+						FromStatus:           entity.LoanPackageRequestStatusPending,
+						ToStatus:             entity.LoanPackageRequestStatusConfirmed,
+						Actor:                "approver",
+						Reason:               entity.LoanPackageRequestStatusReasonSubmissionApproved,
+					},
+				},
+			).Return(nil).Once()
 			loanPackageRequestRepo.EXPECT().UpdateStatusById(testifyMock.Anything, request.Id, entity.LoanPackageRequestStatusConfirmed).Return(confirmedRequest, nil).Once()
 			loanPackageOfferRepository.EXPECT().Create(testifyMock.Anything, testifyMock.Anything).Return(offer, nil).Once()
 			loanPackageOfferInterestRepository.EXPECT().BulkCreate(testifyMock.Anything, testifyMock.Anything).Return(offerInterests, nil).Once()
 			symbolRepo.EXPECT().GetById(testifyMock.Anything, request.SymbolId).Return(symbol, nil).Once()
 			financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, request.InvestorId).Return(accounts, nil).Once()
 			loanPackageRequestEventRepository.EXPECT().NotifyOnlineConfirmation(testifyMock.Anything, testifyMock.Anything).Return(nil).Once()
-			err := useCase.AdminApproveSubmission(context.Background(), 1)
+			odooLoanApprovalRepo.EXPECT().GetBySubmissionId(testifyMock.Anything, submissionSheet.Metadata.Id).Return(entity.OdooLoanApproval{}, qrm.ErrNoRows).Once()
+			odooLoanApprovalRepo.EXPECT().Upsert(testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+				return a.SubmissionSheetId == submissionSheet.Metadata.Id && a.OdooRecordId == 0 &&
+					a.SyncStatus == entity.OdooLoanApprovalSyncStatusReporting && a.OdooState == entity.OdooLoanApprovalStateApproved
+			})).Return(entity.OdooLoanApproval{}, nil).Once()
+			progress, err := useCase.AdminApproveSubmission(context.Background(), 1, "approver", "")
 			assert.Nil(t, err)
+			assert.Equal(t, entity.SubmissionSheetStatusApproved, progress.Status)
+			assert.Equal(t, 1, progress.RequiredApprovals)
+			assert.Len(t, progress.Approvals, 1)
+		},
+	)
+
+	t.Run(
+		"AdminApproveSubmission_request_declined_meanwhile", func(t *testing.T) {
+			request := entity.LoanPackageRequest{
+				Id:         2,
+				Status:     entity.LoanPackageRequestStatusPending,
+				AssetType:  entity.AssetTypeUnderlying,
+				SymbolId:   1,
+				InvestorId: "investorId",
+			}
+			declinedRequest := request
+			declinedRequest.Status = entity.LoanPackageRequestStatusDeclined
+			submissionSheet := entity.SubmissionSheet{
+				Metadata: entity.SubmissionSheetMetadata{
+					Id:                   2,
+					Status:               entity.SubmissionSheetStatusSubmitted,
+					LoanPackageRequestId: request.Id,
+					FlowType:             entity.FlowTypeDnseOnline,
+					Creator:              "creator",
+				},
+				Detail: entity.SubmissionSheetDetail{
+					Id:           2,
+					LoanPolicies: []entity.LoanPolicySnapShot{{Term: 30, InitialRate: decimal.NewFromFloat(0.2)}},
+				},
+			}
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, submissionSheet.Metadata.Id).Return(submissionSheet, nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything).Return(request, nil).Once()
+			financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, request.InvestorId).Return(nil, nil).Once()
+			approvalRepo.EXPECT().GetSubmissionStatusForUpdate(testifyMock.Anything, submissionSheet.Metadata.Id).Return(entity.SubmissionSheetStatusSubmitted, nil).Once()
+			approvalRepo.EXPECT().DeleteExpiredApprovals(testifyMock.Anything, submissionSheet.Metadata.Id, testifyMock.Anything).Return(nil).Once()
+			approvalRepo.EXPECT().GetApprovalsBySubmissionId(testifyMock.Anything, submissionSheet.Metadata.Id).Return([]entity.SubmissionSheetApproval{}, nil).Once()
+			approvalRepo.EXPECT().CreateApproval(testifyMock.Anything, testifyMock.Anything).Return(entity.SubmissionSheetApproval{Id: 2, SubmissionSheetId: submissionSheet.Metadata.Id, Approver: "approver"}, nil).Once()
+			tradingCalendar.EXPECT().StockExchangeCode(testifyMock.Anything, request.SymbolId).Return("HNX", nil).Once()
+			tradingCalendar.EXPECT().AddTradingDays(
+				testifyMock.Anything, "HNX", testifyMock.Anything, appConfig.LoanRequest.ExpireDays,
+			).Return(time.Now(), nil).Once()
+			submissionSheetRepo.EXPECT().UpdateMetadataStatusById(testifyMock.Anything, submissionSheet.Metadata.Id, entity.SubmissionSheetStatusApproved).Return(nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything, testifyMock.Anything).Return(declinedRequest, nil).Once()
+			_, err := useCase.AdminApproveSubmission(context.Background(), submissionSheet.Metadata.Id, "approver", "")
+			assert.ErrorIs(
+				t, err,
+				apperrors.ErrInvalidRequestStatusTransition(entity.LoanPackageRequestStatusDeclined, entity.LoanPackageRequestStatusConfirmed),
+			)
 		},
 	)
 }
@@ -186,13 +292,16 @@ func TestLoanPackageRequestUseCase_AdminRejectSubmission(t *testing.T) {
 	loanPackageRequestEventRepository := mock.NewMockLoanPackageRequestEventRepository(t)
 	symbolRepo := mock.NewMockSymbolRepository(t)
 	financialProductRepo := mock.NewMockFinancialProductRepository(t)
-	financingRepo := mock.NewMockFinancingRepository(t)
+	tradingCalendar := mock.NewMockCalendar(t)
 	loanPolicyTemplateRepo := mock.NewMockLoanPolicyTemplateRepository(t)
 	submissionSheetRepo := mock.NewMockSubmissionSheetRepository(t)
 	marginOperationRepo := mock.NewMockMarginOperationRepository(t)
 	atomicExecutor := mock.NewMockAtomicExecutorExecutePassthrough(t)
 	errorService := mock.ErrReporter{}
-	useCase := NewUseCase(submissionSheetRepo, atomicExecutor, loanPolicyTemplateRepo, marginOperationRepo, financialProductRepo, loanPackageRequestRepo, loanPackageOfferRepository, loanPackageOfferInterestRepository, financingRepo, appConfig, errorService, loanPackageRequestEventRepository, symbolRepo)
+	approvalRepo := mock.NewMockSubmissionSheetApprovalRepository(t)
+	odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+	odooLoanApprovalRepo := mock.NewMockOdooLoanApprovalRepository(t)
+	useCase := NewUseCase(submissionSheetRepo, atomicExecutor, loanPolicyTemplateRepo, marginOperationRepo, financialProductRepo, loanPackageRequestRepo, loanPackageOfferRepository, loanPackageOfferInterestRepository, tradingCalendar, appConfig, errorService, loanPackageRequestEventRepository, symbolRepo, approvalRepo, odooServiceRepo, odooLoanApprovalRepo, &mock.LoanRequestLifecycleRepository{}, &mock.WebhookEventRepository{})
 
 	t.Run(
 		"AdminRejectSubmission_success", func(t *testing.T) {
@@ -203,6 +312,15 @@ func TestLoanPackageRequestUseCase_AdminRejectSubmission(t *testing.T) {
 				},
 			}, nil).Once()
 			submissionSheetRepo.EXPECT().UpdateMetadataStatusById(testifyMock.Anything, submissionId, entity.SubmissionSheetStatusRejected).Return(nil).Once()
+			odooLoanApprovalRepo.EXPECT().GetBySubmissionId(testifyMock.Anything, submissionId).Return(entity.OdooLoanApproval{
+				Id:                1,
+				SubmissionSheetId: submissionId,
+				OdooRecordId:      7,
+				SyncStatus:        entity.OdooLoanApprovalSyncStatusPending,
+			}, nil).Once()
+			odooLoanApprovalRepo.EXPECT().Upsert(testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+				return a.OdooRecordId == 7 && a.SyncStatus == entity.OdooLoanApprovalSyncStatusReporting && a.OdooState == entity.OdooLoanApprovalStateRefused
+			})).Return(entity.OdooLoanApproval{}, nil).Once()
 			err := useCase.AdminRejectSubmission(context.Background(), submissionId)
 			assert.Nil(t, err)
 		})
@@ -240,3 +358,433 @@ func TestLoanPackageRequestUseCase_AdminRejectSubmission(t *testing.T) {
 			assert.ErrorIs(t, err, assert.AnError)
 		})
 }
+
+func TestSubmissionSheetUseCase_ApprovalRules(t *testing.T) {
+	t.Parallel()
+	appConfig := config.AppConfig{
+		SubmissionApproval: config.SubmissionApprovalConfig{
+			DualApprovalLoanRate:    0.5,
+			DualApprovalLimitAmount: 1_000_000_000,
+			ApprovalTtl:             time.Hour,
+			MaxDelegationDuration:   24 * time.Hour,
+		},
+	}
+	submissionSheetRepo := mock.NewMockSubmissionSheetRepository(t)
+	loanPackageRequestRepo := mock.NewMockLoanPackageRequestRepository(t)
+	approvalRepo := mock.NewMockSubmissionSheetApprovalRepository(t)
+	financialProductRepo := mock.NewMockFinancialProductRepository(t)
+	useCase := NewUseCase(
+		submissionSheetRepo,
+		mock.NewMockAtomicExecutorExecutePassthrough(t),
+		mock.NewMockLoanPolicyTemplateRepository(t),
+		mock.NewMockMarginOperationRepository(t),
+		financialProductRepo,
+		loanPackageRequestRepo,
+		mock.NewMockLoanPackageOfferRepository(t),
+		mock.NewMockLoanPackageOfferInterestRepository(t),
+		mock.NewMockCalendar(t),
+		appConfig,
+		mock.ErrReporter{},
+		mock.NewMockLoanPackageRequestEventRepository(t),
+		mock.NewMockSymbolRepository(t),
+		approvalRepo,
+		mock.NewMockOdooServiceRepository(t),
+		mock.NewMockOdooLoanApprovalRepository(t),
+		&mock.LoanRequestLifecycleRepository{},
+		&mock.WebhookEventRepository{},
+	)
+	request := entity.LoanPackageRequest{
+		Id:          1,
+		Status:      entity.LoanPackageRequestStatusPending,
+		LimitAmount: decimal.NewFromInt(100_000_000),
+	}
+	submissionSheet := entity.SubmissionSheet{
+		Metadata: entity.SubmissionSheetMetadata{
+			Id:                   1,
+			Status:               entity.SubmissionSheetStatusSubmitted,
+			LoanPackageRequestId: request.Id,
+			Creator:              "creator",
+		},
+		Detail: entity.SubmissionSheetDetail{
+			LoanRate: entity.LoanRate{InitialRate: decimal.NewFromFloat(0.4)},
+		},
+	}
+
+	t.Run(
+		"AdminApproveSubmission_creator_cannot_approve", func(t *testing.T) {
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, submissionSheet.Metadata.Id).Return(submissionSheet, nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything).Return(request, nil).Once()
+			_, err := useCase.AdminApproveSubmission(context.Background(), submissionSheet.Metadata.Id, "creator", "")
+			assert.ErrorIs(t, err, apperrors.ErrSubmissionSelfApproval)
+		},
+	)
+
+	t.Run(
+		"AdminApproveSubmission_delegate_cannot_approve_for_creator", func(t *testing.T) {
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, submissionSheet.Metadata.Id).Return(submissionSheet, nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything).Return(request, nil).Once()
+			approvalRepo.EXPECT().GetActiveDelegation(testifyMock.Anything, "creator", "delegate", testifyMock.Anything).Return(entity.ApprovalDelegation{Id: 1}, nil).Once()
+			_, err := useCase.AdminApproveSubmission(context.Background(), submissionSheet.Metadata.Id, "delegate", "creator")
+			assert.ErrorIs(t, err, apperrors.ErrSubmissionSelfApproval)
+		},
+	)
+
+	t.Run(
+		"AdminApproveSubmission_without_delegation", func(t *testing.T) {
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, submissionSheet.Metadata.Id).Return(submissionSheet, nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything).Return(request, nil).Once()
+			approvalRepo.EXPECT().GetActiveDelegation(testifyMock.Anything, "riskOfficer", "delegate", testifyMock.Anything).Return(entity.ApprovalDelegation{}, qrm.ErrNoRows).Once()
+			_, err := useCase.AdminApproveSubmission(context.Background(), submissionSheet.Metadata.Id, "delegate", "riskOfficer")
+			assert.ErrorIs(t, err, apperrors.ErrApprovalDelegationNotFound)
+		},
+	)
+
+	t.Run(
+		"AdminApproveSubmission_above_threshold_waits_for_second_approver", func(t *testing.T) {
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, submissionSheet.Metadata.Id).Return(submissionSheet, nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything).Return(request, nil).Once()
+			financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, request.InvestorId).Return(nil, nil).Once()
+			approvalRepo.EXPECT().GetSubmissionStatusForUpdate(testifyMock.Anything, submissionSheet.Metadata.Id).Return(entity.SubmissionSheetStatusSubmitted, nil).Once()
+			approvalRepo.EXPECT().DeleteExpiredApprovals(testifyMock.Anything, submissionSheet.Metadata.Id, testifyMock.Anything).Return(nil).Once()
+			approvalRepo.EXPECT().GetApprovalsBySubmissionId(testifyMock.Anything, submissionSheet.Metadata.Id).Return([]entity.SubmissionSheetApproval{}, nil).Once()
+			approvalRepo.EXPECT().CreateApproval(
+				testifyMock.Anything, testifyMock.MatchedBy(func(approval entity.SubmissionSheetApproval) bool {
+					return approval.Approver == "approver" && approval.ExpiresAt.After(time.Now())
+				}),
+			).Return(entity.SubmissionSheetApproval{Id: 1, Approver: "approver"}, nil).Once()
+			progress, err := useCase.AdminApproveSubmission(context.Background(), submissionSheet.Metadata.Id, "approver", "")
+			assert.Nil(t, err)
+			assert.Equal(t, entity.SubmissionSheetStatusSubmitted, progress.Status)
+			assert.Equal(t, 2, progress.RequiredApprovals)
+			assert.Len(t, progress.Approvals, 1)
+		},
+	)
+
+	t.Run(
+		"AdminApproveSubmission_principal_approves_once", func(t *testing.T) {
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, submissionSheet.Metadata.Id).Return(submissionSheet, nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, request.Id, testifyMock.Anything).Return(request, nil).Once()
+			approvalRepo.EXPECT().GetActiveDelegation(testifyMock.Anything, "riskOfficer", "delegate", testifyMock.Anything).Return(entity.ApprovalDelegation{Id: 1}, nil).Once()
+			financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, request.InvestorId).Return(nil, nil).Once()
+			approvalRepo.EXPECT().GetSubmissionStatusForUpdate(testifyMock.Anything, submissionSheet.Metadata.Id).Return(entity.SubmissionSheetStatusSubmitted, nil).Once()
+			approvalRepo.EXPECT().DeleteExpiredApprovals(testifyMock.Anything, submissionSheet.Metadata.Id, testifyMock.Anything).Return(nil).Once()
+			approvalRepo.EXPECT().GetApprovalsBySubmissionId(testifyMock.Anything, submissionSheet.Metadata.Id).Return(
+				[]entity.SubmissionSheetApproval{{Id: 1, Approver: "riskOfficer"}}, nil,
+			).Once()
+			_, err := useCase.AdminApproveSubmission(context.Background(), submissionSheet.Metadata.Id, "delegate", "riskOfficer")
+			assert.ErrorIs(t, err, apperrors.ErrSubmissionAlreadyApproved)
+		},
+	)
+
+	t.Run(
+		"CreateDelegation_invalid", func(t *testing.T) {
+			_, err := useCase.CreateDelegation(
+				context.Background(), entity.ApprovalDelegation{Delegator: "a", Delegate: "a", ExpiresAt: time.Now().Add(time.Hour)},
+			)
+			assert.ErrorIs(t, err, apperrors.ErrInvalidApprovalDelegation)
+			_, err = useCase.CreateDelegation(
+				context.Background(), entity.ApprovalDelegation{Delegator: "a", Delegate: "b", ExpiresAt: time.Now().Add(48 * time.Hour)},
+			)
+			assert.ErrorIs(t, err, apperrors.ErrInvalidApprovalDelegation)
+		},
+	)
+
+	t.Run(
+		"RevokeDelegation_only_by_delegator", func(t *testing.T) {
+			approvalRepo.EXPECT().GetDelegationById(testifyMock.Anything, int64(1)).Return(entity.ApprovalDelegation{Id: 1, Delegator: "a", Delegate: "b"}, nil).Twice()
+			assert.ErrorIs(t, useCase.RevokeDelegation(context.Background(), 1, "b"), apperrors.ErrApprovalDelegationNotFound)
+			approvalRepo.EXPECT().DeleteDelegation(testifyMock.Anything, int64(1)).Return(nil).Once()
+			assert.Nil(t, useCase.RevokeDelegation(context.Background(), 1, "a"))
+		},
+	)
+}
+
+func TestSubmissionSheetUseCase_SyncOdooDecisions(t *testing.T) {
+	t.Parallel()
+	appConfig := config.AppConfig{
+		OdooSync: config.OdooSyncConfig{
+			BatchSize:       10,
+			MaxAttempts:     3,
+			RetryBackoff:    time.Minute,
+			MaxRetryBackoff: time.Hour,
+		},
+	}
+	submissionSheetRepo := mock.NewMockSubmissionSheetRepository(t)
+	loanPackageRequestRepo := mock.NewMockLoanPackageRequestRepository(t)
+	financialProductRepo := mock.NewMockFinancialProductRepository(t)
+	symbolRepo := mock.NewMockSymbolRepository(t)
+	approvalRepo := mock.NewMockSubmissionSheetApprovalRepository(t)
+	odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+	odooLoanApprovalRepo := mock.NewMockOdooLoanApprovalRepository(t)
+	useCase := NewUseCase(
+		submissionSheetRepo,
+		mock.NewMockAtomicExecutorExecutePassthrough(t),
+		mock.NewMockLoanPolicyTemplateRepository(t),
+		mock.NewMockMarginOperationRepository(t),
+		financialProductRepo,
+		loanPackageRequestRepo,
+		mock.NewMockLoanPackageOfferRepository(t),
+		mock.NewMockLoanPackageOfferInterestRepository(t),
+		mock.NewMockCalendar(t),
+		appConfig,
+		mock.ErrReporter{},
+		mock.NewMockLoanPackageRequestEventRepository(t),
+		symbolRepo,
+		approvalRepo,
+		odooServiceRepo,
+		odooLoanApprovalRepo,
+		&mock.LoanRequestLifecycleRepository{},
+		&mock.WebhookEventRepository{},
+	)
+	submittedSheet := func(id int64) entity.SubmissionSheet {
+		return entity.SubmissionSheet{
+			Metadata: entity.SubmissionSheetMetadata{Id: id, Status: entity.SubmissionSheetStatusSubmitted},
+		}
+	}
+
+	t.Run(
+		"SyncOdooDecisions_nothing_pending", func(t *testing.T) {
+			odooLoanApprovalRepo.EXPECT().GetDue(testifyMock.Anything, entity.OdooLoanApprovalSyncStatusPending, testifyMock.Anything, int64(10)).Return([]entity.OdooLoanApproval{}, nil).Once()
+			applied, err := useCase.SyncOdooDecisions(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 0, applied)
+		},
+	)
+
+	t.Run(
+		"SyncOdooDecisions_applies_refusal_and_retries_failure", func(t *testing.T) {
+			pending := []entity.OdooLoanApproval{
+				{Id: 1, SubmissionSheetId: 11, OdooRecordId: 101, SyncStatus: entity.OdooLoanApprovalSyncStatusPending},
+				{Id: 2, SubmissionSheetId: 12, OdooRecordId: 102, SyncStatus: entity.OdooLoanApprovalSyncStatusPending},
+				{Id: 3, SubmissionSheetId: 13, OdooRecordId: 103, SyncStatus: entity.OdooLoanApprovalSyncStatusPending},
+			}
+			odooLoanApprovalRepo.EXPECT().GetDue(testifyMock.Anything, entity.OdooLoanApprovalSyncStatusPending, testifyMock.Anything, int64(10)).Return(pending, nil).Once()
+			odooServiceRepo.EXPECT().GetLoanApprovalDecisions([]int64{101, 102, 103}).Return(
+				[]entity.OdooLoanApprovalDecision{
+					{RecordId: 101, State: entity.OdooLoanApprovalStateRefused, DecidedBy: "Risk Officer"},
+					{RecordId: 102, State: entity.OdooLoanApprovalStateRefused, DecidedBy: "Risk Officer"},
+				}, nil,
+			).Once()
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(11)).Return(submittedSheet(11), nil).Twice()
+			submissionSheetRepo.EXPECT().UpdateMetadataStatusById(testifyMock.Anything, int64(11), entity.SubmissionSheetStatusRejected).Return(nil).Once()
+			odooLoanApprovalRepo.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+					return a.Id == 1 && a.SyncStatus == entity.OdooLoanApprovalSyncStatusSynced
+				}),
+			).Return(nil).Once()
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(12)).Return(entity.SubmissionSheet{}, assert.AnError).Once()
+			odooLoanApprovalRepo.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+					return a.Id == 2 && a.SyncStatus == entity.OdooLoanApprovalSyncStatusPending && a.Attempts == 1 &&
+						a.NextAttemptAt.After(time.Now())
+				}),
+			).Return(nil).Once()
+			applied, err := useCase.SyncOdooDecisions(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 1, applied)
+		},
+	)
+
+	t.Run(
+		"SyncOdooDecisions_business_error_is_final", func(t *testing.T) {
+			odooLoanApprovalRepo.EXPECT().GetDue(testifyMock.Anything, entity.OdooLoanApprovalSyncStatusPending, testifyMock.Anything, int64(10)).Return(
+				[]entity.OdooLoanApproval{{Id: 1, SubmissionSheetId: 11, OdooRecordId: 101}}, nil,
+			).Once()
+			odooServiceRepo.EXPECT().GetLoanApprovalDecisions([]int64{101}).Return(
+				[]entity.OdooLoanApprovalDecision{{RecordId: 101, State: entity.OdooLoanApprovalStateRefused}}, nil,
+			).Once()
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(11)).Return(submittedSheet(11), nil).Once()
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(11)).Return(entity.SubmissionSheet{
+				Metadata: entity.SubmissionSheetMetadata{Id: 11, Status: entity.SubmissionSheetStatusApproved},
+			}, nil).Once()
+			odooLoanApprovalRepo.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+					return a.SyncStatus == entity.OdooLoanApprovalSyncStatusFailed && a.LastError != ""
+				}),
+			).Return(nil).Once()
+			_, err := useCase.SyncOdooDecisions(context.Background())
+			assert.ErrorIs(t, err, apperrors.ErrorInvalidCurrentSubmissionStatus)
+		},
+	)
+
+	t.Run(
+		"SyncOdooDecisions_creator_cannot_approve_from_odoo", func(t *testing.T) {
+			sheet := submittedSheet(11)
+			sheet.Metadata.LoanPackageRequestId = 1
+			sheet.Metadata.Creator = "creator"
+			odooLoanApprovalRepo.EXPECT().GetDue(testifyMock.Anything, entity.OdooLoanApprovalSyncStatusPending, testifyMock.Anything, int64(10)).Return(
+				[]entity.OdooLoanApproval{{Id: 1, SubmissionSheetId: 11, OdooRecordId: 101}}, nil,
+			).Once()
+			odooServiceRepo.EXPECT().GetLoanApprovalDecisions([]int64{101}).Return(
+				[]entity.OdooLoanApprovalDecision{{RecordId: 101, State: entity.OdooLoanApprovalStateApproved, DecidedBy: "Creator"}}, nil,
+			).Once()
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(11)).Return(sheet, nil).Twice()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, int64(1), testifyMock.Anything).Return(
+				entity.LoanPackageRequest{Id: 1, Status: entity.LoanPackageRequestStatusPending}, nil,
+			).Once()
+			odooLoanApprovalRepo.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+					return a.SyncStatus == entity.OdooLoanApprovalSyncStatusFailed
+				}),
+			).Return(nil).Once()
+			_, err := useCase.SyncOdooDecisions(context.Background())
+			assert.ErrorIs(t, err, apperrors.ErrSubmissionSelfApproval)
+		},
+	)
+
+	t.Run(
+		"SyncOdooDecisions_counted_approval_waits_for_the_others", func(t *testing.T) {
+			sheet := submittedSheet(11)
+			sheet.Metadata.LoanPackageRequestId = 1
+			sheet.Metadata.Creator = "creator"
+			odooLoanApprovalRepo.EXPECT().GetDue(testifyMock.Anything, entity.OdooLoanApprovalSyncStatusPending, testifyMock.Anything, int64(10)).Return(
+				[]entity.OdooLoanApproval{{Id: 1, SubmissionSheetId: 11, OdooRecordId: 101, SyncStatus: entity.OdooLoanApprovalSyncStatusPending}}, nil,
+			).Once()
+			odooServiceRepo.EXPECT().GetLoanApprovalDecisions([]int64{101}).Return(
+				[]entity.OdooLoanApprovalDecision{{RecordId: 101, State: entity.OdooLoanApprovalStateApproved, DecidedBy: "riskOfficer"}}, nil,
+			).Once()
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(11)).Return(sheet, nil).Twice()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, int64(1), testifyMock.Anything).Return(
+				entity.LoanPackageRequest{Id: 1, Status: entity.LoanPackageRequestStatusPending, InvestorId: "investorId"}, nil,
+			).Once()
+			financialProductRepo.EXPECT().GetAllAccountDetail(testifyMock.Anything, "investorId").Return(nil, nil).Once()
+			approvalRepo.EXPECT().GetSubmissionStatusForUpdate(testifyMock.Anything, int64(11)).Return(entity.SubmissionSheetStatusSubmitted, nil).Once()
+			approvalRepo.EXPECT().DeleteExpiredApprovals(testifyMock.Anything, int64(11), testifyMock.Anything).Return(nil).Once()
+			// the same person approved here before approving in Odoo
+			approvalRepo.EXPECT().GetApprovalsBySubmissionId(testifyMock.Anything, int64(11)).Return(
+				[]entity.SubmissionSheetApproval{{Id: 1, Approver: "riskOfficer"}}, nil,
+			).Once()
+			odooLoanApprovalRepo.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+					return a.SyncStatus == entity.OdooLoanApprovalSyncStatusPending && a.Attempts == 0 &&
+						a.OdooState == entity.OdooLoanApprovalStateApproved && a.NextAttemptAt.After(time.Now())
+				}),
+			).Return(nil).Once()
+			applied, err := useCase.SyncOdooDecisions(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 0, applied)
+		},
+	)
+
+	t.Run(
+		"ReportOdooDecisions_updates_and_creates_records", func(t *testing.T) {
+			odooLoanApprovalRepo.EXPECT().GetDue(testifyMock.Anything, entity.OdooLoanApprovalSyncStatusReporting, testifyMock.Anything, int64(10)).Return(
+				[]entity.OdooLoanApproval{
+					{Id: 1, SubmissionSheetId: 11, OdooRecordId: 101, OdooState: entity.OdooLoanApprovalStateRefused},
+					{Id: 2, SubmissionSheetId: 12, OdooState: entity.OdooLoanApprovalStateApproved},
+					{Id: 3, SubmissionSheetId: 13, OdooRecordId: 103, OdooState: entity.OdooLoanApprovalStateApproved, SyncStatus: entity.OdooLoanApprovalSyncStatusReporting},
+				}, nil,
+			).Once()
+			odooServiceRepo.EXPECT().UpdateLoanApprovalState(int64(101), entity.OdooLoanApprovalStateRefused).Return(nil).Once()
+			odooLoanApprovalRepo.EXPECT().Upsert(
+				testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+					return a.Id == 1 && a.SyncStatus == entity.OdooLoanApprovalSyncStatusSynced
+				}),
+			).Return(entity.OdooLoanApproval{}, nil).Once()
+			sheet := submittedSheet(12)
+			sheet.Metadata.LoanPackageRequestId = 2
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(12)).Return(sheet, nil).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, int64(2), testifyMock.Anything).Return(
+				entity.LoanPackageRequest{Id: 2, SymbolId: 5}, nil,
+			).Once()
+			symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(5)).Return(entity.Symbol{Id: 5, Symbol: "ABC"}, nil).Once()
+			approvalRepo.EXPECT().GetApprovalsBySubmissionId(testifyMock.Anything, int64(12)).Return(
+				[]entity.SubmissionSheetApproval{{Id: 1, Approver: "approver"}}, nil,
+			).Once()
+			odooServiceRepo.EXPECT().SendLoanApprovalRequest(testifyMock.MatchedBy(func(req entity.LoanApprovalRequest) bool {
+				return req.SubmissionId == 12 && strings.Contains(req.Description, "approved by approver")
+			})).Return(int64(102), nil).Once()
+			odooLoanApprovalRepo.EXPECT().Upsert(
+				testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+					return a.Id == 2 && a.OdooRecordId == 102 && a.SyncStatus == entity.OdooLoanApprovalSyncStatusSynced
+				}),
+			).Return(entity.OdooLoanApproval{}, nil).Once()
+			odooServiceRepo.EXPECT().UpdateLoanApprovalState(int64(103), entity.OdooLoanApprovalStateApproved).Return(assert.AnError).Once()
+			odooLoanApprovalRepo.EXPECT().Upsert(
+				testifyMock.Anything, testifyMock.MatchedBy(func(a entity.OdooLoanApproval) bool {
+					return a.Id == 3 && a.SyncStatus == entity.OdooLoanApprovalSyncStatusReporting && a.Attempts == 1 && a.NextAttemptAt.After(time.Now())
+				}),
+			).Return(entity.OdooLoanApproval{}, nil).Once()
+			reported, err := useCase.ReportOdooDecisions(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 2, reported)
+		},
+	)
+}
+
+func TestSubmissionSheetUseCase_PreviewOdooPayload(t *testing.T) {
+	t.Parallel()
+	submissionSheetRepo := mock.NewMockSubmissionSheetRepository(t)
+	loanPackageRequestRepo := mock.NewMockLoanPackageRequestRepository(t)
+	symbolRepo := mock.NewMockSymbolRepository(t)
+	odooServiceRepo := mock.NewMockOdooServiceRepository(t)
+	useCase := NewUseCase(
+		submissionSheetRepo,
+		mock.NewMockAtomicExecutorExecutePassthrough(t),
+		mock.NewMockLoanPolicyTemplateRepository(t),
+		mock.NewMockMarginOperationRepository(t),
+		mock.NewMockFinancialProductRepository(t),
+		loanPackageRequestRepo,
+		mock.NewMockLoanPackageOfferRepository(t),
+		mock.NewMockLoanPackageOfferInterestRepository(t),
+		mock.NewMockCalendar(t),
+		config.AppConfig{OdooCategoryId: 60},
+		mock.ErrReporter{},
+		mock.NewMockLoanPackageRequestEventRepository(t),
+		symbolRepo,
+		mock.NewMockSubmissionSheetApprovalRepository(t),
+		odooServiceRepo,
+		mock.NewMockOdooLoanApprovalRepository(t),
+		&mock.LoanRequestLifecycleRepository{},
+		&mock.WebhookEventRepository{},
+	)
+
+	t.Run(
+		"PreviewOdooPayload_success", func(t *testing.T) {
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(
+				entity.SubmissionSheet{
+					Metadata: entity.SubmissionSheetMetadata{Id: 1, LoanPackageRequestId: 2},
+					Detail:   entity.SubmissionSheetDetail{Comment: "comment"},
+				}, nil,
+			).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, int64(2), entity.LoanPackageFilter{}).Return(
+				entity.LoanPackageRequest{Id: 2, SymbolId: 3}, nil,
+			).Once()
+			symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(3)).Return(entity.Symbol{Id: 3, Symbol: "BID"}, nil).Once()
+			preview := entity.OdooPayloadPreview{Model: "approval.finx", Method: "create"}
+			odooServiceRepo.EXPECT().PreviewLoanApprovalRequest(
+				testifyMock.MatchedBy(func(r entity.LoanApprovalRequest) bool {
+					return r.SubmissionId == 1 && r.LoanRequestId == 2 && r.Symbol == "BID" &&
+						r.CategoryId == 60 && r.Description == "comment"
+				}),
+			).Return(preview, nil).Once()
+			res, err := useCase.PreviewOdooPayload(context.Background(), 1)
+			assert.Nil(t, err)
+			assert.Equal(t, preview, res)
+		},
+	)
+
+	t.Run(
+		"PreviewOdooPayload_invalid_payload", func(t *testing.T) {
+			submissionSheetRepo.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(
+				entity.SubmissionSheet{Metadata: entity.SubmissionSheetMetadata{Id: 1, LoanPackageRequestId: 2}}, nil,
+			).Once()
+			loanPackageRequestRepo.EXPECT().GetById(testifyMock.Anything, int64(2), entity.LoanPackageFilter{}).Return(
+				entity.LoanPackageRequest{Id: 2, SymbolId: 3}, nil,
+			).Once()
+			symbolRepo.EXPECT().GetById(testifyMock.Anything, int64(3)).Return(entity.Symbol{Id: 3, Symbol: "BID"}, nil).Once()
+			invalid := apperrors.ErrInvalidOdooPayload("field description is required")
+			odooServiceRepo.EXPECT().PreviewLoanApprovalRequest(testifyMock.Anything).Return(entity.OdooPayloadPreview{}, invalid).Once()
+			_, err := useCase.PreviewOdooPayload(context.Background(), 1)
+			assert.ErrorIs(t, err, invalid)
+		},
+	)
+}
+
+func TestOdooSyncBackoff(t *testing.T) {
+	t.Parallel()
+	cfg := config.OdooSyncConfig{RetryBackoff: time.Minute, MaxRetryBackoff: 10 * time.Minute}
+	assert.Equal(t, time.Minute, odooSyncBackoff(cfg, 1))
+	assert.Equal(t, 4*time.Minute, odooSyncBackoff(cfg, 3))
+	assert.Equal(t, 10*time.Minute, odooSyncBackoff(cfg, 20))
+}
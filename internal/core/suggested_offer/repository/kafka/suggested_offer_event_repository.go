@@ -3,15 +3,17 @@ package kafka
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/segmentio/kafka-go"
-	"github.com/shopspring/decimal"
 	"gitlab.com/enCapital/models"
 	"gitlab.com/enCapital/models/dnse"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/notificationtemplate"
 	"financing-offer/internal/core/suggested_offer/repository"
 	"financing-offer/internal/event"
 	"financing-offer/pkg/pb"
@@ -20,27 +22,50 @@ import (
 var _ repository.SuggestedOfferEventRepository = (*SuggestedOfferEventPublisher)(nil)
 
 type SuggestedOfferEventPublisher struct {
-	config    config.KafkaConfig
-	publisher event.Publisher
+	config       config.KafkaConfig
+	publisher    event.Publisher
+	renderer     notificationtemplate.Renderer
+	logger       *slog.Logger
+	errorService apperrors.Service
 }
 
-func NewSuggestedOfferEventPublisher(config config.KafkaConfig, publisher event.Publisher) *SuggestedOfferEventPublisher {
+func NewSuggestedOfferEventPublisher(
+	config config.KafkaConfig,
+	publisher event.Publisher,
+	renderer notificationtemplate.Renderer,
+	logger *slog.Logger,
+	errorService apperrors.Service,
+) *SuggestedOfferEventPublisher {
 	return &SuggestedOfferEventPublisher{
-		config:    config,
-		publisher: publisher,
+		config:       config,
+		publisher:    publisher,
+		renderer:     renderer,
+		logger:       logger,
+		errorService: errorService,
 	}
 }
 
 func (p *SuggestedOfferEventPublisher) NotifySuggestedOfferCreated(
-	_ context.Context,
+	ctx context.Context,
 	investorId string,
 	config entity.SuggestedOfferConfig,
 	createdOffer entity.SuggestedOffer,
 ) error {
 	errorTemplate := "SuggestedOfferEventPublisher NotifySuggestedOfferCreated %w"
-	title := ""
-	if config.ValueType == entity.ValueTypeInterestRate {
-		title = fmt.Sprintf("Phản hồi đề cử Margin %s", config.Value.Mul(decimal.NewFromInt(100)).String()+"%/năm")
+	title, err := p.renderer.Render(
+		ctx, investorId, entity.NotificationTemplateKeySuggestedOfferCreatedTitle,
+		entity.SuggestedOfferCreatedNotify{InvestorId: investorId, Config: config, Offer: createdOffer},
+	)
+	if err != nil {
+		// a missing or broken template only drops the notification, the suggested offer stays created
+		p.logger.Error(
+			"SuggestedOfferEventPublisher NotifySuggestedOfferCreated render",
+			slog.String("investorId", investorId),
+			slog.String("templateKey", string(entity.NotificationTemplateKeySuggestedOfferCreatedTitle)),
+			slog.String("error", err.Error()),
+		)
+		_ = p.errorService.NotifyError(ctx, fmt.Errorf(errorTemplate, err))
+		return nil
 	}
 	payload := dnse.FinancingOfferSuggestedOfferCreated{
 		InvestorId: investorId,
@@ -54,6 +79,7 @@ func (p *SuggestedOfferEventPublisher) NotifySuggestedOfferCreated(
 		return fmt.Errorf(errorTemplate, err)
 	}
 	if err = p.publisher.Publish(
+		ctx,
 		kafka.Message{
 			Topic: p.config.NotificationTopic,
 			Value: message,
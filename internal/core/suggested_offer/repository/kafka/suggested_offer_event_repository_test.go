@@ -3,6 +3,8 @@ package kafka
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"os"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -14,17 +16,37 @@ import (
 	"financing-offer/test/mock"
 )
 
+// errorRecorder keeps the errors it is notified of
+type errorRecorder struct {
+	errs []error
+}
+
+func (r *errorRecorder) NotifyError(_ context.Context, err error) error {
+	r.errs = append(r.errs, err)
+	return nil
+}
+
+func (r *errorRecorder) Go(ctx context.Context, f func() error) {
+	if err := f(); err != nil {
+		_ = r.NotifyError(ctx, err)
+	}
+}
+
 func TestSuggestedOfferEvent_NotifySuggestedOfferCreated(t *testing.T) {
 	t.Parallel()
 
 	t.Run("Publish success", func(t *testing.T) {
 		kafkaPublisher := mock.NewMockPublisher(t)
+		renderer := mock.NewMockRenderer(t)
 		publisher := NewSuggestedOfferEventPublisher(
 			config.KafkaConfig{
 				NotificationTopic: "notification",
-			}, kafkaPublisher,
+			}, kafkaPublisher, renderer, slog.New(slog.NewJSONHandler(os.Stdout, nil)), mock.ErrReporter{},
 		)
-		kafkaPublisher.EXPECT().Publish(testifyMock.Anything).Return(nil)
+		renderer.EXPECT().Render(
+			testifyMock.Anything, "investorId", entity.NotificationTemplateKeySuggestedOfferCreatedTitle, testifyMock.Anything,
+		).Return("Phản hồi đề cử Margin 12%/năm", nil)
+		kafkaPublisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(nil)
 		err := publisher.NotifySuggestedOfferCreated(context.Background(), "investorId", entity.SuggestedOfferConfig{
 			ValueType: entity.ValueTypeInterestRate,
 			Value:     decimal.NewFromFloat(0.12),
@@ -33,14 +55,36 @@ func TestSuggestedOfferEvent_NotifySuggestedOfferCreated(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Render fail skips the notification", func(t *testing.T) {
+		renderer := mock.NewMockRenderer(t)
+		errorService := &errorRecorder{}
+		publisher := NewSuggestedOfferEventPublisher(
+			config.KafkaConfig{
+				NotificationTopic: "notification",
+			}, mock.NewMockPublisher(t), renderer, slog.New(slog.NewJSONHandler(os.Stdout, nil)), errorService,
+		)
+		renderer.EXPECT().Render(
+			testifyMock.Anything, "investorId", entity.NotificationTemplateKeySuggestedOfferCreatedTitle, testifyMock.Anything,
+		).Return("", errors.New("no template"))
+		err := publisher.NotifySuggestedOfferCreated(context.Background(), "investorId", entity.SuggestedOfferConfig{}, entity.SuggestedOffer{})
+
+		assert.Nil(t, err)
+		assert.Len(t, errorService.errs, 1)
+		assert.Equal(t, "SuggestedOfferEventPublisher NotifySuggestedOfferCreated no template", errorService.errs[0].Error())
+	})
+
 	t.Run("Publish fail", func(t *testing.T) {
 		kafkaPublisher := mock.NewMockPublisher(t)
+		renderer := mock.NewMockRenderer(t)
 		publisher := NewSuggestedOfferEventPublisher(
 			config.KafkaConfig{
 				NotificationTopic: "notification",
-			}, kafkaPublisher,
+			}, kafkaPublisher, renderer, slog.New(slog.NewJSONHandler(os.Stdout, nil)), mock.ErrReporter{},
 		)
-		kafkaPublisher.EXPECT().Publish(testifyMock.Anything).Return(errors.New("test error"))
+		renderer.EXPECT().Render(
+			testifyMock.Anything, "investorId", entity.NotificationTemplateKeySuggestedOfferCreatedTitle, testifyMock.Anything,
+		).Return("Phản hồi đề cử Margin 12%/năm", nil)
+		kafkaPublisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(errors.New("test error"))
 		err := publisher.NotifySuggestedOfferCreated(context.Background(), "investorId", entity.SuggestedOfferConfig{
 			ValueType: entity.ValueTypeInterestRate,
 			Value:     decimal.NewFromFloat(0.12),
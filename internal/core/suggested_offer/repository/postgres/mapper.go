@@ -3,6 +3,8 @@ package postgres
 import (
 	"encoding/json"
 
+	"github.com/volatiletech/null/v9"
+
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/database/dbmodels/finoffer/public/model"
 )
@@ -14,15 +16,34 @@ func MapSuggestedOfferDbToEntity(suggestedOffer model.SuggestedOffer) (entity.Su
 		return entity.SuggestedOffer{}, unmarshalErr
 	}
 	return entity.SuggestedOffer{
-		Id:        suggestedOffer.ID,
-		AccountNo: suggestedOffer.AccountNo,
-		ConfigId:  suggestedOffer.ConfigID,
-		Symbols:   symbols,
-		CreatedAt: suggestedOffer.CreatedAt,
-		UpdatedAt: suggestedOffer.UpdatedAt,
+		Id:          suggestedOffer.ID,
+		AccountNo:   suggestedOffer.AccountNo,
+		InvestorId:  suggestedOffer.InvestorID,
+		CustodyCode: suggestedOffer.CustodyCode,
+		ConfigId:    suggestedOffer.ConfigID,
+		Symbols:     symbols,
+		Status:      entity.SuggestedOfferStatusFromString(suggestedOffer.Status),
+		Note:        suggestedOffer.Note,
+		RespondedBy: suggestedOffer.RespondedBy,
+		RespondedAt: suggestedOffer.RespondedAt.Time,
+		ConvertedAt: suggestedOffer.ConvertedAt.Time,
+		CreatedAt:   suggestedOffer.CreatedAt,
+		UpdatedAt:   suggestedOffer.UpdatedAt,
 	}, nil
 }
 
+func MapSuggestedOffersDbToEntity(suggestedOffers []model.SuggestedOffer) ([]entity.SuggestedOffer, error) {
+	res := make([]entity.SuggestedOffer, 0, len(suggestedOffers))
+	for _, suggestedOffer := range suggestedOffers {
+		e, err := MapSuggestedOfferDbToEntity(suggestedOffer)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	return res, nil
+}
+
 func MapSuggestedOfferEntityToDb(suggestedOffer entity.SuggestedOffer) (model.SuggestedOffer, error) {
 	symbolsByte, marshalErr := json.Marshal(suggestedOffer.Symbols)
 	if marshalErr != nil {
@@ -32,12 +53,65 @@ func MapSuggestedOfferEntityToDb(suggestedOffer entity.SuggestedOffer) (model.Su
 	if suggestedOffer.Config != nil {
 		configId = suggestedOffer.Config.Id
 	}
-	return model.SuggestedOffer{
-		ID:        suggestedOffer.Id,
-		AccountNo: suggestedOffer.AccountNo,
-		ConfigID:  configId,
-		Symbols:   string(symbolsByte),
-		CreatedAt: suggestedOffer.CreatedAt,
-		UpdatedAt: suggestedOffer.UpdatedAt,
-	}, nil
+	status := suggestedOffer.Status
+	if status == "" {
+		status = entity.SuggestedOfferStatusNew
+	}
+	res := model.SuggestedOffer{
+		ID:          suggestedOffer.Id,
+		AccountNo:   suggestedOffer.AccountNo,
+		InvestorID:  suggestedOffer.InvestorId,
+		CustodyCode: suggestedOffer.CustodyCode,
+		ConfigID:    configId,
+		Symbols:     string(symbolsByte),
+		Status:      status.String(),
+		Note:        suggestedOffer.Note,
+		RespondedBy: suggestedOffer.RespondedBy,
+		CreatedAt:   suggestedOffer.CreatedAt,
+		UpdatedAt:   suggestedOffer.UpdatedAt,
+	}
+	if !suggestedOffer.RespondedAt.IsZero() {
+		res.RespondedAt = null.TimeFrom(suggestedOffer.RespondedAt)
+	}
+	if !suggestedOffer.ConvertedAt.IsZero() {
+		res.ConvertedAt = null.TimeFrom(suggestedOffer.ConvertedAt)
+	}
+	return res, nil
+}
+
+func MapSuggestedOfferConversionEntityToDb(conversion entity.SuggestedOfferConversion) model.SuggestedOfferConversion {
+	return model.SuggestedOfferConversion{
+		ID:                   conversion.Id,
+		SuggestedOfferID:     conversion.SuggestedOfferId,
+		LoanPackageRequestID: conversion.LoanPackageRequestId,
+		Symbol:               conversion.Symbol,
+		CreatedAt:            conversion.CreatedAt,
+	}
+}
+
+func MapSuggestedOfferConversionMetrics(
+	statusCounts []SuggestedOfferStatusCount,
+	requestCounts []SuggestedOfferRequestCount,
+) []entity.SuggestedOfferConversionMetric {
+	requestCountByConfigId := make(map[int64]SuggestedOfferRequestCount, len(requestCounts))
+	for _, requestCount := range requestCounts {
+		requestCountByConfigId[requestCount.ConfigID] = requestCount
+	}
+	res := make([]entity.SuggestedOfferConversionMetric, 0, len(statusCounts))
+	for _, statusCount := range statusCounts {
+		requestCount := requestCountByConfigId[statusCount.ConfigID]
+		res = append(
+			res, entity.SuggestedOfferConversionMetric{
+				Config:            entity.SuggestedOfferConfig{Id: statusCount.ConfigID},
+				Total:             statusCount.Total,
+				New:               statusCount.New,
+				Contacted:         statusCount.Contacted,
+				Converted:         statusCount.Converted,
+				Rejected:          statusCount.Rejected,
+				LoanRequests:      requestCount.LoanRequests,
+				ConfirmedRequests: requestCount.ConfirmedRequests,
+			},
+		)
+	}
+	return res
 }
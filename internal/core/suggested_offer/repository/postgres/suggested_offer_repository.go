@@ -2,14 +2,24 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/volatiletech/null/v9"
 
 	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/suggested_offer/repository"
 	"financing-offer/internal/database"
 	"financing-offer/internal/database/dbmodels/finoffer/public/model"
 	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/pkg/querymod"
 )
 
+var _ repository.SuggestedOfferRepository = (*SuggestedOfferPostgresRepository)(nil)
+
 type SuggestedOfferPostgresRepository struct {
 	getDbFunc database.GetDbFunc
 }
@@ -26,7 +36,14 @@ func (s *SuggestedOfferPostgresRepository) Create(ctx context.Context, suggested
 		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
 	}
 	if err = table.SuggestedOffer.
-		INSERT(table.SuggestedOffer.MutableColumns).
+		INSERT(
+			table.SuggestedOffer.ConfigID,
+			table.SuggestedOffer.AccountNo,
+			table.SuggestedOffer.InvestorID,
+			table.SuggestedOffer.CustodyCode,
+			table.SuggestedOffer.Symbols,
+			table.SuggestedOffer.Status,
+		).
 		MODEL(toCreate).
 		RETURNING(table.SuggestedOffer.AllColumns).
 		QueryContext(ctx, s.getDbFunc(ctx), &dest); err != nil {
@@ -35,3 +52,244 @@ func (s *SuggestedOfferPostgresRepository) Create(ctx context.Context, suggested
 	cratedOffer, err := MapSuggestedOfferDbToEntity(dest)
 	return cratedOffer, err
 }
+
+// GetById returns the suggested offer with the ids of the loan package requests it was converted into
+func (s *SuggestedOfferPostgresRepository) GetById(ctx context.Context, id int64) (entity.SuggestedOffer, error) {
+	errorTemplate := "SuggestedOfferPostgresRepository GetById %w"
+	dest := model.SuggestedOffer{}
+	if err := table.SuggestedOffer.SELECT(table.SuggestedOffer.AllColumns).
+		WHERE(table.SuggestedOffer.ID.EQ(postgres.Int64(id))).
+		QueryContext(ctx, s.getDbFunc(ctx), &dest); err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	suggestedOffer, err := MapSuggestedOfferDbToEntity(dest)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	conversions := make([]model.SuggestedOfferConversion, 0)
+	if err := table.SuggestedOfferConversion.SELECT(table.SuggestedOfferConversion.AllColumns).
+		WHERE(table.SuggestedOfferConversion.SuggestedOfferID.EQ(postgres.Int64(id))).
+		ORDER_BY(table.SuggestedOfferConversion.ID).
+		QueryContext(ctx, s.getDbFunc(ctx), &conversions); err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	for _, conversion := range conversions {
+		suggestedOffer.LoanPackageRequestIds = append(suggestedOffer.LoanPackageRequestIds, conversion.LoanPackageRequestID)
+	}
+	return suggestedOffer, nil
+}
+
+func (s *SuggestedOfferPostgresRepository) GetAll(ctx context.Context, filter entity.SuggestedOfferFilter) ([]entity.SuggestedOffer, error) {
+	errorTemplate := "SuggestedOfferPostgresRepository GetAll %w"
+	stm := table.SuggestedOffer.SELECT(table.SuggestedOffer.AllColumns).
+		WHERE(ApplySuggestedOfferFilter(filter)).
+		ORDER_BY(table.SuggestedOffer.ID.DESC())
+	if limit := filter.Limit(); limit > 0 {
+		stm = stm.LIMIT(limit).OFFSET(filter.Offset())
+	}
+	dest := make([]model.SuggestedOffer, 0)
+	if err := stm.QueryContext(ctx, s.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []entity.SuggestedOffer{}, nil
+		}
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	suggestedOffers, err := MapSuggestedOffersDbToEntity(dest)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	return suggestedOffers, nil
+}
+
+func (s *SuggestedOfferPostgresRepository) Count(ctx context.Context, filter entity.SuggestedOfferFilter) (int64, error) {
+	dest := struct {
+		Count int64
+	}{}
+	if err := table.SuggestedOffer.SELECT(postgres.COUNT(table.SuggestedOffer.ID).AS("count")).
+		WHERE(ApplySuggestedOfferFilter(filter)).
+		QueryContext(ctx, s.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("SuggestedOfferPostgresRepository Count %w", err)
+	}
+	return dest.Count, nil
+}
+
+// UpdateStatus moves the suggested offer from the status it was read with, qrm.ErrNoRows means it moved in between
+func (s *SuggestedOfferPostgresRepository) UpdateStatus(
+	ctx context.Context,
+	id int64,
+	from entity.SuggestedOfferStatus,
+	to entity.SuggestedOfferStatus,
+	respondedBy string,
+	note string,
+) (entity.SuggestedOffer, error) {
+	errorTemplate := "SuggestedOfferPostgresRepository UpdateStatus %w"
+	now := time.Now()
+	toUpdate := model.SuggestedOffer{
+		Status:      to.String(),
+		Note:        note,
+		RespondedBy: respondedBy,
+		RespondedAt: null.TimeFrom(now),
+	}
+	columns := postgres.ColumnList{
+		table.SuggestedOffer.Status,
+		table.SuggestedOffer.Note,
+		table.SuggestedOffer.RespondedBy,
+		table.SuggestedOffer.RespondedAt,
+	}
+	if to == entity.SuggestedOfferStatusConverted {
+		toUpdate.ConvertedAt = null.TimeFrom(now)
+		columns = append(columns, table.SuggestedOffer.ConvertedAt)
+	}
+	dest := model.SuggestedOffer{}
+	if err := table.SuggestedOffer.UPDATE(columns).
+		MODEL(toUpdate).
+		WHERE(
+			table.SuggestedOffer.ID.EQ(postgres.Int64(id)).
+				AND(table.SuggestedOffer.Status.EQ(postgres.String(from.String()))),
+		).
+		RETURNING(table.SuggestedOffer.AllColumns).
+		QueryContext(ctx, s.getDbFunc(ctx), &dest); err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	updated, err := MapSuggestedOfferDbToEntity(dest)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	return updated, nil
+}
+
+func (s *SuggestedOfferPostgresRepository) CreateConversions(ctx context.Context, conversions []entity.SuggestedOfferConversion) error {
+	if len(conversions) == 0 {
+		return nil
+	}
+	toCreate := make([]model.SuggestedOfferConversion, 0, len(conversions))
+	for _, conversion := range conversions {
+		toCreate = append(toCreate, MapSuggestedOfferConversionEntityToDb(conversion))
+	}
+	if _, err := table.SuggestedOfferConversion.INSERT(table.SuggestedOfferConversion.MutableColumns).
+		MODELS(toCreate).
+		ExecContext(ctx, s.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("SuggestedOfferPostgresRepository CreateConversions %w", err)
+	}
+	return nil
+}
+
+type SuggestedOfferStatusCount struct {
+	ConfigID  int64 `sql:"primary_key"`
+	Total     int64
+	New       int64
+	Contacted int64
+	Converted int64
+	Rejected  int64
+}
+
+type SuggestedOfferRequestCount struct {
+	ConfigID          int64 `sql:"primary_key"`
+	LoanRequests      int64
+	ConfirmedRequests int64
+}
+
+// GetConversionMetrics counts the suggestions of every program by status and the loan package requests they were converted into,
+// only the config id of the returned metrics is set
+func (s *SuggestedOfferPostgresRepository) GetConversionMetrics(
+	ctx context.Context,
+	filter entity.SuggestedOfferMetricFilter,
+) ([]entity.SuggestedOfferConversionMetric, error) {
+	errorTemplate := "SuggestedOfferPostgresRepository GetConversionMetrics %w"
+	countStatus := func(status entity.SuggestedOfferStatus) postgres.Expression {
+		return postgres.SUM(
+			postgres.CASE().
+				WHEN(table.SuggestedOffer.Status.EQ(postgres.String(status.String()))).
+				THEN(postgres.Int(1)).
+				ELSE(postgres.Int(0)),
+		)
+	}
+	statusCounts := make([]SuggestedOfferStatusCount, 0)
+	if err := table.SuggestedOffer.SELECT(
+		table.SuggestedOffer.ConfigID.AS("suggested_offer_status_count.config_id"),
+		postgres.COUNT(table.SuggestedOffer.ID).AS("suggested_offer_status_count.total"),
+		countStatus(entity.SuggestedOfferStatusNew).AS("suggested_offer_status_count.new"),
+		countStatus(entity.SuggestedOfferStatusContacted).AS("suggested_offer_status_count.contacted"),
+		countStatus(entity.SuggestedOfferStatusConverted).AS("suggested_offer_status_count.converted"),
+		countStatus(entity.SuggestedOfferStatusRejected).AS("suggested_offer_status_count.rejected"),
+	).
+		WHERE(ApplySuggestedOfferMetricFilter(filter)).
+		GROUP_BY(table.SuggestedOffer.ConfigID).
+		ORDER_BY(table.SuggestedOffer.ConfigID).
+		QueryContext(ctx, s.getDbFunc(ctx), &statusCounts); err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	requestCounts := make([]SuggestedOfferRequestCount, 0)
+	if err := postgres.SELECT(
+		table.SuggestedOffer.ConfigID.AS("suggested_offer_request_count.config_id"),
+		postgres.COUNT(table.SuggestedOfferConversion.ID).AS("suggested_offer_request_count.loan_requests"),
+		postgres.SUM(
+			postgres.CASE().
+				WHEN(table.LoanPackageRequest.Status.EQ(postgres.String(entity.LoanPackageRequestStatusConfirmed.String()))).
+				THEN(postgres.Int(1)).
+				ELSE(postgres.Int(0)),
+		).AS("suggested_offer_request_count.confirmed_requests"),
+	).
+		FROM(
+			table.SuggestedOfferConversion.
+				INNER_JOIN(table.SuggestedOffer, table.SuggestedOffer.ID.EQ(table.SuggestedOfferConversion.SuggestedOfferID)).
+				INNER_JOIN(
+					table.LoanPackageRequest,
+					table.LoanPackageRequest.ID.EQ(table.SuggestedOfferConversion.LoanPackageRequestID),
+				),
+		).
+		WHERE(ApplySuggestedOfferMetricFilter(filter)).
+		GROUP_BY(table.SuggestedOffer.ConfigID).
+		QueryContext(ctx, s.getDbFunc(ctx), &requestCounts); err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	return MapSuggestedOfferConversionMetrics(statusCounts, requestCounts), nil
+}
+
+func ApplySuggestedOfferFilter(filter entity.SuggestedOfferFilter) postgres.BoolExpression {
+	expr := postgres.Bool(true)
+	if len(filter.ConfigIds) > 0 {
+		expr = expr.AND(table.SuggestedOffer.ConfigID.IN(querymod.In(filter.ConfigIds)...))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		expr = expr.AND(table.SuggestedOffer.Status.IN(querymod.In(statuses)...))
+	}
+	if filter.AccountNo.IsPresent() {
+		expr = expr.AND(table.SuggestedOffer.AccountNo.EQ(postgres.String(filter.AccountNo.Get())))
+	}
+	if filter.InvestorId.IsPresent() {
+		expr = expr.AND(table.SuggestedOffer.InvestorID.EQ(postgres.String(filter.InvestorId.Get())))
+	}
+	if filter.Symbol.IsPresent() {
+		expr = expr.AND(
+			postgres.RawBool(
+				"suggested_offer.symbols ? #symbol",
+				postgres.RawArgs{"#symbol": filter.Symbol.Get()},
+			),
+		)
+	}
+	if filter.StartDate.IsPresent() {
+		expr = expr.AND(table.SuggestedOffer.CreatedAt.GT_EQ(postgres.TimestampT(filter.StartDate.Get())))
+	}
+	if filter.EndDate.IsPresent() {
+		expr = expr.AND(table.SuggestedOffer.CreatedAt.LT_EQ(postgres.TimestampT(filter.EndDate.Get())))
+	}
+	return expr
+}
+
+func ApplySuggestedOfferMetricFilter(filter entity.SuggestedOfferMetricFilter) postgres.BoolExpression {
+	return ApplySuggestedOfferFilter(
+		entity.SuggestedOfferFilter{
+			ConfigIds: filter.ConfigIds,
+			StartDate: filter.StartDate,
+			EndDate:   filter.EndDate,
+		},
+	)
+}
@@ -26,9 +26,12 @@ func TestSuggestedOfferRepository_Create(t *testing.T) {
 	)
 	// Test data
 	suggestedOffer := entity.SuggestedOffer{
-		Id:        1,
-		ConfigId:  1,
-		AccountNo: "0001000115",
+		Id:          1,
+		ConfigId:    1,
+		AccountNo:   "0001000115",
+		InvestorId:  "0001000115",
+		CustodyCode: "064C000115",
+		Status:      entity.SuggestedOfferStatusNew,
 		Symbols: []string{
 			"ACB",
 			"VCB",
@@ -42,7 +45,10 @@ func TestSuggestedOfferRepository_Create(t *testing.T) {
 			"suggested_offer.id",
 			"suggested_offer.config_id",
 			"suggested_offer.account_no",
+			"suggested_offer.investor_id",
+			"suggested_offer.custody_code",
 			"suggested_offer.symbols",
+			"suggested_offer.status",
 			"suggested_offer.created_at",
 			"suggested_offer.updated_at",
 		})
@@ -50,11 +56,14 @@ func TestSuggestedOfferRepository_Create(t *testing.T) {
 			suggestedOffer.Id,
 			suggestedOffer.ConfigId,
 			suggestedOffer.AccountNo,
+			suggestedOffer.InvestorId,
+			suggestedOffer.CustodyCode,
 			"[\"ACB\", \"VCB\"]",
+			suggestedOffer.Status,
 			suggestedOffer.CreatedAt,
 			suggestedOffer.UpdatedAt,
 		)
-		mock.ExpectQuery("INSERT").WillReturnRows(rows)
+		mock.ExpectQuery(`INSERT INTO public.suggested_offer \(.*custody_code.*\)`).WillReturnRows(rows)
 		res, err := repo.Create(context.Background(), suggestedOffer)
 		assert.Nil(t, err)
 		assert.Equal(t, suggestedOffer, res)
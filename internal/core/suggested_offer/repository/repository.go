@@ -8,6 +8,19 @@ import (
 
 type SuggestedOfferRepository interface {
 	Create(ctx context.Context, suggestedOffer entity.SuggestedOffer) (entity.SuggestedOffer, error)
+	GetById(ctx context.Context, id int64) (entity.SuggestedOffer, error)
+	GetAll(ctx context.Context, filter entity.SuggestedOfferFilter) ([]entity.SuggestedOffer, error)
+	Count(ctx context.Context, filter entity.SuggestedOfferFilter) (int64, error)
+	UpdateStatus(
+		ctx context.Context,
+		id int64,
+		from entity.SuggestedOfferStatus,
+		to entity.SuggestedOfferStatus,
+		respondedBy string,
+		note string,
+	) (entity.SuggestedOffer, error)
+	CreateConversions(ctx context.Context, conversions []entity.SuggestedOfferConversion) error
+	GetConversionMetrics(ctx context.Context, filter entity.SuggestedOfferMetricFilter) ([]entity.SuggestedOfferConversionMetric, error)
 }
 
 type SuggestedOfferEventRepository interface {
@@ -1,7 +1,80 @@
 package http
 
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/optional"
+)
+
 type SuggestedOfferRequest struct {
 	ConfigId  int64    `json:"configId" binding:"required"`
 	Symbols   []string `json:"symbols" binding:"required,min=1"`
 	AccountNo string   `json:"accountNo" binding:"required"`
 }
+
+type GetSuggestedOffersRequest struct {
+	Paging     core.Paging
+	ConfigIds  []int64   `form:"configIds"`
+	Statuses   []string  `form:"statuses"`
+	AccountNo  string    `form:"accountNo"`
+	InvestorId string    `form:"investorId"`
+	Symbol     string    `form:"symbol"`
+	StartDate  time.Time `form:"startDate"`
+	EndDate    time.Time `form:"endDate"`
+}
+
+func (r GetSuggestedOffersRequest) toEntity() entity.SuggestedOfferFilter {
+	statuses := make([]entity.SuggestedOfferStatus, 0, len(r.Statuses))
+	for _, s := range r.Statuses {
+		statuses = append(statuses, entity.SuggestedOfferStatus(s))
+	}
+	return entity.SuggestedOfferFilter{
+		Paging:     r.Paging,
+		ConfigIds:  r.ConfigIds,
+		Statuses:   statuses,
+		AccountNo:  optional.FromValueNonZero(r.AccountNo),
+		InvestorId: optional.FromValueNonZero(r.InvestorId),
+		Symbol:     optional.FromValueNonZero(r.Symbol),
+		StartDate:  optional.FromValueNonZero(r.StartDate),
+		EndDate:    optional.FromValueNonZero(r.EndDate),
+	}
+}
+
+type UpdateSuggestedOfferStatusRequest struct {
+	Status entity.SuggestedOfferStatus `json:"status" binding:"required,oneof=CONTACTED REJECTED"`
+	Note   string                      `json:"note"`
+}
+
+type ConvertSuggestedOfferRequest struct {
+	LoanRate           decimal.Decimal               `json:"loanRate"`
+	LimitAmount        decimal.Decimal               `json:"limitAmount" binding:"required"`
+	Type               entity.LoanPackageRequestType `json:"type" binding:"required,oneof=FLEXIBLE GUARANTEED"`
+	GuaranteedDuration int                           `json:"guaranteedDuration"`
+}
+
+func (r ConvertSuggestedOfferRequest) toEntity() entity.SuggestedOfferConversionTerms {
+	return entity.SuggestedOfferConversionTerms{
+		LoanRate:           r.LoanRate,
+		LimitAmount:        r.LimitAmount,
+		Type:               r.Type,
+		GuaranteedDuration: r.GuaranteedDuration,
+	}
+}
+
+type GetConversionMetricsRequest struct {
+	ConfigIds []int64   `form:"configIds"`
+	StartDate time.Time `form:"startDate"`
+	EndDate   time.Time `form:"endDate"`
+}
+
+func (r GetConversionMetricsRequest) toEntity() entity.SuggestedOfferMetricFilter {
+	return entity.SuggestedOfferMetricFilter{
+		ConfigIds: r.ConfigIds,
+		StartDate: optional.FromValueNonZero(r.StartDate),
+		EndDate:   optional.FromValueNonZero(r.EndDate),
+	}
+}
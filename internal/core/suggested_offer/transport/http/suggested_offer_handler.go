@@ -77,3 +77,194 @@ func (h *SuggestedOfferHandler) CreateOffer(ctx *gin.Context) {
 		},
 	)
 }
+
+// GetAll godoc
+//
+//	@Summary		Get all suggested offers
+//	@Description	Get all suggested offers (admin)
+//	@Tags			suggested offer,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			page[size]		query		int64		false	"pageSize"
+//	@Param			page[number]	query		int64		false	"pageNumber"
+//	@Param			configIds		query		[]int64		false	"configIds"
+//	@Param			statuses		query		[]string	false	"statuses"
+//	@Param			accountNo		query		string		false	"accountNo"
+//	@Param			investorId		query		string		false	"investorId"
+//	@Param			symbol			query		string		false	"symbol"
+//	@Param			startDate		query		string		false	"startDate"
+//	@Param			endDate			query		string		false	"endDate"
+//	@Success		200				{object}	handler.ResponseWithPaging[[]entity.SuggestedOffer]
+//	@Failure		400				{object}	handler.ErrorResponse
+//	@Failure		500				{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/suggested-offers [get]
+func (h *SuggestedOfferHandler) GetAll(ctx *gin.Context) {
+	req := GetSuggestedOffersRequest{}
+	if err := h.ParseQueryWithPagination(ctx, &req.Paging, &req); err != nil {
+		h.RenderBadRequest(ctx, "parse query")
+		return
+	}
+	res, pagingMeta, err := h.suggestedOfferUseCase.GetAll(ctx, req.toEntity())
+	if err != nil {
+		h.logger.Error("Suggested Offer GetAll", slog.String("error", err.Error()))
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.ResponseWithPaging[[]entity.SuggestedOffer]{
+			Data:     res,
+			MetaData: pagingMeta,
+		},
+	)
+}
+
+// GetById godoc
+//
+//	@Summary		Get suggested offer by id
+//	@Description	Get suggested offer by id with the loan package requests it was converted into
+//	@Tags			suggested offer,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int64	true	"id"
+//	@Success		200	{object}	handler.BaseResponse[entity.SuggestedOffer]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		404	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/suggested-offers/{id} [get]
+func (h *SuggestedOfferHandler) GetById(ctx *gin.Context) {
+	errorMessage := "Suggested Offer GetById"
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderBadRequest(ctx, "id invalid")
+		return
+	}
+	res, err := h.suggestedOfferUseCase.GetById(ctx, id)
+	if err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[entity.SuggestedOffer]{
+			Data: res,
+		},
+	)
+}
+
+// UpdateStatus godoc
+//
+//	@Summary		Update suggested offer status
+//	@Description	Mark a suggested offer as contacted or rejected
+//	@Tags			suggested offer,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int64								true	"id"
+//	@Param			request	body		UpdateSuggestedOfferStatusRequest	true	"body"
+//	@Success		200		{object}	handler.BaseResponse[entity.SuggestedOffer]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		409		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/suggested-offers/{id}/status [patch]
+func (h *SuggestedOfferHandler) UpdateStatus(ctx *gin.Context) {
+	errorMessage := "Suggested Offer UpdateStatus"
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderBadRequest(ctx, "id invalid")
+		return
+	}
+	var request UpdateSuggestedOfferStatusRequest
+	if err = ctx.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, err.Error())
+		return
+	}
+	res, err := h.suggestedOfferUseCase.UpdateStatus(ctx, id, request.Status, h.UserSubOrEmpty(ctx), request.Note)
+	if err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[entity.SuggestedOffer]{
+			Data: res,
+		},
+	)
+}
+
+// Convert godoc
+//
+//	@Summary		Convert suggested offer
+//	@Description	Create a loan package request for every symbol of the suggested offer and mark it converted
+//	@Tags			suggested offer,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int64							true	"id"
+//	@Param			request	body		ConvertSuggestedOfferRequest	true	"body"
+//	@Success		200		{object}	handler.BaseResponse[entity.SuggestedOffer]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		409		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/suggested-offers/{id}/convert [post]
+func (h *SuggestedOfferHandler) Convert(ctx *gin.Context) {
+	errorMessage := "Suggested Offer Convert"
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderBadRequest(ctx, "id invalid")
+		return
+	}
+	var request ConvertSuggestedOfferRequest
+	if err = ctx.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, err.Error())
+		return
+	}
+	res, err := h.suggestedOfferUseCase.ConvertSuggestedOffer(ctx, id, request.toEntity(), h.UserSubOrEmpty(ctx))
+	if err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[entity.SuggestedOffer]{
+			Data: res,
+		},
+	)
+}
+
+// GetConversionMetrics godoc
+//
+//	@Summary		Get suggested offer conversion metrics
+//	@Description	Count suggested offers by status and the loan package requests they were converted into, per program
+//	@Tags			suggested offer,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			configIds	query		[]int64	false	"configIds"
+//	@Param			startDate	query		string	false	"startDate"
+//	@Param			endDate		query		string	false	"endDate"
+//	@Success		200			{object}	handler.BaseResponse[[]entity.SuggestedOfferConversionMetric]
+//	@Failure		400			{object}	handler.ErrorResponse
+//	@Failure		500			{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/suggested-offers/conversion-metrics [get]
+func (h *SuggestedOfferHandler) GetConversionMetrics(ctx *gin.Context) {
+	var request GetConversionMetricsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		h.RenderBadRequest(ctx, "parse query")
+		return
+	}
+	res, err := h.suggestedOfferUseCase.GetConversionMetrics(ctx, request.toEntity())
+	if err != nil {
+		h.logger.Error("Suggested Offer GetConversionMetrics", slog.String("error", err.Error()))
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[[]entity.SuggestedOfferConversionMetric]{
+			Data: res,
+		},
+	)
+}
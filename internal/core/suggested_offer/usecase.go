@@ -5,21 +5,38 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core"
 	"financing-offer/internal/core/entity"
+	investorRepo "financing-offer/internal/core/investor/repository"
+	"financing-offer/internal/core/loanpackagerequest"
 	orderServiceRepo "financing-offer/internal/core/orderservice/repository"
 	"financing-offer/internal/core/suggested_offer/repository"
 	configRepository "financing-offer/internal/core/suggested_offer_config/repository"
+	symbolRepo "financing-offer/internal/core/symbol/repository"
 )
 
 type UseCase interface {
 	CreateSuggestedOffer(ctx context.Context, investorId string, custodyCode string, suggestedOffer entity.SuggestedOffer) (entity.SuggestedOffer, error)
+	GetAll(ctx context.Context, filter entity.SuggestedOfferFilter) ([]entity.SuggestedOffer, core.PagingMetaData, error)
+	GetById(ctx context.Context, id int64) (entity.SuggestedOffer, error)
+	UpdateStatus(ctx context.Context, id int64, status entity.SuggestedOfferStatus, actor string, note string) (entity.SuggestedOffer, error)
+	ConvertSuggestedOffer(ctx context.Context, id int64, terms entity.SuggestedOfferConversionTerms, actor string) (entity.SuggestedOffer, error)
+	GetConversionMetrics(ctx context.Context, filter entity.SuggestedOfferMetricFilter) ([]entity.SuggestedOfferConversionMetric, error)
 }
 
 type useCase struct {
-	repository       repository.SuggestedOfferRepository
-	configRepository configRepository.SuggestedOfferConfigRepository
-	eventRepository  repository.SuggestedOfferEventRepository
-	orderServiceRepo orderServiceRepo.OrderServiceRepository
+	repository                repository.SuggestedOfferRepository
+	configRepository          configRepository.SuggestedOfferConfigRepository
+	eventRepository           repository.SuggestedOfferEventRepository
+	orderServiceRepo          orderServiceRepo.OrderServiceRepository
+	symbolRepository          symbolRepo.SymbolRepository
+	loanPackageRequestUseCase loanpackagerequest.UseCase
+	investorRepository        investorRepo.InvestorPersistenceRepository
 }
 
 func (u *useCase) CreateSuggestedOffer(ctx context.Context, investorId string, custodyCode string, suggestedOffer entity.SuggestedOffer) (entity.SuggestedOffer, error) {
@@ -35,6 +52,8 @@ func (u *useCase) CreateSuggestedOffer(ctx context.Context, investorId string, c
 	if err != nil {
 		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
 	}
+	suggestedOffer.InvestorId = investorId
+	suggestedOffer.CustodyCode = custodyCode
 	createdOffer, err := u.repository.Create(ctx, suggestedOffer)
 	if err != nil {
 		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
@@ -47,16 +66,261 @@ func (u *useCase) CreateSuggestedOffer(ctx context.Context, investorId string, c
 	return createdOffer, nil
 }
 
+func (u *useCase) GetAll(ctx context.Context, filter entity.SuggestedOfferFilter) ([]entity.SuggestedOffer, core.PagingMetaData, error) {
+	var (
+		suggestedOffers []entity.SuggestedOffer
+		configs         []entity.SuggestedOfferConfig
+		eg              errgroup.Group
+		pagingMetaData  = core.PagingMetaData{PageSize: filter.Size, PageNumber: filter.Number}
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.repository.GetAll(ctx, filter)
+			suggestedOffers = res
+			return scopedErr
+		},
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.repository.Count(ctx, filter)
+			pagingMetaData.Total = res
+			pagingMetaData.TotalPages = filter.TotalPages(res)
+			return scopedErr
+		},
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.configRepository.GetAll(ctx)
+			configs = res
+			return scopedErr
+		},
+	)
+	if err := eg.Wait(); err != nil {
+		return nil, pagingMetaData, fmt.Errorf("suggestedOfferUseCase GetAll %w", err)
+	}
+	configById := make(map[int64]entity.SuggestedOfferConfig, len(configs))
+	for _, config := range configs {
+		configById[config.Id] = config
+	}
+	for i := range suggestedOffers {
+		if config, ok := configById[suggestedOffers[i].ConfigId]; ok {
+			suggestedOffers[i].Config = &config
+		}
+	}
+	return suggestedOffers, pagingMetaData, nil
+}
+
+func (u *useCase) GetById(ctx context.Context, id int64) (entity.SuggestedOffer, error) {
+	errorTemplate := "suggestedOfferUseCase GetById %w"
+	suggestedOffer, err := u.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	config, err := u.configRepository.GetById(ctx, suggestedOffer.ConfigId)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	suggestedOffer.Config = &config
+	return suggestedOffer, nil
+}
+
+// UpdateStatus records the admin follow-up of a suggestion, CONVERTED is only reached through ConvertSuggestedOffer
+func (u *useCase) UpdateStatus(
+	ctx context.Context,
+	id int64,
+	status entity.SuggestedOfferStatus,
+	actor string,
+	note string,
+) (entity.SuggestedOffer, error) {
+	errorTemplate := "suggestedOfferUseCase UpdateStatus %w"
+	if status == entity.SuggestedOfferStatusConverted {
+		return entity.SuggestedOffer{}, apperrors.ErrInvalidInput("use the convert endpoint to convert a suggested offer")
+	}
+	suggestedOffer, err := u.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	updated, err := u.transitionStatus(ctx, suggestedOffer, status, actor, note)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	updated.LoanPackageRequestIds = suggestedOffer.LoanPackageRequestIds
+	return updated, nil
+}
+
+// ConvertSuggestedOffer creates a pending loan package request for every symbol of the suggestion
+// and marks it CONVERTED in the same transaction, the investor is created with them unless it exists
+func (u *useCase) ConvertSuggestedOffer(
+	ctx context.Context,
+	id int64,
+	terms entity.SuggestedOfferConversionTerms,
+	actor string,
+) (entity.SuggestedOffer, error) {
+	errorTemplate := "suggestedOfferUseCase ConvertSuggestedOffer %w"
+	suggestedOffer, err := u.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	if !suggestedOffer.Status.CanTransitionTo(entity.SuggestedOfferStatusConverted) {
+		return entity.SuggestedOffer{}, apperrors.ErrInvalidSuggestedOfferStatusTransition(
+			suggestedOffer.Status, entity.SuggestedOfferStatusConverted,
+		)
+	}
+	if suggestedOffer.InvestorId == "" {
+		return entity.SuggestedOffer{}, apperrors.ErrSuggestedOfferWithoutInvestor
+	}
+	config, err := u.configRepository.GetById(ctx, suggestedOffer.ConfigId)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	loanRate := terms.LoanRate
+	if loanRate.IsZero() {
+		if config.ValueType != entity.ValueTypeLoanRate {
+			return entity.SuggestedOffer{}, apperrors.ErrInvalidInput("loan rate is required for an interest rate program")
+		}
+		loanRate = config.Value
+	}
+	loanPackageRequests := make([]entity.LoanPackageRequest, 0, len(suggestedOffer.Symbols))
+	for _, symbolCode := range suggestedOffer.Symbols {
+		symbol, err := u.symbolRepository.GetBySymbol(ctx, symbolCode)
+		if err != nil {
+			return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+		}
+		loanPackageRequests = append(
+			loanPackageRequests, entity.LoanPackageRequest{
+				SymbolId:           symbol.Id,
+				InvestorId:         suggestedOffer.InvestorId,
+				AccountNo:          suggestedOffer.AccountNo,
+				LoanRate:           loanRate,
+				LimitAmount:        terms.LimitAmount,
+				Type:               terms.Type,
+				Status:             entity.LoanPackageRequestStatusPending,
+				GuaranteedDuration: terms.GuaranteedDuration,
+				AssetType:          entity.AssetTypeUnderlying,
+			},
+		)
+	}
+	var converted entity.SuggestedOffer
+	created, err := u.loanPackageRequestUseCase.AdminCreateRequests(
+		ctx, loanPackageRequests, actor, func(tc context.Context, created []entity.LoanPackageRequest) error {
+			// suggestions made before their custody code was kept cannot create the investor
+			if suggestedOffer.CustodyCode != "" {
+				err := u.investorRepository.CreateIfNotExist(
+					tc, entity.Investor{InvestorId: suggestedOffer.InvestorId, CustodyCode: suggestedOffer.CustodyCode},
+				)
+				if err != nil {
+					return err
+				}
+			}
+			res, err := u.transitionStatus(tc, suggestedOffer, entity.SuggestedOfferStatusConverted, actor, suggestedOffer.Note)
+			if err != nil {
+				return err
+			}
+			converted = res
+			conversions := make([]entity.SuggestedOfferConversion, 0, len(created))
+			for i, request := range created {
+				conversions = append(
+					conversions, entity.SuggestedOfferConversion{
+						SuggestedOfferId:     suggestedOffer.Id,
+						LoanPackageRequestId: request.Id,
+						Symbol:               suggestedOffer.Symbols[i],
+					},
+				)
+			}
+			return u.repository.CreateConversions(tc, conversions)
+		},
+	)
+	if err != nil {
+		return entity.SuggestedOffer{}, fmt.Errorf(errorTemplate, err)
+	}
+	for _, request := range created {
+		converted.LoanPackageRequestIds = append(converted.LoanPackageRequestIds, request.Id)
+	}
+	converted.Config = &config
+	return converted, nil
+}
+
+// transitionStatus rejects moves the suggestion state machine does not allow, an offer changed by another admin
+// since it was read is reported the same way
+func (u *useCase) transitionStatus(
+	ctx context.Context,
+	suggestedOffer entity.SuggestedOffer,
+	status entity.SuggestedOfferStatus,
+	actor string,
+	note string,
+) (entity.SuggestedOffer, error) {
+	if !suggestedOffer.Status.CanTransitionTo(status) {
+		return entity.SuggestedOffer{}, apperrors.ErrInvalidSuggestedOfferStatusTransition(suggestedOffer.Status, status)
+	}
+	updated, err := u.repository.UpdateStatus(ctx, suggestedOffer.Id, suggestedOffer.Status, status, actor, note)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return entity.SuggestedOffer{}, apperrors.ErrInvalidSuggestedOfferStatusTransition(suggestedOffer.Status, status)
+	}
+	return updated, err
+}
+
+// GetConversionMetrics reports every program with suggestions in the filtered period, rates are ratios in [0, 1]
+func (u *useCase) GetConversionMetrics(ctx context.Context, filter entity.SuggestedOfferMetricFilter) ([]entity.SuggestedOfferConversionMetric, error) {
+	errorTemplate := "suggestedOfferUseCase GetConversionMetrics %w"
+	var (
+		metrics []entity.SuggestedOfferConversionMetric
+		configs []entity.SuggestedOfferConfig
+		eg      errgroup.Group
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.repository.GetConversionMetrics(ctx, filter)
+			metrics = res
+			return scopedErr
+		},
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.configRepository.GetAll(ctx)
+			configs = res
+			return scopedErr
+		},
+	)
+	if err := eg.Wait(); err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	configById := make(map[int64]entity.SuggestedOfferConfig, len(configs))
+	for _, config := range configs {
+		configById[config.Id] = config
+	}
+	for i, metric := range metrics {
+		if config, ok := configById[metric.Config.Id]; ok {
+			metrics[i].Config = config
+		}
+		metrics[i].ConversionRate = ratio(metric.Converted, metric.Total)
+		metrics[i].RequestConfirmRate = ratio(metric.ConfirmedRequests, metric.LoanRequests)
+	}
+	return metrics, nil
+}
+
+func ratio(part, total int64) decimal.Decimal {
+	if total == 0 {
+		return decimal.Zero
+	}
+	return decimal.NewFromInt(part).DivRound(decimal.NewFromInt(total), 4)
+}
+
 func NewUseCase(
 	repository repository.SuggestedOfferRepository,
 	configRepository configRepository.SuggestedOfferConfigRepository,
 	eventRepository repository.SuggestedOfferEventRepository,
 	orderServiceRepo orderServiceRepo.OrderServiceRepository,
+	symbolRepository symbolRepo.SymbolRepository,
+	loanPackageRequestUseCase loanpackagerequest.UseCase,
+	investorRepository investorRepo.InvestorPersistenceRepository,
 ) UseCase {
 	return &useCase{
-		repository:       repository,
-		configRepository: configRepository,
-		eventRepository:  eventRepository,
-		orderServiceRepo: orderServiceRepo,
+		repository:                repository,
+		configRepository:          configRepository,
+		eventRepository:           eventRepository,
+		orderServiceRepo:          orderServiceRepo,
+		symbolRepository:          symbolRepository,
+		loanPackageRequestUseCase: loanPackageRequestUseCase,
+		investorRepository:        investorRepository,
 	}
 }
@@ -5,9 +5,12 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	testifyMock "github.com/stretchr/testify/mock"
 
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/core/entity"
 	"financing-offer/test/mock"
 )
@@ -21,7 +24,11 @@ func TestSuggestedOfferUseCase(t *testing.T) {
 		configRepository := mock.NewMockSuggestedOfferConfigRepository(t)
 		eventRepository := mock.NewMockSuggestedOfferEventRepository(t)
 		orderServiceRepo := mock.NewMockOrderServiceRepository(t)
-		useCase := NewUseCase(repository, configRepository, eventRepository, orderServiceRepo)
+		useCase := NewUseCase(
+			repository, configRepository, eventRepository, orderServiceRepo,
+			mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+			mock.NewMockInvestorPersistenceRepository(t),
+		)
 		configRepository.EXPECT().GetById(testifyMock.Anything, offerRequest.ConfigId).Return(
 			entity.SuggestedOfferConfig{}, errors.New("not found"))
 		_, err := useCase.CreateSuggestedOffer(context.Background(), "investor", "custodyCode", offerRequest)
@@ -36,7 +43,11 @@ func TestSuggestedOfferUseCase(t *testing.T) {
 		configRepository := mock.NewMockSuggestedOfferConfigRepository(t)
 		eventRepository := mock.NewMockSuggestedOfferEventRepository(t)
 		orderServiceRepo := mock.NewMockOrderServiceRepository(t)
-		useCase := NewUseCase(repository, configRepository, eventRepository, orderServiceRepo)
+		useCase := NewUseCase(
+			repository, configRepository, eventRepository, orderServiceRepo,
+			mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+			mock.NewMockInvestorPersistenceRepository(t),
+		)
 		configRepository.EXPECT().GetById(testifyMock.Anything, offerRequest.ConfigId).Return(
 			entity.SuggestedOfferConfig{
 				Status: entity.SuggestedOfferConfigStatusInactive,
@@ -47,20 +58,25 @@ func TestSuggestedOfferUseCase(t *testing.T) {
 
 	t.Run("create suggested offer error db", func(t *testing.T) {
 		offerRequest := entity.SuggestedOffer{
-			ConfigId:  1,
-			AccountNo: "accountNo",
-			Symbols:   []string{"ACB"},
+			ConfigId:   1,
+			AccountNo:  "accountNo",
+			InvestorId: "investor",
+			Symbols:    []string{"ACB"},
 		}
 		repository := mock.NewMockSuggestedOfferRepository(t)
 		configRepository := mock.NewMockSuggestedOfferConfigRepository(t)
 		eventRepository := mock.NewMockSuggestedOfferEventRepository(t)
 		orderServiceRepo := mock.NewMockOrderServiceRepository(t)
-		useCase := NewUseCase(repository, configRepository, eventRepository, orderServiceRepo)
+		useCase := NewUseCase(
+			repository, configRepository, eventRepository, orderServiceRepo,
+			mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+			mock.NewMockInvestorPersistenceRepository(t),
+		)
 		configRepository.EXPECT().GetById(testifyMock.Anything, offerRequest.ConfigId).Return(
 			entity.SuggestedOfferConfig{
 				Status: entity.SuggestedOfferConfigStatusActive,
 			}, nil)
-		repository.EXPECT().Create(testifyMock.Anything, offerRequest).Return(
+		repository.EXPECT().Create(testifyMock.Anything, testifyMock.Anything).Return(
 			entity.SuggestedOffer{}, errors.New("duplicate key"))
 		orderServiceRepo.EXPECT().GetAccountByAccountNoAndCustodyCode(testifyMock.Anything, "custodyCode", "accountNo").Return(entity.OrderServiceAccount{
 			CustodyCode: "custodyCode",
@@ -75,16 +91,21 @@ func TestSuggestedOfferUseCase(t *testing.T) {
 			Status: entity.SuggestedOfferConfigStatusActive,
 		}
 		offerRequest := entity.SuggestedOffer{
-			ConfigId:  1,
-			Config:    &config,
-			AccountNo: "accountNo",
-			Symbols:   []string{"ACB"},
+			ConfigId:   1,
+			Config:     &config,
+			AccountNo:  "accountNo",
+			InvestorId: "investor",
+			Symbols:    []string{"ACB"},
 		}
 		repository := mock.NewMockSuggestedOfferRepository(t)
 		configRepository := mock.NewMockSuggestedOfferConfigRepository(t)
 		eventRepository := mock.NewMockSuggestedOfferEventRepository(t)
 		orderServiceRepo := mock.NewMockOrderServiceRepository(t)
-		useCase := NewUseCase(repository, configRepository, eventRepository, orderServiceRepo)
+		useCase := NewUseCase(
+			repository, configRepository, eventRepository, orderServiceRepo,
+			mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+			mock.NewMockInvestorPersistenceRepository(t),
+		)
 		configRepository.EXPECT().GetById(testifyMock.Anything, offerRequest.ConfigId).Return(config, nil)
 		orderServiceRepo.EXPECT().GetAccountByAccountNoAndCustodyCode(testifyMock.Anything, "custodyCode", "accountNo").Return(entity.OrderServiceAccount{}, errors.New("test"))
 		_, err := useCase.CreateSuggestedOffer(context.Background(), "investor", "custodyCode", offerRequest)
@@ -96,26 +117,220 @@ func TestSuggestedOfferUseCase(t *testing.T) {
 			Status: entity.SuggestedOfferConfigStatusActive,
 		}
 		offerRequest := entity.SuggestedOffer{
-			ConfigId:  1,
-			Config:    &config,
-			AccountNo: "accountNo",
-			Symbols:   []string{"ACB"},
+			ConfigId:   1,
+			Config:     &config,
+			AccountNo:  "accountNo",
+			InvestorId: "investor",
+			Symbols:    []string{"ACB"},
 		}
 		repository := mock.NewMockSuggestedOfferRepository(t)
 		configRepository := mock.NewMockSuggestedOfferConfigRepository(t)
 		eventRepository := mock.NewMockSuggestedOfferEventRepository(t)
 		orderServiceRepo := mock.NewMockOrderServiceRepository(t)
-		useCase := NewUseCase(repository, configRepository, eventRepository, orderServiceRepo)
+		useCase := NewUseCase(
+			repository, configRepository, eventRepository, orderServiceRepo,
+			mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+			mock.NewMockInvestorPersistenceRepository(t),
+		)
+		toCreate := offerRequest
+		toCreate.CustodyCode = "custodyCode"
 		configRepository.EXPECT().GetById(testifyMock.Anything, offerRequest.ConfigId).Return(config, nil)
-		eventRepository.EXPECT().NotifySuggestedOfferCreated(testifyMock.Anything, "investor", config, offerRequest).Return(nil)
-		repository.EXPECT().Create(testifyMock.Anything, offerRequest).Return(
-			offerRequest, nil)
+		eventRepository.EXPECT().NotifySuggestedOfferCreated(testifyMock.Anything, "investor", config, toCreate).Return(nil)
+		repository.EXPECT().Create(testifyMock.Anything, toCreate).Return(
+			toCreate, nil)
 		orderServiceRepo.EXPECT().GetAccountByAccountNoAndCustodyCode(testifyMock.Anything, "custodyCode", "accountNo").Return(entity.OrderServiceAccount{
 			CustodyCode: "custodyCode",
 			AccountNo:   "accountNo",
 		}, nil)
 		createdOffer, err := useCase.CreateSuggestedOffer(context.Background(), "investor", "custodyCode", offerRequest)
 		assert.Nil(t, err)
-		assert.Equal(t, offerRequest, createdOffer)
+		assert.Equal(t, toCreate, createdOffer)
 	})
 }
+
+func TestSuggestedOfferUseCase_UpdateStatus(t *testing.T) {
+	suggestedOffer := entity.SuggestedOffer{Id: 1, ConfigId: 2, Status: entity.SuggestedOfferStatusNew}
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockSuggestedOfferRepository) {
+		repository := mock.NewMockSuggestedOfferRepository(t)
+		return NewUseCase(
+			repository, mock.NewMockSuggestedOfferConfigRepository(t), mock.NewMockSuggestedOfferEventRepository(t),
+			mock.NewMockOrderServiceRepository(t), mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+			mock.NewMockInvestorPersistenceRepository(t),
+		), repository
+	}
+
+	t.Run("contacted offer is updated", func(t *testing.T) {
+		useCase, repository := newUseCase(t)
+		repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(suggestedOffer, nil)
+		repository.EXPECT().UpdateStatus(
+			testifyMock.Anything, int64(1), entity.SuggestedOfferStatusNew, entity.SuggestedOfferStatusContacted,
+			"admin", "called",
+		).Return(entity.SuggestedOffer{Id: 1, Status: entity.SuggestedOfferStatusContacted, Note: "called"}, nil)
+		res, err := useCase.UpdateStatus(context.Background(), 1, entity.SuggestedOfferStatusContacted, "admin", "called")
+		assert.Nil(t, err)
+		assert.Equal(t, entity.SuggestedOfferStatusContacted, res.Status)
+	})
+
+	t.Run("rejected offer cannot be contacted", func(t *testing.T) {
+		useCase, repository := newUseCase(t)
+		rejected := suggestedOffer
+		rejected.Status = entity.SuggestedOfferStatusRejected
+		repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(rejected, nil)
+		_, err := useCase.UpdateStatus(context.Background(), 1, entity.SuggestedOfferStatusContacted, "admin", "")
+		assert.ErrorIs(
+			t, err,
+			apperrors.ErrInvalidSuggestedOfferStatusTransition(entity.SuggestedOfferStatusRejected, entity.SuggestedOfferStatusContacted),
+		)
+	})
+
+	t.Run("offer changed by another admin is a conflict", func(t *testing.T) {
+		useCase, repository := newUseCase(t)
+		repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(suggestedOffer, nil)
+		repository.EXPECT().UpdateStatus(
+			testifyMock.Anything, int64(1), entity.SuggestedOfferStatusNew, entity.SuggestedOfferStatusRejected, "admin", "",
+		).Return(entity.SuggestedOffer{}, qrm.ErrNoRows)
+		_, err := useCase.UpdateStatus(context.Background(), 1, entity.SuggestedOfferStatusRejected, "admin", "")
+		assert.ErrorIs(
+			t, err,
+			apperrors.ErrInvalidSuggestedOfferStatusTransition(entity.SuggestedOfferStatusNew, entity.SuggestedOfferStatusRejected),
+		)
+	})
+
+	t.Run("converted status is only reached by conversion", func(t *testing.T) {
+		useCase, _ := newUseCase(t)
+		_, err := useCase.UpdateStatus(context.Background(), 1, entity.SuggestedOfferStatusConverted, "admin", "")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSuggestedOfferUseCase_ConvertSuggestedOffer(t *testing.T) {
+	suggestedOffer := entity.SuggestedOffer{
+		Id:          1,
+		ConfigId:    2,
+		AccountNo:   "0001000115",
+		InvestorId:  "0001000115",
+		CustodyCode: "064C000115",
+		Symbols:     []string{"ACB", "VCB"},
+		Status:      entity.SuggestedOfferStatusContacted,
+	}
+	config := entity.SuggestedOfferConfig{
+		Id:        2,
+		Value:     decimal.NewFromFloat(0.5),
+		ValueType: entity.ValueTypeLoanRate,
+		Status:    entity.SuggestedOfferConfigStatusActive,
+	}
+	terms := entity.SuggestedOfferConversionTerms{
+		LimitAmount: decimal.NewFromInt(1_000_000_000),
+		Type:        entity.LoanPackageRequestTypeFlexible,
+	}
+
+	t.Run("a request is created for every symbol", func(t *testing.T) {
+		repository := mock.NewMockSuggestedOfferRepository(t)
+		configRepository := mock.NewMockSuggestedOfferConfigRepository(t)
+		symbolRepository := mock.NewMockSymbolRepository(t)
+		loanPackageRequestUseCase := mock.NewMockLoanPackageRequestUseCase(t)
+		investorRepository := mock.NewMockInvestorPersistenceRepository(t)
+		useCase := NewUseCase(
+			repository, configRepository, mock.NewMockSuggestedOfferEventRepository(t),
+			mock.NewMockOrderServiceRepository(t), symbolRepository, loanPackageRequestUseCase, investorRepository,
+		)
+		repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(suggestedOffer, nil)
+		configRepository.EXPECT().GetById(testifyMock.Anything, int64(2)).Return(config, nil)
+		symbolRepository.EXPECT().GetBySymbol(testifyMock.Anything, "ACB").Return(entity.Symbol{Id: 10, Symbol: "ACB"}, nil)
+		symbolRepository.EXPECT().GetBySymbol(testifyMock.Anything, "VCB").Return(entity.Symbol{Id: 11, Symbol: "VCB"}, nil)
+		loanPackageRequestUseCase.EXPECT().AdminCreateRequests(
+			testifyMock.Anything,
+			testifyMock.MatchedBy(func(requests []entity.LoanPackageRequest) bool {
+				return len(requests) == 2 &&
+					requests[0].SymbolId == 10 && requests[1].SymbolId == 11 &&
+					requests[0].LoanRate.Equal(config.Value) &&
+					requests[0].InvestorId == suggestedOffer.InvestorId &&
+					requests[0].Status == entity.LoanPackageRequestStatusPending
+			}),
+			"admin",
+			testifyMock.Anything,
+		).RunAndReturn(
+			func(
+				ctx context.Context,
+				requests []entity.LoanPackageRequest,
+				_ string,
+				afterCreate func(context.Context, []entity.LoanPackageRequest) error,
+			) ([]entity.LoanPackageRequest, error) {
+				requests[0].Id, requests[1].Id = 100, 101
+				return requests, afterCreate(ctx, requests)
+			},
+		)
+		investorRepository.EXPECT().CreateIfNotExist(
+			testifyMock.Anything, entity.Investor{InvestorId: "0001000115", CustodyCode: "064C000115"},
+		).Return(nil)
+		repository.EXPECT().UpdateStatus(
+			testifyMock.Anything, int64(1), entity.SuggestedOfferStatusContacted, entity.SuggestedOfferStatusConverted, "admin", "",
+		).Return(entity.SuggestedOffer{Id: 1, Status: entity.SuggestedOfferStatusConverted}, nil)
+		repository.EXPECT().CreateConversions(
+			testifyMock.Anything, []entity.SuggestedOfferConversion{
+				{SuggestedOfferId: 1, LoanPackageRequestId: 100, Symbol: "ACB"},
+				{SuggestedOfferId: 1, LoanPackageRequestId: 101, Symbol: "VCB"},
+			},
+		).Return(nil)
+		res, err := useCase.ConvertSuggestedOffer(context.Background(), 1, terms, "admin")
+		assert.Nil(t, err)
+		assert.Equal(t, entity.SuggestedOfferStatusConverted, res.Status)
+		assert.Equal(t, []int64{100, 101}, res.LoanPackageRequestIds)
+	})
+
+	t.Run("offer without investor cannot be converted", func(t *testing.T) {
+		repository := mock.NewMockSuggestedOfferRepository(t)
+		useCase := NewUseCase(
+			repository, mock.NewMockSuggestedOfferConfigRepository(t), mock.NewMockSuggestedOfferEventRepository(t),
+			mock.NewMockOrderServiceRepository(t), mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+			mock.NewMockInvestorPersistenceRepository(t),
+		)
+		withoutInvestor := suggestedOffer
+		withoutInvestor.InvestorId = ""
+		repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(withoutInvestor, nil)
+		_, err := useCase.ConvertSuggestedOffer(context.Background(), 1, terms, "admin")
+		assert.ErrorIs(t, err, apperrors.ErrSuggestedOfferWithoutInvestor)
+	})
+
+	t.Run("converted offer cannot be converted again", func(t *testing.T) {
+		repository := mock.NewMockSuggestedOfferRepository(t)
+		useCase := NewUseCase(
+			repository, mock.NewMockSuggestedOfferConfigRepository(t), mock.NewMockSuggestedOfferEventRepository(t),
+			mock.NewMockOrderServiceRepository(t), mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+			mock.NewMockInvestorPersistenceRepository(t),
+		)
+		converted := suggestedOffer
+		converted.Status = entity.SuggestedOfferStatusConverted
+		repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(converted, nil)
+		_, err := useCase.ConvertSuggestedOffer(context.Background(), 1, terms, "admin")
+		assert.ErrorIs(
+			t, err,
+			apperrors.ErrInvalidSuggestedOfferStatusTransition(entity.SuggestedOfferStatusConverted, entity.SuggestedOfferStatusConverted),
+		)
+	})
+}
+
+func TestSuggestedOfferUseCase_GetConversionMetrics(t *testing.T) {
+	repository := mock.NewMockSuggestedOfferRepository(t)
+	configRepository := mock.NewMockSuggestedOfferConfigRepository(t)
+	useCase := NewUseCase(
+		repository, configRepository, mock.NewMockSuggestedOfferEventRepository(t),
+		mock.NewMockOrderServiceRepository(t), mock.NewMockSymbolRepository(t), mock.NewMockLoanPackageRequestUseCase(t),
+		mock.NewMockInvestorPersistenceRepository(t),
+	)
+	config := entity.SuggestedOfferConfig{Id: 2, Name: "program"}
+	repository.EXPECT().GetConversionMetrics(testifyMock.Anything, entity.SuggestedOfferMetricFilter{}).Return(
+		[]entity.SuggestedOfferConversionMetric{
+			{Config: entity.SuggestedOfferConfig{Id: 2}, Total: 8, Converted: 2, LoanRequests: 3, ConfirmedRequests: 1},
+			{Config: entity.SuggestedOfferConfig{Id: 3}, Total: 1, New: 1},
+		}, nil,
+	)
+	configRepository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.SuggestedOfferConfig{config}, nil)
+	res, err := useCase.GetConversionMetrics(context.Background(), entity.SuggestedOfferMetricFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, config, res[0].Config)
+	assert.Equal(t, "0.25", res[0].ConversionRate.String())
+	assert.Equal(t, "0.3333", res[0].RequestConfirmRate.String())
+	assert.True(t, res[1].ConversionRate.IsZero())
+	assert.True(t, res[1].RequestConfirmRate.IsZero())
+}
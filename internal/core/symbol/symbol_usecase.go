@@ -108,7 +108,14 @@ func (s *symbolUseCase) GetAll(ctx context.Context, filter entity.SymbolFilter)
 
 func (s *symbolUseCase) UpdateStatus(ctx context.Context, newSymbol entity.Symbol) (entity.Symbol, error) {
 	errorWrapMsg := "symbolUseCase UpdateStatus %w"
-	res, err := s.repository.Update(ctx, newSymbol)
+	var res entity.Symbol
+	// the audit trigger reads the actor set by the executor
+	err := s.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = s.repository.Update(tc, newSymbol)
+			return err
+		},
+	)
 	if err != nil {
 		return entity.Symbol{}, fmt.Errorf(errorWrapMsg, err)
 	}
@@ -116,7 +123,13 @@ func (s *symbolUseCase) UpdateStatus(ctx context.Context, newSymbol entity.Symbo
 }
 
 func (s *symbolUseCase) Create(ctx context.Context, symbol entity.Symbol) (entity.Symbol, error) {
-	res, err := s.repository.Create(ctx, symbol)
+	var res entity.Symbol
+	err := s.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = s.repository.Create(tc, symbol)
+			return err
+		},
+	)
 	if err != nil {
 		return res, fmt.Errorf("symbolUseCase Create %w", err)
 	}
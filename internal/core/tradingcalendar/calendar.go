@@ -0,0 +1,252 @@
+package tradingcalendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/tradingcalendar/repository"
+	"financing-offer/pkg/optional"
+)
+
+const (
+	dateLayout  = "2006-01-02"
+	clockLayout = "15:04"
+	// windowDays is the number of days loaded at once when walking the calendar
+	windowDays = 62
+	// maxWalkDays stops a walk over a calendar marking every day as a holiday
+	maxWalkDays = 3660
+)
+
+// Calendar answers trading day questions of a stock exchange, a day is traded unless it is a weekend
+// or a holiday of the exchange or of all exchanges
+type Calendar interface {
+	Session(ctx context.Context, stockExchangeCode string, date time.Time) (entity.TradingSession, error)
+	IsTradingSession(ctx context.Context, stockExchangeCode string, at time.Time) (bool, error)
+	// AddTradingDays returns the time of from on the days-th trading day after it
+	AddTradingDays(ctx context.Context, stockExchangeCode string, from time.Time, days int) (time.Time, error)
+	// LastCutOff returns the n-th latest cut-off at or before at, at itself when n is not positive
+	LastCutOff(ctx context.Context, stockExchangeCode string, at time.Time, n int) (time.Time, error)
+	// StockExchangeCode returns the code of the stock exchange symbolId is listed on, whose calendar its requests follow
+	StockExchangeCode(ctx context.Context, symbolId int64) (string, error)
+}
+
+type calendar struct {
+	location         *time.Location
+	openTime         time.Duration
+	closeTime        time.Duration
+	halfDayCloseTime time.Duration
+	cutOffTime       time.Duration
+	repository       repository.TradingCalendarRepository
+}
+
+func NewCalendar(cfg config.TradingCalendarConfig, repository repository.TradingCalendarRepository) (Calendar, error) {
+	errorTemplate := "NewCalendar %w"
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	res := &calendar{location: location, repository: repository}
+	for _, clock := range []struct {
+		value string
+		dest  *time.Duration
+	}{
+		{cfg.OpenTime, &res.openTime},
+		{cfg.CloseTime, &res.closeTime},
+		{cfg.HalfDayCloseTime, &res.halfDayCloseTime},
+		{cfg.CutOffTime, &res.cutOffTime},
+	} {
+		if *clock.dest, err = ParseClock(clock.value); err != nil {
+			return nil, fmt.Errorf(errorTemplate, err)
+		}
+	}
+	return res, nil
+}
+
+// ParseClock returns the time since midnight of an HH:MM clock
+func ParseClock(value string) (time.Duration, error) {
+	t, err := time.Parse(clockLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("ParseClock %q %w", value, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (c *calendar) Session(ctx context.Context, stockExchangeCode string, date time.Time) (entity.TradingSession, error) {
+	day := c.startOfDay(date)
+	days, err := c.loadDays(ctx, stockExchangeCode, day, day)
+	if err != nil {
+		return entity.TradingSession{}, fmt.Errorf("calendar Session %w", err)
+	}
+	return c.session(stockExchangeCode, day, days), nil
+}
+
+func (c *calendar) IsTradingSession(ctx context.Context, stockExchangeCode string, at time.Time) (bool, error) {
+	session, err := c.Session(ctx, stockExchangeCode, at)
+	if err != nil {
+		return false, fmt.Errorf("calendar IsTradingSession %w", err)
+	}
+	return session.TradingDay && !at.Before(session.OpenAt) && at.Before(session.CloseAt), nil
+}
+
+func (c *calendar) AddTradingDays(ctx context.Context, stockExchangeCode string, from time.Time, days int) (time.Time, error) {
+	if days <= 0 {
+		return from, nil
+	}
+	local := from.In(c.location)
+	count := 0
+	var res time.Time
+	err := c.walk(
+		ctx, stockExchangeCode, c.startOfDay(local).AddDate(0, 0, 1), 1, func(session entity.TradingSession) bool {
+			if !session.TradingDay {
+				return false
+			}
+			count++
+			if count < days {
+				return false
+			}
+			res = time.Date(
+				session.Date.Year(), session.Date.Month(), session.Date.Day(),
+				local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), c.location,
+			)
+			return true
+		},
+	)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("calendar AddTradingDays %w", err)
+	}
+	return res, nil
+}
+
+func (c *calendar) LastCutOff(ctx context.Context, stockExchangeCode string, at time.Time, n int) (time.Time, error) {
+	if n <= 0 {
+		return at, nil
+	}
+	count := 0
+	var res time.Time
+	err := c.walk(
+		ctx, stockExchangeCode, c.startOfDay(at), -1, func(session entity.TradingSession) bool {
+			if !session.TradingDay || session.CutOffAt.After(at) {
+				return false
+			}
+			count++
+			if count < n {
+				return false
+			}
+			res = session.CutOffAt
+			return true
+		},
+	)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("calendar LastCutOff %w", err)
+	}
+	return res, nil
+}
+
+func (c *calendar) StockExchangeCode(ctx context.Context, symbolId int64) (string, error) {
+	code, err := c.repository.GetStockExchangeCodeBySymbolId(ctx, symbolId)
+	if err != nil {
+		return "", fmt.Errorf("calendar StockExchangeCode %w", err)
+	}
+	return code, nil
+}
+
+// AddTradingDaysForSymbol adds days trading days to from on the calendar of the stock exchange of symbolId
+func AddTradingDaysForSymbol(ctx context.Context, calendar Calendar, symbolId int64, from time.Time, days int) (time.Time, error) {
+	stockExchangeCode, err := calendar.StockExchangeCode(ctx, symbolId)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("AddTradingDaysForSymbol %w", err)
+	}
+	return calendar.AddTradingDays(ctx, stockExchangeCode, from, days)
+}
+
+// walk visits the sessions from start one day at a time in direction until visit returns true
+func (c *calendar) walk(
+	ctx context.Context,
+	stockExchangeCode string,
+	start time.Time,
+	direction int,
+	visit func(session entity.TradingSession) bool,
+) error {
+	for walked := 0; walked < maxWalkDays; walked += windowDays {
+		windowStart := start.AddDate(0, 0, direction*walked)
+		windowEnd := windowStart.AddDate(0, 0, direction*(windowDays-1))
+		from, to := windowStart, windowEnd
+		if direction < 0 {
+			from, to = windowEnd, windowStart
+		}
+		days, err := c.loadDays(ctx, stockExchangeCode, from, to)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < windowDays; i++ {
+			if visit(c.session(stockExchangeCode, windowStart.AddDate(0, 0, direction*i), days)) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("no trading day of %s within %d days of %s", stockExchangeCode, maxWalkDays, start.Format(dateLayout))
+}
+
+// loadDays returns the days of the exchange between from and to by date, a day of the exchange overrides a day of all exchanges
+func (c *calendar) loadDays(
+	ctx context.Context,
+	stockExchangeCode string,
+	from time.Time,
+	to time.Time,
+) (map[string]entity.TradingCalendarDay, error) {
+	days, err := c.repository.GetAll(
+		ctx, entity.TradingCalendarDayFilter{
+			StockExchangeCodes: []string{stockExchangeCode, entity.TradingCalendarAllExchanges},
+			DateFrom:           optional.Some(from),
+			DateTo:             optional.Some(to),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]entity.TradingCalendarDay, len(days))
+	for _, day := range days {
+		key := day.Date.Format(dateLayout)
+		if _, ok := res[key]; ok && day.StockExchangeCode == entity.TradingCalendarAllExchanges {
+			continue
+		}
+		res[key] = day
+	}
+	return res, nil
+}
+
+func (c *calendar) session(
+	stockExchangeCode string,
+	date time.Time,
+	days map[string]entity.TradingCalendarDay,
+) entity.TradingSession {
+	res := entity.TradingSession{StockExchangeCode: stockExchangeCode, Date: date}
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		return res
+	}
+	day, ok := days[date.Format(dateLayout)]
+	if ok && day.Type == entity.TradingCalendarDayTypeHoliday {
+		return res
+	}
+	res.TradingDay = true
+	closeTime := c.closeTime
+	if ok && day.Type == entity.TradingCalendarDayTypeHalfDay {
+		res.HalfDay = true
+		closeTime = c.halfDayCloseTime
+		if dayCloseTime, err := ParseClock(day.CloseTime); err == nil {
+			closeTime = dayCloseTime
+		}
+	}
+	res.OpenAt = date.Add(c.openTime)
+	res.CloseAt = date.Add(closeTime)
+	res.CutOffAt = date.Add(min(c.cutOffTime, closeTime))
+	return res
+}
+
+func (c *calendar) startOfDay(t time.Time) time.Time {
+	local := t.In(c.location)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location)
+}
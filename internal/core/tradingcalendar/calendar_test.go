@@ -0,0 +1,140 @@
+package tradingcalendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestCalendar(t *testing.T) {
+	t.Parallel()
+	cfg := config.TradingCalendarConfig{
+		Timezone:         "Asia/Ho_Chi_Minh",
+		OpenTime:         "09:00",
+		CloseTime:        "15:00",
+		HalfDayCloseTime: "11:30",
+		CutOffTime:       "14:45",
+	}
+	location, _ := time.LoadLocation(cfg.Timezone)
+	at := func(day int, month time.Month, hour int, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, location)
+	}
+	date := func(day int, month time.Month) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	// Wednesday 29/04 is a half day on HOSE, Thursday 30/04 and Friday 01/05 are holidays of all exchanges
+	// except HNX trading a half day on 30/04
+	days := []entity.TradingCalendarDay{
+		{StockExchangeCode: "HOSE", Date: date(29, time.April), Type: entity.TradingCalendarDayTypeHalfDay},
+		{StockExchangeCode: entity.TradingCalendarAllExchanges, Date: date(30, time.April), Type: entity.TradingCalendarDayTypeHoliday},
+		{StockExchangeCode: "HNX", Date: date(30, time.April), Type: entity.TradingCalendarDayTypeHalfDay, CloseTime: "10:00"},
+		{StockExchangeCode: entity.TradingCalendarAllExchanges, Date: date(1, time.May), Type: entity.TradingCalendarDayTypeHoliday},
+	}
+	newCalendar := func(t *testing.T) Calendar {
+		repository := mock.NewMockTradingCalendarRepository(t)
+		repository.EXPECT().GetAll(testifyMock.Anything, testifyMock.Anything).RunAndReturn(
+			func(ctx context.Context, filter entity.TradingCalendarDayFilter) ([]entity.TradingCalendarDay, error) {
+				res := make([]entity.TradingCalendarDay, 0)
+				for _, day := range days {
+					for _, code := range filter.StockExchangeCodes {
+						if day.StockExchangeCode == code {
+							res = append(res, day)
+						}
+					}
+				}
+				return res, nil
+			},
+		).Maybe()
+		calendar, err := NewCalendar(cfg, repository)
+		assert.Nil(t, err)
+		return calendar
+	}
+
+	t.Run(
+		"half day session", func(t *testing.T) {
+			session, err := newCalendar(t).Session(context.Background(), "HOSE", at(29, time.April, 20, 0))
+			assert.Nil(t, err)
+			assert.True(t, session.TradingDay)
+			assert.True(t, session.HalfDay)
+			assert.Equal(t, at(29, time.April, 9, 0), session.OpenAt)
+			assert.Equal(t, at(29, time.April, 11, 30), session.CloseAt)
+			assert.Equal(t, at(29, time.April, 11, 30), session.CutOffAt)
+		},
+	)
+
+	t.Run(
+		"holiday and weekend are not traded", func(t *testing.T) {
+			calendar := newCalendar(t)
+			holiday, err := calendar.Session(context.Background(), "HOSE", at(30, time.April, 10, 0))
+			assert.Nil(t, err)
+			assert.False(t, holiday.TradingDay)
+			weekend, err := calendar.Session(context.Background(), "HOSE", at(2, time.May, 10, 0))
+			assert.Nil(t, err)
+			assert.False(t, weekend.TradingDay)
+		},
+	)
+
+	t.Run(
+		"exchange day overrides day of all exchanges", func(t *testing.T) {
+			session, err := newCalendar(t).Session(context.Background(), "HNX", at(30, time.April, 8, 0))
+			assert.Nil(t, err)
+			assert.True(t, session.TradingDay)
+			assert.Equal(t, at(30, time.April, 10, 0), session.CloseAt)
+		},
+	)
+
+	t.Run(
+		"trading session", func(t *testing.T) {
+			calendar := newCalendar(t)
+			open, err := calendar.IsTradingSession(context.Background(), "HOSE", at(29, time.April, 11, 0))
+			assert.Nil(t, err)
+			assert.True(t, open)
+			closed, err := calendar.IsTradingSession(context.Background(), "HOSE", at(29, time.April, 12, 0))
+			assert.Nil(t, err)
+			assert.False(t, closed)
+		},
+	)
+
+	t.Run(
+		"add trading days skips holidays and weekend", func(t *testing.T) {
+			calendar := newCalendar(t)
+			res, err := calendar.AddTradingDays(context.Background(), "HOSE", at(28, time.April, 10, 15), 2)
+			assert.Nil(t, err)
+			assert.Equal(t, at(4, time.May, 10, 15), res)
+			res, err = calendar.AddTradingDays(context.Background(), "HNX", at(28, time.April, 10, 15), 2)
+			assert.Nil(t, err)
+			assert.Equal(t, at(30, time.April, 10, 15), res)
+		},
+	)
+
+	t.Run(
+		"last cut-off", func(t *testing.T) {
+			calendar := newCalendar(t)
+			res, err := calendar.LastCutOff(context.Background(), "HOSE", at(4, time.May, 9, 0), 1)
+			assert.Nil(t, err)
+			assert.Equal(t, at(29, time.April, 11, 30), res)
+			res, err = calendar.LastCutOff(context.Background(), "HOSE", at(4, time.May, 16, 0), 2)
+			assert.Nil(t, err)
+			assert.Equal(t, at(29, time.April, 11, 30), res)
+			res, err = calendar.LastCutOff(context.Background(), "HOSE", at(4, time.May, 9, 0), 2)
+			assert.Nil(t, err)
+			assert.Equal(t, at(28, time.April, 14, 45), res)
+		},
+	)
+
+	t.Run(
+		"invalid clock", func(t *testing.T) {
+			invalid := cfg
+			invalid.CutOffTime = "3pm"
+			_, err := NewCalendar(invalid, mock.NewMockTradingCalendarRepository(t))
+			assert.NotNil(t, err)
+		},
+	)
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func MapTradingCalendarDayDbToEntity(day model.TradingCalendarDay) entity.TradingCalendarDay {
+	return entity.TradingCalendarDay{
+		Id:                day.ID,
+		StockExchangeCode: day.StockExchangeCode,
+		Date:              day.Date,
+		Type:              entity.TradingCalendarDayType(day.Type),
+		CloseTime:         day.CloseTime,
+		Description:       day.Description,
+		Source:            entity.TradingCalendarDaySource(day.Source),
+		CreatedAt:         day.CreatedAt,
+		UpdatedAt:         day.UpdatedAt,
+	}
+}
+
+func MapTradingCalendarDaysDbToEntity(days []model.TradingCalendarDay) []entity.TradingCalendarDay {
+	res := make([]entity.TradingCalendarDay, 0, len(days))
+	for _, day := range days {
+		res = append(res, MapTradingCalendarDayDbToEntity(day))
+	}
+	return res
+}
+
+func MapTradingCalendarDayEntityToDb(day entity.TradingCalendarDay) model.TradingCalendarDay {
+	return model.TradingCalendarDay{
+		ID:                day.Id,
+		StockExchangeCode: day.StockExchangeCode,
+		Date:              day.Date,
+		Type:              day.Type.String(),
+		CloseTime:         day.CloseTime,
+		Description:       day.Description,
+		Source:            day.Source.String(),
+		CreatedAt:         day.CreatedAt,
+		UpdatedAt:         day.UpdatedAt,
+	}
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/tradingcalendar/repository"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/pkg/querymod"
+)
+
+var _ repository.TradingCalendarRepository = (*TradingCalendarPostgresRepository)(nil)
+
+type TradingCalendarPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewTradingCalendarPostgresRepository(getDbFunc database.GetDbFunc) *TradingCalendarPostgresRepository {
+	return &TradingCalendarPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *TradingCalendarPostgresRepository) GetAll(ctx context.Context, filter entity.TradingCalendarDayFilter) ([]entity.TradingCalendarDay, error) {
+	dest := make([]model.TradingCalendarDay, 0)
+	if err := table.TradingCalendarDay.SELECT(table.TradingCalendarDay.AllColumns).
+		WHERE(ApplyTradingCalendarDayFilter(filter)).
+		ORDER_BY(table.TradingCalendarDay.Date, table.TradingCalendarDay.StockExchangeCode).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return nil, fmt.Errorf("TradingCalendarPostgresRepository GetAll %w", err)
+	}
+	return MapTradingCalendarDaysDbToEntity(dest), nil
+}
+
+func (r *TradingCalendarPostgresRepository) GetById(ctx context.Context, id int64) (entity.TradingCalendarDay, error) {
+	var dest model.TradingCalendarDay
+	if err := table.TradingCalendarDay.SELECT(table.TradingCalendarDay.AllColumns).
+		WHERE(table.TradingCalendarDay.ID.EQ(postgres.Int64(id))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return entity.TradingCalendarDay{}, fmt.Errorf("TradingCalendarPostgresRepository GetById %w", err)
+	}
+	return MapTradingCalendarDayDbToEntity(dest), nil
+}
+
+func (r *TradingCalendarPostgresRepository) Create(ctx context.Context, day entity.TradingCalendarDay) (entity.TradingCalendarDay, error) {
+	created := model.TradingCalendarDay{}
+	if err := table.TradingCalendarDay.INSERT(table.TradingCalendarDay.MutableColumns).
+		MODEL(MapTradingCalendarDayEntityToDb(day)).
+		RETURNING(table.TradingCalendarDay.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &created); err != nil {
+		return entity.TradingCalendarDay{}, fmt.Errorf("TradingCalendarPostgresRepository Create %w", err)
+	}
+	return MapTradingCalendarDayDbToEntity(created), nil
+}
+
+func (r *TradingCalendarPostgresRepository) Update(ctx context.Context, day entity.TradingCalendarDay) (entity.TradingCalendarDay, error) {
+	updated := model.TradingCalendarDay{}
+	if err := table.TradingCalendarDay.UPDATE(table.TradingCalendarDay.MutableColumns).
+		MODEL(MapTradingCalendarDayEntityToDb(day)).
+		WHERE(table.TradingCalendarDay.ID.EQ(postgres.Int64(day.Id))).
+		RETURNING(table.TradingCalendarDay.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &updated); err != nil {
+		return entity.TradingCalendarDay{}, fmt.Errorf("TradingCalendarPostgresRepository Update %w", err)
+	}
+	return MapTradingCalendarDayDbToEntity(updated), nil
+}
+
+func (r *TradingCalendarPostgresRepository) Delete(ctx context.Context, id int64) error {
+	if _, err := table.TradingCalendarDay.DELETE().
+		WHERE(table.TradingCalendarDay.ID.EQ(postgres.Int64(id))).
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("TradingCalendarPostgresRepository Delete %w", err)
+	}
+	return nil
+}
+
+func (r *TradingCalendarPostgresRepository) ReplaceSynced(
+	ctx context.Context,
+	source entity.TradingCalendarDaySource,
+	from time.Time,
+	to time.Time,
+	days []entity.TradingCalendarDay,
+) error {
+	errorTemplate := "TradingCalendarPostgresRepository ReplaceSynced %w"
+	if _, err := table.TradingCalendarDay.DELETE().
+		WHERE(
+			table.TradingCalendarDay.Source.EQ(postgres.String(source.String())).
+				AND(table.TradingCalendarDay.Date.BETWEEN(toDate(from), toDate(to))),
+		).
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	if len(days) == 0 {
+		return nil
+	}
+	models := make([]model.TradingCalendarDay, 0, len(days))
+	for _, day := range days {
+		day.Source = source
+		models = append(models, MapTradingCalendarDayEntityToDb(day))
+	}
+	if _, err := table.TradingCalendarDay.INSERT(table.TradingCalendarDay.MutableColumns).
+		MODELS(models).
+		ON_CONFLICT(table.TradingCalendarDay.StockExchangeCode, table.TradingCalendarDay.Date).
+		DO_NOTHING().
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	return nil
+}
+
+func (r *TradingCalendarPostgresRepository) GetStockExchangeCodeBySymbolId(ctx context.Context, symbolId int64) (string, error) {
+	var dest model.StockExchange
+	if err := table.StockExchange.SELECT(table.StockExchange.Code).
+		FROM(table.StockExchange.INNER_JOIN(table.Symbol, table.StockExchange.ID.EQ(table.Symbol.StockExchangeID))).
+		WHERE(table.Symbol.ID.EQ(postgres.Int64(symbolId))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return "", fmt.Errorf("TradingCalendarPostgresRepository GetStockExchangeCodeBySymbolId %w", err)
+	}
+	return dest.Code, nil
+}
+
+func ApplyTradingCalendarDayFilter(filter entity.TradingCalendarDayFilter) postgres.BoolExpression {
+	conditions := postgres.Bool(true)
+	if len(filter.StockExchangeCodes) > 0 {
+		conditions = conditions.AND(table.TradingCalendarDay.StockExchangeCode.IN(querymod.In(filter.StockExchangeCodes)...))
+	}
+	if filter.DateFrom.IsPresent() {
+		conditions = conditions.AND(table.TradingCalendarDay.Date.GT_EQ(toDate(filter.DateFrom.Get())))
+	}
+	if filter.DateTo.IsPresent() {
+		conditions = conditions.AND(table.TradingCalendarDay.Date.LT_EQ(toDate(filter.DateTo.Get())))
+	}
+	return conditions
+}
+
+// toDate keeps the calendar date of t in its own location
+func toDate(t time.Time) postgres.DateExpression {
+	return postgres.Date(t.Year(), t.Month(), t.Day())
+}
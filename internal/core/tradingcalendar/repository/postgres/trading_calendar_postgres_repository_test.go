@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+	"financing-offer/pkg/optional"
+)
+
+func TestTradingCalendarPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, err := dbtest.New()
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	repo := NewTradingCalendarPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	date := time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	t.Run(
+		"get all days", func(t *testing.T) {
+			mock.ExpectQuery("SELECT (.+) FROM public.trading_calendar_day").WillReturnRows(
+				sqlmock.NewRows(
+					[]string{
+						"trading_calendar_day.id",
+						"trading_calendar_day.stock_exchange_code",
+						"trading_calendar_day.date",
+						"trading_calendar_day.type",
+						"trading_calendar_day.close_time",
+						"trading_calendar_day.source",
+					},
+				).AddRow(1, entity.TradingCalendarAllExchanges, date, "HALF_DAY", "11:30", "MANUAL"),
+			)
+			days, err := repo.GetAll(
+				context.Background(), entity.TradingCalendarDayFilter{
+					StockExchangeCodes: []string{"HOSE", entity.TradingCalendarAllExchanges},
+					DateFrom:           optional.Some(date),
+				},
+			)
+			assert.Nil(t, err)
+			assert.Equal(
+				t, []entity.TradingCalendarDay{
+					{
+						Id:                1,
+						StockExchangeCode: entity.TradingCalendarAllExchanges,
+						Date:              date,
+						Type:              entity.TradingCalendarDayTypeHalfDay,
+						CloseTime:         "11:30",
+						Source:            entity.TradingCalendarDaySourceManual,
+					},
+				}, days,
+			)
+		},
+	)
+
+	t.Run(
+		"get stock exchange code of symbol", func(t *testing.T) {
+			mock.ExpectQuery("SELECT (.+) FROM public.stock_exchange INNER JOIN public.symbol").
+				WithArgs(int64(7)).
+				WillReturnRows(sqlmock.NewRows([]string{"stock_exchange.code"}).AddRow("HNX"))
+			code, err := repo.GetStockExchangeCodeBySymbolId(context.Background(), 7)
+			assert.Nil(t, err)
+			assert.Equal(t, "HNX", code)
+		},
+	)
+
+	t.Run(
+		"replace synced days", func(t *testing.T) {
+			mock.ExpectExec("DELETE FROM public.trading_calendar_day").WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec("INSERT INTO public.trading_calendar_day (.+) ON CONFLICT (.+) DO NOTHING").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			err := repo.ReplaceSynced(
+				context.Background(), entity.TradingCalendarDaySourceFinancingApi, date, date.AddDate(0, 0, 90),
+				[]entity.TradingCalendarDay{
+					{StockExchangeCode: entity.TradingCalendarAllExchanges, Date: date, Type: entity.TradingCalendarDayTypeHoliday},
+				},
+			)
+			assert.Nil(t, err)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"replace synced days without days", func(t *testing.T) {
+			mock.ExpectExec("DELETE FROM public.trading_calendar_day").WillReturnResult(sqlmock.NewResult(0, 2))
+			err := repo.ReplaceSynced(
+				context.Background(), entity.TradingCalendarDaySourceFinancingApi, date, date.AddDate(0, 0, 90), nil,
+			)
+			assert.Nil(t, err)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financing-offer/internal/core/entity"
+)
+
+type TradingCalendarRepository interface {
+	GetAll(ctx context.Context, filter entity.TradingCalendarDayFilter) ([]entity.TradingCalendarDay, error)
+	GetById(ctx context.Context, id int64) (entity.TradingCalendarDay, error)
+	Create(ctx context.Context, day entity.TradingCalendarDay) (entity.TradingCalendarDay, error)
+	Update(ctx context.Context, day entity.TradingCalendarDay) (entity.TradingCalendarDay, error)
+	Delete(ctx context.Context, id int64) error
+	// ReplaceSynced replaces the days of source between from and to with days, a day already set by another source is kept
+	ReplaceSynced(ctx context.Context, source entity.TradingCalendarDaySource, from time.Time, to time.Time, days []entity.TradingCalendarDay) error
+	GetStockExchangeCodeBySymbolId(ctx context.Context, symbolId int64) (string, error)
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"time"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/optional"
+)
+
+const dateLayout = "2006-01-02"
+
+type TradingCalendarDayRequest struct {
+	StockExchangeCode string                        `json:"stockExchangeCode" binding:"required"`
+	Date              string                        `json:"date" binding:"required,datetime=2006-01-02"`
+	Type              entity.TradingCalendarDayType `json:"type" binding:"required,oneof=HOLIDAY HALF_DAY"`
+	CloseTime         string                        `json:"closeTime"`
+	Description       string                        `json:"description"`
+}
+
+func (r TradingCalendarDayRequest) toEntity(id int64) (entity.TradingCalendarDay, error) {
+	date, err := time.Parse(dateLayout, r.Date)
+	if err != nil {
+		return entity.TradingCalendarDay{}, err
+	}
+	return entity.TradingCalendarDay{
+		Id:                id,
+		StockExchangeCode: r.StockExchangeCode,
+		Date:              date,
+		Type:              r.Type,
+		CloseTime:         r.CloseTime,
+		Description:       r.Description,
+	}, nil
+}
+
+type GetTradingCalendarDaysRequest struct {
+	StockExchangeCodes []string  `form:"stockExchangeCodes"`
+	DateFrom           time.Time `form:"dateFrom" time_format:"2006-01-02"`
+	DateTo             time.Time `form:"dateTo" time_format:"2006-01-02"`
+}
+
+func (r GetTradingCalendarDaysRequest) toFilter() entity.TradingCalendarDayFilter {
+	return entity.TradingCalendarDayFilter{
+		StockExchangeCodes: r.StockExchangeCodes,
+		DateFrom:           optional.FromValueNonZero(r.DateFrom),
+		DateTo:             optional.FromValueNonZero(r.DateTo),
+	}
+}
+
+type GetTradingSessionRequest struct {
+	StockExchangeCode string    `form:"stockExchangeCode" binding:"required"`
+	Date              time.Time `form:"date" binding:"required" time_format:"2006-01-02"`
+}
+
+type AddTradingDaysRequest struct {
+	StockExchangeCode string    `form:"stockExchangeCode" binding:"required"`
+	From              time.Time `form:"from" binding:"required"`
+	Days              int       `form:"days" binding:"min=0"`
+}
+
+type AddTradingDaysResponse struct {
+	StockExchangeCode string    `json:"stockExchangeCode"`
+	From              time.Time `json:"from"`
+	Days              int       `json:"days"`
+	Result            time.Time `json:"result"`
+}
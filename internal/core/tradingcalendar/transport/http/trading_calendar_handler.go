@@ -0,0 +1,252 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/tradingcalendar"
+	"financing-offer/internal/handler"
+)
+
+type TradingCalendarHandler struct {
+	handler.BaseHandler
+	logger  *slog.Logger
+	useCase tradingcalendar.UseCase
+}
+
+func NewTradingCalendarHandler(
+	baseHandler handler.BaseHandler,
+	logger *slog.Logger,
+	useCase tradingcalendar.UseCase,
+) *TradingCalendarHandler {
+	return &TradingCalendarHandler{BaseHandler: baseHandler, logger: logger, useCase: useCase}
+}
+
+// GetDays godoc
+//
+//	@Summary		Get trading calendar days
+//	@Description	Get the holidays and half days of the trading calendar
+//	@Tags			trading calendar,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			stockExchangeCodes	query		[]string	false	"stock exchange codes, ALL for the days of every exchange"
+//	@Param			dateFrom			query		string		false	"date from (2006-01-02)"
+//	@Param			dateTo				query		string		false	"date to (2006-01-02)"
+//	@Success		200					{object}	handler.BaseResponse[[]entity.TradingCalendarDay]
+//	@Failure		400					{object}	handler.ErrorResponse
+//	@Failure		500					{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/trading-calendar/days [get]
+func (h *TradingCalendarHandler) GetDays(ctx *gin.Context) {
+	req := GetTradingCalendarDaysRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("get trading calendar days", slog.String("error", err.Error()))
+		h.RenderParseBodyError(ctx)
+		return
+	}
+	days, err := h.useCase.GetDays(ctx, req.toFilter())
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[[]entity.TradingCalendarDay]{
+			Data: days,
+		},
+	)
+}
+
+// CreateDay godoc
+//
+//	@Summary		Create trading calendar day
+//	@Description	Create a holiday or a half day of a stock exchange, ALL applies to every exchange
+//	@Tags			trading calendar,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			day	body		TradingCalendarDayRequest	true	"trading calendar day"
+//	@Success		201	{object}	handler.BaseResponse[entity.TradingCalendarDay]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		409	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/trading-calendar/days [post]
+func (h *TradingCalendarHandler) CreateDay(ctx *gin.Context) {
+	errorMessage := "create trading calendar day"
+	req := TradingCalendarDayRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	day, err := req.toEntity(0)
+	if err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	created, err := h.useCase.CreateDay(ctx, day)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusCreated, handler.BaseResponse[entity.TradingCalendarDay]{
+			Data: created,
+		},
+	)
+}
+
+// UpdateDay godoc
+//
+//	@Summary		Update trading calendar day
+//	@Description	Update a trading calendar day, a synced day becomes a manual one
+//	@Tags			trading calendar,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int							true	"id"
+//	@Param			day	body		TradingCalendarDayRequest	true	"trading calendar day"
+//	@Success		200	{object}	handler.BaseResponse[entity.TradingCalendarDay]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		404	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/trading-calendar/days/{id} [patch]
+func (h *TradingCalendarHandler) UpdateDay(ctx *gin.Context) {
+	errorMessage := "update trading calendar day"
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	req := TradingCalendarDayRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	day, err := req.toEntity(id)
+	if err != nil {
+		h.logger.Error(errorMessage, slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	if _, err := h.useCase.GetDayById(ctx, id); err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	updated, err := h.useCase.UpdateDay(ctx, day)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[entity.TradingCalendarDay]{
+			Data: updated,
+		},
+	)
+}
+
+// DeleteDay godoc
+//
+//	@Summary		Delete trading calendar day
+//	@Description	Delete trading calendar day
+//	@Tags			trading calendar,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		204	{object}	handler.BaseResponse[string]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/trading-calendar/days/{id} [delete]
+func (h *TradingCalendarHandler) DeleteDay(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	if err := h.useCase.DeleteDay(ctx, id); err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusNoContent, handler.BaseResponse[string]{
+			Data: "ok",
+		},
+	)
+}
+
+// GetSession godoc
+//
+//	@Summary		Get trading session
+//	@Description	Get the trading session of a stock exchange on a date
+//	@Tags			trading calendar,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			stockExchangeCode	query		string	true	"stock exchange code"
+//	@Param			date				query		string	true	"date (2006-01-02)"
+//	@Success		200					{object}	handler.BaseResponse[entity.TradingSession]
+//	@Failure		400					{object}	handler.ErrorResponse
+//	@Failure		500					{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/trading-calendar/session [get]
+func (h *TradingCalendarHandler) GetSession(ctx *gin.Context) {
+	req := GetTradingSessionRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("get trading session", slog.String("error", err.Error()))
+		h.RenderParseBodyError(ctx)
+		return
+	}
+	session, err := h.useCase.GetSession(ctx, req.StockExchangeCode, req.Date)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[entity.TradingSession]{
+			Data: session,
+		},
+	)
+}
+
+// AddTradingDays godoc
+//
+//	@Summary		Add trading days
+//	@Description	Get the time of from on the days-th trading day after it
+//	@Tags			trading calendar,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			stockExchangeCode	query		string	true	"stock exchange code"
+//	@Param			from				query		string	true	"from (RFC3339)"
+//	@Param			days				query		int		true	"trading days"
+//	@Success		200					{object}	handler.BaseResponse[AddTradingDaysResponse]
+//	@Failure		400					{object}	handler.ErrorResponse
+//	@Failure		500					{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/trading-calendar/add-trading-days [get]
+func (h *TradingCalendarHandler) AddTradingDays(ctx *gin.Context) {
+	req := AddTradingDaysRequest{}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("add trading days", slog.String("error", err.Error()))
+		h.RenderParseBodyError(ctx)
+		return
+	}
+	res, err := h.useCase.AddTradingDays(ctx, req.StockExchangeCode, req.From, req.Days)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[AddTradingDaysResponse]{
+			Data: AddTradingDaysResponse{
+				StockExchangeCode: req.StockExchangeCode,
+				From:              req.From,
+				Days:              req.Days,
+				Result:            res,
+			},
+		},
+	)
+}
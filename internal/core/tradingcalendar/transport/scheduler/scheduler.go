@@ -0,0 +1,44 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/scheduler"
+	"financing-offer/internal/core/tradingcalendar"
+)
+
+type TradingCalendarScheduler struct {
+	logger       *slog.Logger
+	useCase      tradingcalendar.UseCase
+	errorService apperrors.Service
+}
+
+func NewTradingCalendarScheduler(
+	logger *slog.Logger,
+	useCase tradingcalendar.UseCase,
+	errorService apperrors.Service,
+) *TradingCalendarScheduler {
+	return &TradingCalendarScheduler{
+		logger:       logger,
+		useCase:      useCase,
+		errorService: errorService,
+	}
+}
+
+func (s *TradingCalendarScheduler) SyncFromFinancingApi(ctx context.Context) error {
+	synced, err := s.useCase.SyncFromFinancingApi(ctx)
+	if err != nil {
+		s.logger.Error("SyncFromFinancingApi", slog.String("error", err.Error()))
+		if scheduler.FinalAttempt(ctx) {
+			if err := s.errorService.NotifyError(ctx, err); err != nil {
+				s.logger.Error("SyncFromFinancingApi NotifyError", slog.String("error", err.Error()))
+			}
+		}
+		return err
+	}
+	s.logger.Info("SyncFromFinancingApi", slog.Int("holidays", synced))
+	scheduler.Track(ctx, "holidays", synced)
+	return nil
+}
@@ -0,0 +1,180 @@
+package tradingcalendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	financingRepo "financing-offer/internal/core/financing/repository"
+	"financing-offer/internal/core/tradingcalendar/repository"
+)
+
+type UseCase interface {
+	GetDays(ctx context.Context, filter entity.TradingCalendarDayFilter) ([]entity.TradingCalendarDay, error)
+	GetDayById(ctx context.Context, id int64) (entity.TradingCalendarDay, error)
+	CreateDay(ctx context.Context, day entity.TradingCalendarDay) (entity.TradingCalendarDay, error)
+	UpdateDay(ctx context.Context, day entity.TradingCalendarDay) (entity.TradingCalendarDay, error)
+	DeleteDay(ctx context.Context, id int64) error
+	GetSession(ctx context.Context, stockExchangeCode string, date time.Time) (entity.TradingSession, error)
+	AddTradingDays(ctx context.Context, stockExchangeCode string, from time.Time, days int) (time.Time, error)
+	// SyncFromFinancingApi replaces the holidays synced from the financing api over the configured horizon,
+	// the days set by admins are kept
+	SyncFromFinancingApi(ctx context.Context) (int, error)
+}
+
+type useCase struct {
+	cfg                 config.TradingCalendarConfig
+	calendar            Calendar
+	repository          repository.TradingCalendarRepository
+	financingRepository financingRepo.FinancingRepository
+}
+
+func NewUseCase(
+	cfg config.TradingCalendarConfig,
+	calendar Calendar,
+	repository repository.TradingCalendarRepository,
+	financingRepository financingRepo.FinancingRepository,
+) UseCase {
+	return &useCase{
+		cfg:                 cfg,
+		calendar:            calendar,
+		repository:          repository,
+		financingRepository: financingRepository,
+	}
+}
+
+func (u *useCase) GetDays(ctx context.Context, filter entity.TradingCalendarDayFilter) ([]entity.TradingCalendarDay, error) {
+	res, err := u.repository.GetAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("tradingCalendarUseCase GetDays %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) GetDayById(ctx context.Context, id int64) (entity.TradingCalendarDay, error) {
+	res, err := u.repository.GetById(ctx, id)
+	if err != nil {
+		return res, fmt.Errorf("tradingCalendarUseCase GetDayById %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) CreateDay(ctx context.Context, day entity.TradingCalendarDay) (entity.TradingCalendarDay, error) {
+	if err := validateDay(day); err != nil {
+		return entity.TradingCalendarDay{}, err
+	}
+	day.Source = entity.TradingCalendarDaySourceManual
+	res, err := u.repository.Create(ctx, day)
+	if err != nil {
+		return res, fmt.Errorf("tradingCalendarUseCase CreateDay %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) UpdateDay(ctx context.Context, day entity.TradingCalendarDay) (entity.TradingCalendarDay, error) {
+	if err := validateDay(day); err != nil {
+		return entity.TradingCalendarDay{}, err
+	}
+	// an edited day is owned by admins and is no longer replaced by the sync
+	day.Source = entity.TradingCalendarDaySourceManual
+	res, err := u.repository.Update(ctx, day)
+	if err != nil {
+		return res, fmt.Errorf("tradingCalendarUseCase UpdateDay %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) DeleteDay(ctx context.Context, id int64) error {
+	if err := u.repository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("tradingCalendarUseCase DeleteDay %w", err)
+	}
+	return nil
+}
+
+func (u *useCase) GetSession(ctx context.Context, stockExchangeCode string, date time.Time) (entity.TradingSession, error) {
+	res, err := u.calendar.Session(ctx, stockExchangeCode, date)
+	if err != nil {
+		return res, fmt.Errorf("tradingCalendarUseCase GetSession %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) AddTradingDays(ctx context.Context, stockExchangeCode string, from time.Time, days int) (time.Time, error) {
+	if days < 0 {
+		return time.Time{}, apperrors.ErrInvalidInput("days must not be negative")
+	}
+	res, err := u.calendar.AddTradingDays(ctx, stockExchangeCode, from, days)
+	if err != nil {
+		return res, fmt.Errorf("tradingCalendarUseCase AddTradingDays %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) SyncFromFinancingApi(ctx context.Context) (int, error) {
+	errorTemplate := "tradingCalendarUseCase SyncFromFinancingApi %w"
+	location, err := time.LoadLocation(u.cfg.Timezone)
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	now := time.Now().In(location)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, u.cfg.Sync.HorizonDays)
+	holidays := make([]entity.TradingCalendarDay, 0)
+	// the financing api only tells the next business date, the weekdays it skips are holidays of all exchanges
+	for current := from; current.Before(to); {
+		next, err := u.financingRepository.GetDateAfter(current, 1)
+		if err != nil {
+			return 0, fmt.Errorf(errorTemplate, err)
+		}
+		next = time.Date(next.Year(), next.Month(), next.Day(), 0, 0, 0, 0, time.UTC)
+		if !next.After(current) {
+			return 0, fmt.Errorf(errorTemplate, fmt.Errorf("business date %s is not after %s", next.Format(dateLayout), current.Format(dateLayout)))
+		}
+		for skipped := current.AddDate(0, 0, 1); skipped.Before(next) && !skipped.After(to); skipped = skipped.AddDate(0, 0, 1) {
+			if skipped.Weekday() == time.Saturday || skipped.Weekday() == time.Sunday {
+				continue
+			}
+			holidays = append(
+				holidays, entity.TradingCalendarDay{
+					StockExchangeCode: entity.TradingCalendarAllExchanges,
+					Date:              skipped,
+					Type:              entity.TradingCalendarDayTypeHoliday,
+					Description:       "synced from financing api",
+				},
+			)
+		}
+		current = next
+	}
+	if err := u.repository.ReplaceSynced(ctx, entity.TradingCalendarDaySourceFinancingApi, from.AddDate(0, 0, 1), to, holidays); err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	return len(holidays), nil
+}
+
+func validateDay(day entity.TradingCalendarDay) error {
+	if day.StockExchangeCode == "" {
+		return apperrors.ErrInvalidInput("stockExchangeCode must not be empty")
+	}
+	if day.Date.IsZero() {
+		return apperrors.ErrInvalidInput("date must not be empty")
+	}
+	switch day.Type {
+	case entity.TradingCalendarDayTypeHoliday:
+		if day.CloseTime != "" {
+			return apperrors.ErrInvalidInput("closeTime is only set on a half day")
+		}
+	case entity.TradingCalendarDayTypeHalfDay:
+		if day.CloseTime == "" {
+			return nil
+		}
+		if _, err := ParseClock(day.CloseTime); err != nil {
+			return apperrors.ErrInvalidInput("closeTime must be HH:MM")
+		}
+	default:
+		return apperrors.ErrInvalidInput(fmt.Sprintf("unknown type %s", day.Type))
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package tradingcalendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestTradingCalendarUseCase(t *testing.T) {
+	t.Parallel()
+	cfg := config.TradingCalendarConfig{
+		Timezone: "Asia/Ho_Chi_Minh",
+		Sync:     config.TradingCalendarSyncConfig{Enable: true, HorizonDays: 30},
+	}
+	isWeekend := func(date time.Time) bool {
+		return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+	}
+
+	t.Run(
+		"sync marks skipped weekdays as holidays", func(t *testing.T) {
+			repository := mock.NewMockTradingCalendarRepository(t)
+			financingRepository := mock.NewMockFinancingRepository(t)
+			useCase := NewUseCase(cfg, mock.NewMockCalendar(t), repository, financingRepository)
+			location, _ := time.LoadLocation(cfg.Timezone)
+			now := time.Now().In(location)
+			holiday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 7)
+			for isWeekend(holiday) {
+				holiday = holiday.AddDate(0, 0, 1)
+			}
+			financingRepository.EXPECT().GetDateAfter(testifyMock.Anything, 1).RunAndReturn(
+				func(date time.Time, workingDays int) (time.Time, error) {
+					next := date.AddDate(0, 0, 1)
+					for isWeekend(next) || next.Equal(holiday) {
+						next = next.AddDate(0, 0, 1)
+					}
+					return next, nil
+				},
+			)
+			repository.EXPECT().ReplaceSynced(
+				testifyMock.Anything, entity.TradingCalendarDaySourceFinancingApi, testifyMock.Anything, testifyMock.Anything,
+				testifyMock.MatchedBy(
+					func(days []entity.TradingCalendarDay) bool {
+						return len(days) == 1 && days[0].Date.Equal(holiday) &&
+							days[0].StockExchangeCode == entity.TradingCalendarAllExchanges &&
+							days[0].Type == entity.TradingCalendarDayTypeHoliday
+					},
+				),
+			).Return(nil)
+			synced, err := useCase.SyncFromFinancingApi(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 1, synced)
+		},
+	)
+
+	t.Run(
+		"reject close time of a holiday", func(t *testing.T) {
+			useCase := NewUseCase(
+				cfg, mock.NewMockCalendar(t), mock.NewMockTradingCalendarRepository(t), mock.NewMockFinancingRepository(t),
+			)
+			_, err := useCase.CreateDay(
+				context.Background(), entity.TradingCalendarDay{
+					StockExchangeCode: "HOSE",
+					Date:              time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC),
+					Type:              entity.TradingCalendarDayTypeHoliday,
+					CloseTime:         "11:30",
+				},
+			)
+			assert.Equal(t, apperrors.ErrInvalidInput("closeTime is only set on a half day"), err)
+		},
+	)
+
+	t.Run(
+		"create manual half day", func(t *testing.T) {
+			repository := mock.NewMockTradingCalendarRepository(t)
+			useCase := NewUseCase(cfg, mock.NewMockCalendar(t), repository, mock.NewMockFinancingRepository(t))
+			day := entity.TradingCalendarDay{
+				StockExchangeCode: "HOSE",
+				Date:              time.Date(2026, 4, 29, 0, 0, 0, 0, time.UTC),
+				Type:              entity.TradingCalendarDayTypeHalfDay,
+				CloseTime:         "11:30",
+			}
+			created := day
+			created.Id = 1
+			created.Source = entity.TradingCalendarDaySourceManual
+			repository.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(d entity.TradingCalendarDay) bool {
+						return d.Source == entity.TradingCalendarDaySourceManual
+					},
+				),
+			).Return(created, nil)
+			res, err := useCase.CreateDay(context.Background(), day)
+			assert.Nil(t, err)
+			assert.Equal(t, created, res)
+		},
+	)
+}
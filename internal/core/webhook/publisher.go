@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/webhook/repository"
+)
+
+var _ repository.WebhookEventRepository = (*EventPublisher)(nil)
+
+// EventPublisher records a delivery of an event for every subscription of its type,
+// so an event is only delivered when the transaction carried by ctx commits
+type EventPublisher struct {
+	deliveryRepository repository.WebhookDeliveryRepository
+}
+
+func NewEventPublisher(deliveryRepository repository.WebhookDeliveryRepository) *EventPublisher {
+	return &EventPublisher{deliveryRepository: deliveryRepository}
+}
+
+func (p *EventPublisher) Publish(ctx context.Context, eventType entity.WebhookEventType, data any) error {
+	errorTemplate := "webhook EventPublisher Publish %w"
+	event := entity.WebhookEvent{
+		Id:         uuid.NewString(),
+		Type:       eventType,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	if _, err := p.deliveryRepository.CreateForEvent(ctx, event, string(payload)); err != nil {
+		return fmt.Errorf(errorTemplate, err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func MapWebhookSubscriptionDbToEntity(subscription model.WebhookSubscription) (entity.WebhookSubscription, error) {
+	eventTypes := make([]entity.WebhookEventType, 0)
+	if err := json.Unmarshal([]byte(subscription.EventTypes), &eventTypes); err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+	return entity.WebhookSubscription{
+		Id:         subscription.ID,
+		Name:       subscription.Name,
+		Url:        subscription.Url,
+		Secret:     subscription.Secret,
+		EventTypes: eventTypes,
+		Active:     subscription.Active,
+		CreatedBy:  subscription.CreatedBy,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}, nil
+}
+
+func MapWebhookSubscriptionEntityToDb(subscription entity.WebhookSubscription) (model.WebhookSubscription, error) {
+	eventTypes := subscription.EventTypes
+	if eventTypes == nil {
+		eventTypes = []entity.WebhookEventType{}
+	}
+	eventTypesJSON, err := json.Marshal(eventTypes)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+	return model.WebhookSubscription{
+		ID:         subscription.Id,
+		Name:       subscription.Name,
+		Url:        subscription.Url,
+		Secret:     subscription.Secret,
+		EventTypes: string(eventTypesJSON),
+		Active:     subscription.Active,
+		CreatedBy:  subscription.CreatedBy,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}, nil
+}
+
+func MapWebhookSubscriptionsDbToEntity(subscriptions []model.WebhookSubscription) ([]entity.WebhookSubscription, error) {
+	res := make([]entity.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		e, err := MapWebhookSubscriptionDbToEntity(subscription)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	return res, nil
+}
+
+func MapWebhookDeliveryDbToEntity(delivery model.WebhookDelivery) entity.WebhookDelivery {
+	return entity.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.EventID,
+		EventType:      entity.WebhookEventType(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         entity.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastError:      delivery.LastError,
+		ResponseStatus: delivery.ResponseStatus,
+		ReplayOfId:     delivery.ReplayOfID,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func MapWebhookDeliveryEntityToDb(delivery entity.WebhookDelivery) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		ID:             delivery.Id,
+		SubscriptionID: delivery.SubscriptionId,
+		EventID:        delivery.EventId,
+		EventType:      string(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastError:      delivery.LastError,
+		ResponseStatus: delivery.ResponseStatus,
+		ReplayOfID:     delivery.ReplayOfId,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func MapWebhookDeliveriesDbToEntity(deliveries []model.WebhookDelivery) []entity.WebhookDelivery {
+	res := make([]entity.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		res = append(res, MapWebhookDeliveryDbToEntity(delivery))
+	}
+	return res
+}
@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/webhook/repository"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+)
+
+var _ repository.WebhookDeliveryRepository = (*WebhookDeliveryPostgresRepository)(nil)
+
+type WebhookDeliveryPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewWebhookDeliveryPostgresRepository(getDbFunc database.GetDbFunc) *WebhookDeliveryPostgresRepository {
+	return &WebhookDeliveryPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *WebhookDeliveryPostgresRepository) CreateForEvent(ctx context.Context, event entity.WebhookEvent, payload string) (int64, error) {
+	errorTemplate := "WebhookDeliveryPostgresRepository CreateForEvent %w"
+	eventTypes, err := json.Marshal([]entity.WebhookEventType{event.Type})
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	result, err := table.WebhookDelivery.INSERT(
+		table.WebhookDelivery.SubscriptionID,
+		table.WebhookDelivery.EventID,
+		table.WebhookDelivery.EventType,
+		table.WebhookDelivery.Payload,
+		table.WebhookDelivery.Status,
+		table.WebhookDelivery.NextAttemptAt,
+	).
+		QUERY(
+			table.WebhookSubscription.SELECT(
+				table.WebhookSubscription.ID,
+				postgres.String(event.Id),
+				postgres.String(event.Type.String()),
+				postgres.Json(payload),
+				postgres.String(string(entity.WebhookDeliveryStatusPending)),
+				postgres.TimestampT(time.Now()),
+			).WHERE(
+				table.WebhookSubscription.Active.IS_TRUE().
+					AND(
+						postgres.RawBool(
+							"webhook_subscription.event_types @> #eventTypes::jsonb",
+							postgres.RawArgs{"#eventTypes": string(eventTypes)},
+						),
+					),
+			),
+		).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	created, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	return created, nil
+}
+
+func (r *WebhookDeliveryPostgresRepository) Create(ctx context.Context, delivery entity.WebhookDelivery) (entity.WebhookDelivery, error) {
+	created := model.WebhookDelivery{}
+	if err := table.WebhookDelivery.INSERT(table.WebhookDelivery.MutableColumns).
+		MODEL(MapWebhookDeliveryEntityToDb(delivery)).
+		RETURNING(table.WebhookDelivery.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &created); err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf("WebhookDeliveryPostgresRepository Create %w", err)
+	}
+	return MapWebhookDeliveryDbToEntity(created), nil
+}
+
+func (r *WebhookDeliveryPostgresRepository) GetById(ctx context.Context, id int64) (entity.WebhookDelivery, error) {
+	var dest model.WebhookDelivery
+	if err := table.WebhookDelivery.SELECT(table.WebhookDelivery.AllColumns).
+		WHERE(table.WebhookDelivery.ID.EQ(postgres.Int64(id))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf("WebhookDeliveryPostgresRepository GetById %w", err)
+	}
+	return MapWebhookDeliveryDbToEntity(dest), nil
+}
+
+func (r *WebhookDeliveryPostgresRepository) GetAll(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	stm := table.WebhookDelivery.SELECT(table.WebhookDelivery.AllColumns).
+		WHERE(ApplyWebhookDeliveryFilter(filter)).
+		ORDER_BY(table.WebhookDelivery.ID.DESC())
+	if limit := filter.Limit(); limit > 0 {
+		stm = stm.LIMIT(limit).OFFSET(filter.Offset())
+	}
+	dest := make([]model.WebhookDelivery, 0)
+	if err := stm.QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []entity.WebhookDelivery{}, nil
+		}
+		return nil, fmt.Errorf("WebhookDeliveryPostgresRepository GetAll %w", err)
+	}
+	return MapWebhookDeliveriesDbToEntity(dest), nil
+}
+
+func (r *WebhookDeliveryPostgresRepository) Count(ctx context.Context, filter entity.WebhookDeliveryFilter) (int64, error) {
+	dest := struct {
+		Count int64
+	}{}
+	if err := table.WebhookDelivery.SELECT(postgres.COUNT(table.WebhookDelivery.ID).AS("count")).
+		WHERE(ApplyWebhookDeliveryFilter(filter)).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("WebhookDeliveryPostgresRepository Count %w", err)
+	}
+	return dest.Count, nil
+}
+
+func (r *WebhookDeliveryPostgresRepository) ClaimDeliverable(ctx context.Context, limit int64, lease time.Duration) ([]entity.WebhookDelivery, error) {
+	now := time.Now()
+	deliverable := table.WebhookDelivery.SELECT(table.WebhookDelivery.ID).
+		WHERE(
+			table.WebhookDelivery.Status.EQ(postgres.String(string(entity.WebhookDeliveryStatusPending))).
+				AND(table.WebhookDelivery.NextAttemptAt.LT_EQ(postgres.TimestampT(now))),
+		).
+		ORDER_BY(table.WebhookDelivery.ID.ASC()).
+		LIMIT(limit).
+		FOR(postgres.UPDATE().SKIP_LOCKED())
+	dest := make([]model.WebhookDelivery, 0)
+	if err := table.WebhookDelivery.UPDATE(table.WebhookDelivery.NextAttemptAt).
+		SET(postgres.TimestampT(now.Add(lease))).
+		WHERE(table.WebhookDelivery.ID.IN(deliverable)).
+		RETURNING(table.WebhookDelivery.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []entity.WebhookDelivery{}, nil
+		}
+		return nil, fmt.Errorf("WebhookDeliveryPostgresRepository ClaimDeliverable %w", err)
+	}
+	slices.SortFunc(
+		dest, func(a, b model.WebhookDelivery) int {
+			return cmp.Compare(a.ID, b.ID)
+		},
+	)
+	return MapWebhookDeliveriesDbToEntity(dest), nil
+}
+
+func (r *WebhookDeliveryPostgresRepository) Update(ctx context.Context, delivery entity.WebhookDelivery) error {
+	if _, err := table.WebhookDelivery.UPDATE(
+		table.WebhookDelivery.Status,
+		table.WebhookDelivery.Attempts,
+		table.WebhookDelivery.NextAttemptAt,
+		table.WebhookDelivery.LastError,
+		table.WebhookDelivery.ResponseStatus,
+		table.WebhookDelivery.DeliveredAt,
+	).
+		MODEL(MapWebhookDeliveryEntityToDb(delivery)).
+		WHERE(table.WebhookDelivery.ID.EQ(postgres.Int64(delivery.Id))).
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("WebhookDeliveryPostgresRepository Update %w", err)
+	}
+	return nil
+}
+
+func ApplyWebhookDeliveryFilter(filter entity.WebhookDeliveryFilter) postgres.BoolExpression {
+	conditions := postgres.Bool(true)
+	if filter.SubscriptionId.IsPresent() {
+		conditions = conditions.AND(table.WebhookDelivery.SubscriptionID.EQ(postgres.Int64(filter.SubscriptionId.Get())))
+	}
+	if filter.EventType.IsPresent() {
+		conditions = conditions.AND(table.WebhookDelivery.EventType.EQ(postgres.String(filter.EventType.Get().String())))
+	}
+	if filter.Status.IsPresent() {
+		conditions = conditions.AND(table.WebhookDelivery.Status.EQ(postgres.String(string(filter.Status.Get()))))
+	}
+	return conditions
+}
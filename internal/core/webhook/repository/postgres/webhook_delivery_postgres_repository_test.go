@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestWebhookDeliveryPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, err := dbtest.New()
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	repo := NewWebhookDeliveryPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+
+	t.Run(
+		"create for event fans out to matching subscriptions", func(t *testing.T) {
+			mock.ExpectExec(`INSERT INTO public.webhook_delivery (.+) SELECT (.+) FROM public.webhook_subscription WHERE (.+)event_types @> (.+)`).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			created, err := repo.CreateForEvent(
+				context.Background(),
+				entity.WebhookEvent{Id: "event-id", Type: entity.WebhookEventTypeOfferReady},
+				`{"id":"event-id"}`,
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(2), created)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"create for event error", func(t *testing.T) {
+			mock.ExpectExec("INSERT INTO public.webhook_delivery").WillReturnError(assert.AnError)
+			_, err := repo.CreateForEvent(
+				context.Background(),
+				entity.WebhookEvent{Id: "event-id", Type: entity.WebhookEventTypeOfferReady},
+				`{"id":"event-id"}`,
+			)
+			assert.ErrorIs(t, err, assert.AnError)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"claim deliverable leases the due deliveries in id order", func(t *testing.T) {
+			mock.ExpectQuery(`UPDATE public.webhook_delivery SET next_attempt_at = (.+) WHERE (.+)IN \(\s*SELECT (.+)FOR UPDATE SKIP LOCKED\s*\)\s*RETURNING`).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"webhook_delivery.id", "webhook_delivery.status"}).
+						AddRow(9, entity.WebhookDeliveryStatusPending).
+						AddRow(4, entity.WebhookDeliveryStatusPending),
+				)
+			claimed, err := repo.ClaimDeliverable(context.Background(), 10, time.Minute)
+			assert.Nil(t, err)
+			assert.Len(t, claimed, 2)
+			assert.Equal(t, int64(4), claimed[0].Id)
+			assert.Equal(t, int64(9), claimed[1].Id)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"claim deliverable error", func(t *testing.T) {
+			mock.ExpectQuery("UPDATE public.webhook_delivery").WillReturnError(assert.AnError)
+			_, err := repo.ClaimDeliverable(context.Background(), 10, time.Minute)
+			assert.ErrorIs(t, err, assert.AnError)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/webhook/repository"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+)
+
+var _ repository.WebhookSubscriptionRepository = (*WebhookSubscriptionPostgresRepository)(nil)
+
+type WebhookSubscriptionPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewWebhookSubscriptionPostgresRepository(getDbFunc database.GetDbFunc) *WebhookSubscriptionPostgresRepository {
+	return &WebhookSubscriptionPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *WebhookSubscriptionPostgresRepository) GetAll(ctx context.Context) ([]entity.WebhookSubscription, error) {
+	errorTemplate := "WebhookSubscriptionPostgresRepository GetAll %w"
+	dest := make([]model.WebhookSubscription, 0)
+	if err := table.WebhookSubscription.SELECT(table.WebhookSubscription.AllColumns).
+		ORDER_BY(table.WebhookSubscription.ID).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	res, err := MapWebhookSubscriptionsDbToEntity(dest)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (r *WebhookSubscriptionPostgresRepository) GetById(ctx context.Context, id int64) (entity.WebhookSubscription, error) {
+	errorTemplate := "WebhookSubscriptionPostgresRepository GetById %w"
+	var dest model.WebhookSubscription
+	if err := table.WebhookSubscription.SELECT(table.WebhookSubscription.AllColumns).
+		WHERE(table.WebhookSubscription.ID.EQ(postgres.Int64(id))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	res, err := MapWebhookSubscriptionDbToEntity(dest)
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (r *WebhookSubscriptionPostgresRepository) Create(ctx context.Context, subscription entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+	errorTemplate := "WebhookSubscriptionPostgresRepository Create %w"
+	createModel, err := MapWebhookSubscriptionEntityToDb(subscription)
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	created := model.WebhookSubscription{}
+	if err := table.WebhookSubscription.INSERT(table.WebhookSubscription.MutableColumns).
+		MODEL(createModel).
+		RETURNING(table.WebhookSubscription.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &created); err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	res, err := MapWebhookSubscriptionDbToEntity(created)
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (r *WebhookSubscriptionPostgresRepository) Update(ctx context.Context, subscription entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+	errorTemplate := "WebhookSubscriptionPostgresRepository Update %w"
+	updateModel, err := MapWebhookSubscriptionEntityToDb(subscription)
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	updated := model.WebhookSubscription{}
+	if err := table.WebhookSubscription.UPDATE(
+		table.WebhookSubscription.Name,
+		table.WebhookSubscription.Url,
+		table.WebhookSubscription.Secret,
+		table.WebhookSubscription.EventTypes,
+		table.WebhookSubscription.Active,
+	).
+		MODEL(updateModel).
+		WHERE(table.WebhookSubscription.ID.EQ(postgres.Int64(subscription.Id))).
+		RETURNING(table.WebhookSubscription.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &updated); err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	res, err := MapWebhookSubscriptionDbToEntity(updated)
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (r *WebhookSubscriptionPostgresRepository) Delete(ctx context.Context, id int64) error {
+	if _, err := table.WebhookSubscription.DELETE().
+		WHERE(table.WebhookSubscription.ID.EQ(postgres.Int64(id))).
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("WebhookSubscriptionPostgresRepository Delete %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financing-offer/internal/core/entity"
+)
+
+type WebhookSubscriptionRepository interface {
+	GetAll(ctx context.Context) ([]entity.WebhookSubscription, error)
+	GetById(ctx context.Context, id int64) (entity.WebhookSubscription, error)
+	Create(ctx context.Context, subscription entity.WebhookSubscription) (entity.WebhookSubscription, error)
+	Update(ctx context.Context, subscription entity.WebhookSubscription) (entity.WebhookSubscription, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type WebhookDeliveryRepository interface {
+	// CreateForEvent creates a pending delivery of the event for every active subscription of its type
+	// and returns the number of deliveries
+	CreateForEvent(ctx context.Context, event entity.WebhookEvent, payload string) (int64, error)
+	Create(ctx context.Context, delivery entity.WebhookDelivery) (entity.WebhookDelivery, error)
+	GetById(ctx context.Context, id int64) (entity.WebhookDelivery, error)
+	GetAll(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error)
+	Count(ctx context.Context, filter entity.WebhookDeliveryFilter) (int64, error)
+	// ClaimDeliverable moves the next attempt of the pending deliveries that are due lease into the future and returns them,
+	// a claimed delivery is skipped by other pollers until it is updated or its lease expires
+	ClaimDeliverable(ctx context.Context, limit int64, lease time.Duration) ([]entity.WebhookDelivery, error)
+	Update(ctx context.Context, delivery entity.WebhookDelivery) error
+}
+
+// WebhookEventRepository records an event for the subscriptions of its type,
+// the deliveries are only sent once the transaction carried by ctx commits
+type WebhookEventRepository interface {
+	Publish(ctx context.Context, eventType entity.WebhookEventType, data any) error
+}
+
+type WebhookSenderRepository interface {
+	// Send posts body to url and returns the response status, an error is returned for a non 2xx status
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int32, error)
+}
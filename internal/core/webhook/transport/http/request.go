@@ -0,0 +1,45 @@
+package http
+
+import (
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/optional"
+)
+
+type WebhookSubscriptionRequest struct {
+	Name       string                    `json:"name" binding:"required"`
+	Url        string                    `json:"url" binding:"required,url"`
+	Secret     string                    `json:"secret"`
+	EventTypes []entity.WebhookEventType `json:"eventTypes" binding:"required,min=1"`
+	Active     *bool                     `json:"active"`
+}
+
+func (r WebhookSubscriptionRequest) toEntity(id int64) entity.WebhookSubscription {
+	active := true
+	if r.Active != nil {
+		active = *r.Active
+	}
+	return entity.WebhookSubscription{
+		Id:         id,
+		Name:       r.Name,
+		Url:        r.Url,
+		Secret:     r.Secret,
+		EventTypes: r.EventTypes,
+		Active:     active,
+	}
+}
+
+type GetWebhookDeliveriesRequest struct {
+	Paging    core.Paging
+	EventType entity.WebhookEventType      `form:"eventType"`
+	Status    entity.WebhookDeliveryStatus `form:"status" binding:"omitempty,oneof=PENDING SUCCESS DEAD"`
+}
+
+func (r GetWebhookDeliveriesRequest) toFilter(subscriptionId int64) entity.WebhookDeliveryFilter {
+	return entity.WebhookDeliveryFilter{
+		Paging:         r.Paging,
+		SubscriptionId: optional.Some(subscriptionId),
+		EventType:      optional.FromValueNonZero(r.EventType),
+		Status:         optional.FromValueNonZero(r.Status),
+	}
+}
@@ -0,0 +1,232 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/webhook"
+	"financing-offer/internal/handler"
+)
+
+type WebhookHandler struct {
+	handler.BaseHandler
+	logger  *slog.Logger
+	useCase webhook.UseCase
+}
+
+func NewWebhookHandler(baseHandler handler.BaseHandler, logger *slog.Logger, useCase webhook.UseCase) *WebhookHandler {
+	return &WebhookHandler{BaseHandler: baseHandler, logger: logger, useCase: useCase}
+}
+
+// GetSubscriptions godoc
+//
+//	@Summary		Get webhook subscriptions
+//	@Description	Get the webhook subscriptions of partner systems, the secrets are not returned
+//	@Tags			webhook,admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	handler.BaseResponse[[]entity.WebhookSubscription]
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/webhooks/subscriptions [get]
+func (h *WebhookHandler) GetSubscriptions(ctx *gin.Context) {
+	subscriptions, err := h.useCase.GetSubscriptions(ctx)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[[]entity.WebhookSubscription]{Data: subscriptions})
+}
+
+// GetSubscriptionById godoc
+//
+//	@Summary		Get webhook subscription
+//	@Description	Get a webhook subscription, the secret is not returned
+//	@Tags			webhook,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	handler.BaseResponse[entity.WebhookSubscription]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		404	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/webhooks/subscriptions/{id} [get]
+func (h *WebhookHandler) GetSubscriptionById(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	subscription, err := h.useCase.GetSubscriptionById(ctx, id)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.WebhookSubscription]{Data: subscription})
+}
+
+// CreateSubscription godoc
+//
+//	@Summary		Create webhook subscription
+//	@Description	Register an endpoint receiving the events of the chosen types, a secret is generated when it is not given.
+//	@Description	The secret is only returned by this call, deliveries are signed with it in the X-Webhook-Signature header
+//	@Tags			webhook,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			subscription	body		WebhookSubscriptionRequest	true	"webhook subscription"
+//	@Success		201				{object}	handler.BaseResponse[entity.WebhookSubscription]
+//	@Failure		400				{object}	handler.ErrorResponse
+//	@Failure		500				{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/webhooks/subscriptions [post]
+func (h *WebhookHandler) CreateSubscription(ctx *gin.Context) {
+	req := WebhookSubscriptionRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("create webhook subscription", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	subscription := req.toEntity(0)
+	subscription.CreatedBy = h.UserSubOrEmpty(ctx)
+	created, err := h.useCase.CreateSubscription(ctx, subscription)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, handler.BaseResponse[entity.WebhookSubscription]{Data: created})
+}
+
+// UpdateSubscription godoc
+//
+//	@Summary		Update webhook subscription
+//	@Description	Update a webhook subscription, the secret is kept when it is not given
+//	@Tags			webhook,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int							true	"id"
+//	@Param			subscription	body		WebhookSubscriptionRequest	true	"webhook subscription"
+//	@Success		200				{object}	handler.BaseResponse[entity.WebhookSubscription]
+//	@Failure		400				{object}	handler.ErrorResponse
+//	@Failure		404				{object}	handler.ErrorResponse
+//	@Failure		500				{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/webhooks/subscriptions/{id} [patch]
+func (h *WebhookHandler) UpdateSubscription(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	req := WebhookSubscriptionRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("update webhook subscription", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	updated, err := h.useCase.UpdateSubscription(ctx, req.toEntity(id))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.WebhookSubscription]{Data: updated})
+}
+
+// DeleteSubscription godoc
+//
+//	@Summary		Delete webhook subscription
+//	@Description	Delete a webhook subscription with its delivery log
+//	@Tags			webhook,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		204	{object}	handler.BaseResponse[string]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/webhooks/subscriptions/{id} [delete]
+func (h *WebhookHandler) DeleteSubscription(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	if err := h.useCase.DeleteSubscription(ctx, id); err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusNoContent, handler.BaseResponse[string]{Data: "ok"})
+}
+
+// GetDeliveries godoc
+//
+//	@Summary		Get webhook deliveries
+//	@Description	Get the delivery log of a webhook subscription, latest first
+//	@Tags			webhook,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int		true	"subscription id"
+//	@Param			eventType		query		string	false	"event type"
+//	@Param			status			query		string	false	"PENDING, SUCCESS or DEAD"
+//	@Param			page[size]		query		int		false	"page size"
+//	@Param			page[number]	query		int		false	"page number"
+//	@Success		200				{object}	handler.ResponseWithPaging[[]entity.WebhookDelivery]
+//	@Failure		400				{object}	handler.ErrorResponse
+//	@Failure		500				{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/webhooks/subscriptions/{id}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	req := GetWebhookDeliveriesRequest{}
+	if err := h.ParseQueryWithPagination(ctx, &req.Paging, &req); err != nil {
+		h.logger.Error("get webhook deliveries", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "parse query")
+		return
+	}
+	deliveries, meta, err := h.useCase.GetDeliveries(ctx, req.toFilter(id))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(
+		http.StatusOK, handler.ResponseWithPaging[[]entity.WebhookDelivery]{
+			Data:     deliveries,
+			MetaData: meta,
+		},
+	)
+}
+
+// ReplayDelivery godoc
+//
+//	@Summary		Replay webhook delivery
+//	@Description	Send the event of a delivery again, the replay is a new pending delivery of the same event id
+//	@Tags			webhook,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"delivery id"
+//	@Success		202	{object}	handler.BaseResponse[entity.WebhookDelivery]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		404	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/webhooks/deliveries/{id}/replay [post]
+func (h *WebhookHandler) ReplayDelivery(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	delivery, err := h.useCase.ReplayDelivery(ctx, id)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusAccepted, handler.BaseResponse[entity.WebhookDelivery]{Data: delivery})
+}
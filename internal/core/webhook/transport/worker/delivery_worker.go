@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/webhook"
+)
+
+type DeliveryWorker struct {
+	cfg          config.WebhookConfig
+	logger       *slog.Logger
+	useCase      webhook.UseCase
+	errorService apperrors.Service
+}
+
+func NewDeliveryWorker(
+	cfg config.WebhookConfig,
+	logger *slog.Logger,
+	useCase webhook.UseCase,
+	errorService apperrors.Service,
+) *DeliveryWorker {
+	return &DeliveryWorker{
+		cfg:          cfg,
+		logger:       logger,
+		useCase:      useCase,
+		errorService: errorService,
+	}
+}
+
+// Run delivers pending webhook deliveries every poll interval until ctx is cancelled
+func (w *DeliveryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.deliver(ctx)
+		}
+	}
+}
+
+// deliver keeps draining full batches so a backlog does not wait for the next tick
+func (w *DeliveryWorker) deliver(ctx context.Context) {
+	for ctx.Err() == nil {
+		delivered, err := w.useCase.DeliverPending(ctx)
+		if err != nil {
+			w.logger.Error("DeliveryWorker deliver", slog.String("error", err.Error()))
+			if notifyErr := w.errorService.NotifyError(ctx, err); notifyErr != nil {
+				w.logger.Error("DeliveryWorker NotifyError", slog.String("error", notifyErr.Error()))
+			}
+			return
+		}
+		if int64(delivered) < w.cfg.BatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/atomicity"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/webhook/repository"
+)
+
+const (
+	HeaderEventId    = "X-Webhook-Id"
+	HeaderEventType  = "X-Webhook-Event"
+	HeaderDeliveryId = "X-Webhook-Delivery"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderSignature  = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+	secretBytes     = 32
+)
+
+type UseCase interface {
+	GetSubscriptions(ctx context.Context) ([]entity.WebhookSubscription, error)
+	GetSubscriptionById(ctx context.Context, id int64) (entity.WebhookSubscription, error)
+	// CreateSubscription generates the secret when it is empty, the secret is only returned here
+	CreateSubscription(ctx context.Context, subscription entity.WebhookSubscription) (entity.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscription entity.WebhookSubscription) (entity.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	GetDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, core.PagingMetaData, error)
+	// ReplayDelivery sends the event of a delivery again as a new delivery
+	ReplayDelivery(ctx context.Context, id int64) (entity.WebhookDelivery, error)
+	// DeliverPending sends the next batch of due deliveries and returns the batch size
+	DeliverPending(ctx context.Context) (int, error)
+}
+
+type useCase struct {
+	cfg                    config.WebhookConfig
+	logger                 *slog.Logger
+	subscriptionRepository repository.WebhookSubscriptionRepository
+	deliveryRepository     repository.WebhookDeliveryRepository
+	sender                 repository.WebhookSenderRepository
+	errorService           apperrors.Service
+	atomicExecutor         atomicity.AtomicExecutor
+}
+
+func NewUseCase(
+	cfg config.WebhookConfig,
+	logger *slog.Logger,
+	subscriptionRepository repository.WebhookSubscriptionRepository,
+	deliveryRepository repository.WebhookDeliveryRepository,
+	sender repository.WebhookSenderRepository,
+	errorService apperrors.Service,
+	atomicExecutor atomicity.AtomicExecutor,
+) UseCase {
+	return &useCase{
+		cfg:                    cfg,
+		logger:                 logger,
+		subscriptionRepository: subscriptionRepository,
+		deliveryRepository:     deliveryRepository,
+		sender:                 sender,
+		errorService:           errorService,
+		atomicExecutor:         atomicExecutor,
+	}
+}
+
+func (u *useCase) GetSubscriptions(ctx context.Context) ([]entity.WebhookSubscription, error) {
+	res, err := u.subscriptionRepository.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("webhookUseCase GetSubscriptions %w", err)
+	}
+	for i := range res {
+		res[i].Secret = ""
+	}
+	return res, nil
+}
+
+func (u *useCase) GetSubscriptionById(ctx context.Context, id int64) (entity.WebhookSubscription, error) {
+	res, err := u.subscriptionRepository.GetById(ctx, id)
+	if err != nil {
+		return res, fmt.Errorf("webhookUseCase GetSubscriptionById %w", err)
+	}
+	res.Secret = ""
+	return res, nil
+}
+
+func (u *useCase) CreateSubscription(ctx context.Context, subscription entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+	errorTemplate := "webhookUseCase CreateSubscription %w"
+	if err := validateSubscription(subscription); err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+	if subscription.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+		}
+		subscription.Secret = secret
+	}
+	var res entity.WebhookSubscription
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.subscriptionRepository.Create(tc, subscription)
+			return err
+		},
+	)
+	if err != nil {
+		return res, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (u *useCase) UpdateSubscription(ctx context.Context, subscription entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+	errorTemplate := "webhookUseCase UpdateSubscription %w"
+	if err := validateSubscription(subscription); err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+	current, err := u.subscriptionRepository.GetById(ctx, subscription.Id)
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf(errorTemplate, err)
+	}
+	// the secret is kept unless a new one is given, receivers would otherwise reject every delivery
+	if subscription.Secret == "" {
+		subscription.Secret = current.Secret
+	}
+	var res entity.WebhookSubscription
+	err = u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.subscriptionRepository.Update(tc, subscription)
+			return err
+		},
+	)
+	if err != nil {
+		return res, fmt.Errorf(errorTemplate, err)
+	}
+	res.Secret = ""
+	return res, nil
+}
+
+func (u *useCase) DeleteSubscription(ctx context.Context, id int64) error {
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			return u.subscriptionRepository.Delete(tc, id)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("webhookUseCase DeleteSubscription %w", err)
+	}
+	return nil
+}
+
+func (u *useCase) GetDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, core.PagingMetaData, error) {
+	var (
+		eg             errgroup.Group
+		deliveries     []entity.WebhookDelivery
+		pagingMetaData = core.PagingMetaData{PageSize: filter.Size, PageNumber: filter.Number}
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.deliveryRepository.GetAll(ctx, filter)
+			deliveries = res
+			return scopedErr
+		},
+	)
+	eg.Go(
+		func() error {
+			res, scopedErr := u.deliveryRepository.Count(ctx, filter)
+			pagingMetaData.Total = res
+			pagingMetaData.TotalPages = filter.TotalPages(res)
+			return scopedErr
+		},
+	)
+	if err := eg.Wait(); err != nil {
+		return nil, pagingMetaData, fmt.Errorf("webhookUseCase GetDeliveries %w", err)
+	}
+	return deliveries, pagingMetaData, nil
+}
+
+func (u *useCase) ReplayDelivery(ctx context.Context, id int64) (entity.WebhookDelivery, error) {
+	errorTemplate := "webhookUseCase ReplayDelivery %w"
+	delivery, err := u.deliveryRepository.GetById(ctx, id)
+	if err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf(errorTemplate, err)
+	}
+	subscription, err := u.subscriptionRepository.GetById(ctx, delivery.SubscriptionId)
+	if err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf(errorTemplate, err)
+	}
+	if !subscription.Active {
+		return entity.WebhookDelivery{}, apperrors.ErrInvalidInput("subscription is not active")
+	}
+	res, err := u.deliveryRepository.Create(
+		ctx, entity.WebhookDelivery{
+			SubscriptionId: delivery.SubscriptionId,
+			EventId:        delivery.EventId,
+			EventType:      delivery.EventType,
+			Payload:        delivery.Payload,
+			Status:         entity.WebhookDeliveryStatusPending,
+			NextAttemptAt:  time.Now(),
+			ReplayOfId:     &delivery.Id,
+		},
+	)
+	if err != nil {
+		return res, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (u *useCase) DeliverPending(ctx context.Context) (int, error) {
+	errorTemplate := "webhookUseCase DeliverPending %w"
+	deliveries, err := u.deliveryRepository.ClaimDeliverable(ctx, u.cfg.BatchSize, u.cfg.ClaimLease)
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	// every delivery is sent and updated on its own, a delivery that fails here is claimed again once its lease expires
+	errs := make([]error, 0)
+	subscriptions := make(map[int64]entity.WebhookSubscription)
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptions[delivery.SubscriptionId]
+		if !ok {
+			if subscription, err = u.subscriptionRepository.GetById(ctx, delivery.SubscriptionId); err != nil {
+				errs = append(errs, fmt.Errorf("delivery %d: %w", delivery.Id, err))
+				continue
+			}
+			subscriptions[delivery.SubscriptionId] = subscription
+		}
+		delivery = u.deliver(ctx, subscription, delivery)
+		if err := u.deliveryRepository.Update(ctx, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("delivery %d: %w", delivery.Id, err))
+			continue
+		}
+		if delivery.Status == entity.WebhookDeliveryStatusDead && subscription.Active {
+			u.notifyDead(ctx, delivery)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return len(deliveries), fmt.Errorf(errorTemplate, err)
+	}
+	return len(deliveries), nil
+}
+
+func (u *useCase) notifyDead(ctx context.Context, delivery entity.WebhookDelivery) {
+	deadErr := fmt.Errorf(
+		"webhook delivery %d (subscription %d, event %s) is dead after %d attempts: %s",
+		delivery.Id, delivery.SubscriptionId, delivery.EventType, delivery.Attempts, delivery.LastError,
+	)
+	if err := u.errorService.NotifyError(ctx, deadErr); err != nil {
+		u.logger.Error("webhookUseCase DeliverPending NotifyError", slog.String("error", err.Error()))
+	}
+}
+
+// deliver posts a delivery to the subscription and returns it with the delivery outcome applied
+func (u *useCase) deliver(
+	ctx context.Context,
+	subscription entity.WebhookSubscription,
+	delivery entity.WebhookDelivery,
+) entity.WebhookDelivery {
+	now := time.Now()
+	if !subscription.Active {
+		delivery.Status = entity.WebhookDeliveryStatusDead
+		delivery.LastError = "subscription is not active"
+		return delivery
+	}
+	body := []byte(delivery.Payload)
+	timestamp := now.Unix()
+	status, err := u.sender.Send(
+		ctx, subscription.Url, map[string]string{
+			"Content-Type":   "application/json",
+			HeaderEventId:    delivery.EventId,
+			HeaderEventType:  delivery.EventType.String(),
+			HeaderDeliveryId: strconv.FormatInt(delivery.Id, 10),
+			HeaderTimestamp:  strconv.FormatInt(timestamp, 10),
+			HeaderSignature:  Sign(subscription.Secret, timestamp, body),
+		}, body,
+	)
+	delivery.ResponseStatus = status
+	if err == nil {
+		delivery.Status = entity.WebhookDeliveryStatusSuccess
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+		return delivery
+	}
+	delivery.Attempts++
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= u.cfg.MaxAttempts {
+		delivery.Status = entity.WebhookDeliveryStatusDead
+		return delivery
+	}
+	delivery.NextAttemptAt = now.Add(u.retryBackoff(delivery.Attempts))
+	return delivery
+}
+
+// retryBackoff doubles the configured backoff for every failed attempt, up to MaxRetryBackoff
+func (u *useCase) retryBackoff(attempts int32) time.Duration {
+	backoff := u.cfg.RetryBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if u.cfg.MaxRetryBackoff > 0 && backoff >= u.cfg.MaxRetryBackoff {
+			return u.cfg.MaxRetryBackoff
+		}
+	}
+	return backoff
+}
+
+// Sign returns the signature header of a body sent at timestamp, the HMAC-SHA256 of "<timestamp>.<body>"
+// keyed by the secret of the subscription
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func validateSubscription(subscription entity.WebhookSubscription) error {
+	if subscription.Name == "" {
+		return apperrors.ErrInvalidInput("name must not be empty")
+	}
+	endpoint, err := url.Parse(subscription.Url)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return apperrors.ErrInvalidInput("url must be an http or https url")
+	}
+	if len(subscription.EventTypes) == 0 {
+		return apperrors.ErrInvalidInput("eventTypes must not be empty")
+	}
+	for _, eventType := range subscription.EventTypes {
+		if !eventType.IsValid() {
+			return apperrors.ErrInvalidInput(fmt.Sprintf("unknown event type %s", eventType))
+		}
+	}
+	return nil
+}
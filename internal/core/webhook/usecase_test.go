@@ -0,0 +1,317 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	webhookClient "financing-offer/pkg/infra/webhook"
+	"financing-offer/test/mock"
+)
+
+func TestWebhookUseCase_DeliverPending(t *testing.T) {
+	t.Parallel()
+	cfg := config.WebhookConfig{
+		BatchSize:       10,
+		MaxAttempts:     3,
+		RetryBackoff:    time.Second,
+		MaxRetryBackoff: 3 * time.Second,
+		Timeout:         time.Second,
+		ClaimLease:      time.Minute,
+	}
+	pendingDelivery := entity.WebhookDelivery{
+		Id:             7,
+		SubscriptionId: 1,
+		EventId:        "event-id",
+		EventType:      entity.WebhookEventTypeRequestCreated,
+		Payload:        `{"id":"event-id","type":"REQUEST_CREATED","data":{"id":1}}`,
+		Status:         entity.WebhookDeliveryStatusPending,
+	}
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockWebhookSubscriptionRepository, *mock.MockWebhookDeliveryRepository) {
+		subscriptionRepository := mock.NewMockWebhookSubscriptionRepository(t)
+		deliveryRepository := mock.NewMockWebhookDeliveryRepository(t)
+		useCase := NewUseCase(
+			cfg,
+			slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			subscriptionRepository,
+			deliveryRepository,
+			webhookClient.NewClient(cfg),
+			mock.ErrReporter{},
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+		)
+		return useCase, subscriptionRepository, deliveryRepository
+	}
+	subscriptionOf := func(url string) entity.WebhookSubscription {
+		return entity.WebhookSubscription{
+			Id:         1,
+			Url:        url,
+			Secret:     "secret",
+			EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeRequestCreated},
+			Active:     true,
+		}
+	}
+
+	t.Run(
+		"DeliverPending_signed_delivery", func(t *testing.T) {
+			var verified atomic.Bool
+			receiver := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						body, _ := io.ReadAll(r.Body)
+						timestamp, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+						verified.Store(
+							r.Header.Get(HeaderSignature) == Sign("secret", timestamp, body) &&
+								r.Header.Get(HeaderEventType) == "REQUEST_CREATED" &&
+								r.Header.Get(HeaderDeliveryId) == "7" &&
+								string(body) == pendingDelivery.Payload,
+						)
+						w.WriteHeader(http.StatusNoContent)
+					},
+				),
+			)
+			defer receiver.Close()
+			useCase, subscriptionRepository, deliveryRepository := newUseCase(t)
+			deliveryRepository.EXPECT().ClaimDeliverable(testifyMock.Anything, cfg.BatchSize, cfg.ClaimLease).
+				Return([]entity.WebhookDelivery{pendingDelivery}, nil)
+			subscriptionRepository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(subscriptionOf(receiver.URL), nil)
+			deliveryRepository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(delivery entity.WebhookDelivery) bool {
+						return delivery.Status == entity.WebhookDeliveryStatusSuccess &&
+							delivery.ResponseStatus == http.StatusNoContent &&
+							delivery.DeliveredAt != nil
+					},
+				),
+			).Return(nil)
+			delivered, err := useCase.DeliverPending(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 1, delivered)
+			assert.True(t, verified.Load())
+		},
+	)
+
+	t.Run(
+		"DeliverPending_retry_later", func(t *testing.T) {
+			receiver := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					},
+				),
+			)
+			defer receiver.Close()
+			useCase, subscriptionRepository, deliveryRepository := newUseCase(t)
+			deliveryRepository.EXPECT().ClaimDeliverable(testifyMock.Anything, cfg.BatchSize, cfg.ClaimLease).
+				Return([]entity.WebhookDelivery{pendingDelivery}, nil)
+			subscriptionRepository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(subscriptionOf(receiver.URL), nil)
+			deliveryRepository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(delivery entity.WebhookDelivery) bool {
+						return delivery.Status == entity.WebhookDeliveryStatusPending &&
+							delivery.Attempts == 1 &&
+							delivery.ResponseStatus == http.StatusInternalServerError &&
+							delivery.LastError != "" &&
+							delivery.NextAttemptAt.After(time.Now())
+					},
+				),
+			).Return(nil)
+			_, err := useCase.DeliverPending(context.Background())
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"DeliverPending_dead_after_max_attempts", func(t *testing.T) {
+			receiver := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusBadGateway)
+					},
+				),
+			)
+			defer receiver.Close()
+			useCase, subscriptionRepository, deliveryRepository := newUseCase(t)
+			exhaustedDelivery := pendingDelivery
+			exhaustedDelivery.Attempts = cfg.MaxAttempts - 1
+			deliveryRepository.EXPECT().ClaimDeliverable(testifyMock.Anything, cfg.BatchSize, cfg.ClaimLease).
+				Return([]entity.WebhookDelivery{exhaustedDelivery}, nil)
+			subscriptionRepository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(subscriptionOf(receiver.URL), nil)
+			deliveryRepository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(delivery entity.WebhookDelivery) bool {
+						return delivery.Status == entity.WebhookDeliveryStatusDead && delivery.Attempts == cfg.MaxAttempts
+					},
+				),
+			).Return(nil)
+			_, err := useCase.DeliverPending(context.Background())
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"DeliverPending_inactive_subscription", func(t *testing.T) {
+			useCase, subscriptionRepository, deliveryRepository := newUseCase(t)
+			subscription := subscriptionOf("http://127.0.0.1:0")
+			subscription.Active = false
+			deliveryRepository.EXPECT().ClaimDeliverable(testifyMock.Anything, cfg.BatchSize, cfg.ClaimLease).
+				Return([]entity.WebhookDelivery{pendingDelivery}, nil)
+			subscriptionRepository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(subscription, nil)
+			deliveryRepository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(delivery entity.WebhookDelivery) bool {
+						return delivery.Status == entity.WebhookDeliveryStatusDead && delivery.Attempts == 0
+					},
+				),
+			).Return(nil)
+			_, err := useCase.DeliverPending(context.Background())
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"DeliverPending_repository_error", func(t *testing.T) {
+			useCase, _, deliveryRepository := newUseCase(t)
+			deliveryRepository.EXPECT().ClaimDeliverable(testifyMock.Anything, cfg.BatchSize, cfg.ClaimLease).
+				Return(nil, assert.AnError)
+			_, err := useCase.DeliverPending(context.Background())
+			assert.ErrorIs(t, err, assert.AnError)
+		},
+	)
+
+	t.Run(
+		"DeliverPending_update_error_keeps_the_other_deliveries", func(t *testing.T) {
+			var received atomic.Int32
+			receiver := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						received.Add(1)
+						w.WriteHeader(http.StatusOK)
+					},
+				),
+			)
+			defer receiver.Close()
+			useCase, subscriptionRepository, deliveryRepository := newUseCase(t)
+			nextDelivery := pendingDelivery
+			nextDelivery.Id = 8
+			deliveryRepository.EXPECT().ClaimDeliverable(testifyMock.Anything, cfg.BatchSize, cfg.ClaimLease).
+				Return([]entity.WebhookDelivery{pendingDelivery, nextDelivery}, nil)
+			subscriptionRepository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(subscriptionOf(receiver.URL), nil).Once()
+			deliveryRepository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(delivery entity.WebhookDelivery) bool {
+						return delivery.Id == pendingDelivery.Id
+					},
+				),
+			).Return(assert.AnError)
+			deliveryRepository.EXPECT().Update(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(delivery entity.WebhookDelivery) bool {
+						return delivery.Id == nextDelivery.Id && delivery.Status == entity.WebhookDeliveryStatusSuccess
+					},
+				),
+			).Return(nil)
+			delivered, err := useCase.DeliverPending(context.Background())
+			assert.ErrorIs(t, err, assert.AnError)
+			assert.Equal(t, 2, delivered)
+			assert.Equal(t, int32(2), received.Load())
+		},
+	)
+}
+
+func TestWebhookUseCase_CreateSubscription(t *testing.T) {
+	t.Parallel()
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockWebhookSubscriptionRepository) {
+		subscriptionRepository := mock.NewMockWebhookSubscriptionRepository(t)
+		useCase := NewUseCase(
+			config.WebhookConfig{},
+			slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			subscriptionRepository,
+			mock.NewMockWebhookDeliveryRepository(t),
+			mock.NewMockWebhookSenderRepository(t),
+			mock.ErrReporter{},
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+		)
+		return useCase, subscriptionRepository
+	}
+	subscription := entity.WebhookSubscription{
+		Name:       "partner",
+		Url:        "https://partner.example.com/hooks",
+		EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeOfferReady},
+		Active:     true,
+	}
+
+	t.Run(
+		"CreateSubscription_generates_secret", func(t *testing.T) {
+			useCase, subscriptionRepository := newUseCase(t)
+			subscriptionRepository.EXPECT().Create(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(created entity.WebhookSubscription) bool {
+						return len(created.Secret) == 2*secretBytes
+					},
+				),
+			).RunAndReturn(
+				func(_ context.Context, created entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+					return created, nil
+				},
+			)
+			created, err := useCase.CreateSubscription(context.Background(), subscription)
+			assert.Nil(t, err)
+			assert.NotEmpty(t, created.Secret)
+		},
+	)
+
+	t.Run(
+		"CreateSubscription_invalid", func(t *testing.T) {
+			useCase, _ := newUseCase(t)
+			for _, tc := range []struct {
+				subscription entity.WebhookSubscription
+				message      string
+			}{
+				{
+					entity.WebhookSubscription{Url: subscription.Url, EventTypes: subscription.EventTypes},
+					"name must not be empty",
+				},
+				{
+					entity.WebhookSubscription{Name: "partner", Url: "ftp://partner.example.com", EventTypes: subscription.EventTypes},
+					"url must be an http or https url",
+				},
+				{
+					entity.WebhookSubscription{Name: "partner", Url: subscription.Url},
+					"eventTypes must not be empty",
+				},
+				{
+					entity.WebhookSubscription{
+						Name: "partner", Url: subscription.Url, EventTypes: []entity.WebhookEventType{"UNKNOWN"},
+					},
+					"unknown event type UNKNOWN",
+				},
+			} {
+				_, err := useCase.CreateSubscription(context.Background(), tc.subscription)
+				assert.Equal(t, apperrors.ErrInvalidInput(tc.message), err)
+			}
+		},
+	)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	// echo -n '1700000000.{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(
+		t,
+		"sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54",
+		Sign("secret", 1700000000, []byte(`{"id":"1"}`)),
+	)
+}
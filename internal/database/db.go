@@ -60,11 +60,12 @@ func New(cfg config.DbConfig, tasks *shutdown.Tasks) (GetDbFunc, *atomicity.DbAt
 			return nil, emptyAtomicExecutor, err
 		}
 	}
+	tracedDb := traceDB(db)
 	getDbFunc := func(ctx context.Context) DB {
 		if tx := atomicity.ContextGetTx(ctx); tx != nil {
-			return tx
+			return traceDB(tx)
 		}
-		return db
+		return tracedDb
 	}
 
 	tasks.AddShutdownTask(
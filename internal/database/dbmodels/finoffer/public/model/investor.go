@@ -12,8 +12,9 @@ import (
 )
 
 type Investor struct {
-	InvestorID  string `sql:"primary_key"`
-	CustodyCode string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	InvestorID      string `sql:"primary_key"`
+	CustodyCode     string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	PreferredLocale string
 }
@@ -33,4 +33,5 @@ type LoanPackageOfferInterest struct {
 	InitialRate             decimal.Decimal
 	ContractSize            int64
 	SubmissionSheetDetailID *int64
+	WorkflowID              *string
 }
@@ -18,4 +18,5 @@ type LoanRequestSchedulerConfig struct {
 	AffectedFrom    time.Time
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	Rules           string
 }
@@ -17,6 +17,7 @@ type SchedulerJob struct {
 	JobStatus    string
 	TriggerBy    string
 	TrackingData string
+	ScheduledAt  *time.Time
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -8,14 +8,22 @@
 package model
 
 import (
+	"github.com/volatiletech/null/v9"
 	"time"
 )
 
 type SuggestedOffer struct {
-	ID        int64 `sql:"primary_key"`
-	ConfigID  int64
-	AccountNo string
-	Symbols   string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          int64 `sql:"primary_key"`
+	ConfigID    int64
+	AccountNo   string
+	Symbols     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	InvestorID  string
+	Status      string
+	Note        string
+	RespondedBy string
+	RespondedAt null.Time
+	ConvertedAt null.Time
+	CustodyCode string
 }
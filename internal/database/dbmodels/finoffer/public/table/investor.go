@@ -17,10 +17,11 @@ type investorTable struct {
 	postgres.Table
 
 	// Columns
-	InvestorID  postgres.ColumnString
-	CustodyCode postgres.ColumnString
-	CreatedAt   postgres.ColumnTimestamp
-	UpdatedAt   postgres.ColumnTimestamp
+	InvestorID      postgres.ColumnString
+	CustodyCode     postgres.ColumnString
+	CreatedAt       postgres.ColumnTimestamp
+	UpdatedAt       postgres.ColumnTimestamp
+	PreferredLocale postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -61,22 +62,24 @@ func newInvestorTable(schemaName, tableName, alias string) *InvestorTable {
 
 func newInvestorTableImpl(schemaName, tableName, alias string) investorTable {
 	var (
-		InvestorIDColumn  = postgres.StringColumn("investor_id")
-		CustodyCodeColumn = postgres.StringColumn("custody_code")
-		CreatedAtColumn   = postgres.TimestampColumn("created_at")
-		UpdatedAtColumn   = postgres.TimestampColumn("updated_at")
-		allColumns        = postgres.ColumnList{InvestorIDColumn, CustodyCodeColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns    = postgres.ColumnList{CustodyCodeColumn}
+		InvestorIDColumn      = postgres.StringColumn("investor_id")
+		CustodyCodeColumn     = postgres.StringColumn("custody_code")
+		CreatedAtColumn       = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn       = postgres.TimestampColumn("updated_at")
+		PreferredLocaleColumn = postgres.StringColumn("preferred_locale")
+		allColumns            = postgres.ColumnList{InvestorIDColumn, CustodyCodeColumn, CreatedAtColumn, UpdatedAtColumn, PreferredLocaleColumn}
+		mutableColumns        = postgres.ColumnList{CustodyCodeColumn, PreferredLocaleColumn}
 	)
 
 	return investorTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		InvestorID:  InvestorIDColumn,
-		CustodyCode: CustodyCodeColumn,
-		CreatedAt:   CreatedAtColumn,
-		UpdatedAt:   UpdatedAtColumn,
+		InvestorID:      InvestorIDColumn,
+		CustodyCode:     CustodyCodeColumn,
+		CreatedAt:       CreatedAtColumn,
+		UpdatedAt:       UpdatedAtColumn,
+		PreferredLocale: PreferredLocaleColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -36,6 +36,7 @@ type loanPackageOfferInterestTable struct {
 	InitialRate             postgres.ColumnFloat
 	ContractSize            postgres.ColumnInteger
 	SubmissionSheetDetailID postgres.ColumnInteger
+	WorkflowID              postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -95,8 +96,9 @@ func newLoanPackageOfferInterestTableImpl(schemaName, tableName, alias string) l
 		InitialRateColumn             = postgres.FloatColumn("initial_rate")
 		ContractSizeColumn            = postgres.IntegerColumn("contract_size")
 		SubmissionSheetDetailIDColumn = postgres.IntegerColumn("submission_sheet_detail_id")
-		allColumns                    = postgres.ColumnList{IDColumn, LoanPackageOfferIDColumn, ScoreGroupInterestIDColumn, LimitAmountColumn, LoanRateColumn, InterestRateColumn, StatusColumn, CreatedAtColumn, UpdatedAtColumn, LoanIDColumn, CancelledByColumn, CancelledAtColumn, TermColumn, FeeRateColumn, CancelledReasonColumn, AssetTypeColumn, InitialRateColumn, ContractSizeColumn, SubmissionSheetDetailIDColumn}
-		mutableColumns                = postgres.ColumnList{LoanPackageOfferIDColumn, ScoreGroupInterestIDColumn, LimitAmountColumn, LoanRateColumn, InterestRateColumn, StatusColumn, LoanIDColumn, CancelledByColumn, CancelledAtColumn, TermColumn, FeeRateColumn, CancelledReasonColumn, AssetTypeColumn, InitialRateColumn, ContractSizeColumn, SubmissionSheetDetailIDColumn}
+		WorkflowIDColumn              = postgres.StringColumn("workflow_id")
+		allColumns                    = postgres.ColumnList{IDColumn, LoanPackageOfferIDColumn, ScoreGroupInterestIDColumn, LimitAmountColumn, LoanRateColumn, InterestRateColumn, StatusColumn, CreatedAtColumn, UpdatedAtColumn, LoanIDColumn, CancelledByColumn, CancelledAtColumn, TermColumn, FeeRateColumn, CancelledReasonColumn, AssetTypeColumn, InitialRateColumn, ContractSizeColumn, SubmissionSheetDetailIDColumn, WorkflowIDColumn}
+		mutableColumns                = postgres.ColumnList{LoanPackageOfferIDColumn, ScoreGroupInterestIDColumn, LimitAmountColumn, LoanRateColumn, InterestRateColumn, StatusColumn, LoanIDColumn, CancelledByColumn, CancelledAtColumn, TermColumn, FeeRateColumn, CancelledReasonColumn, AssetTypeColumn, InitialRateColumn, ContractSizeColumn, SubmissionSheetDetailIDColumn, WorkflowIDColumn}
 	)
 
 	return loanPackageOfferInterestTable{
@@ -122,6 +124,7 @@ func newLoanPackageOfferInterestTableImpl(schemaName, tableName, alias string) l
 		InitialRate:             InitialRateColumn,
 		ContractSize:            ContractSizeColumn,
 		SubmissionSheetDetailID: SubmissionSheetDetailIDColumn,
+		WorkflowID:              WorkflowIDColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -22,6 +22,7 @@ type loanRequestSchedulerConfigTable struct {
 	AffectedFrom    postgres.ColumnTimestamp
 	CreatedAt       postgres.ColumnTimestamp
 	UpdatedAt       postgres.ColumnTimestamp
+	Rules           postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -67,8 +68,9 @@ func newLoanRequestSchedulerConfigTableImpl(schemaName, tableName, alias string)
 		AffectedFromColumn    = postgres.TimestampColumn("affected_from")
 		CreatedAtColumn       = postgres.TimestampColumn("created_at")
 		UpdatedAtColumn       = postgres.TimestampColumn("updated_at")
-		allColumns            = postgres.ColumnList{IDColumn, MaximumLoanRateColumn, AffectedFromColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns        = postgres.ColumnList{MaximumLoanRateColumn, AffectedFromColumn}
+		RulesColumn           = postgres.StringColumn("rules")
+		allColumns            = postgres.ColumnList{IDColumn, MaximumLoanRateColumn, AffectedFromColumn, CreatedAtColumn, UpdatedAtColumn, RulesColumn}
+		mutableColumns        = postgres.ColumnList{MaximumLoanRateColumn, AffectedFromColumn, RulesColumn}
 	)
 
 	return loanRequestSchedulerConfigTable{
@@ -80,6 +82,7 @@ func newLoanRequestSchedulerConfigTableImpl(schemaName, tableName, alias string)
 		AffectedFrom:    AffectedFromColumn,
 		CreatedAt:       CreatedAtColumn,
 		UpdatedAt:       UpdatedAtColumn,
+		Rules:           RulesColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -22,6 +22,7 @@ type schedulerJobTable struct {
 	JobStatus    postgres.ColumnString
 	TriggerBy    postgres.ColumnString
 	TrackingData postgres.ColumnString
+	ScheduledAt  postgres.ColumnTimestamp
 	CreatedAt    postgres.ColumnTimestamp
 	UpdatedAt    postgres.ColumnTimestamp
 
@@ -69,10 +70,11 @@ func newSchedulerJobTableImpl(schemaName, tableName, alias string) schedulerJobT
 		JobStatusColumn    = postgres.StringColumn("job_status")
 		TriggerByColumn    = postgres.StringColumn("trigger_by")
 		TrackingDataColumn = postgres.StringColumn("tracking_data")
+		ScheduledAtColumn  = postgres.TimestampColumn("scheduled_at")
 		CreatedAtColumn    = postgres.TimestampColumn("created_at")
 		UpdatedAtColumn    = postgres.TimestampColumn("updated_at")
-		allColumns         = postgres.ColumnList{IDColumn, JobTypeColumn, JobStatusColumn, TriggerByColumn, TrackingDataColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns     = postgres.ColumnList{JobTypeColumn, JobStatusColumn, TriggerByColumn, TrackingDataColumn}
+		allColumns         = postgres.ColumnList{IDColumn, JobTypeColumn, JobStatusColumn, TriggerByColumn, TrackingDataColumn, ScheduledAtColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns     = postgres.ColumnList{JobTypeColumn, JobStatusColumn, TriggerByColumn, TrackingDataColumn, ScheduledAtColumn}
 	)
 
 	return schedulerJobTable{
@@ -84,6 +86,7 @@ func newSchedulerJobTableImpl(schemaName, tableName, alias string) schedulerJobT
 		JobStatus:    JobStatusColumn,
 		TriggerBy:    TriggerByColumn,
 		TrackingData: TrackingDataColumn,
+		ScheduledAt:  ScheduledAtColumn,
 		CreatedAt:    CreatedAtColumn,
 		UpdatedAt:    UpdatedAtColumn,
 
@@ -17,12 +17,19 @@ type suggestedOfferTable struct {
 	postgres.Table
 
 	// Columns
-	ID        postgres.ColumnInteger
-	ConfigID  postgres.ColumnInteger
-	AccountNo postgres.ColumnString
-	Symbols   postgres.ColumnString
-	CreatedAt postgres.ColumnTimestamp
-	UpdatedAt postgres.ColumnTimestamp
+	ID          postgres.ColumnInteger
+	ConfigID    postgres.ColumnInteger
+	AccountNo   postgres.ColumnString
+	Symbols     postgres.ColumnString
+	CreatedAt   postgres.ColumnTimestamp
+	UpdatedAt   postgres.ColumnTimestamp
+	InvestorID  postgres.ColumnString
+	Status      postgres.ColumnString
+	Note        postgres.ColumnString
+	RespondedBy postgres.ColumnString
+	RespondedAt postgres.ColumnTimestamp
+	ConvertedAt postgres.ColumnTimestamp
+	CustodyCode postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -63,26 +70,40 @@ func newSuggestedOfferTable(schemaName, tableName, alias string) *SuggestedOffer
 
 func newSuggestedOfferTableImpl(schemaName, tableName, alias string) suggestedOfferTable {
 	var (
-		IDColumn        = postgres.IntegerColumn("id")
-		ConfigIDColumn  = postgres.IntegerColumn("config_id")
-		AccountNoColumn = postgres.StringColumn("account_no")
-		SymbolsColumn   = postgres.StringColumn("symbols")
-		CreatedAtColumn = postgres.TimestampColumn("created_at")
-		UpdatedAtColumn = postgres.TimestampColumn("updated_at")
-		allColumns      = postgres.ColumnList{IDColumn, ConfigIDColumn, AccountNoColumn, SymbolsColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns  = postgres.ColumnList{ConfigIDColumn, AccountNoColumn, SymbolsColumn}
+		IDColumn          = postgres.IntegerColumn("id")
+		ConfigIDColumn    = postgres.IntegerColumn("config_id")
+		AccountNoColumn   = postgres.StringColumn("account_no")
+		SymbolsColumn     = postgres.StringColumn("symbols")
+		CreatedAtColumn   = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn   = postgres.TimestampColumn("updated_at")
+		InvestorIDColumn  = postgres.StringColumn("investor_id")
+		StatusColumn      = postgres.StringColumn("status")
+		NoteColumn        = postgres.StringColumn("note")
+		RespondedByColumn = postgres.StringColumn("responded_by")
+		RespondedAtColumn = postgres.TimestampColumn("responded_at")
+		ConvertedAtColumn = postgres.TimestampColumn("converted_at")
+		CustodyCodeColumn = postgres.StringColumn("custody_code")
+		allColumns        = postgres.ColumnList{IDColumn, ConfigIDColumn, AccountNoColumn, SymbolsColumn, CreatedAtColumn, UpdatedAtColumn, InvestorIDColumn, StatusColumn, NoteColumn, RespondedByColumn, RespondedAtColumn, ConvertedAtColumn, CustodyCodeColumn}
+		mutableColumns    = postgres.ColumnList{ConfigIDColumn, AccountNoColumn, SymbolsColumn, InvestorIDColumn, StatusColumn, NoteColumn, RespondedByColumn, RespondedAtColumn, ConvertedAtColumn, CustodyCodeColumn}
 	)
 
 	return suggestedOfferTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:        IDColumn,
-		ConfigID:  ConfigIDColumn,
-		AccountNo: AccountNoColumn,
-		Symbols:   SymbolsColumn,
-		CreatedAt: CreatedAtColumn,
-		UpdatedAt: UpdatedAtColumn,
+		ID:          IDColumn,
+		ConfigID:    ConfigIDColumn,
+		AccountNo:   AccountNoColumn,
+		Symbols:     SymbolsColumn,
+		CreatedAt:   CreatedAtColumn,
+		UpdatedAt:   UpdatedAtColumn,
+		InvestorID:  InvestorIDColumn,
+		Status:      StatusColumn,
+		Note:        NoteColumn,
+		RespondedBy: RespondedByColumn,
+		RespondedAt: RespondedAtColumn,
+		ConvertedAt: ConvertedAtColumn,
+		CustodyCode: CustodyCodeColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -10,27 +10,42 @@ package table
 // UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
+	ApprovalDelegation = ApprovalDelegation.FromSchema(schema)
 	BlacklistSymbol = BlacklistSymbol.FromSchema(schema)
+	CacheEntry = CacheEntry.FromSchema(schema)
+	DbEventLog = DbEventLog.FromSchema(schema)
+	FeatureFlag = FeatureFlag.FromSchema(schema)
 	FinancialConfiguration = FinancialConfiguration.FromSchema(schema)
+	IdempotencyRecord = IdempotencyRecord.FromSchema(schema)
 	Investor = Investor.FromSchema(schema)
 	InvestorAccount = InvestorAccount.FromSchema(schema)
 	LoanContract = LoanContract.FromSchema(schema)
 	LoanPackageOffer = LoanPackageOffer.FromSchema(schema)
 	LoanPackageOfferInterest = LoanPackageOfferInterest.FromSchema(schema)
 	LoanPackageRequest = LoanPackageRequest.FromSchema(schema)
+	LoanPackageRequestStatusHistory = LoanPackageRequestStatusHistory.FromSchema(schema)
 	LoanPolicyTemplate = LoanPolicyTemplate.FromSchema(schema)
 	LoanRequestSchedulerConfig = LoanRequestSchedulerConfig.FromSchema(schema)
 	LoggedRequest = LoggedRequest.FromSchema(schema)
+	NotificationTemplate = NotificationTemplate.FromSchema(schema)
+	OdooLoanApproval = OdooLoanApproval.FromSchema(schema)
 	OfflineOfferUpdate = OfflineOfferUpdate.FromSchema(schema)
+	OutboxMessage = OutboxMessage.FromSchema(schema)
 	PromotionCampaign = PromotionCampaign.FromSchema(schema)
+	RateLimitBucket = RateLimitBucket.FromSchema(schema)
 	SchedulerJob = SchedulerJob.FromSchema(schema)
 	ScoreGroup = ScoreGroup.FromSchema(schema)
 	ScoreGroupInterest = ScoreGroupInterest.FromSchema(schema)
 	StockExchange = StockExchange.FromSchema(schema)
+	SubmissionSheetApproval = SubmissionSheetApproval.FromSchema(schema)
 	SubmissionSheetDetail = SubmissionSheetDetail.FromSchema(schema)
 	SubmissionSheetMetadata = SubmissionSheetMetadata.FromSchema(schema)
 	SuggestedOffer = SuggestedOffer.FromSchema(schema)
 	SuggestedOfferConfig = SuggestedOfferConfig.FromSchema(schema)
+	SuggestedOfferConversion = SuggestedOfferConversion.FromSchema(schema)
 	Symbol = Symbol.FromSchema(schema)
 	SymbolScore = SymbolScore.FromSchema(schema)
+	TradingCalendarDay = TradingCalendarDay.FromSchema(schema)
+	WebhookDelivery = WebhookDelivery.FromSchema(schema)
+	WebhookSubscription = WebhookSubscription.FromSchema(schema)
 }
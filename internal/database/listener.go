@@ -22,7 +22,13 @@ type DbListener struct {
 	errorReporter apperrors.Service
 }
 
-func (l *DbListener) Listen(ctx context.Context, channel string, callback func(ctx context.Context, data string) error) error {
+// Listen calls callback with the payload of every notification on channel until ctx is cancelled.
+// Notifications sent while the connection was lost are not delivered, onReconnect is called once it is restored
+// so the caller can catch up
+func (l *DbListener) Listen(
+	ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+	onReconnect func(ctx context.Context) error,
+) error {
 	listener := pq.NewListener(l.dsn, 10*time.Second, time.Minute, nil)
 	if err := listener.Listen(channel); err != nil {
 		return err
@@ -38,31 +44,45 @@ func (l *DbListener) Listen(ctx context.Context, channel string, callback func(c
 			select {
 			case <-ctx.Done():
 				return
-			case n := <-listener.Notify:
-				l.logger.Info("received db event", slog.String("event", n.Extra))
+			case n, ok := <-listener.Notify:
+				if !ok {
+					return
+				}
+				// lib/pq sends nil once the connection is re-established
 				if n == nil {
+					l.logger.Warn("db listener reconnected", slog.String("channel", channel))
+					l.handle(ctx, onReconnect)
 					continue
 				}
-				l.wg.Add(1)
-				go func() {
-					defer l.wg.Done()
-					defer func() {
-						if r := recover(); r != nil {
-							l.logger.Error("panic recovered from db listener", slog.Any("panic", r))
-							_ = l.errorReporter.NotifyError(ctx, fmt.Errorf("panic recovered from db listener"))
-						}
-					}()
-					if err := callback(context.Background(), n.Extra); err != nil {
-						l.logger.Error("error while handling db event", slog.String("error", err.Error()))
-						_ = l.errorReporter.NotifyError(ctx, err)
-					}
-				}()
+				l.logger.Info("received db event", slog.String("event", n.Extra))
+				l.handle(
+					ctx, func(ctx context.Context) error {
+						return callback(ctx, n.Extra)
+					},
+				)
 			}
 		}
 	}()
 	return nil
 }
 
+func (l *DbListener) handle(ctx context.Context, f func(ctx context.Context) error) {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				l.logger.Error("panic recovered from db listener", slog.Any("panic", r))
+				_ = l.errorReporter.NotifyError(ctx, fmt.Errorf("panic recovered from db listener"))
+			}
+		}()
+		if err := f(context.Background()); err != nil {
+			l.logger.Error("error while handling db event", slog.String("error", err.Error()))
+			_ = l.errorReporter.NotifyError(ctx, err)
+		}
+	}()
+}
+
 func NewListener(logger *slog.Logger, cfg config.DbConfig, tasks *shutdown.Tasks, errorReporter apperrors.Service) *DbListener {
 	completeDsn := fmt.Sprintf(
 		"postgres://%s:%s@%s:%s/%s?binary_parameters=yes", cfg.User, cfg.Password,
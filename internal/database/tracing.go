@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "financing-offer/internal/database"
+
+var _ DB = tracedDB{}
+
+// tracedDB records a span for every statement run with a context, the statements run without one have no trace to join
+type tracedDB struct {
+	DB
+	tracer trace.Tracer
+}
+
+func traceDB(db DB) DB {
+	return tracedDB{DB: db, tracer: otel.Tracer(tracerName)}
+}
+
+func (db tracedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := db.start(ctx, query)
+	defer span.End()
+	res, err := db.DB.ExecContext(ctx, query, args...)
+	recordError(span, err)
+	return res, err
+}
+
+func (db tracedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := db.start(ctx, query)
+	defer span.End()
+	rows, err := db.DB.QueryContext(ctx, query, args...)
+	recordError(span, err)
+	return rows, err
+}
+
+func (db tracedDB) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation := statementOperation(query)
+	return db.tracer.Start(
+		ctx, "postgres "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperation(operation), semconv.DBStatement(query)),
+	)
+}
+
+// statementOperation is the leading keyword of the statement, WITH for a statement starting with a common table expression
+func statementOperation(query string) string {
+	keyword, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	return strings.ToUpper(keyword)
+}
+
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
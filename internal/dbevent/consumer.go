@@ -0,0 +1,218 @@
+package dbevent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/atomicity"
+	"financing-offer/internal/config"
+	"financing-offer/internal/dbevent/event"
+	"financing-offer/internal/dbevent/repository"
+)
+
+const Channel = "db_event"
+
+type Listener interface {
+	Listen(
+		ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+		onReconnect func(ctx context.Context) error,
+	) error
+}
+
+// Consumer turns db_event notifications into change events.
+// Every change is kept in db_event_log, so notifications missed while disconnected are replayed from there.
+// The events are handled one at a time by a single worker in seq order, a notification only wakes it up
+type Consumer struct {
+	cfg            config.CdcConfig
+	logger         *slog.Logger
+	listener       Listener
+	repository     repository.DbEventLogRepository
+	atomicExecutor atomicity.AtomicExecutor
+	errorService   apperrors.Service
+	handlers       map[string]Handler
+	wakeup         chan struct{}
+	reconnected    atomic.Bool
+	// lastSeq is the last seq processed by the worker, it is only accessed from the worker
+	lastSeq int64
+}
+
+func NewConsumer(
+	cfg config.CdcConfig,
+	logger *slog.Logger,
+	listener Listener,
+	repository repository.DbEventLogRepository,
+	atomicExecutor atomicity.AtomicExecutor,
+	errorService apperrors.Service,
+	handlers ...Handler,
+) *Consumer {
+	handlerByTable := make(map[string]Handler, len(handlers))
+	for _, handler := range handlers {
+		handlerByTable[handler.Table()] = handler
+	}
+	return &Consumer{
+		cfg:            cfg,
+		logger:         logger,
+		listener:       listener,
+		repository:     repository,
+		atomicExecutor: atomicExecutor,
+		errorService:   errorService,
+		handlers:       handlerByTable,
+		wakeup:         make(chan struct{}, 1),
+	}
+}
+
+// Start subscribes to db_event and runs the worker, which replays the backlog then handles the notified events
+// and keeps replaying and cleaning up the log until ctx is cancelled
+func (c *Consumer) Start(ctx context.Context) error {
+	if err := c.Listen(ctx); err != nil {
+		return fmt.Errorf("Consumer Start: %w", err)
+	}
+	go c.run(ctx)
+	return nil
+}
+
+func (c *Consumer) Listen(ctx context.Context) error {
+	return c.listener.Listen(ctx, Channel, c.OnNotify, c.OnReconnect)
+}
+
+// OnNotify wakes the worker up, which processes every event after the last one it processed including the notified one
+func (c *Consumer) OnNotify(_ context.Context, data string) error {
+	notification := event.DbEvent{}
+	if err := json.Unmarshal([]byte(data), &notification); err != nil {
+		return fmt.Errorf("Consumer OnNotify: %w", err)
+	}
+	c.wake()
+	return nil
+}
+
+// OnReconnect has the worker replay the whole backlog, the notifications sent while disconnected are lost
+func (c *Consumer) OnReconnect(_ context.Context) error {
+	c.reconnected.Store(true)
+	c.wake()
+	return nil
+}
+
+func (c *Consumer) wake() {
+	select {
+	case c.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (c *Consumer) run(ctx context.Context) {
+	if _, err := c.Replay(ctx, time.Now()); err != nil {
+		c.notifyError(ctx, err)
+	}
+	ticker := time.NewTicker(c.cfg.ReplayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.wakeup:
+			if err := c.drain(ctx); err != nil {
+				c.notifyError(ctx, err)
+			}
+		case <-ticker.C:
+			c.maintain(ctx)
+		}
+	}
+}
+
+// drain processes the events after the last processed one, or the whole backlog after a reconnect
+func (c *Consumer) drain(ctx context.Context) error {
+	if c.reconnected.Swap(false) {
+		replayed, err := c.Replay(ctx, time.Now())
+		if replayed > 0 {
+			c.logger.Warn("replayed db events missed while reconnecting", slog.Int("count", replayed))
+		}
+		return err
+	}
+	_, err := c.replay(ctx, c.lastSeq, time.Now())
+	return err
+}
+
+// Replay processes every unprocessed event created before createdBefore in seq order and returns how many were handled
+func (c *Consumer) Replay(ctx context.Context, createdBefore time.Time) (int, error) {
+	return c.replay(ctx, 0, createdBefore)
+}
+
+func (c *Consumer) replay(ctx context.Context, afterSeq int64, createdBefore time.Time) (int, error) {
+	var (
+		processed int
+		errs      []error
+	)
+	for ctx.Err() == nil {
+		seqs, err := c.repository.GetUnprocessedSeqs(ctx, afterSeq, createdBefore, c.cfg.BatchSize)
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		for _, seq := range seqs {
+			if seq > c.lastSeq {
+				c.lastSeq = seq
+			}
+			if err := c.process(ctx, seq); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			processed++
+		}
+		if int64(len(seqs)) < c.cfg.BatchSize {
+			break
+		}
+		afterSeq = seqs[len(seqs)-1]
+	}
+	if len(errs) > 0 {
+		return processed, fmt.Errorf("Consumer Replay: %w", errors.Join(errs...))
+	}
+	return processed, nil
+}
+
+// process claims and handles a single event, events already claimed by another replay are skipped
+func (c *Consumer) process(ctx context.Context, seq int64) error {
+	return c.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			dbEvent, err := c.repository.Claim(tc, seq)
+			if err != nil {
+				if apperrors.IsNotFoundError(err) {
+					return nil
+				}
+				return fmt.Errorf("Consumer process %d: %w", seq, err)
+			}
+			handler, ok := c.handlers[dbEvent.CollectionName]
+			if !ok {
+				return nil
+			}
+			if err := handler.Handle(tc, dbEvent); err != nil {
+				return fmt.Errorf("Consumer process %d: %w", seq, err)
+			}
+			return nil
+		},
+	)
+}
+
+func (c *Consumer) maintain(ctx context.Context) {
+	// recent events are left to their own notification
+	if replayed, err := c.Replay(ctx, time.Now().Add(-c.cfg.ReplayDelay)); err != nil {
+		c.notifyError(ctx, err)
+	} else if replayed > 0 {
+		c.logger.Warn("replayed missed db events", slog.Int("count", replayed))
+	}
+	if _, err := c.repository.DeleteProcessedBefore(ctx, time.Now().Add(-c.cfg.Retention)); err != nil {
+		c.notifyError(ctx, err)
+	}
+}
+
+func (c *Consumer) notifyError(ctx context.Context, err error) {
+	c.logger.Error("Consumer", slog.String("error", err.Error()))
+	if notifyErr := c.errorService.NotifyError(ctx, err); notifyErr != nil {
+		c.logger.Error("Consumer NotifyError", slog.String("error", notifyErr.Error()))
+	}
+}
@@ -0,0 +1,294 @@
+package dbevent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	symbolPostgres "financing-offer/internal/core/symbol/repository/postgres"
+	"financing-offer/internal/dbevent/event"
+	"financing-offer/test/mock"
+)
+
+func TestConsumer(t *testing.T) {
+	t.Parallel()
+	cfg := config.CdcConfig{
+		TopicPrefix: "cdc",
+		BatchSize:   2,
+	}
+	symbolEvent := func(seq int64) event.DbEvent {
+		return event.DbEvent{
+			ID:             uuid.New(),
+			Seq:            seq,
+			Timestamp:      time.Now(),
+			Action:         "update",
+			DbSchema:       "public",
+			CollectionName: "symbol",
+			Record:         json.RawMessage(`{"id": 7, "symbol": "HPG", "status": "ACTIVE", "stock_exchange_id": 1}`),
+			Old:            json.RawMessage(`{"id": 7, "symbol": "HPG", "status": "INACTIVE", "stock_exchange_id": 1}`),
+		}
+	}
+	newConsumer := func(t *testing.T) (*Consumer, *mock.MockDbEventLogRepository, *mock.MockPublisher) {
+		repository := mock.NewMockDbEventLogRepository(t)
+		publisher := mock.NewMockPublisher(t)
+		consumer := NewConsumer(
+			cfg,
+			slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			nil,
+			repository,
+			mock.NewMockAtomicExecutorExecutePassthrough(t),
+			mock.ErrReporter{},
+			NewTableHandler(
+				"symbol", cfg.TopicPrefix, publisher, symbolPostgres.MapSymbolDbToEntity,
+				func(e entity.Symbol) string { return strconv.FormatInt(e.Id, 10) },
+			),
+		)
+		return consumer, repository, publisher
+	}
+
+	t.Run(
+		"drain_publish_change_event", func(t *testing.T) {
+			consumer, repository, publisher := newConsumer(t)
+			dbEvent := symbolEvent(1)
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(0), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{1}, nil)
+			repository.EXPECT().Claim(testifyMock.Anything, int64(1)).Return(dbEvent, nil)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).RunAndReturn(
+				func(_ context.Context, message kafka.Message) error {
+					assert.Equal(t, "cdc.symbol", message.Topic)
+					assert.Equal(t, []byte("7"), message.Key)
+					changeEvent := ChangeEvent[entity.Symbol]{}
+					assert.Nil(t, json.Unmarshal(message.Value, &changeEvent))
+					assert.Equal(t, dbEvent.ID, changeEvent.Id)
+					assert.Equal(t, "update", changeEvent.Action)
+					assert.Equal(t, entity.SymbolStatusActive, changeEvent.Record.Status)
+					assert.Equal(t, entity.SymbolStatusInactive, changeEvent.Old.Status)
+					return nil
+				},
+			)
+			assert.Nil(t, consumer.drain(context.Background()))
+		},
+	)
+
+	t.Run(
+		"drain_already_processed", func(t *testing.T) {
+			consumer, repository, _ := newConsumer(t)
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(0), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{1}, nil)
+			repository.EXPECT().Claim(testifyMock.Anything, int64(1)).Return(event.DbEvent{}, qrm.ErrNoRows)
+			assert.Nil(t, consumer.drain(context.Background()))
+		},
+	)
+
+	t.Run(
+		"drain_unknown_table", func(t *testing.T) {
+			consumer, repository, _ := newConsumer(t)
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(0), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{1}, nil)
+			repository.EXPECT().Claim(testifyMock.Anything, int64(1)).Return(
+				event.DbEvent{Seq: 1, CollectionName: "investor"}, nil,
+			)
+			assert.Nil(t, consumer.drain(context.Background()))
+		},
+	)
+
+	t.Run(
+		"drain_from_last_processed_seq", func(t *testing.T) {
+			consumer, repository, publisher := newConsumer(t)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(nil).Times(3)
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(0), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{1}, nil).Once()
+			repository.EXPECT().Claim(testifyMock.Anything, int64(1)).Return(symbolEvent(1), nil).Once()
+			assert.Nil(t, consumer.drain(context.Background()))
+
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(1), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{2, 3}, nil).Once()
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(3), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{4}, nil).Once()
+			repository.EXPECT().Claim(testifyMock.Anything, int64(2)).Return(symbolEvent(2), nil).Once()
+			repository.EXPECT().Claim(testifyMock.Anything, int64(3)).Return(symbolEvent(3), nil).Once()
+			repository.EXPECT().Claim(testifyMock.Anything, int64(4)).Return(event.DbEvent{}, qrm.ErrNoRows).Once()
+			assert.Nil(t, consumer.drain(context.Background()))
+			assert.Equal(t, int64(4), consumer.lastSeq)
+		},
+	)
+
+	t.Run(
+		"drain_after_reconnect_replays_backlog", func(t *testing.T) {
+			consumer, repository, publisher := newConsumer(t)
+			consumer.lastSeq = 5
+			assert.Nil(t, consumer.OnReconnect(context.Background()))
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(0), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{3}, nil).Once()
+			repository.EXPECT().Claim(testifyMock.Anything, int64(3)).Return(symbolEvent(3), nil).Once()
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(nil).Once()
+			assert.Nil(t, consumer.drain(context.Background()))
+
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(5), testifyMock.Anything, cfg.BatchSize).
+				Return(nil, nil).Once()
+			assert.Nil(t, consumer.drain(context.Background()))
+		},
+	)
+
+	t.Run(
+		"drain_handler_error", func(t *testing.T) {
+			consumer, repository, publisher := newConsumer(t)
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(0), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{1}, nil)
+			repository.EXPECT().Claim(testifyMock.Anything, int64(1)).Return(symbolEvent(1), nil)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(errors.New("error"))
+			err := consumer.drain(context.Background())
+			assert.Equal(t, "Consumer Replay: Consumer process 1: TableHandler Handle symbol publish: error", err.Error())
+		},
+	)
+
+	t.Run(
+		"OnNotify_invalid_payload", func(t *testing.T) {
+			consumer, _, _ := newConsumer(t)
+			assert.NotNil(t, consumer.OnNotify(context.Background(), `{"seq": "1"`))
+		},
+	)
+
+	t.Run(
+		"concurrent_notifications_published_in_seq_order", func(t *testing.T) {
+			const count = 50
+			var (
+				mu          sync.Mutex
+				nextSeq     int64 = 1
+				unprocessed []int64
+				published   []string
+				onNotify    func(ctx context.Context, data string) error
+			)
+			repository := mock.NewMockDbEventLogRepository(t)
+			publisher := mock.NewMockPublisher(t)
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything, cfg.BatchSize).
+				RunAndReturn(
+					func(_ context.Context, afterSeq int64, _ time.Time, limit int64) ([]int64, error) {
+						mu.Lock()
+						defer mu.Unlock()
+						seqs := make([]int64, 0)
+						for _, seq := range unprocessed {
+							if seq > afterSeq && int64(len(seqs)) < limit {
+								seqs = append(seqs, seq)
+							}
+						}
+						return seqs, nil
+					},
+				)
+			repository.EXPECT().Claim(testifyMock.Anything, testifyMock.Anything).RunAndReturn(
+				func(_ context.Context, seq int64) (event.DbEvent, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					for i, unprocessedSeq := range unprocessed {
+						if unprocessedSeq == seq {
+							unprocessed = append(unprocessed[:i], unprocessed[i+1:]...)
+							dbEvent := symbolEvent(seq)
+							dbEvent.Record = json.RawMessage(fmt.Sprintf(`{"id": %d, "symbol": "HPG"}`, seq))
+							return dbEvent, nil
+						}
+					}
+					return event.DbEvent{}, qrm.ErrNoRows
+				},
+			)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).RunAndReturn(
+				func(_ context.Context, message kafka.Message) error {
+					mu.Lock()
+					defer mu.Unlock()
+					published = append(published, string(message.Key))
+					return nil
+				},
+			)
+			consumer := NewConsumer(
+				config.CdcConfig{TopicPrefix: cfg.TopicPrefix, BatchSize: cfg.BatchSize, ReplayInterval: time.Hour},
+				slog.New(slog.NewJSONHandler(io.Discard, nil)),
+				listenerFunc(
+					func(_ context.Context, _ string, callback func(ctx context.Context, data string) error, _ func(ctx context.Context) error) error {
+						onNotify = callback
+						return nil
+					},
+				),
+				repository,
+				mock.NewMockAtomicExecutorExecutePassthrough(t),
+				mock.ErrReporter{},
+				NewTableHandler(
+					"symbol", cfg.TopicPrefix, publisher, symbolPostgres.MapSymbolDbToEntity,
+					func(e entity.Symbol) string { return strconv.FormatInt(e.Id, 10) },
+				),
+			)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			assert.Nil(t, consumer.Start(ctx))
+
+			var wg sync.WaitGroup
+			for i := 0; i < count; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					mu.Lock()
+					seq := nextSeq
+					nextSeq++
+					unprocessed = append(unprocessed, seq)
+					mu.Unlock()
+					assert.Nil(t, onNotify(ctx, fmt.Sprintf(`{"seq": %d, "table": "symbol"}`, seq)))
+				}()
+			}
+			wg.Wait()
+
+			expected := make([]string, 0, count)
+			for seq := 1; seq <= count; seq++ {
+				expected = append(expected, strconv.Itoa(seq))
+			}
+			assert.Eventually(
+				t, func() bool {
+					mu.Lock()
+					defer mu.Unlock()
+					return len(published) == count
+				}, 5*time.Second, 10*time.Millisecond,
+			)
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, expected, published)
+		},
+	)
+
+	t.Run(
+		"Replay_continue_after_error", func(t *testing.T) {
+			consumer, repository, publisher := newConsumer(t)
+			repository.EXPECT().GetUnprocessedSeqs(testifyMock.Anything, int64(0), testifyMock.Anything, cfg.BatchSize).
+				Return([]int64{1}, nil)
+			repository.EXPECT().Claim(testifyMock.Anything, int64(1)).Return(symbolEvent(1), nil)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(errors.New("error"))
+			replayed, err := consumer.Replay(context.Background(), time.Now())
+			assert.Equal(t, 0, replayed)
+			assert.Equal(t, "Consumer Replay: Consumer process 1: TableHandler Handle symbol publish: error", err.Error())
+		},
+	)
+}
+
+type listenerFunc func(
+	ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+	onReconnect func(ctx context.Context) error,
+) error
+
+func (f listenerFunc) Listen(
+	ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+	onReconnect func(ctx context.Context) error,
+) error {
+	return f(ctx, channel, callback, onReconnect)
+}
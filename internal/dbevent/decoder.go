@@ -0,0 +1,63 @@
+package dbevent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	timestampWithoutZone = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?$`)
+	dateOnly             = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// DecodeRecord decodes a row_to_json payload into a jet model.
+// Column names are snake_case and timestamps carry no zone, so both are normalized before unmarshalling
+func DecodeRecord[M any](raw json.RawMessage) (*M, error) {
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	row := make(map[string]any)
+	if err := decoder.Decode(&row); err != nil {
+		return nil, fmt.Errorf("DecodeRecord: %w", err)
+	}
+	normalized := make(map[string]any, len(row))
+	for column, value := range row {
+		normalized[strings.ReplaceAll(column, "_", "")] = normalizeValue(value)
+	}
+	body, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeRecord: %w", err)
+	}
+	model := new(M)
+	if err := json.Unmarshal(body, model); err != nil {
+		return nil, fmt.Errorf("DecodeRecord: %w", err)
+	}
+	return model, nil
+}
+
+func normalizeValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		if timestampWithoutZone.MatchString(v) {
+			return v + "Z"
+		}
+		if dateOnly.MatchString(v) {
+			return v + "T00:00:00Z"
+		}
+		return v
+	case map[string]any, []any:
+		// json and jsonb columns are mapped to string fields by jet
+		body, err := json.Marshal(v)
+		if err != nil {
+			return value
+		}
+		return string(body)
+	default:
+		return value
+	}
+}
@@ -0,0 +1,74 @@
+package dbevent
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func TestDecodeRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"DecodeRecord_row_to_json", func(t *testing.T) {
+			raw := json.RawMessage(`{
+				"id": 12,
+				"symbol_id": 3,
+				"investor_id": "0001000115",
+				"account_no": "0001000115",
+				"loan_rate": 0.6,
+				"limit_amount": 1000000000,
+				"type": "FLEXIBLE",
+				"status": "PENDING",
+				"created_at": "2024-04-01T09:30:00.123456",
+				"updated_at": "2024-04-01T09:30:00",
+				"guaranteed_duration": 0,
+				"asset_type": "UNDERLYING",
+				"initial_rate": 0.5,
+				"contract_size": 0
+			}`)
+			record, err := DecodeRecord[model.LoanPackageRequest](raw)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(12), record.ID)
+			assert.Equal(t, int64(3), record.SymbolID)
+			assert.Equal(t, "0001000115", record.InvestorID)
+			assert.True(t, decimal.NewFromFloat(0.6).Equal(record.LoanRate))
+			assert.True(t, decimal.NewFromInt(1000000000).Equal(record.LimitAmount))
+			assert.Equal(t, model.AssetType("UNDERLYING"), record.AssetType)
+			assert.Equal(t, time.Date(2024, 4, 1, 9, 30, 0, 123456000, time.UTC), record.CreatedAt)
+		},
+	)
+
+	t.Run(
+		"DecodeRecord_date_and_null", func(t *testing.T) {
+			raw := json.RawMessage(`{"id": 1, "symbol_id": 2, "affected_from": "2024-04-01", "affected_to": null, "status": "ACTIVE"}`)
+			record, err := DecodeRecord[model.BlacklistSymbol](raw)
+			assert.Nil(t, err)
+			assert.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), record.AffectedFrom)
+			assert.False(t, record.AffectedTo.Valid)
+		},
+	)
+
+	t.Run(
+		"DecodeRecord_null_record", func(t *testing.T) {
+			record, err := DecodeRecord[model.Symbol](json.RawMessage("null"))
+			assert.Nil(t, err)
+			assert.Nil(t, record)
+			record, err = DecodeRecord[model.Symbol](nil)
+			assert.Nil(t, err)
+			assert.Nil(t, record)
+		},
+	)
+
+	t.Run(
+		"DecodeRecord_invalid", func(t *testing.T) {
+			_, err := DecodeRecord[model.Symbol](json.RawMessage(`{"id": "abc"}`))
+			assert.NotNil(t, err)
+		},
+	)
+}
@@ -9,6 +9,7 @@ import (
 
 type DbEvent struct {
 	ID             uuid.UUID       `json:"id"`
+	Seq            int64           `json:"seq"`
 	Timestamp      time.Time       `json:"timestamp"`
 	Action         string          `json:"action"`
 	DbSchema       string          `json:"db_schema"`
@@ -0,0 +1,106 @@
+package dbevent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+
+	"financing-offer/internal/dbevent/event"
+	eventPublisher "financing-offer/internal/event"
+)
+
+type Handler interface {
+	Table() string
+	Handle(ctx context.Context, dbEvent event.DbEvent) error
+}
+
+// ChangeEvent is the domain event published for every change of a captured table
+type ChangeEvent[E any] struct {
+	Id        uuid.UUID `json:"id"`
+	Seq       int64     `json:"seq"`
+	Timestamp time.Time `json:"timestamp"`
+	Action    string    `json:"action"`
+	Table     string    `json:"table"`
+	Record    *E        `json:"record"`
+	Old       *E        `json:"old"`
+}
+
+var _ Handler = (*TableHandler[any, any])(nil)
+
+// TableHandler decodes the rows of a table into its jet model M, maps them to the entity E and publishes a ChangeEvent
+type TableHandler[M any, E any] struct {
+	table     string
+	topic     string
+	publisher eventPublisher.Publisher
+	mapper    func(M) E
+	key       func(E) string
+}
+
+func NewTableHandler[M any, E any](
+	table string,
+	topicPrefix string,
+	publisher eventPublisher.Publisher,
+	mapper func(M) E,
+	key func(E) string,
+) *TableHandler[M, E] {
+	return &TableHandler[M, E]{
+		table:     table,
+		topic:     topicPrefix + "." + table,
+		publisher: publisher,
+		mapper:    mapper,
+		key:       key,
+	}
+}
+
+func (h *TableHandler[M, E]) Table() string {
+	return h.table
+}
+
+func (h *TableHandler[M, E]) Handle(ctx context.Context, dbEvent event.DbEvent) error {
+	record, err := h.decode(dbEvent.Record)
+	if err != nil {
+		return fmt.Errorf("TableHandler Handle %s record: %w", h.table, err)
+	}
+	old, err := h.decode(dbEvent.Old)
+	if err != nil {
+		return fmt.Errorf("TableHandler Handle %s old: %w", h.table, err)
+	}
+	changeEvent := ChangeEvent[E]{
+		Id:        dbEvent.ID,
+		Seq:       dbEvent.Seq,
+		Timestamp: dbEvent.Timestamp,
+		Action:    dbEvent.Action,
+		Table:     h.table,
+		Record:    record,
+		Old:       old,
+	}
+	value, err := json.Marshal(changeEvent)
+	if err != nil {
+		return fmt.Errorf("TableHandler Handle %s marshal: %w", h.table, err)
+	}
+	keyEntity := record
+	if keyEntity == nil {
+		keyEntity = old
+	}
+	message := kafka.Message{Topic: h.topic, Value: value}
+	if keyEntity != nil {
+		message.Key = []byte(h.key(*keyEntity))
+	}
+	if err := h.publisher.Publish(ctx, message); err != nil {
+		return fmt.Errorf("TableHandler Handle %s publish: %w", h.table, err)
+	}
+	return nil
+}
+
+func (h *TableHandler[M, E]) decode(raw json.RawMessage) (*E, error) {
+	model, err := DecodeRecord[M](raw)
+	if err != nil || model == nil {
+		return nil, err
+	}
+	entity := h.mapper(*model)
+	return &entity, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financing-offer/internal/dbevent/event"
+)
+
+type DbEventLogRepository interface {
+	// Claim marks the event as processed and returns it, qrm.ErrNoRows is returned when it was already claimed
+	Claim(ctx context.Context, seq int64) (event.DbEvent, error)
+	GetUnprocessedSeqs(ctx context.Context, afterSeq int64, createdBefore time.Time, limit int64) ([]int64, error)
+	DeleteProcessedBefore(ctx context.Context, processedBefore time.Time) (int64, error)
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/internal/dbevent/event"
+	"financing-offer/internal/dbevent/repository"
+)
+
+var _ repository.DbEventLogRepository = (*DbEventLogPostgresRepository)(nil)
+
+type DbEventLogPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewDbEventLogPostgresRepository(getDbFunc database.GetDbFunc) *DbEventLogPostgresRepository {
+	return &DbEventLogPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *DbEventLogPostgresRepository) Claim(ctx context.Context, seq int64) (event.DbEvent, error) {
+	claimed := model.DbEventLog{}
+	err := table.DbEventLog.UPDATE(table.DbEventLog.ProcessedAt).
+		SET(postgres.TimestampT(time.Now())).
+		WHERE(
+			table.DbEventLog.Seq.EQ(postgres.Int64(seq)).
+				AND(table.DbEventLog.ProcessedAt.IS_NULL()),
+		).
+		RETURNING(table.DbEventLog.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &claimed)
+	if err != nil {
+		return event.DbEvent{}, fmt.Errorf("DbEventLogPostgresRepository Claim: %w", err)
+	}
+	return MapDbEventLogDbToEntity(claimed), nil
+}
+
+func (r *DbEventLogPostgresRepository) GetUnprocessedSeqs(ctx context.Context, afterSeq int64, createdBefore time.Time, limit int64) ([]int64, error) {
+	dest := make([]struct {
+		Seq int64 `alias:"db_event_log.seq"`
+	}, 0)
+	err := table.DbEventLog.SELECT(table.DbEventLog.Seq).
+		WHERE(
+			table.DbEventLog.ProcessedAt.IS_NULL().
+				AND(table.DbEventLog.Seq.GT(postgres.Int64(afterSeq))).
+				AND(table.DbEventLog.CreatedAt.LT(postgres.TimestampT(createdBefore))),
+		).
+		ORDER_BY(table.DbEventLog.Seq.ASC()).
+		LIMIT(limit).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return nil, fmt.Errorf("DbEventLogPostgresRepository GetUnprocessedSeqs: %w", err)
+	}
+	seqs := make([]int64, 0, len(dest))
+	for _, row := range dest {
+		seqs = append(seqs, row.Seq)
+	}
+	return seqs, nil
+}
+
+func (r *DbEventLogPostgresRepository) DeleteProcessedBefore(ctx context.Context, processedBefore time.Time) (int64, error) {
+	res, err := table.DbEventLog.DELETE().
+		WHERE(table.DbEventLog.ProcessedAt.LT(postgres.TimestampT(processedBefore))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("DbEventLogPostgresRepository DeleteProcessedBefore: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("DbEventLogPostgresRepository DeleteProcessedBefore: %w", err)
+	}
+	return deleted, nil
+}
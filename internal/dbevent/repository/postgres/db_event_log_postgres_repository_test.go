@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestDbEventLogPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, _ := dbtest.New()
+	repo := NewDbEventLogPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	columns := []string{
+		"db_event_log.seq",
+		"db_event_log.id",
+		"db_event_log.action",
+		"db_event_log.db_schema",
+		"db_event_log.db_table",
+		"db_event_log.record",
+		"db_event_log.old",
+		"db_event_log.processed_at",
+		"db_event_log.created_at",
+	}
+
+	t.Run("ClaimSuccess", func(t *testing.T) {
+		now := time.Now()
+		id := uuid.New()
+		mock.ExpectQuery(`(?s)UPDATE public.db_event_log .+processed_at IS NULL.+RETURNING`).WillReturnRows(
+			mock.NewRows(columns).AddRow(
+				5, id.String(), "insert", "public", "symbol", `{"id": 1}`, nil, now, now,
+			),
+		)
+		dbEvent, err := repo.Claim(context.Background(), 5)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), dbEvent.Seq)
+		assert.Equal(t, id, dbEvent.ID)
+		assert.Equal(t, "symbol", dbEvent.CollectionName)
+		assert.JSONEq(t, `{"id": 1}`, string(dbEvent.Record))
+		assert.Nil(t, dbEvent.Old)
+	})
+
+	t.Run("ClaimAlreadyProcessed", func(t *testing.T) {
+		mock.ExpectQuery("UPDATE public.db_event_log").WillReturnRows(mock.NewRows(columns))
+		_, err := repo.Claim(context.Background(), 5)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("ClaimFailure", func(t *testing.T) {
+		mock.ExpectQuery("UPDATE").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.Claim(context.Background(), 5)
+		assert.Equal(t, "DbEventLogPostgresRepository Claim: jet: error", err.Error())
+	})
+
+	t.Run("GetUnprocessedSeqsSuccess", func(t *testing.T) {
+		mock.ExpectQuery(`(?s)SELECT db_event_log.seq .+ FROM public.db_event_log .+ORDER BY db_event_log.seq ASC`).
+			WillReturnRows(mock.NewRows([]string{"db_event_log.seq"}).AddRow(3).AddRow(4))
+		seqs, err := repo.GetUnprocessedSeqs(context.Background(), 2, time.Now(), 10)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{3, 4}, seqs)
+	})
+
+	t.Run("GetUnprocessedSeqsFailure", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.GetUnprocessedSeqs(context.Background(), 0, time.Now(), 10)
+		assert.Equal(t, "DbEventLogPostgresRepository GetUnprocessedSeqs: jet: error", err.Error())
+	})
+
+	t.Run("DeleteProcessedBeforeSuccess", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM public.db_event_log").WillReturnResult(sqlmock.NewResult(0, 7))
+		deleted, err := repo.DeleteProcessedBefore(context.Background(), time.Now())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(7), deleted)
+	})
+
+	t.Run("DeleteProcessedBeforeFailure", func(t *testing.T) {
+		mock.ExpectExec("DELETE").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.DeleteProcessedBefore(context.Background(), time.Now())
+		assert.Equal(t, "DbEventLogPostgresRepository DeleteProcessedBefore: error", err.Error())
+	})
+}
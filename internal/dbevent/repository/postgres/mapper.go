@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/dbevent/event"
+)
+
+func MapDbEventLogDbToEntity(log model.DbEventLog) event.DbEvent {
+	dbEvent := event.DbEvent{
+		ID:             log.ID,
+		Seq:            log.Seq,
+		Timestamp:      log.CreatedAt,
+		Action:         log.Action,
+		DbSchema:       log.DbSchema,
+		CollectionName: log.DbTable,
+	}
+	if log.Record != nil {
+		dbEvent.Record = json.RawMessage(*log.Record)
+	}
+	if log.Old != nil {
+		dbEvent.Old = json.RawMessage(*log.Old)
+	}
+	return dbEvent
+}
@@ -7,9 +7,11 @@ import (
 	"financing-offer/internal/core/promotion_campaign"
 	"financing-offer/internal/core/submission_default"
 	"log/slog"
+	"strconv"
 
 	"github.com/samber/do"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
 
 	"financing-offer/internal/apperrors"
 	"financing-offer/internal/apperrors/repository"
@@ -18,6 +20,10 @@ import (
 	configRepo "financing-offer/internal/config/repository"
 	configPostgres "financing-offer/internal/config/repository/postgres"
 	configHttp "financing-offer/internal/config/transport/http"
+	"financing-offer/internal/core/audit"
+	auditRepo "financing-offer/internal/core/audit/repository"
+	auditPostgres "financing-offer/internal/core/audit/repository/postgres"
+	auditHttp "financing-offer/internal/core/audit/transport/http"
 	awaitingconfirmrequest "financing-offer/internal/core/awaiting_confirm_request"
 	awaitingConfirmRequestRepo "financing-offer/internal/core/awaiting_confirm_request/repository"
 	awaitingConfirmRequestPostgres "financing-offer/internal/core/awaiting_confirm_request/repository/postgres"
@@ -30,19 +36,30 @@ import (
 	combinedRequestPostgres "financing-offer/internal/core/combined_loan_request/repository/postgres"
 	combinedRequestHttp "financing-offer/internal/core/combined_loan_request/transport/http"
 	configurationHttp "financing-offer/internal/core/configuration/transport/http"
+	"financing-offer/internal/core/entity"
 	financialProductDomain "financing-offer/internal/core/financialproduct"
 	financialProductRepo "financing-offer/internal/core/financialproduct/repository"
 	financialProductHttp "financing-offer/internal/core/financialproduct/transport/http"
 	financingApiRepository "financing-offer/internal/core/financing/repository"
 	flexOpenApiRepo "financing-offer/internal/core/flex/repository"
+	"financing-offer/internal/core/idempotency"
+	idempotencyRepo "financing-offer/internal/core/idempotency/repository"
+	idempotencyPostgres "financing-offer/internal/core/idempotency/repository/postgres"
+	idempotencyScheduler "financing-offer/internal/core/idempotency/transport/scheduler"
 	"financing-offer/internal/core/investor"
 	investorRepo "financing-offer/internal/core/investor/repository"
 	investorPostgres "financing-offer/internal/core/investor/repository/postgres"
 	investorHttp "financing-offer/internal/core/investor/transport/http"
+	investorScheduler "financing-offer/internal/core/investor/transport/scheduler"
 	"financing-offer/internal/core/investor_account"
 	investorAccountRepo "financing-offer/internal/core/investor_account/repository"
 	investorAccountPostgres "financing-offer/internal/core/investor_account/repository/postgres"
+	investorAccountConsumer "financing-offer/internal/core/investor_account/transport/consumer"
 	investorAccountHttp "financing-offer/internal/core/investor_account/transport/http"
+	"financing-offer/internal/core/lifecycle"
+	lifecycleRepo "financing-offer/internal/core/lifecycle/repository"
+	lifecycleTemporal "financing-offer/internal/core/lifecycle/repository/temporal"
+	lifecycleWorker "financing-offer/internal/core/lifecycle/transport/worker"
 	"financing-offer/internal/core/loancontract"
 	loanContractPostgres "financing-offer/internal/core/loancontract/repository/postgres"
 	loanContractHttp "financing-offer/internal/core/loancontract/transport/http"
@@ -51,10 +68,12 @@ import (
 	loanOfferHttp "financing-offer/internal/core/loanoffer/transport/http"
 	loanOfferScheduler "financing-offer/internal/core/loanoffer/transport/scheduler"
 	"financing-offer/internal/core/loanofferinterest"
+	loanOfferInterestConsumer "financing-offer/internal/core/loanofferinterest/consumer"
 	loanOfferInterestHttp "financing-offer/internal/core/loanofferinterest/http"
 	loanOfferInterestRepo "financing-offer/internal/core/loanofferinterest/repository"
 	loanOfferInterestKafka "financing-offer/internal/core/loanofferinterest/repository/kafka"
 	loanPackageOfferInterestPostgres "financing-offer/internal/core/loanofferinterest/repository/postgres"
+	loanOfferInterestScheduler "financing-offer/internal/core/loanofferinterest/scheduler"
 	"financing-offer/internal/core/loanpackagerequest"
 	loanPackageRequestRepo "financing-offer/internal/core/loanpackagerequest/repository"
 	loanRequestKafka "financing-offer/internal/core/loanpackagerequest/repository/kafka"
@@ -65,11 +84,20 @@ import (
 	loanPolicyTemplatePostgres "financing-offer/internal/core/loanpolicytemplate/repository/postgres"
 	loanPolicyTemplateHttp "financing-offer/internal/core/loanpolicytemplate/transport/http"
 	marginOperationRepo "financing-offer/internal/core/marginoperation/repository"
+	"financing-offer/internal/core/notificationtemplate"
+	notificationTemplateRepo "financing-offer/internal/core/notificationtemplate/repository"
+	notificationTemplatePostgres "financing-offer/internal/core/notificationtemplate/repository/postgres"
+	notificationTemplateHttp "financing-offer/internal/core/notificationtemplate/transport/http"
 	odooServiceRepo "financing-offer/internal/core/odoo_service/repository"
+	odooServicePostgres "financing-offer/internal/core/odoo_service/repository/postgres"
 	offlineofferupdate "financing-offer/internal/core/offline_offer_update"
 	offlineOfferRepo "financing-offer/internal/core/offline_offer_update/repository"
 	offlineOfferPosgres "financing-offer/internal/core/offline_offer_update/repository/postgres"
 	orderServiceRepo "financing-offer/internal/core/orderservice/repository"
+	"financing-offer/internal/core/outbox"
+	outboxRepo "financing-offer/internal/core/outbox/repository"
+	outboxPostgres "financing-offer/internal/core/outbox/repository/postgres"
+	outboxWorker "financing-offer/internal/core/outbox/transport/worker"
 	promotionCampaignPostgres "financing-offer/internal/core/promotion_campaign/repository/postgres"
 	promotionCampaignHttp "financing-offer/internal/core/promotion_campaign/transport/http"
 	promotionloanpackage "financing-offer/internal/core/promotion_loan_package"
@@ -89,8 +117,10 @@ import (
 	"financing-offer/internal/core/stockexchange/transport/http"
 	submissionDefaultHttp "financing-offer/internal/core/submission_default/transport/http"
 	"financing-offer/internal/core/submissionsheet"
+	submissionSheetRepo "financing-offer/internal/core/submissionsheet/repository"
 	submissionSheetPostgres "financing-offer/internal/core/submissionsheet/repository/postgres"
 	submissionSheetHttp "financing-offer/internal/core/submissionsheet/transport/http"
+	submissionSheetScheduler "financing-offer/internal/core/submissionsheet/transport/scheduler"
 	suggestedOffer "financing-offer/internal/core/suggested_offer"
 	suggestedOfferRepo "financing-offer/internal/core/suggested_offer/repository"
 	suggestedOfferKafka "financing-offer/internal/core/suggested_offer/repository/kafka"
@@ -106,11 +136,41 @@ import (
 	"financing-offer/internal/core/symbolscore"
 	symbolScorePostgres "financing-offer/internal/core/symbolscore/repository/postgres"
 	symbolScoreHttp "financing-offer/internal/core/symbolscore/transport/http"
+	"financing-offer/internal/core/tradingcalendar"
+	tradingCalendarRepo "financing-offer/internal/core/tradingcalendar/repository"
+	tradingCalendarPostgres "financing-offer/internal/core/tradingcalendar/repository/postgres"
+	tradingCalendarHttp "financing-offer/internal/core/tradingcalendar/transport/http"
+	tradingCalendarScheduler "financing-offer/internal/core/tradingcalendar/transport/scheduler"
+	"financing-offer/internal/core/webhook"
+	webhookRepo "financing-offer/internal/core/webhook/repository"
+	webhookPostgres "financing-offer/internal/core/webhook/repository/postgres"
+	webhookHttp "financing-offer/internal/core/webhook/transport/http"
+	webhookWorker "financing-offer/internal/core/webhook/transport/worker"
 	"financing-offer/internal/database"
+	"financing-offer/internal/dbevent"
+	dbEventRepo "financing-offer/internal/dbevent/repository"
+	dbEventPostgres "financing-offer/internal/dbevent/repository/postgres"
 	"financing-offer/internal/event"
 	"financing-offer/internal/featureflag"
+	featureFlagRepo "financing-offer/internal/featureflag/repository"
+	featureFlagPostgres "financing-offer/internal/featureflag/repository/postgres"
 	http2 "financing-offer/internal/featureflag/transport/http"
 	"financing-offer/internal/handler"
+	"financing-offer/internal/metrics"
+	metricsRepo "financing-offer/internal/metrics/repository"
+	metricsPostgres "financing-offer/internal/metrics/repository/postgres"
+	"financing-offer/internal/permission"
+	permissionHttp "financing-offer/internal/permission/transport/http"
+	"financing-offer/internal/ratelimit"
+	rateLimitRepo "financing-offer/internal/ratelimit/repository"
+	rateLimitPostgres "financing-offer/internal/ratelimit/repository/postgres"
+	rateLimitScheduler "financing-offer/internal/ratelimit/transport/scheduler"
+	"financing-offer/internal/sharedcache"
+	sharedCacheRepo "financing-offer/internal/sharedcache/repository"
+	sharedCachePostgres "financing-offer/internal/sharedcache/repository/postgres"
+	sharedCacheHttp "financing-offer/internal/sharedcache/transport/http"
+	sharedCacheScheduler "financing-offer/internal/sharedcache/transport/scheduler"
+	"financing-offer/internal/tracing"
 	"financing-offer/pkg/cache"
 	"financing-offer/pkg/environment"
 	"financing-offer/pkg/infra/financialproduct"
@@ -119,6 +179,7 @@ import (
 	mo_service "financing-offer/pkg/infra/mo-service"
 	odooService "financing-offer/pkg/infra/odoo_service"
 	orderService "financing-offer/pkg/infra/order-service"
+	webhookClient "financing-offer/pkg/infra/webhook"
 	"financing-offer/pkg/mattermost"
 	"financing-offer/pkg/shutdown"
 )
@@ -136,7 +197,10 @@ func NewInjector(logger *slog.Logger) *do.Injector {
 	do.Provide(injector, NewTemporalClient)
 	do.Provide(injector, NewFlexOpenApiClient)
 	do.Provide(injector, NewOdooServiceClient)
+	do.Provide(injector, NewInProcessCache)
+	do.Provide(injector, NewPostgresCache)
 	do.Provide(injector, NewCache)
+	do.Provide(injector, NewCachePurger)
 
 	do.Provide(injector, NewBlackListRepository)
 	do.Provide(injector, NewStockExchangeRepository)
@@ -150,6 +214,7 @@ func NewInjector(logger *slog.Logger) *do.Injector {
 	do.Provide(injector, NewLoanContractRepository)
 	do.Provide(injector, NewLoanRequestSchedulerConfigRepository)
 	do.Provide(injector, NewSchedulerJobRepository)
+	do.Provide(injector, NewJobLockRepository)
 	do.Provide(injector, NewOfflineOfferUpdateRepository)
 	do.Provide(injector, NewPromotionCampaignRepository)
 	do.Provide(injector, NewAwaitingConfirmRequestRepository)
@@ -158,13 +223,31 @@ func NewInjector(logger *slog.Logger) *do.Injector {
 	do.Provide(injector, NewInvestorAccountRepository)
 	do.Provide(injector, NewLoanPolicyTemplateRepository)
 	do.Provide(injector, NewSubmissionSheetRepository)
+	do.Provide(injector, NewSubmissionSheetApprovalRepository)
+	do.Provide(injector, NewOdooLoanApprovalRepository)
 	do.Provide(injector, NewSuggestedOfferConfigRepository)
 	do.Provide(injector, NewSuggestedOfferRepository)
+	do.Provide(injector, NewOutboxMessageRepository)
+	do.Provide(injector, NewIdempotencyRecordRepository)
+	do.Provide(injector, NewRateLimitBucketRepository)
+	do.Provide(injector, NewAuditLogRepository)
+	do.Provide(injector, NewTradingCalendarRepository)
+	do.Provide(injector, NewWebhookSubscriptionRepository)
+	do.Provide(injector, NewWebhookDeliveryRepository)
+	do.Provide(injector, NewWebhookSenderRepository)
+	do.Provide(injector, NewWebhookEventPublisher)
+	do.Provide(injector, NewNotificationTemplateRepository)
+	do.Provide(injector, NewKpiRepository)
+	do.Provide(injector, NewCacheEntryRepository)
+	do.Provide(injector, NewFeatureFlagRepository)
+
+	do.Provide(injector, NewOutboxPublisher)
 
 	do.Provide(injector, NewLoanOfferRequestEventPublisher)
 	do.Provide(injector, NewLoanOfferInterestEventPublisher)
 	do.Provide(injector, NewConfigurationPersistenceRepository)
 	do.Provide(injector, NewSuggestedOfferEventPublisher)
+	do.Provide(injector, NewLoanRequestLifecycleRepository)
 
 	do.Provide(injector, NewBlackListUseCase)
 	do.Provide(injector, NewStockExchangeUseCase)
@@ -176,9 +259,14 @@ func NewInjector(logger *slog.Logger) *do.Injector {
 	do.Provide(injector, NewLoanPackageOfferUseCase)
 	do.Provide(injector, NewLoanOfferInterestUseCase)
 	do.Provide(injector, NewLoanContractUseCase)
+	do.Provide(injector, NewFeatureFlagStore)
 	do.Provide(injector, NewFeatureUseCase)
+	do.Provide(injector, NewPermissionUseCase)
 	do.Provide(injector, NewConfigUseCase)
 	do.Provide(injector, NewSchedulerUseCase)
+	do.Provide(injector, NewJobRunner)
+	do.Provide(injector, NewJobRegistry)
+	do.Provide(injector, NewJobUseCase)
 	do.Provide(injector, NewOfflineOfferUpdateUseCase)
 	do.Provide(injector, NewAwaitingConfirmRequestUseCase)
 	do.Provide(injector, NewCombinedRequestUseCase)
@@ -194,6 +282,16 @@ func NewInjector(logger *slog.Logger) *do.Injector {
 	do.Provide(injector, NewMarginOperationUseCase)
 	do.Provide(injector, NewSubmissionDefaultUseCase)
 	do.Provide(injector, NewPromotionCampaignUseCase)
+	do.Provide(injector, NewOutboxUseCase)
+	do.Provide(injector, NewIdempotencyUseCase)
+	do.Provide(injector, NewPostgresRateLimiter)
+	do.Provide(injector, NewRateLimiter)
+	do.Provide(injector, NewAuditUseCase)
+	do.Provide(injector, NewTradingCalendar)
+	do.Provide(injector, NewTradingCalendarUseCase)
+	do.Provide(injector, NewWebhookUseCase)
+	do.Provide(injector, NewNotificationTemplateUseCase)
+	do.Provide(injector, NewNotificationTemplateRenderer)
 
 	do.Provide(injector, NewBaseHandler)
 	do.Provide(injector, NewBlackListHandler)
@@ -207,8 +305,10 @@ func NewInjector(logger *slog.Logger) *do.Injector {
 	do.Provide(injector, NewLoanContractHandler)
 	do.Provide(injector, NewLoanPackageOfferInterestHandler)
 	do.Provide(injector, NewFeatureHandler)
+	do.Provide(injector, NewPermissionHandler)
 	do.Provide(injector, NewConfigHandler)
 	do.Provide(injector, NewSchedulerHandler)
+	do.Provide(injector, NewJobHandler)
 	do.Provide(injector, NewAwaitingConfirmRequestHandler)
 	do.Provide(injector, NewCombinedRequestHandler)
 	do.Provide(injector, NewInvestorHandler)
@@ -220,11 +320,32 @@ func NewInjector(logger *slog.Logger) *do.Injector {
 
 	do.Provide(injector, NewLoanOfferScheduler)
 	do.Provide(injector, NewLoanPackageRequestScheduler)
+	do.Provide(injector, NewIdempotencyScheduler)
+	do.Provide(injector, NewSubmissionSheetScheduler)
+	do.Provide(injector, NewLoanOfferInterestScheduler)
+	do.Provide(injector, NewRateLimitScheduler)
+	do.Provide(injector, NewCacheScheduler)
+	do.Provide(injector, NewInvestorScheduler)
+	do.Provide(injector, NewTradingCalendarScheduler)
+	do.Provide(injector, NewOutboxRelayWorker)
+	do.Provide(injector, NewWebhookDeliveryWorker)
+	do.Provide(injector, NewKpiCollector)
+	do.Provide(injector, NewLoanRequestLifecycleActivities)
+	do.Provide(injector, NewLoanRequestLifecycleWorker)
+	do.Provide(injector, NewDbListener)
+	do.Provide(injector, NewDbEventLogRepository)
+	do.Provide(injector, NewCdcConsumer)
+	do.Provide(injector, NewKafkaConsumerGroup)
 	do.Provide(injector, NewSubmissionSheetHandler)
 	do.Provide(injector, NewPromotionLoanPackageHandler)
 	do.Provide(injector, NewConfigurationHandler)
 	do.Provide(injector, NewSubmissionDefaultHandler)
 	do.Provide(injector, NewPromotionCampaignHandler)
+	do.Provide(injector, NewAuditHandler)
+	do.Provide(injector, NewTradingCalendarHandler)
+	do.Provide(injector, NewWebhookHandler)
+	do.Provide(injector, NewNotificationTemplateHandler)
+	do.Provide(injector, NewCacheHandler)
 	return injector
 }
 
@@ -250,7 +371,9 @@ func NewFinancialProductClient(i *do.Injector) (financialProductRepo.FinancialPr
 	cfg := do.MustInvoke[config.AppConfig](i)
 	baseClient := financialproduct.NewClient(cfg.FinancialProduct)
 	cacheStore := do.MustInvoke[cache.Cache](i)
-	return financialproduct.NewCachedFinancialProductRepository(baseClient, cacheStore), nil
+	return financialproduct.NewCachedFinancialProductRepository(
+		baseClient, metrics.InstrumentCache("financial_product", cacheStore),
+	), nil
 }
 
 func NewMoServiceClient(i *do.Injector) (marginOperationRepo.MarginOperationRepository, error) {
@@ -271,9 +394,9 @@ func NewOrderServiceClient(i *do.Injector) (orderServiceRepo.OrderServiceReposit
 func NewOdooServiceClient(i *do.Injector) (odooServiceRepo.OdooServiceRepository, error) {
 	cfg := do.MustInvoke[config.AppConfig](i)
 	if cfg.Env == "prod" {
-		return odooService.NewClient(cfg.OdooService, nil)
+		return odooService.NewClient(cfg.OdooService.ForEnv(cfg.Env), nil)
 	}
-	return odooService.NewInMemoryClient(cfg.OdooService)
+	return odooService.NewInMemoryClient(cfg.OdooService.ForEnv(cfg.Env))
 }
 
 func NewFlexOpenApiClient(i *do.Injector) (flexOpenApiRepo.FlexOpenApiRepository, error) {
@@ -281,11 +404,140 @@ func NewFlexOpenApiClient(i *do.Injector) (flexOpenApiRepo.FlexOpenApiRepository
 	return flexApi.NewClient(cfg.FlexOpenApi), nil
 }
 
+func NewOutboxMessageRepository(i *do.Injector) (outboxRepo.OutboxMessageRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return outboxPostgres.NewOutboxMessagePostgresRepository(getDbFunc), nil
+}
+
+func NewOutboxPublisher(i *do.Injector) (*outbox.Publisher, error) {
+	outboxRepository := do.MustInvoke[outboxRepo.OutboxMessageRepository](i)
+	return outbox.NewPublisher(outboxRepository), nil
+}
+
+func NewOutboxUseCase(i *do.Injector) (outbox.UseCase, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	outboxRepository := do.MustInvoke[outboxRepo.OutboxMessageRepository](i)
+	publisher := do.MustInvoke[event.Publisher](i)
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return outbox.NewUseCase(cfg.Outbox, logger, outboxRepository, publisher, atomicExecutor, errorService), nil
+}
+
+func NewDbListener(i *do.Injector) (*database.DbListener, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	tasks := do.MustInvoke[*shutdown.Tasks](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return database.NewListener(logger, cfg.Db, tasks, errorService), nil
+}
+
+func NewDbEventLogRepository(i *do.Injector) (dbEventRepo.DbEventLogRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return dbEventPostgres.NewDbEventLogPostgresRepository(getDbFunc), nil
+}
+
+func NewCdcConsumer(i *do.Injector) (*dbevent.Consumer, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	listener := do.MustInvoke[*database.DbListener](i)
+	dbEventLogRepository := do.MustInvoke[dbEventRepo.DbEventLogRepository](i)
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	publisher := do.MustInvoke[*outbox.Publisher](i)
+	topicPrefix := cfg.Cdc.TopicPrefix
+	return dbevent.NewConsumer(
+		cfg.Cdc, logger, listener, dbEventLogRepository, atomicExecutor, errorService,
+		dbevent.NewTableHandler(
+			"loan_package_request", topicPrefix, publisher,
+			loanPackageRequestPostgres.MapLoanPackageRequestDbToEntity,
+			func(e entity.LoanPackageRequest) string { return strconv.FormatInt(e.Id, 10) },
+		),
+		dbevent.NewTableHandler(
+			"loan_package_offer_interest", topicPrefix, publisher,
+			loanPackageOfferInterestPostgres.MapLoanPackageOfferInterestDbToEntity,
+			func(e entity.LoanPackageOfferInterest) string { return strconv.FormatInt(e.Id, 10) },
+		),
+		dbevent.NewTableHandler(
+			"symbol", topicPrefix, publisher,
+			symbolPostgres.MapSymbolDbToEntity,
+			func(e entity.Symbol) string { return strconv.FormatInt(e.Id, 10) },
+		),
+		dbevent.NewTableHandler(
+			"blacklist_symbol", topicPrefix, publisher,
+			blSymbolPostgres.MapBlacklistSymbolDbToEntity,
+			func(e entity.BlacklistSymbol) string { return strconv.FormatInt(e.Id, 10) },
+		),
+		dbevent.NewTableHandler(
+			"loan_contract", topicPrefix, publisher,
+			loanContractPostgres.MapLoanContractDbToEntity,
+			func(e entity.LoanContract) string { return strconv.FormatInt(e.Id, 10) },
+		),
+	), nil
+}
+
+func NewKafkaConsumerGroup(i *do.Injector) (*event.ConsumerGroup, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	publisher := do.MustInvoke[event.Publisher](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	loanOfferInterestUseCase := do.MustInvoke[loanofferinterest.UseCase](i)
+	investorAccountUseCase := do.MustInvoke[investor_account.UseCase](i)
+	return event.NewConsumerGroup(
+		cfg.Kafka.Consumer, logger, event.NewKafkaReaderFactory(cfg.Kafka), publisher, errorService,
+		loanOfferInterestConsumer.NewLoanPackageActivatedHandler(cfg.Kafka.Consumer, logger, loanOfferInterestUseCase),
+		investorAccountConsumer.NewAccountMarginChangedHandler(cfg.Kafka.Consumer, logger, investorAccountUseCase),
+	), nil
+}
+
+func NewOutboxRelayWorker(i *do.Injector) (*outboxWorker.RelayWorker, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[outbox.UseCase](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return outboxWorker.NewRelayWorker(cfg.Outbox, logger, useCase, errorService), nil
+}
+
+func NewLoanRequestLifecycleActivities(i *do.Injector) (*lifecycle.Activities, error) {
+	loanPackageRequestUseCase := do.MustInvoke[loanpackagerequest.UseCase](i)
+	loanOfferUseCase := do.MustInvoke[loanoffer.UseCase](i)
+	loanOfferInterestUseCase := do.MustInvoke[loanofferinterest.UseCase](i)
+	return lifecycle.NewActivities(loanPackageRequestUseCase, loanOfferUseCase, loanOfferInterestUseCase), nil
+}
+
+func NewLoanRequestLifecycleWorker(i *do.Injector) (*lifecycleWorker.LoanRequestLifecycleWorker, error) {
+	temporalClient := do.MustInvoke[client.Client](i)
+	activities := do.MustInvoke[*lifecycle.Activities](i)
+	return lifecycleWorker.NewLoanRequestLifecycleWorker(temporalClient, activities), nil
+}
+
 func NewSchedulerJobRepository(i *do.Injector) (schedulerRepo.SchedulerJobRepository, error) {
 	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
 	return schedulerRepoPostgres.NewSchedulerJobRepository(getDbFunc), nil
 }
 
+func NewJobLockRepository(i *do.Injector) (schedulerRepo.JobLockRepository, error) {
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
+	return schedulerRepoPostgres.NewJobLockPostgresRepository(atomicExecutor.DB), nil
+}
+
+func NewAuditLogRepository(i *do.Injector) (auditRepo.AuditLogRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return auditPostgres.NewAuditLogPostgresRepository(getDbFunc), nil
+}
+
+func NewAuditUseCase(i *do.Injector) (audit.UseCase, error) {
+	repository := do.MustInvoke[auditRepo.AuditLogRepository](i)
+	return audit.NewUseCase(repository), nil
+}
+
+func NewAuditHandler(i *do.Injector) (*auditHttp.AuditHandler, error) {
+	baseHandler := do.MustInvoke[handler.BaseHandler](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[audit.UseCase](i)
+	return auditHttp.NewAuditHandler(baseHandler, logger, useCase), nil
+}
+
 func NewBaseHandler(i *do.Injector) (handler.BaseHandler, error) {
 	logger := do.MustInvoke[*slog.Logger](i)
 	errorService := do.MustInvoke[apperrors.Service](i)
@@ -354,21 +606,39 @@ func NewPromotionCampaignRepository(i *do.Injector) (*promotionCampaignPostgres.
 
 func NewLoanOfferInterestEventPublisher(i *do.Injector) (loanOfferInterestRepo.LoanPackageOfferInterestEventRepository, error) {
 	cfg := do.MustInvoke[config.AppConfig](i)
-	publisher := do.MustInvoke[event.Publisher](i)
+	publisher := do.MustInvoke[*outbox.Publisher](i)
+	temporalClient := do.MustInvoke[client.Client](i)
+	renderer := do.MustInvoke[notificationtemplate.Renderer](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return loanOfferInterestKafka.NewLoanOfferInterestEventPublisher(
+		cfg.Kafka, publisher, temporalClient, renderer, logger, errorService,
+	), nil
+}
+
+// NewLoanRequestLifecycleRepository only dials Temporal when the lifecycle workflows are enabled
+func NewLoanRequestLifecycleRepository(i *do.Injector) (lifecycleRepo.LoanRequestLifecycleRepository, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	if !cfg.Temporal.Worker.Enable {
+		return lifecycleTemporal.NewLoanRequestLifecycleTemporalRepository(cfg.Temporal.Worker, nil), nil
+	}
 	temporalClient := do.MustInvoke[client.Client](i)
-	return loanOfferInterestKafka.NewLoanOfferInterestEventPublisher(cfg.Kafka, publisher, temporalClient), nil
+	return lifecycleTemporal.NewLoanRequestLifecycleTemporalRepository(cfg.Temporal.Worker, temporalClient), nil
 }
 
 func NewLoanOfferRequestEventPublisher(i *do.Injector) (loanPackageRequestRepo.LoanPackageRequestEventRepository, error) {
 	cfg := do.MustInvoke[config.AppConfig](i)
-	publisher := do.MustInvoke[event.Publisher](i)
+	publisher := do.MustInvoke[*outbox.Publisher](i)
 	return loanRequestKafka.NewLoanPackageRequestEventPublisher(cfg.Kafka, publisher), nil
 }
 
 func NewSuggestedOfferEventPublisher(i *do.Injector) (suggestedOfferRepo.SuggestedOfferEventRepository, error) {
 	cfg := do.MustInvoke[config.AppConfig](i)
 	publisher := do.MustInvoke[event.Publisher](i)
-	return suggestedOfferKafka.NewSuggestedOfferEventPublisher(cfg.Kafka, publisher), nil
+	renderer := do.MustInvoke[notificationtemplate.Renderer](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return suggestedOfferKafka.NewSuggestedOfferEventPublisher(cfg.Kafka, publisher, renderer, logger, errorService), nil
 }
 
 func NewLoanRequestSchedulerConfigRepository(i *do.Injector) (schedulerRepo.LoanRequestSchedulerConfigRepository, error) {
@@ -432,7 +702,8 @@ func NewSymbolUseCase(i *do.Injector) (symbol.UseCase, error) {
 func NewScoreGroupUseCase(i *do.Injector) (scoregroup.UseCase, error) {
 	scoreGroupRepo := do.MustInvoke[*scoreGroupPostgres.ScoreGroupRepository](i)
 	scoreGroupInterestRepo := do.MustInvoke[*scoreGroupInterestPostgres.ScoreGroupInterestSqlRepository](i)
-	return scoregroup.NewUseCase(scoreGroupRepo, scoreGroupInterestRepo), nil
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
+	return scoregroup.NewUseCase(scoreGroupRepo, scoreGroupInterestRepo, atomicExecutor), nil
 }
 
 func NewLoanPolicyTemplateUseCase(i *do.Injector) (loanpolicytemplate.UseCase, error) {
@@ -444,8 +715,9 @@ func NewScoreGroupInterestUseCase(i *do.Injector) (scoregroupinterest.UseCase, e
 	scoreGroupInterestRepo := do.MustInvoke[*scoreGroupInterestPostgres.ScoreGroupInterestSqlRepository](i)
 	loanRequestRepo := do.MustInvoke[*loanPackageRequestPostgres.LoanPackageRequestPostgresRepository](i)
 	symbolScoreRepo := do.MustInvoke[*symbolScorePostgres.SymbolScoreRepository](i)
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
 	return scoregroupinterest.NewUseCase(
-		scoreGroupInterestRepo, loanRequestRepo, symbolScoreRepo,
+		scoreGroupInterestRepo, loanRequestRepo, symbolScoreRepo, atomicExecutor,
 	), nil
 }
 
@@ -457,6 +729,110 @@ func NewSchedulerUseCase(i *do.Injector) (scheduler.UseCase, error) {
 	), nil
 }
 
+func NewJobRunner(i *do.Injector) (*scheduler.JobRunner, error) {
+	logger := do.MustInvoke[*slog.Logger](i)
+	lockRepository := do.MustInvoke[schedulerRepo.JobLockRepository](i)
+	jobRepository := do.MustInvoke[schedulerRepo.SchedulerJobRepository](i)
+	return scheduler.NewJobRunner(logger, lockRepository, jobRepository), nil
+}
+
+func NewJobRegistry(i *do.Injector) (*scheduler.JobRegistry, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	loanOfferHandler := do.MustInvoke[*loanOfferScheduler.LoanOfferScheduler](i)
+	loanRequestHandler := do.MustInvoke[*loanPackageScheduler.LoanRequestScheduler](i)
+	idempotencyHandler := do.MustInvoke[*idempotencyScheduler.IdempotencyScheduler](i)
+	submissionSheetHandler := do.MustInvoke[*submissionSheetScheduler.SubmissionSheetScheduler](i)
+	loanOfferInterestHandler := do.MustInvoke[*loanOfferInterestScheduler.LoanOfferInterestScheduler](i)
+	investorHandler := do.MustInvoke[*investorScheduler.InvestorScheduler](i)
+	jobs := []scheduler.Job{
+		{
+			// kept when the lifecycle workflows expire their offers on a durable timer, it catches the offers of requests
+			// whose workflow never started, like the ones opened before the workers were enabled
+			Type: entity.JobTypeExpireLoanOffers,
+			Cron: cfg.Cron.ExpireLoanOffers,
+			Run:  loanOfferHandler.ExpireLoanOffers,
+		},
+		{
+			Type: entity.JobTypeDeclineLoanRequests,
+			Cron: cfg.Cron.DeclineLoanRequests,
+			Run:  loanRequestHandler.DeclineLoanRequests,
+		},
+		{
+			Type: entity.JobTypePurgeIdempotency,
+			Cron: cfg.Cron.PurgeIdempotency,
+			Run:  idempotencyHandler.PurgeExpired,
+		},
+		{
+			Type: entity.JobTypeSyncOdooApprovals,
+			Cron: cfg.Cron.SyncOdooApprovals,
+			Run:  submissionSheetHandler.SyncOdooDecisions,
+		},
+		{
+			Type: entity.JobTypeReconcileLoanPackageCreation,
+			Cron: cfg.Cron.ReconcileLoanPackageCreation,
+			Run:  loanOfferInterestHandler.ReconcileCreatingLoanPackages,
+		},
+		{
+			Type: entity.JobTypeSyncLoanPackageData,
+			Run:  loanOfferInterestHandler.SyncLoanPackageData,
+		},
+		{
+			Type: entity.JobTypeFillInvestorIds,
+			Run:  investorHandler.FillInvestorIds,
+		},
+	}
+	if cfg.TradingCalendar.Sync.Enable {
+		tradingCalendarHandler := do.MustInvoke[*tradingCalendarScheduler.TradingCalendarScheduler](i)
+		jobs = append(
+			jobs, scheduler.Job{
+				Type: entity.JobTypeSyncTradingCalendar,
+				Cron: cfg.Cron.SyncTradingCalendar,
+				// the calendar is empty after the first deploy and would miss the holidays until the weekly tick
+				RunOnStart: true,
+				Run:        tradingCalendarHandler.SyncFromFinancingApi,
+			},
+		)
+	}
+	if cfg.RateLimit.Enable && cfg.RateLimit.Store == config.RateLimitStorePostgres {
+		rateLimitHandler := do.MustInvoke[*rateLimitScheduler.RateLimitScheduler](i)
+		jobs = append(
+			jobs, scheduler.Job{
+				Type: entity.JobTypePurgeRateLimits,
+				Cron: cfg.Cron.PurgeRateLimits,
+				Run:  rateLimitHandler.PurgeIdleBuckets,
+			},
+		)
+	}
+	if cfg.Cache.Store == config.CacheStorePostgres {
+		cacheHandler := do.MustInvoke[*sharedCacheScheduler.CacheScheduler](i)
+		jobs = append(
+			jobs, scheduler.Job{
+				Type: entity.JobTypePurgeCacheEntries,
+				Cron: cfg.Cron.PurgeCacheEntries,
+				Run:  cacheHandler.PurgeExpiredEntries,
+			},
+		)
+	}
+	registry := scheduler.NewJobRegistry()
+	for _, job := range jobs {
+		if jobConfig, ok := cfg.Jobs[string(job.Type)]; ok {
+			job.Timeout = jobConfig.Timeout
+			job.Retry = scheduler.RetryPolicy{MaxAttempts: jobConfig.MaxAttempts, Backoff: jobConfig.RetryBackoff}
+		}
+		if err := registry.Register(job); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func NewJobUseCase(i *do.Injector) (scheduler.JobUseCase, error) {
+	registry := do.MustInvoke[*scheduler.JobRegistry](i)
+	runner := do.MustInvoke[*scheduler.JobRunner](i)
+	jobRepository := do.MustInvoke[schedulerRepo.SchedulerJobRepository](i)
+	return scheduler.NewJobUseCase(registry, runner, jobRepository), nil
+}
+
 func NewFinancialProductUseCase(i *do.Injector) (financialProductDomain.UseCase, error) {
 	cfg := do.MustInvoke[config.AppConfig](i)
 	financialProductRepository := do.MustInvoke[financialProductRepo.FinancialProductRepository](i)
@@ -475,7 +851,12 @@ func NewSuggestedOfferUseCase(i *do.Injector) (suggestedOffer.UseCase, error) {
 	configRepo := do.MustInvoke[suggestedOfferConfigRepo.SuggestedOfferConfigRepository](i)
 	eventRepo := do.MustInvoke[suggestedOfferRepo.SuggestedOfferEventRepository](i)
 	orderServiceRepo := do.MustInvoke[orderServiceRepo.OrderServiceRepository](i)
-	return suggestedOffer.NewUseCase(repo, configRepo, eventRepo, orderServiceRepo), nil
+	symbolRepository := do.MustInvoke[*symbolPostgres.SymbolRepository](i)
+	loanPackageRequestUseCase := do.MustInvoke[loanpackagerequest.UseCase](i)
+	investorRepository := do.MustInvoke[investorRepo.InvestorPersistenceRepository](i)
+	return suggestedOffer.NewUseCase(
+		repo, configRepo, eventRepo, orderServiceRepo, symbolRepository, loanPackageRequestUseCase, investorRepository,
+	), nil
 }
 
 func NewPromotionCampaignUseCase(i *do.Injector) (promotion_campaign.UseCase, error) {
@@ -488,6 +869,16 @@ func NewLoanPolicyTemplateRepository(i *do.Injector) (*loanPolicyTemplatePostgre
 	return loanPolicyTemplatePostgres.NewLoanPolicyTemplateRepository(getDbFunc), nil
 }
 
+func NewSubmissionSheetApprovalRepository(i *do.Injector) (submissionSheetRepo.SubmissionSheetApprovalRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return submissionSheetPostgres.NewSubmissionSheetApprovalPostgresRepository(getDbFunc), nil
+}
+
+func NewOdooLoanApprovalRepository(i *do.Injector) (odooServiceRepo.OdooLoanApprovalRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return odooServicePostgres.NewOdooLoanApprovalPostgresRepository(getDbFunc), nil
+}
+
 func NewSubmissionSheetRepository(i *do.Injector) (*submissionSheetPostgres.SubmissionSheetPostgresRepository, error) {
 	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
 	return submissionSheetPostgres.NewSubmissionSheetPostgresRepository(getDbFunc), nil
@@ -508,7 +899,7 @@ func NewLoanPackageRequestUseCase(i *do.Injector) (loanpackagerequest.UseCase, e
 	symbolRepo := do.MustInvoke[*symbolPostgres.SymbolRepository](i)
 	loanContractRepo := do.MustInvoke[*loanContractPostgres.LoanContractRepository](i)
 	financialProductClient := do.MustInvoke[financialProductRepo.FinancialProductRepository](i)
-	financingApiClient := do.MustInvoke[financingApiRepository.FinancingRepository](i)
+	tradingCalendar := do.MustInvoke[tradingcalendar.Calendar](i)
 	schedulerJobRepo := do.MustInvoke[schedulerRepo.SchedulerJobRepository](i)
 	loanPolicyRepository := do.MustInvoke[*loanPolicyTemplatePostgres.LoanPolicyTemplateRepository](i)
 	errorService := do.MustInvoke[apperrors.Service](i)
@@ -519,6 +910,9 @@ func NewLoanPackageRequestUseCase(i *do.Injector) (loanpackagerequest.UseCase, e
 	marginOperationRepository := do.MustInvoke[marginOperationRepo.MarginOperationRepository](i)
 	configurationRepository := do.MustInvoke[configRepo.ConfigurationPersistenceRepository](i)
 	odooServiceRepository := do.MustInvoke[odooServiceRepo.OdooServiceRepository](i)
+	odooLoanApprovalRepository := do.MustInvoke[odooServiceRepo.OdooLoanApprovalRepository](i)
+	lifecycleRepository := do.MustInvoke[lifecycleRepo.LoanRequestLifecycleRepository](i)
+	webhookEventRepository := do.MustInvoke[webhookRepo.WebhookEventRepository](i)
 	return loanpackagerequest.NewUseCase(
 		loanRequestRepo,
 		atomicExecutor,
@@ -532,7 +926,7 @@ func NewLoanPackageRequestUseCase(i *do.Injector) (loanpackagerequest.UseCase, e
 		cfg,
 		loanPolicyRepository,
 		logger,
-		financingApiClient,
+		tradingCalendar,
 		schedulerJobRepo,
 		errorService,
 		investorRepository,
@@ -540,6 +934,9 @@ func NewLoanPackageRequestUseCase(i *do.Injector) (loanpackagerequest.UseCase, e
 		marginOperationRepository,
 		configurationRepository,
 		odooServiceRepository,
+		odooLoanApprovalRepository,
+		lifecycleRepository,
+		webhookEventRepository,
 	), nil
 }
 
@@ -548,8 +945,11 @@ func NewLoanPackageOfferUseCase(i *do.Injector) (loanoffer.UseCase, error) {
 	cfg := do.MustInvoke[config.AppConfig](i)
 	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
 	loanPackageOfferInterestRepo := do.MustInvoke[*loanPackageOfferInterestPostgres.LoanPackageOfferInterestPostgresRepository](i)
+	tradingCalendar := do.MustInvoke[tradingcalendar.Calendar](i)
+	webhookEventRepository := do.MustInvoke[webhookRepo.WebhookEventRepository](i)
 	return loanoffer.NewUseCase(
-		loanPackageOfferRepo, cfg.LoanRequest, loanPackageOfferInterestRepo, atomicExecutor,
+		loanPackageOfferRepo, cfg.LoanRequest, loanPackageOfferInterestRepo, atomicExecutor, tradingCalendar,
+		webhookEventRepository,
 	), nil
 }
 
@@ -565,6 +965,8 @@ func NewLoanOfferInterestUseCase(i *do.Injector) (loanofferinterest.UseCase, err
 	submissionSheetRepo := do.MustInvoke[*submissionSheetPostgres.SubmissionSheetPostgresRepository](i)
 	loanTemplateRepo := do.MustInvoke[*loanPolicyTemplatePostgres.LoanPolicyTemplateRepository](i)
 	appConfig := do.MustInvoke[config.AppConfig](i)
+	lifecycleRepository := do.MustInvoke[lifecycleRepo.LoanRequestLifecycleRepository](i)
+	webhookEventRepository := do.MustInvoke[webhookRepo.WebhookEventRepository](i)
 	return loanofferinterest.NewUseCase(
 		loanPackageOfferInterestRepo,
 		atomicExecutor,
@@ -577,6 +979,8 @@ func NewLoanOfferInterestUseCase(i *do.Injector) (loanofferinterest.UseCase, err
 		submissionSheetRepo,
 		loanTemplateRepo,
 		appConfig,
+		lifecycleRepository,
+		webhookEventRepository,
 	), nil
 }
 
@@ -595,9 +999,31 @@ func NewLoanContractUseCase(i *do.Injector) (loancontract.UseCase, error) {
 	), nil
 }
 
+func NewPermissionUseCase(i *do.Injector) (permission.UseCase, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	return permission.NewUseCase(cfg.Permissions), nil
+}
+
+func NewFeatureFlagRepository(i *do.Injector) (featureFlagRepo.FeatureFlagRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return featureFlagPostgres.NewFeatureFlagPostgresRepository(getDbFunc), nil
+}
+
+func NewFeatureFlagStore(i *do.Injector) (*featureflag.Store, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	repository := do.MustInvoke[featureFlagRepo.FeatureFlagRepository](i)
+	listener := do.MustInvoke[*database.DbListener](i)
+	return featureflag.NewStore(cfg.FeatureFlag, logger, repository, listener), nil
+}
+
 func NewFeatureUseCase(i *do.Injector) (featureflag.UseCase, error) {
 	cfg := do.MustInvoke[config.AppConfig](i)
-	return featureflag.NewUseCase(cfg.Features), nil
+	store := do.MustInvoke[*featureflag.Store](i)
+	repository := do.MustInvoke[featureFlagRepo.FeatureFlagRepository](i)
+	investorAccountRepository := do.MustInvoke[investorAccountRepo.InvestorAccountRepository](i)
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
+	return featureflag.NewUseCase(cfg.Features, store, repository, investorAccountRepository, atomicExecutor), nil
 }
 
 func NewConfigUseCase(i *do.Injector) (config.UseCase, error) {
@@ -645,11 +1071,16 @@ func NewSubmissionSheetUseCase(i *do.Injector) (submissionsheet.UseCase, error)
 	loanPackageRequestRepository := do.MustInvoke[*loanPackageRequestPostgres.LoanPackageRequestPostgresRepository](i)
 	loanPackageOfferRepository := do.MustInvoke[*loanPackageOfferPostgres.LoanPackageOfferPostgresRepository](i)
 	loanPackageOfferInterestRepository := do.MustInvoke[*loanPackageOfferInterestPostgres.LoanPackageOfferInterestPostgresRepository](i)
-	financingApiClient := do.MustInvoke[financingApiRepository.FinancingRepository](i)
+	tradingCalendar := do.MustInvoke[tradingcalendar.Calendar](i)
 	appConfig := do.MustInvoke[config.AppConfig](i)
 	errorService := do.MustInvoke[apperrors.Service](i)
 	loanPackageRequestEventRepository := do.MustInvoke[loanPackageRequestRepo.LoanPackageRequestEventRepository](i)
 	symbolRepository := do.MustInvoke[*symbolPostgres.SymbolRepository](i)
+	approvalRepository := do.MustInvoke[submissionSheetRepo.SubmissionSheetApprovalRepository](i)
+	odooServiceRepository := do.MustInvoke[odooServiceRepo.OdooServiceRepository](i)
+	odooLoanApprovalRepository := do.MustInvoke[odooServiceRepo.OdooLoanApprovalRepository](i)
+	lifecycleRepository := do.MustInvoke[lifecycleRepo.LoanRequestLifecycleRepository](i)
+	webhookEventRepository := do.MustInvoke[webhookRepo.WebhookEventRepository](i)
 	return submissionsheet.NewUseCase(
 		repo,
 		atomicExecutor,
@@ -659,11 +1090,16 @@ func NewSubmissionSheetUseCase(i *do.Injector) (submissionsheet.UseCase, error)
 		loanPackageRequestRepository,
 		loanPackageOfferRepository,
 		loanPackageOfferInterestRepository,
-		financingApiClient,
+		tradingCalendar,
 		appConfig,
 		errorService,
 		loanPackageRequestEventRepository,
 		symbolRepository,
+		approvalRepository,
+		odooServiceRepository,
+		odooLoanApprovalRepository,
+		lifecycleRepository,
+		webhookEventRepository,
 	), nil
 }
 
@@ -774,6 +1210,12 @@ func NewLoanContractHandler(i *do.Injector) (*loanContractHttp.LoanContractHandl
 	return loanContractHttp.NewLoanContractHandler(baseHandler, logger, loanContractUseCase), nil
 }
 
+func NewPermissionHandler(i *do.Injector) (*permissionHttp.PermissionHandler, error) {
+	baseHandler := do.MustInvoke[handler.BaseHandler](i)
+	useCase := do.MustInvoke[permission.UseCase](i)
+	return permissionHttp.NewPermissionHandler(baseHandler, useCase), nil
+}
+
 func NewFeatureHandler(i *do.Injector) (*http2.FeatureHandler, error) {
 	baseHandler := do.MustInvoke[handler.BaseHandler](i)
 	featureUseCase := do.MustInvoke[featureflag.UseCase](i)
@@ -792,8 +1234,16 @@ func NewSchedulerHandler(i *do.Injector) (*schedulerHttp.SchedulerHandler, error
 	baseHandler := do.MustInvoke[handler.BaseHandler](i)
 	logger := do.MustInvoke[*slog.Logger](i)
 	useCase := do.MustInvoke[scheduler.UseCase](i)
+	loanRequestUseCase := do.MustInvoke[loanpackagerequest.UseCase](i)
+
+	return schedulerHttp.NewSchedulerHandler(baseHandler, logger, useCase, loanRequestUseCase), nil
+}
 
-	return schedulerHttp.NewSchedulerHandler(baseHandler, logger, useCase), nil
+func NewJobHandler(i *do.Injector) (*schedulerHttp.JobHandler, error) {
+	baseHandler := do.MustInvoke[handler.BaseHandler](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[scheduler.JobUseCase](i)
+	return schedulerHttp.NewJobHandler(baseHandler, logger, useCase), nil
 }
 
 func NewAwaitingConfirmRequestHandler(i *do.Injector) (*awaitingConfirmRequestHttp.AwaitingConfirmRequestHandler, error) {
@@ -860,6 +1310,65 @@ func NewPromotionLoanPackageHandler(i *do.Injector) (*promotionLoanPackageHttp.P
 	return promotionLoanPackageHttp.NewPromotionLoanPackageHandler(baseHandler, cacheStore, useCase), nil
 }
 
+func NewIdempotencyRecordRepository(i *do.Injector) (idempotencyRepo.IdempotencyRecordRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return idempotencyPostgres.NewIdempotencyRecordPostgresRepository(getDbFunc), nil
+}
+
+func NewIdempotencyUseCase(i *do.Injector) (idempotency.UseCase, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	repository := do.MustInvoke[idempotencyRepo.IdempotencyRecordRepository](i)
+	return idempotency.NewUseCase(cfg.Idempotency, repository), nil
+}
+
+func NewSubmissionSheetScheduler(i *do.Injector) (*submissionSheetScheduler.SubmissionSheetScheduler, error) {
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[submissionsheet.UseCase](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return submissionSheetScheduler.NewSubmissionSheetScheduler(logger, useCase, errorService), nil
+}
+
+func NewLoanOfferInterestScheduler(i *do.Injector) (*loanOfferInterestScheduler.LoanOfferInterestScheduler, error) {
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[loanofferinterest.UseCase](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return loanOfferInterestScheduler.NewLoanOfferInterestScheduler(logger, useCase, errorService), nil
+}
+
+func NewIdempotencyScheduler(i *do.Injector) (*idempotencyScheduler.IdempotencyScheduler, error) {
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[idempotency.UseCase](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return idempotencyScheduler.NewIdempotencyScheduler(logger, useCase, errorService), nil
+}
+
+func NewRateLimitBucketRepository(i *do.Injector) (rateLimitRepo.RateLimitBucketRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return rateLimitPostgres.NewRateLimitBucketPostgresRepository(getDbFunc), nil
+}
+
+func NewPostgresRateLimiter(i *do.Injector) (*ratelimit.PostgresLimiter, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	repository := do.MustInvoke[rateLimitRepo.RateLimitBucketRepository](i)
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
+	return ratelimit.NewPostgresLimiter(cfg.RateLimit, repository, atomicExecutor), nil
+}
+
+func NewRateLimiter(i *do.Injector) (ratelimit.Limiter, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	if cfg.RateLimit.Store == config.RateLimitStorePostgres {
+		return do.MustInvoke[*ratelimit.PostgresLimiter](i), nil
+	}
+	return ratelimit.NewInProcessLimiter(), nil
+}
+
+func NewRateLimitScheduler(i *do.Injector) (*rateLimitScheduler.RateLimitScheduler, error) {
+	logger := do.MustInvoke[*slog.Logger](i)
+	limiter := do.MustInvoke[*ratelimit.PostgresLimiter](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return rateLimitScheduler.NewRateLimitScheduler(logger, limiter, errorService), nil
+}
+
 func NewConfigurationHandler(i *do.Injector) (*configurationHttp.ConfigurationHandler, error) {
 	baseHandler := do.MustInvoke[handler.BaseHandler](i)
 	useCase := do.MustInvoke[configuration.UseCase](i)
@@ -867,6 +1376,13 @@ func NewConfigurationHandler(i *do.Injector) (*configurationHttp.ConfigurationHa
 	return configurationHttp.NewConfigurationHandler(baseHandler, cacheStore, useCase), nil
 }
 
+func NewInvestorScheduler(i *do.Injector) (*investorScheduler.InvestorScheduler, error) {
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[investor.UseCase](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return investorScheduler.NewInvestorScheduler(logger, useCase, errorService), nil
+}
+
 func NewLoanOfferScheduler(i *do.Injector) (*loanOfferScheduler.LoanOfferScheduler, error) {
 	logger := do.MustInvoke[*slog.Logger](i)
 	useCase := do.MustInvoke[loanoffer.UseCase](i)
@@ -902,20 +1418,65 @@ func NewPromotionCampaignHandler(i *do.Injector) (*promotionCampaignHttp.Promoti
 	return promotionCampaignHttp.NewPromotionCampaignHandler(baseHandler, logger, useCase), nil
 }
 
-func NewCache(i *do.Injector) (cache.Cache, error) {
+func NewInProcessCache(i *do.Injector) (*cache.InProcessCache, error) {
 	tasks := do.MustInvoke[*shutdown.Tasks](i)
 	return cache.NewInProcessCache(tasks)
 }
 
+func NewPostgresCache(i *do.Injector) (*sharedcache.PostgresCache, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	local := do.MustInvoke[*cache.InProcessCache](i)
+	repository := do.MustInvoke[sharedCacheRepo.CacheEntryRepository](i)
+	listener := do.MustInvoke[*database.DbListener](i)
+	return sharedcache.NewPostgresCache(cfg.Cache, logger, local, repository, listener), nil
+}
+
+func NewCache(i *do.Injector) (cache.Cache, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	if cfg.Cache.Store == config.CacheStorePostgres {
+		return cache.WithFamilyTtls(do.MustInvoke[*sharedcache.PostgresCache](i), cfg.Cache.Ttls), nil
+	}
+	return cache.WithFamilyTtls(do.MustInvoke[*cache.InProcessCache](i), cfg.Cache.Ttls), nil
+}
+
+func NewCachePurger(i *do.Injector) (sharedcache.Purger, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	if cfg.Cache.Store == config.CacheStorePostgres {
+		return do.MustInvoke[*sharedcache.PostgresCache](i), nil
+	}
+	return sharedcache.NewLocalPurger(do.MustInvoke[cache.Cache](i)), nil
+}
+
+func NewCacheEntryRepository(i *do.Injector) (sharedCacheRepo.CacheEntryRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return sharedCachePostgres.NewCacheEntryPostgresRepository(getDbFunc), nil
+}
+
+func NewCacheScheduler(i *do.Injector) (*sharedCacheScheduler.CacheScheduler, error) {
+	logger := do.MustInvoke[*slog.Logger](i)
+	postgresCache := do.MustInvoke[*sharedcache.PostgresCache](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return sharedCacheScheduler.NewCacheScheduler(logger, postgresCache, errorService), nil
+}
+
+func NewCacheHandler(i *do.Injector) (*sharedCacheHttp.CacheHandler, error) {
+	baseHandler := do.MustInvoke[handler.BaseHandler](i)
+	purger := do.MustInvoke[sharedcache.Purger](i)
+	return sharedCacheHttp.NewCacheHandler(baseHandler, purger), nil
+}
+
 func NewTemporalClient(i *do.Injector) (client.Client, error) {
 	logger := do.MustInvoke[*slog.Logger](i)
 	tasks := do.MustInvoke[*shutdown.Tasks](i)
 	cfg := do.MustInvoke[config.AppConfig](i)
 	c, err := client.Dial(
 		client.Options{
-			HostPort:  cfg.Temporal.Host,
-			Namespace: cfg.Temporal.Namespace,
-			Logger:    logger,
+			HostPort:       cfg.Temporal.Host,
+			Namespace:      cfg.Temporal.Namespace,
+			Logger:         logger,
+			MetricsHandler: metrics.NewTemporalHandler(),
+			Interceptors:   []interceptor.ClientInterceptor{tracing.NewTemporalInterceptor()},
 		},
 	)
 	if err != nil {
@@ -933,3 +1494,119 @@ func NewTemporalClient(i *do.Injector) (client.Client, error) {
 	)
 	return c, nil
 }
+
+func NewTradingCalendarRepository(i *do.Injector) (tradingCalendarRepo.TradingCalendarRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return tradingCalendarPostgres.NewTradingCalendarPostgresRepository(getDbFunc), nil
+}
+
+func NewTradingCalendar(i *do.Injector) (tradingcalendar.Calendar, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	repository := do.MustInvoke[tradingCalendarRepo.TradingCalendarRepository](i)
+	return tradingcalendar.NewCalendar(cfg.TradingCalendar, repository)
+}
+
+func NewTradingCalendarUseCase(i *do.Injector) (tradingcalendar.UseCase, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	calendar := do.MustInvoke[tradingcalendar.Calendar](i)
+	repository := do.MustInvoke[tradingCalendarRepo.TradingCalendarRepository](i)
+	financingApiClient := do.MustInvoke[financingApiRepository.FinancingRepository](i)
+	return tradingcalendar.NewUseCase(cfg.TradingCalendar, calendar, repository, financingApiClient), nil
+}
+
+func NewTradingCalendarHandler(i *do.Injector) (*tradingCalendarHttp.TradingCalendarHandler, error) {
+	baseHandler := do.MustInvoke[handler.BaseHandler](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[tradingcalendar.UseCase](i)
+	return tradingCalendarHttp.NewTradingCalendarHandler(baseHandler, logger, useCase), nil
+}
+
+func NewTradingCalendarScheduler(i *do.Injector) (*tradingCalendarScheduler.TradingCalendarScheduler, error) {
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[tradingcalendar.UseCase](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return tradingCalendarScheduler.NewTradingCalendarScheduler(logger, useCase, errorService), nil
+}
+
+func NewWebhookSubscriptionRepository(i *do.Injector) (webhookRepo.WebhookSubscriptionRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return webhookPostgres.NewWebhookSubscriptionPostgresRepository(getDbFunc), nil
+}
+
+func NewWebhookDeliveryRepository(i *do.Injector) (webhookRepo.WebhookDeliveryRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return webhookPostgres.NewWebhookDeliveryPostgresRepository(getDbFunc), nil
+}
+
+func NewWebhookSenderRepository(i *do.Injector) (webhookRepo.WebhookSenderRepository, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	return webhookClient.NewClient(cfg.Webhook), nil
+}
+
+func NewWebhookEventPublisher(i *do.Injector) (webhookRepo.WebhookEventRepository, error) {
+	deliveryRepository := do.MustInvoke[webhookRepo.WebhookDeliveryRepository](i)
+	return webhook.NewEventPublisher(deliveryRepository), nil
+}
+
+func NewWebhookUseCase(i *do.Injector) (webhook.UseCase, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	subscriptionRepository := do.MustInvoke[webhookRepo.WebhookSubscriptionRepository](i)
+	deliveryRepository := do.MustInvoke[webhookRepo.WebhookDeliveryRepository](i)
+	sender := do.MustInvoke[webhookRepo.WebhookSenderRepository](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
+	return webhook.NewUseCase(
+		cfg.Webhook, logger, subscriptionRepository, deliveryRepository, sender, errorService, atomicExecutor,
+	), nil
+}
+
+func NewWebhookHandler(i *do.Injector) (*webhookHttp.WebhookHandler, error) {
+	baseHandler := do.MustInvoke[handler.BaseHandler](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[webhook.UseCase](i)
+	return webhookHttp.NewWebhookHandler(baseHandler, logger, useCase), nil
+}
+
+func NewWebhookDeliveryWorker(i *do.Injector) (*webhookWorker.DeliveryWorker, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[webhook.UseCase](i)
+	errorService := do.MustInvoke[apperrors.Service](i)
+	return webhookWorker.NewDeliveryWorker(cfg.Webhook, logger, useCase, errorService), nil
+}
+
+func NewNotificationTemplateRepository(i *do.Injector) (notificationTemplateRepo.NotificationTemplateRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return notificationTemplatePostgres.NewNotificationTemplatePostgresRepository(getDbFunc), nil
+}
+
+func NewNotificationTemplateUseCase(i *do.Injector) (notificationtemplate.UseCase, error) {
+	repository := do.MustInvoke[notificationTemplateRepo.NotificationTemplateRepository](i)
+	investorRepository := do.MustInvoke[investorRepo.InvestorPersistenceRepository](i)
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](i)
+	return notificationtemplate.NewUseCase(repository, investorRepository, atomicExecutor), nil
+}
+
+func NewNotificationTemplateRenderer(i *do.Injector) (notificationtemplate.Renderer, error) {
+	return do.MustInvoke[notificationtemplate.UseCase](i), nil
+}
+
+func NewNotificationTemplateHandler(i *do.Injector) (*notificationTemplateHttp.NotificationTemplateHandler, error) {
+	baseHandler := do.MustInvoke[handler.BaseHandler](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	useCase := do.MustInvoke[notificationtemplate.UseCase](i)
+	return notificationTemplateHttp.NewNotificationTemplateHandler(baseHandler, logger, useCase), nil
+}
+
+func NewKpiRepository(i *do.Injector) (metricsRepo.KpiRepository, error) {
+	getDbFunc := do.MustInvoke[database.GetDbFunc](i)
+	return metricsPostgres.NewKpiPostgresRepository(getDbFunc), nil
+}
+
+func NewKpiCollector(i *do.Injector) (*metrics.KpiCollector, error) {
+	cfg := do.MustInvoke[config.AppConfig](i)
+	logger := do.MustInvoke[*slog.Logger](i)
+	repository := do.MustInvoke[metricsRepo.KpiRepository](i)
+	return metrics.NewKpiCollector(cfg.Metrics, logger, repository), nil
+}
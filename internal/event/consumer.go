@@ -0,0 +1,297 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/tracing"
+	"financing-offer/pkg/number"
+)
+
+const (
+	HeaderDlqError         = "x-dlq-error"
+	HeaderDlqOriginalTopic = "x-dlq-original-topic"
+	HeaderDlqPartition     = "x-dlq-partition"
+	HeaderDlqOffset        = "x-dlq-offset"
+	HeaderDlqAttempts      = "x-dlq-attempts"
+	HeaderDlqConsumerGroup = "x-dlq-consumer-group"
+)
+
+// ErrPoisonMessage marks a message that can never be handled, like other permanent errors it goes to the dead letter topic
+// without being retried
+var ErrPoisonMessage = errors.New("poison message")
+
+// Poison wraps err so the consumer group dead-letters the message right away
+func Poison(err error) error {
+	return fmt.Errorf("%w: %w", ErrPoisonMessage, err)
+}
+
+type Handler interface {
+	Topic() string
+	Handle(ctx context.Context, message kafka.Message) error
+}
+
+var _ Handler = (*JsonHandler[any])(nil)
+
+// JsonHandler decodes the message value into E before handing it over, a value that is not valid json is poison
+type JsonHandler[E any] struct {
+	topic  string
+	handle func(ctx context.Context, key string, event E) error
+}
+
+func NewJsonHandler[E any](topic string, handle func(ctx context.Context, key string, event E) error) *JsonHandler[E] {
+	return &JsonHandler[E]{topic: topic, handle: handle}
+}
+
+func (h *JsonHandler[E]) Topic() string {
+	return h.topic
+}
+
+func (h *JsonHandler[E]) Handle(ctx context.Context, message kafka.Message) error {
+	var event E
+	if err := json.Unmarshal(message.Value, &event); err != nil {
+		return Poison(fmt.Errorf("JsonHandler %s decode: %w", h.topic, err))
+	}
+	return h.handle(ctx, string(message.Key), event)
+}
+
+type Reader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, messages ...kafka.Message) error
+	Close() error
+}
+
+// NewKafkaReaderFactory creates readers joining the configured consumer group, offsets are committed explicitly after handling
+func NewKafkaReaderFactory(cfg config.KafkaConfig) func(topic string) Reader {
+	startOffset := StartOffset(cfg.Consumer.StartOffset)
+	return func(topic string) Reader {
+		return kafka.NewReader(
+			kafka.ReaderConfig{
+				Brokers:     []string{cfg.Host},
+				GroupID:     cfg.Consumer.GroupId,
+				Topic:       topic,
+				StartOffset: startOffset,
+			},
+		)
+	}
+}
+
+// StartOffset is where a consumer group without a committed offset starts reading a partition,
+// "first" replays the whole retained topic and anything else only reads messages produced from now on
+func StartOffset(name string) int64 {
+	if name == config.KafkaStartOffsetFirst {
+		return kafka.FirstOffset
+	}
+	return kafka.LastOffset
+}
+
+// ConsumerGroup runs one reader per handled topic. A message is committed once it is handled or dead-lettered,
+// failures are retried with backoff and a message still failing after MaxAttempts goes to <topic><DlqTopicSuffix>
+type ConsumerGroup struct {
+	cfg          config.KafkaConsumerConfig
+	logger       *slog.Logger
+	newReader    func(topic string) Reader
+	publisher    Publisher
+	errorService apperrors.Service
+	handlers     []Handler
+	wg           sync.WaitGroup
+}
+
+func NewConsumerGroup(
+	cfg config.KafkaConsumerConfig,
+	logger *slog.Logger,
+	newReader func(topic string) Reader,
+	publisher Publisher,
+	errorService apperrors.Service,
+	handlers ...Handler,
+) *ConsumerGroup {
+	return &ConsumerGroup{
+		cfg:          cfg,
+		logger:       logger,
+		newReader:    newReader,
+		publisher:    publisher,
+		errorService: errorService,
+		handlers:     handlers,
+	}
+}
+
+// Start consumes every handled topic in its own goroutine until ctx is cancelled
+func (g *ConsumerGroup) Start(ctx context.Context) {
+	for _, handler := range g.handlers {
+		reader := g.newReader(handler.Topic())
+		g.wg.Add(1)
+		go func(handler Handler) {
+			defer g.wg.Done()
+			defer func() {
+				if err := reader.Close(); err != nil {
+					g.logger.Error("ConsumerGroup close reader", slog.String("topic", handler.Topic()), slog.String("error", err.Error()))
+				}
+			}()
+			g.consume(ctx, reader, handler)
+		}(handler)
+	}
+}
+
+// Wait blocks until every reader stopped, the message being handled when ctx was cancelled is left uncommitted
+func (g *ConsumerGroup) Wait() {
+	g.wg.Wait()
+}
+
+func (g *ConsumerGroup) consume(ctx context.Context, reader Reader, handler Handler) {
+	for {
+		message, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			g.notifyError(ctx, fmt.Errorf("ConsumerGroup fetch %s: %w", handler.Topic(), err))
+			if !sleep(ctx, g.cfg.RetryBackoff) {
+				return
+			}
+			continue
+		}
+		if !g.processTraced(ctx, handler, message) {
+			return
+		}
+		if err := reader.CommitMessages(ctx, message); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			g.notifyError(ctx, fmt.Errorf("ConsumerGroup commit %s: %w", handler.Topic(), err))
+		}
+	}
+}
+
+// processTraced processes message in a consumer span continuing the trace the message was published in
+func (g *ConsumerGroup) processTraced(ctx context.Context, handler Handler, message kafka.Message) bool {
+	ctx, span := otel.Tracer(tracerName).Start(
+		tracing.ExtractKafkaHeaders(ctx, message), message.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(message.Topic),
+			semconv.MessagingKafkaMessageKey(string(message.Key)),
+		),
+	)
+	defer span.End()
+	return g.process(ctx, handler, message)
+}
+
+// process reports false when ctx was cancelled before the message was handled or dead-lettered
+func (g *ConsumerGroup) process(ctx context.Context, handler Handler, message kafka.Message) bool {
+	backoff := g.cfg.RetryBackoff
+	var (
+		attempts int
+		err      error
+	)
+	for attempts = 1; ; attempts++ {
+		if err = handler.Handle(ctx, message); err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		if isPermanent(err) || attempts >= g.cfg.MaxAttempts {
+			break
+		}
+		g.logger.Warn(
+			"ConsumerGroup retry",
+			slog.String("topic", message.Topic),
+			slog.Int64("offset", message.Offset),
+			slog.Int("attempts", attempts),
+			slog.String("error", err.Error()),
+		)
+		if !sleep(ctx, backoff) {
+			return false
+		}
+		backoff = min(2*backoff, g.cfg.MaxRetryBackoff)
+	}
+	return g.deadLetter(ctx, message, attempts, err)
+}
+
+// deadLetter keeps trying to publish the message to the dead letter topic, the offset must not move past a lost message
+func (g *ConsumerGroup) deadLetter(ctx context.Context, message kafka.Message, attempts int, cause error) bool {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(cause)
+	span.SetStatus(codes.Error, cause.Error())
+	g.notifyError(
+		ctx, fmt.Errorf(
+			"ConsumerGroup dead letter %s partition %d offset %d after %d attempts: %w",
+			message.Topic, message.Partition, message.Offset, attempts, cause,
+		),
+	)
+	dlqMessage := kafka.Message{
+		Topic: message.Topic + g.cfg.DlqTopicSuffix,
+		Key:   message.Key,
+		Value: message.Value,
+		Headers: append(
+			append([]kafka.Header(nil), message.Headers...),
+			kafka.Header{Key: HeaderDlqError, Value: []byte(cause.Error())},
+			kafka.Header{Key: HeaderDlqOriginalTopic, Value: []byte(message.Topic)},
+			kafka.Header{Key: HeaderDlqPartition, Value: []byte(strconv.Itoa(message.Partition))},
+			kafka.Header{Key: HeaderDlqOffset, Value: []byte(strconv.FormatInt(message.Offset, 10))},
+			kafka.Header{Key: HeaderDlqAttempts, Value: []byte(strconv.Itoa(attempts))},
+			kafka.Header{Key: HeaderDlqConsumerGroup, Value: []byte(g.cfg.GroupId)},
+		),
+	}
+	for {
+		err := g.publisher.Publish(ctx, dlqMessage)
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		g.notifyError(ctx, fmt.Errorf("ConsumerGroup publish %s: %w", dlqMessage.Topic, err))
+		if !sleep(ctx, g.cfg.MaxRetryBackoff) {
+			return false
+		}
+	}
+}
+
+func (g *ConsumerGroup) notifyError(ctx context.Context, err error) {
+	g.logger.Error("ConsumerGroup", slog.String("error", err.Error()))
+	if notifyErr := g.errorService.NotifyError(ctx, err); notifyErr != nil {
+		g.logger.Error("ConsumerGroup NotifyError", slog.String("error", notifyErr.Error()))
+	}
+}
+
+// isPermanent reports the errors a retry cannot fix: poison messages, missing rows and client app errors
+func isPermanent(err error) bool {
+	if errors.Is(err, ErrPoisonMessage) || apperrors.IsNotFoundError(err) {
+		return true
+	}
+	var appErr apperrors.AppError
+	if errors.As(err, &appErr) {
+		code := number.GetFirstThreeDigits(appErr.Code)
+		return code >= http.StatusBadRequest && code < http.StatusInternalServerError
+	}
+	return false
+}
+
+// sleep reports false when ctx was cancelled before d elapsed
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
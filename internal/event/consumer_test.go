@@ -0,0 +1,212 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/test/mock"
+)
+
+func TestConsumerGroup(t *testing.T) {
+	t.Parallel()
+	cfg := config.KafkaConsumerConfig{
+		GroupId:         "financing-offer",
+		MaxAttempts:     3,
+		RetryBackoff:    time.Millisecond,
+		MaxRetryBackoff: 2 * time.Millisecond,
+		DlqTopicSuffix:  ".dlq",
+	}
+	message := kafka.Message{
+		Topic:     "account_margin_changed",
+		Partition: 1,
+		Offset:    42,
+		Key:       []byte("0001"),
+		Value:     []byte(`{"accountNo":"0001","marginStatus":"v3"}`),
+	}
+	type accountMarginChanged struct {
+		AccountNo    string `json:"accountNo"`
+		MarginStatus string `json:"marginStatus"`
+	}
+	// run consumes message once, the reader blocks on the next fetch until the group is stopped
+	run := func(t *testing.T, handler Handler, publisher Publisher, expectCommit bool) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		reader := mock.NewMockReader(t)
+		reader.EXPECT().FetchMessage(testifyMock.Anything).Return(message, nil).Once()
+		reader.EXPECT().FetchMessage(testifyMock.Anything).RunAndReturn(
+			func(ctx context.Context) (kafka.Message, error) {
+				<-ctx.Done()
+				return kafka.Message{}, ctx.Err()
+			},
+		).Maybe()
+		if expectCommit {
+			reader.EXPECT().CommitMessages(testifyMock.Anything, message).RunAndReturn(
+				func(context.Context, ...kafka.Message) error {
+					cancel()
+					return nil
+				},
+			)
+		}
+		reader.EXPECT().Close().Return(nil)
+		group := NewConsumerGroup(
+			cfg,
+			slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			func(topic string) Reader {
+				assert.Equal(t, message.Topic, topic)
+				return reader
+			},
+			publisher,
+			mock.ErrReporter{},
+			handler,
+		)
+		group.Start(ctx)
+		group.Wait()
+	}
+
+	t.Run(
+		"handled message is committed", func(t *testing.T) {
+			var handled accountMarginChanged
+			handler := NewJsonHandler(
+				message.Topic, func(_ context.Context, key string, event accountMarginChanged) error {
+					assert.Equal(t, "0001", key)
+					handled = event
+					return nil
+				},
+			)
+			run(t, handler, mock.NewMockPublisher(t), true)
+			assert.Equal(t, accountMarginChanged{AccountNo: "0001", MarginStatus: "v3"}, handled)
+		},
+	)
+
+	t.Run(
+		"failed message is retried", func(t *testing.T) {
+			attempts := 0
+			handler := NewJsonHandler(
+				message.Topic, func(context.Context, string, accountMarginChanged) error {
+					attempts++
+					if attempts == 1 {
+						return assert.AnError
+					}
+					return nil
+				},
+			)
+			run(t, handler, mock.NewMockPublisher(t), true)
+			assert.Equal(t, 2, attempts)
+		},
+	)
+
+	t.Run(
+		"message failing every attempt goes to the dead letter topic", func(t *testing.T) {
+			attempts := 0
+			handler := NewJsonHandler(
+				message.Topic, func(context.Context, string, accountMarginChanged) error {
+					attempts++
+					return assert.AnError
+				},
+			)
+			publisher := mock.NewMockPublisher(t)
+			publisher.EXPECT().Publish(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(dlqMessage kafka.Message) bool {
+						headers := make(map[string]string, len(dlqMessage.Headers))
+						for _, header := range dlqMessage.Headers {
+							headers[header.Key] = string(header.Value)
+						}
+						return dlqMessage.Topic == "account_margin_changed.dlq" &&
+							string(dlqMessage.Value) == string(message.Value) &&
+							headers[HeaderDlqOriginalTopic] == message.Topic &&
+							headers[HeaderDlqOffset] == "42" &&
+							headers[HeaderDlqAttempts] == "3" &&
+							headers[HeaderDlqError] == assert.AnError.Error()
+					},
+				),
+			).Return(nil)
+			run(t, handler, publisher, true)
+			assert.Equal(t, cfg.MaxAttempts, attempts)
+		},
+	)
+
+	t.Run(
+		"poison message goes to the dead letter topic without retry", func(t *testing.T) {
+			attempts := 0
+			handler := NewJsonHandler(
+				message.Topic, func(context.Context, string, accountMarginChanged) error {
+					attempts++
+					return apperrors.ErrInvalidInput("unknown margin status v4")
+				},
+			)
+			publisher := mock.NewMockPublisher(t)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(nil)
+			run(t, handler, publisher, true)
+			assert.Equal(t, 1, attempts)
+		},
+	)
+
+	t.Run(
+		"dead letter is published again after a failure", func(t *testing.T) {
+			handler := NewJsonHandler(
+				message.Topic, func(context.Context, string, accountMarginChanged) error {
+					return Poison(errors.New("broken"))
+				},
+			)
+			publisher := mock.NewMockPublisher(t)
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(assert.AnError).Once()
+			publisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(nil).Once()
+			run(t, handler, publisher, true)
+		},
+	)
+
+	t.Run(
+		"message is left uncommitted on shutdown", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			reader := mock.NewMockReader(t)
+			reader.EXPECT().FetchMessage(testifyMock.Anything).Return(message, nil).Once()
+			reader.EXPECT().Close().Return(nil)
+			handler := NewJsonHandler(
+				message.Topic, func(context.Context, string, accountMarginChanged) error {
+					cancel()
+					return context.Canceled
+				},
+			)
+			group := NewConsumerGroup(
+				cfg,
+				slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+				func(string) Reader { return reader },
+				mock.NewMockPublisher(t),
+				mock.ErrReporter{},
+				handler,
+			)
+			group.Start(ctx)
+			group.Wait()
+		},
+	)
+}
+
+func TestJsonHandler_InvalidJsonIsPoison(t *testing.T) {
+	t.Parallel()
+	handler := NewJsonHandler(
+		"topic", func(context.Context, string, map[string]string) error {
+			return nil
+		},
+	)
+	err := handler.Handle(context.Background(), kafka.Message{Value: []byte("{")})
+	assert.ErrorIs(t, err, ErrPoisonMessage)
+}
+
+func TestStartOffset(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, kafka.FirstOffset, StartOffset(config.KafkaStartOffsetFirst))
+	assert.Equal(t, kafka.LastOffset, StartOffset("last"))
+	assert.Equal(t, kafka.LastOffset, StartOffset(""))
+}
@@ -4,13 +4,21 @@ import (
 	"context"
 
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 
 	"financing-offer/internal/config"
+	"financing-offer/internal/metrics"
+	"financing-offer/internal/tracing"
 	"financing-offer/pkg/shutdown"
 )
 
+const tracerName = "financing-offer/internal/event"
+
 type Publisher interface {
-	Publish(message kafka.Message) error
+	Publish(ctx context.Context, message kafka.Message) error
 }
 
 type publisher struct {
@@ -33,6 +41,25 @@ func NewPublisher(cfg config.AppConfig, tasks *shutdown.Tasks) Publisher {
 	return &publisher{kafkaWriter: writer}
 }
 
-func (publisher *publisher) Publish(message kafka.Message) error {
-	return publisher.kafkaWriter.WriteMessages(context.Background(), message)
+// Publish writes message in a producer span, the trace context travels to the consumers in the message headers
+func (publisher *publisher) Publish(ctx context.Context, message kafka.Message) error {
+	ctx, span := otel.Tracer(tracerName).Start(
+		ctx, message.Topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(message.Topic),
+			semconv.MessagingKafkaMessageKey(string(message.Key)),
+		),
+	)
+	defer span.End()
+	message.Headers = append([]kafka.Header(nil), message.Headers...)
+	tracing.InjectKafkaHeaders(ctx, &message)
+	if err := publisher.kafkaWriter.WriteMessages(ctx, message); err != nil {
+		metrics.KafkaPublishFailures.WithLabelValues(message.Topic).Inc()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,117 @@
+package featureflag
+
+import (
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+
+	"financing-offer/internal/core/entity"
+)
+
+// Evaluate tells whether flag is on for subject, marginStatuses loads the margin statuses of the accounts of
+// the investor and is only called when the other segments of the flag match
+func Evaluate(
+	flag entity.FeatureFlag,
+	subject entity.FeatureFlagSubject,
+	marginStatuses func() ([]entity.MarginStatus, error),
+) (bool, error) {
+	if flag.Killed {
+		return false, nil
+	}
+	rules := flag.Rules
+	if slices.Contains(rules.InvestorIds, subject.InvestorId) {
+		return true, nil
+	}
+	if RolloutBucket(flag.Name, subject.InvestorId) >= rules.RolloutPercentage {
+		return false, nil
+	}
+	if len(rules.CustodyCodePrefixes) > 0 && !slices.ContainsFunc(
+		rules.CustodyCodePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(subject.CustodyCode, prefix)
+		},
+	) {
+		return false, nil
+	}
+	if rules.MinAppVersion != "" && CompareVersions(subject.AppVersion, rules.MinAppVersion) < 0 {
+		return false, nil
+	}
+	if len(rules.MarginStatuses) > 0 {
+		statuses, err := marginStatuses()
+		if err != nil {
+			return false, err
+		}
+		if !slices.ContainsFunc(
+			statuses, func(status entity.MarginStatus) bool {
+				return slices.Contains(rules.MarginStatuses, status)
+			},
+		) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// RolloutBucket places the investor in one of the 100 buckets of the flag, an investor keeps its bucket while the
+// percentage of the flag grows and the buckets of different flags are independent
+func RolloutBucket(flagName string, investorId string) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(flagName + ":" + investorId))
+	return int(hash.Sum32() % 100)
+}
+
+// CompareVersions compares two dotted app versions numerically, "2.10.0" is after "2.9.1".
+// A version that cannot be parsed, such as an empty one, is before every valid version
+func CompareVersions(a, b string) int {
+	aParts, aOk := parseVersion(a)
+	bParts, bOk := parseVersion(b)
+	switch {
+	case !aOk && !bOk:
+		return 0
+	case !aOk:
+		return -1
+	case !bOk:
+		return 1
+	}
+	for i := 0; i < max(len(aParts), len(bParts)); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+		if aPart != bPart {
+			if aPart < bPart {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseVersion parses the numeric parts of a version like "v2.5.1", suffixes such as "-beta" or " (123)" are ignored
+func parseVersion(version string) ([]int, bool) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if end := strings.IndexFunc(
+		version, func(r rune) bool {
+			return r != '.' && (r < '0' || r > '9')
+		},
+	); end >= 0 {
+		version = version[:end]
+	}
+	if version == "" {
+		return nil, false
+	}
+	parts := strings.Split(version, ".")
+	res := make([]int, 0, len(parts))
+	for _, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, false
+		}
+		res = append(res, number)
+	}
+	return res, true
+}
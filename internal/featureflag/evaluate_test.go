@@ -0,0 +1,210 @@
+package featureflag
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	// investor ids rolled out to by a 50% rollout of loanRequest, and excluded from it
+	var inRollout, outOfRollout string
+	for i := 0; inRollout == "" || outOfRollout == ""; i++ {
+		investorId := fmt.Sprintf("%010d", i)
+		if RolloutBucket("loanRequest", investorId) < 50 {
+			inRollout = investorId
+		} else {
+			outOfRollout = investorId
+		}
+	}
+	noMarginStatuses := func() ([]entity.MarginStatus, error) {
+		return nil, errors.New("should not load margin statuses")
+	}
+	marginStatuses := func(statuses ...entity.MarginStatus) func() ([]entity.MarginStatus, error) {
+		return func() ([]entity.MarginStatus, error) { return statuses, nil }
+	}
+	flag := func(killed bool, rules entity.FeatureFlagRules) entity.FeatureFlag {
+		return entity.FeatureFlag{Name: "loanRequest", Killed: killed, Rules: rules}
+	}
+
+	testCases := []struct {
+		name           string
+		flag           entity.FeatureFlag
+		subject        entity.FeatureFlagSubject
+		marginStatuses func() ([]entity.MarginStatus, error)
+		expected       bool
+	}{
+		{
+			name:           "killed flag is off for listed investors",
+			flag:           flag(true, entity.FeatureFlagRules{InvestorIds: []string{inRollout}, RolloutPercentage: 100}),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout},
+			marginStatuses: noMarginStatuses,
+			expected:       false,
+		},
+		{
+			name: "listed investor skips the segments",
+			flag: flag(
+				false, entity.FeatureFlagRules{InvestorIds: []string{outOfRollout}, MinAppVersion: "3.0.0"},
+			),
+			subject:        entity.FeatureFlagSubject{InvestorId: outOfRollout},
+			marginStatuses: noMarginStatuses,
+			expected:       true,
+		},
+		{
+			name:           "no rollout",
+			flag:           flag(false, entity.FeatureFlagRules{}),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout},
+			marginStatuses: noMarginStatuses,
+			expected:       false,
+		},
+		{
+			name:           "investor in rollout",
+			flag:           flag(false, entity.FeatureFlagRules{RolloutPercentage: 50}),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout},
+			marginStatuses: noMarginStatuses,
+			expected:       true,
+		},
+		{
+			name:           "investor out of rollout",
+			flag:           flag(false, entity.FeatureFlagRules{RolloutPercentage: 50}),
+			subject:        entity.FeatureFlagSubject{InvestorId: outOfRollout},
+			marginStatuses: noMarginStatuses,
+			expected:       false,
+		},
+		{
+			name: "custody code prefix matches",
+			flag: flag(
+				false, entity.FeatureFlagRules{RolloutPercentage: 100, CustodyCodePrefixes: []string{"064A", "064C"}},
+			),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout, CustodyCode: "064C000115"},
+			marginStatuses: noMarginStatuses,
+			expected:       true,
+		},
+		{
+			name:           "custody code prefix does not match",
+			flag:           flag(false, entity.FeatureFlagRules{RolloutPercentage: 100, CustodyCodePrefixes: []string{"064A"}}),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout, CustodyCode: "064C000115"},
+			marginStatuses: noMarginStatuses,
+			expected:       false,
+		},
+		{
+			name:           "app version is recent enough",
+			flag:           flag(false, entity.FeatureFlagRules{RolloutPercentage: 100, MinAppVersion: "2.9.0"}),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout, AppVersion: "2.10.0"},
+			marginStatuses: noMarginStatuses,
+			expected:       true,
+		},
+		{
+			name:           "app version is too old",
+			flag:           flag(false, entity.FeatureFlagRules{RolloutPercentage: 100, MinAppVersion: "2.9.0"}),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout, AppVersion: "2.8.5"},
+			marginStatuses: noMarginStatuses,
+			expected:       false,
+		},
+		{
+			name:           "app version is missing",
+			flag:           flag(false, entity.FeatureFlagRules{RolloutPercentage: 100, MinAppVersion: "2.9.0"}),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout},
+			marginStatuses: noMarginStatuses,
+			expected:       false,
+		},
+		{
+			name: "an account has a targeted margin status",
+			flag: flag(
+				false, entity.FeatureFlagRules{
+					RolloutPercentage: 100, MarginStatuses: []entity.MarginStatus{entity.MarginStatusVersion3},
+				},
+			),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout},
+			marginStatuses: marginStatuses(entity.MarginStatusVersion2, entity.MarginStatusVersion3),
+			expected:       true,
+		},
+		{
+			name: "no account has a targeted margin status",
+			flag: flag(
+				false, entity.FeatureFlagRules{
+					RolloutPercentage: 100, MarginStatuses: []entity.MarginStatus{entity.MarginStatusVersion3},
+				},
+			),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout},
+			marginStatuses: marginStatuses(entity.MarginStatusVersion2),
+			expected:       false,
+		},
+		{
+			name: "margin statuses are not loaded when another segment does not match",
+			flag: flag(
+				false, entity.FeatureFlagRules{
+					RolloutPercentage:   100,
+					CustodyCodePrefixes: []string{"064A"},
+					MarginStatuses:      []entity.MarginStatus{entity.MarginStatusVersion3},
+				},
+			),
+			subject:        entity.FeatureFlagSubject{InvestorId: inRollout, CustodyCode: "064C000115"},
+			marginStatuses: noMarginStatuses,
+			expected:       false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				res, err := Evaluate(tc.flag, tc.subject, tc.marginStatuses)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.expected, res)
+			},
+		)
+	}
+
+	t.Run(
+		"load margin statuses error", func(t *testing.T) {
+			_, err := Evaluate(
+				flag(
+					false, entity.FeatureFlagRules{
+						RolloutPercentage: 100, MarginStatuses: []entity.MarginStatus{entity.MarginStatusVersion3},
+					},
+				),
+				entity.FeatureFlagSubject{InvestorId: inRollout},
+				func() ([]entity.MarginStatus, error) { return nil, assert.AnError },
+			)
+			assert.ErrorIs(t, err, assert.AnError)
+		},
+	)
+}
+
+func TestRolloutBucket(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, RolloutBucket("loanRequest", "0001000115"), RolloutBucket("loanRequest", "0001000115"))
+
+	const investors = 10000
+	inRollout := 0
+	for i := 0; i < investors; i++ {
+		if RolloutBucket("loanRequest", fmt.Sprintf("%010d", i)) < 20 {
+			inRollout++
+		}
+	}
+	assert.InDelta(t, investors/5, inRollout, investors*0.02)
+}
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "2.10.0", b: "2.9.1", expected: 1},
+		{a: "2.9", b: "2.9.0", expected: 0},
+		{a: "v2.9.0", b: "2.9.0", expected: 0},
+		{a: "2.9.0-beta", b: "2.9.0", expected: 0},
+		{a: "2.9.0 (1234)", b: "2.9.1", expected: -1},
+		{a: "", b: "1.0.0", expected: -1},
+		{a: "latest", b: "1.0.0", expected: -1},
+		{a: "1.0.0", b: "", expected: 1},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, CompareVersions(tc.a, tc.b), "%s vs %s", tc.a, tc.b)
+	}
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"financing-offer/internal/core/entity"
+)
+
+type FeatureFlagRepository interface {
+	GetAll(ctx context.Context) ([]entity.FeatureFlag, error)
+	GetById(ctx context.Context, id int64) (entity.FeatureFlag, error)
+	Create(ctx context.Context, flag entity.FeatureFlag) (entity.FeatureFlag, error)
+	Update(ctx context.Context, flag entity.FeatureFlag) (entity.FeatureFlag, error)
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/internal/featureflag/repository"
+)
+
+var _ repository.FeatureFlagRepository = (*FeatureFlagPostgresRepository)(nil)
+
+type FeatureFlagPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewFeatureFlagPostgresRepository(getDbFunc database.GetDbFunc) *FeatureFlagPostgresRepository {
+	return &FeatureFlagPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *FeatureFlagPostgresRepository) GetAll(ctx context.Context) ([]entity.FeatureFlag, error) {
+	errorTemplate := "FeatureFlagPostgresRepository GetAll %w"
+	dest := make([]model.FeatureFlag, 0)
+	if err := table.FeatureFlag.SELECT(table.FeatureFlag.AllColumns).
+		ORDER_BY(table.FeatureFlag.Name).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	res, err := MapFeatureFlagsDbToEntity(dest)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (r *FeatureFlagPostgresRepository) GetById(ctx context.Context, id int64) (entity.FeatureFlag, error) {
+	errorTemplate := "FeatureFlagPostgresRepository GetById %w"
+	var dest model.FeatureFlag
+	if err := table.FeatureFlag.SELECT(table.FeatureFlag.AllColumns).
+		WHERE(table.FeatureFlag.ID.EQ(postgres.Int64(id))).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	res, err := MapFeatureFlagDbToEntity(dest)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (r *FeatureFlagPostgresRepository) Create(ctx context.Context, flag entity.FeatureFlag) (entity.FeatureFlag, error) {
+	errorTemplate := "FeatureFlagPostgresRepository Create %w"
+	createModel, err := MapFeatureFlagEntityToDb(flag)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	created := model.FeatureFlag{}
+	if err := table.FeatureFlag.INSERT(table.FeatureFlag.MutableColumns).
+		MODEL(createModel).
+		RETURNING(table.FeatureFlag.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &created); err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	res, err := MapFeatureFlagDbToEntity(created)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (r *FeatureFlagPostgresRepository) Update(ctx context.Context, flag entity.FeatureFlag) (entity.FeatureFlag, error) {
+	errorTemplate := "FeatureFlagPostgresRepository Update %w"
+	updateModel, err := MapFeatureFlagEntityToDb(flag)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	updated := model.FeatureFlag{}
+	if err := table.FeatureFlag.UPDATE(
+		table.FeatureFlag.Description,
+		table.FeatureFlag.Killed,
+		table.FeatureFlag.Rules,
+		table.FeatureFlag.UpdatedBy,
+	).
+		MODEL(updateModel).
+		WHERE(table.FeatureFlag.ID.EQ(postgres.Int64(flag.Id))).
+		RETURNING(table.FeatureFlag.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &updated); err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	res, err := MapFeatureFlagDbToEntity(updated)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	return res, nil
+}
+
+func (r *FeatureFlagPostgresRepository) Delete(ctx context.Context, id int64) error {
+	if _, err := table.FeatureFlag.DELETE().
+		WHERE(table.FeatureFlag.ID.EQ(postgres.Int64(id))).
+		ExecContext(ctx, r.getDbFunc(ctx)); err != nil {
+		return fmt.Errorf("FeatureFlagPostgresRepository Delete %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestFeatureFlagPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, err := dbtest.New()
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	repo := NewFeatureFlagPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	columns := []string{
+		"feature_flag.id",
+		"feature_flag.name",
+		"feature_flag.description",
+		"feature_flag.killed",
+		"feature_flag.rules",
+		"feature_flag.created_by",
+		"feature_flag.updated_by",
+		"feature_flag.created_at",
+		"feature_flag.updated_at",
+	}
+	now := time.Now()
+	flag := entity.FeatureFlag{
+		Id:          1,
+		Name:        "loanRequest",
+		Description: "loan package requests",
+		Rules: entity.FeatureFlagRules{
+			InvestorIds:         []string{"0001000115"},
+			RolloutPercentage:   20,
+			CustodyCodePrefixes: []string{"064C"},
+			MarginStatuses:      []entity.MarginStatus{entity.MarginStatusVersion3},
+			MinAppVersion:       "2.5.0",
+		},
+		CreatedBy: "admin",
+		UpdatedBy: "admin",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	rules := `{"investorIds":["0001000115"],"rolloutPercentage":20,"custodyCodePrefixes":["064C"],"marginStatuses":["v3"],"minAppVersion":"2.5.0"}`
+
+	t.Run(
+		"get all maps the rules", func(t *testing.T) {
+			mock.ExpectQuery(`SELECT (.+) FROM public.feature_flag ORDER BY feature_flag.name`).WillReturnRows(
+				sqlmock.NewRows(columns).AddRow(
+					flag.Id, flag.Name, flag.Description, flag.Killed, rules, flag.CreatedBy, flag.UpdatedBy,
+					flag.CreatedAt, flag.UpdatedAt,
+				),
+			)
+			res, err := repo.GetAll(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, []entity.FeatureFlag{flag}, res)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"get all with invalid rules", func(t *testing.T) {
+			mock.ExpectQuery("SELECT").WillReturnRows(
+				sqlmock.NewRows(columns).AddRow(
+					flag.Id, flag.Name, flag.Description, flag.Killed, "[]", flag.CreatedBy, flag.UpdatedBy,
+					flag.CreatedAt, flag.UpdatedAt,
+				),
+			)
+			_, err := repo.GetAll(context.Background())
+			assert.NotNil(t, err)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"update error", func(t *testing.T) {
+			mock.ExpectQuery(`UPDATE public.feature_flag`).WillReturnError(assert.AnError)
+			_, err := repo.Update(context.Background(), flag)
+			assert.ErrorIs(t, err, assert.AnError)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+}
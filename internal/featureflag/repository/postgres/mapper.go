@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func MapFeatureFlagDbToEntity(flag model.FeatureFlag) (entity.FeatureFlag, error) {
+	rules := entity.FeatureFlagRules{}
+	if err := json.Unmarshal([]byte(flag.Rules), &rules); err != nil {
+		return entity.FeatureFlag{}, err
+	}
+	return entity.FeatureFlag{
+		Id:          flag.ID,
+		Name:        flag.Name,
+		Description: flag.Description,
+		Killed:      flag.Killed,
+		Rules:       rules,
+		CreatedBy:   flag.CreatedBy,
+		UpdatedBy:   flag.UpdatedBy,
+		CreatedAt:   flag.CreatedAt,
+		UpdatedAt:   flag.UpdatedAt,
+	}, nil
+}
+
+func MapFeatureFlagEntityToDb(flag entity.FeatureFlag) (model.FeatureFlag, error) {
+	rulesJSON, err := json.Marshal(flag.Rules)
+	if err != nil {
+		return model.FeatureFlag{}, err
+	}
+	return model.FeatureFlag{
+		ID:          flag.Id,
+		Name:        flag.Name,
+		Description: flag.Description,
+		Killed:      flag.Killed,
+		Rules:       string(rulesJSON),
+		CreatedBy:   flag.CreatedBy,
+		UpdatedBy:   flag.UpdatedBy,
+		CreatedAt:   flag.CreatedAt,
+		UpdatedAt:   flag.UpdatedAt,
+	}, nil
+}
+
+func MapFeatureFlagsDbToEntity(flags []model.FeatureFlag) ([]entity.FeatureFlag, error) {
+	res := make([]entity.FeatureFlag, 0, len(flags))
+	for _, flag := range flags {
+		e, err := MapFeatureFlagDbToEntity(flag)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package featureflag
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/featureflag/repository"
+)
+
+// Channel is the postgres channel the feature_flag table notifies the name of its changed flags on
+const Channel = "feature_flag_changed"
+
+type Listener interface {
+	Listen(
+		ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+		onReconnect func(ctx context.Context) error,
+	) error
+}
+
+// Store keeps the flags of the feature_flag table in process. They are reloaded on the first read after a change
+// is notified on Channel, when the listener reconnects since notifications may have been missed meanwhile,
+// and after RefreshInterval in case the listener has not noticed it was disconnected yet.
+// A failed reload is logged and the previous flags are served until the next RefreshInterval
+type Store struct {
+	cfg        config.FeatureFlagConfig
+	logger     *slog.Logger
+	repository repository.FeatureFlagRepository
+	listener   Listener
+
+	mu       sync.RWMutex
+	flags    map[string]entity.FeatureFlag
+	stale    bool
+	loadedAt time.Time
+}
+
+func NewStore(
+	cfg config.FeatureFlagConfig,
+	logger *slog.Logger,
+	repository repository.FeatureFlagRepository,
+	listener Listener,
+) *Store {
+	return &Store{
+		cfg:        cfg,
+		logger:     logger,
+		repository: repository,
+		listener:   listener,
+	}
+}
+
+// Start listens to the changes of the flags until ctx is cancelled
+func (s *Store) Start(ctx context.Context) error {
+	if err := s.listener.Listen(ctx, Channel, s.OnNotify, s.OnReconnect); err != nil {
+		return fmt.Errorf("featureFlagStore Start %w", err)
+	}
+	return nil
+}
+
+// OnNotify reloads the flags on the next read, data is the name of the changed flag
+func (s *Store) OnNotify(_ context.Context, _ string) error {
+	s.Invalidate()
+	return nil
+}
+
+// OnReconnect reloads the flags on the next read, a change notified while the listener was disconnected is lost
+func (s *Store) OnReconnect(_ context.Context) error {
+	s.Invalidate()
+	return nil
+}
+
+func (s *Store) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stale = true
+}
+
+// Get returns the flag named name, the bool return value is false when no such flag is stored
+func (s *Store) Get(ctx context.Context, name string) (entity.FeatureFlag, bool, error) {
+	flags, err := s.load(ctx)
+	if err != nil {
+		return entity.FeatureFlag{}, false, fmt.Errorf("featureFlagStore Get %w", err)
+	}
+	flag, ok := flags[name]
+	return flag, ok, nil
+}
+
+func (s *Store) load(ctx context.Context) (map[string]entity.FeatureFlag, error) {
+	s.mu.RLock()
+	if s.fresh() {
+		defer s.mu.RUnlock()
+		return s.flags, nil
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fresh() {
+		return s.flags, nil
+	}
+	// the reload serves every waiting request, it must not fail because the one running it is cancelled
+	flags, err := s.repository.GetAll(context.WithoutCancel(ctx))
+	if err != nil {
+		if s.flags == nil {
+			return nil, err
+		}
+		s.logger.Error("reload feature flags", slog.String("error", err.Error()))
+		s.stale = false
+		s.loadedAt = time.Now()
+		return s.flags, nil
+	}
+	s.flags = make(map[string]entity.FeatureFlag, len(flags))
+	for _, flag := range flags {
+		s.flags[flag.Name] = flag
+	}
+	s.stale = false
+	s.loadedAt = time.Now()
+	return s.flags, nil
+}
+
+func (s *Store) fresh() bool {
+	return s.flags != nil && !s.stale && time.Since(s.loadedAt) < s.cfg.RefreshInterval
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"financing-offer/internal/core/entity"
 	"financing-offer/internal/featureflag"
 	"financing-offer/internal/handler"
 )
@@ -24,18 +25,34 @@ func NewFeatureHandler(baseHandler handler.BaseHandler, logger *slog.Logger, use
 	}
 }
 
+// CheckFeatureEnable godoc
+//
+//	@Summary		Verify feature
+//	@Description	Tell whether a feature is enabled for the investor, the flags stored in the database override the
+//	@Description	features of the config
+//	@Tags			feature,investor
+//	@Accept			json
+//	@Produce		json
+//	@Param			name			path		string	true	"feature name"
+//	@Param			X-App-Version	header		string	false	"app version"
+//	@Success		200				{object}	handler.BaseResponse[bool]
+//	@Failure		400				{object}	handler.ErrorResponse
+//	@Failure		401				{object}	handler.ErrorResponse
+//	@Failure		500				{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/features/{name}/verify [get]
 func (h *FeatureHandler) CheckFeatureEnable(ctx *gin.Context) {
 	featureName := ctx.Param("name")
 	if featureName == "" {
 		h.RenderBadRequest(ctx, "feature name is required")
 		return
 	}
-	investorId, err := h.InvestorId(ctx)
+	subject, err := FeatureFlagSubject(ctx)
 	if err != nil {
 		h.RenderUnauthenticated(ctx, err.Error())
 		return
 	}
-	isEnable, err := h.useCase.IsFeatureEnable(featureName, investorId)
+	isEnable, err := h.useCase.IsFeatureEnable(ctx, featureName, subject)
 	if err != nil {
 		h.RenderError(ctx, err)
 		return
@@ -46,3 +63,175 @@ func (h *FeatureHandler) CheckFeatureEnable(ctx *gin.Context) {
 		},
 	)
 }
+
+// GetAll godoc
+//
+//	@Summary		Get feature flags
+//	@Description	Get the feature flags stored in the database
+//	@Tags			feature,admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	handler.BaseResponse[[]entity.FeatureFlag]
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/feature-flags [get]
+func (h *FeatureHandler) GetAll(ctx *gin.Context) {
+	flags, err := h.useCase.GetAll(ctx)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[[]entity.FeatureFlag]{Data: flags})
+}
+
+// GetById godoc
+//
+//	@Summary		Get feature flag
+//	@Description	Get a feature flag
+//	@Tags			feature,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	handler.BaseResponse[entity.FeatureFlag]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		404	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/feature-flags/{id} [get]
+func (h *FeatureHandler) GetById(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	flag, err := h.useCase.GetById(ctx, id)
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.FeatureFlag]{Data: flag})
+}
+
+// Create godoc
+//
+//	@Summary		Create feature flag
+//	@Description	Create a feature flag, it overrides the feature of the config with the same name
+//	@Tags			feature,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			flag	body		CreateFeatureFlagRequest	true	"feature flag"
+//	@Success		201		{object}	handler.BaseResponse[entity.FeatureFlag]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		409		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/feature-flags [post]
+func (h *FeatureHandler) Create(ctx *gin.Context) {
+	req := CreateFeatureFlagRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("create feature flag", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	created, err := h.useCase.Create(ctx, req.toEntity(h.UserSubOrEmpty(ctx)))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, handler.BaseResponse[entity.FeatureFlag]{Data: created})
+}
+
+// Update godoc
+//
+//	@Summary		Update feature flag
+//	@Description	Replace the description and rules of a feature flag, the replicas reload it once the change is notified
+//	@Tags			feature,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"id"
+//	@Param			flag	body		UpdateFeatureFlagRequest	true	"feature flag"
+//	@Success		200		{object}	handler.BaseResponse[entity.FeatureFlag]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		404		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/feature-flags/{id} [patch]
+func (h *FeatureHandler) Update(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	req := UpdateFeatureFlagRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("update feature flag", slog.String("error", err.Error()))
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	updated, err := h.useCase.Update(ctx, req.toEntity(id, h.UserSubOrEmpty(ctx)))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.FeatureFlag]{Data: updated})
+}
+
+// SetKillSwitch godoc
+//
+//	@Summary		Set feature flag kill switch
+//	@Description	Turn a feature flag off for every investor, or back to its rules
+//	@Tags			feature,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"id"
+//	@Param			switch	body		SetKillSwitchRequest	true	"kill switch"
+//	@Success		200		{object}	handler.BaseResponse[entity.FeatureFlag]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		404		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/feature-flags/{id}/kill-switch [put]
+func (h *FeatureHandler) SetKillSwitch(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	req := SetKillSwitchRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		h.RenderBadRequest(ctx, "invalid payload")
+		return
+	}
+	updated, err := h.useCase.SetKilled(ctx, id, req.Killed, h.UserSubOrEmpty(ctx))
+	if err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, handler.BaseResponse[entity.FeatureFlag]{Data: updated})
+}
+
+// Delete godoc
+//
+//	@Summary		Delete feature flag
+//	@Description	Delete a feature flag, the feature of the config with the same name applies again
+//	@Tags			feature,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		204	{object}	handler.BaseResponse[string]
+//	@Failure		400	{object}	handler.ErrorResponse
+//	@Failure		500	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/feature-flags/{id} [delete]
+func (h *FeatureHandler) Delete(ctx *gin.Context) {
+	id, err := h.ParamsInt(ctx)
+	if err != nil {
+		h.RenderIdInvalid(ctx)
+		return
+	}
+	if err := h.useCase.Delete(ctx, id); err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusNoContent, handler.BaseResponse[string]{Data: "ok"})
+}
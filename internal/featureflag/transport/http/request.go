@@ -0,0 +1,80 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/appcontext"
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/entity"
+)
+
+// AppVersionHeader carries the version of the app sending the request, flags with a minimum app version are off
+// for the requests without it
+const AppVersionHeader = "X-App-Version"
+
+// FeatureFlagSubject returns the authenticated investor the flags of the request are evaluated for
+func FeatureFlagSubject(c *gin.Context) (entity.FeatureFlagSubject, error) {
+	customerInfo := appcontext.ContextGetCustomerInfo(c)
+	if customerInfo == nil || customerInfo.InvestorId == "" {
+		return entity.FeatureFlagSubject{}, apperrors.ErrInvalidInvestorId
+	}
+	return entity.FeatureFlagSubject{
+		InvestorId:  customerInfo.InvestorId,
+		CustodyCode: customerInfo.CustodyCode,
+		AppVersion:  c.GetHeader(AppVersionHeader),
+	}, nil
+}
+
+type FeatureFlagRulesRequest struct {
+	InvestorIds         []string              `json:"investorIds"`
+	RolloutPercentage   int                   `json:"rolloutPercentage" binding:"min=0,max=100"`
+	CustodyCodePrefixes []string              `json:"custodyCodePrefixes"`
+	MarginStatuses      []entity.MarginStatus `json:"marginStatuses"`
+	MinAppVersion       string                `json:"minAppVersion"`
+}
+
+func (r FeatureFlagRulesRequest) toEntity() entity.FeatureFlagRules {
+	return entity.FeatureFlagRules{
+		InvestorIds:         r.InvestorIds,
+		RolloutPercentage:   r.RolloutPercentage,
+		CustodyCodePrefixes: r.CustodyCodePrefixes,
+		MarginStatuses:      r.MarginStatuses,
+		MinAppVersion:       r.MinAppVersion,
+	}
+}
+
+type CreateFeatureFlagRequest struct {
+	Name        string                  `json:"name" binding:"required,max=100"`
+	Description string                  `json:"description"`
+	Killed      bool                    `json:"killed"`
+	Rules       FeatureFlagRulesRequest `json:"rules"`
+}
+
+func (r CreateFeatureFlagRequest) toEntity(creator string) entity.FeatureFlag {
+	return entity.FeatureFlag{
+		Name:        r.Name,
+		Description: r.Description,
+		Killed:      r.Killed,
+		Rules:       r.Rules.toEntity(),
+		CreatedBy:   creator,
+		UpdatedBy:   creator,
+	}
+}
+
+type UpdateFeatureFlagRequest struct {
+	Description string                  `json:"description"`
+	Rules       FeatureFlagRulesRequest `json:"rules"`
+}
+
+func (r UpdateFeatureFlagRequest) toEntity(id int64, updater string) entity.FeatureFlag {
+	return entity.FeatureFlag{
+		Id:          id,
+		Description: r.Description,
+		Rules:       r.Rules.toEntity(),
+		UpdatedBy:   updater,
+	}
+}
+
+type SetKillSwitchRequest struct {
+	Killed bool `json:"killed"`
+}
@@ -1,32 +1,208 @@
 package featureflag
 
 import (
+	"context"
+	"fmt"
 	"slices"
 
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/atomicity"
 	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	investorAccountRepo "financing-offer/internal/core/investor_account/repository"
+	"financing-offer/internal/featureflag/repository"
 )
 
 type UseCase interface {
-	IsFeatureEnable(featureName string, investorId string) (bool, error)
+	IsFeatureEnable(ctx context.Context, featureName string, subject entity.FeatureFlagSubject) (bool, error)
+	GetAll(ctx context.Context) ([]entity.FeatureFlag, error)
+	GetById(ctx context.Context, id int64) (entity.FeatureFlag, error)
+	Create(ctx context.Context, flag entity.FeatureFlag) (entity.FeatureFlag, error)
+	Update(ctx context.Context, flag entity.FeatureFlag) (entity.FeatureFlag, error)
+	SetKilled(ctx context.Context, id int64, killed bool, updatedBy string) (entity.FeatureFlag, error)
+	Delete(ctx context.Context, id int64) error
 }
 
 type useCase struct {
-	features map[string]config.FeatureConfig
+	features                  map[string]config.FeatureConfig
+	store                     *Store
+	repository                repository.FeatureFlagRepository
+	investorAccountRepository investorAccountRepo.InvestorAccountRepository
+	atomicExecutor            atomicity.AtomicExecutor
 }
 
-func (u *useCase) IsFeatureEnable(featureName string, investorId string) (bool, error) {
+func NewUseCase(
+	features map[string]config.FeatureConfig,
+	store *Store,
+	repository repository.FeatureFlagRepository,
+	investorAccountRepository investorAccountRepo.InvestorAccountRepository,
+	atomicExecutor atomicity.AtomicExecutor,
+) UseCase {
+	return &useCase{
+		features:                  features,
+		store:                     store,
+		repository:                repository,
+		investorAccountRepository: investorAccountRepository,
+		atomicExecutor:            atomicExecutor,
+	}
+}
+
+// IsFeatureEnable evaluates the stored flag named featureName, the features of the config are used for the names
+// without a stored flag
+func (u *useCase) IsFeatureEnable(ctx context.Context, featureName string, subject entity.FeatureFlagSubject) (bool, error) {
+	errorTemplate := "featureFlagUseCase IsFeatureEnable %w"
+	flag, ok, err := u.store.Get(ctx, featureName)
+	if err != nil {
+		return false, fmt.Errorf(errorTemplate, err)
+	}
+	if !ok {
+		return u.isConfigFeatureEnable(featureName, subject.InvestorId), nil
+	}
+	isEnable, err := Evaluate(
+		flag, subject, func() ([]entity.MarginStatus, error) {
+			accounts, err := u.investorAccountRepository.GetByInvestorId(ctx, subject.InvestorId)
+			if err != nil {
+				return nil, err
+			}
+			statuses := make([]entity.MarginStatus, 0, len(accounts))
+			for _, account := range accounts {
+				statuses = append(statuses, account.MarginStatus)
+			}
+			return statuses, nil
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf(errorTemplate, err)
+	}
+	return isEnable, nil
+}
+
+func (u *useCase) isConfigFeatureEnable(featureName string, investorId string) bool {
 	feature, ok := u.features[featureName]
 	if !ok {
-		return false, nil
+		return false
 	}
 	if feature.Enable {
-		return true, nil
+		return true
 	}
-	return slices.Contains(feature.InvestorIds, investorId), nil
+	return slices.Contains(feature.InvestorIds, investorId)
 }
 
-func NewUseCase(features map[string]config.FeatureConfig) UseCase {
-	return &useCase{
-		features: features,
+func (u *useCase) GetAll(ctx context.Context) ([]entity.FeatureFlag, error) {
+	res, err := u.repository.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("featureFlagUseCase GetAll %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) GetById(ctx context.Context, id int64) (entity.FeatureFlag, error) {
+	res, err := u.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf("featureFlagUseCase GetById %w", err)
+	}
+	return res, nil
+}
+
+func (u *useCase) Create(ctx context.Context, flag entity.FeatureFlag) (entity.FeatureFlag, error) {
+	if err := validateRules(flag.Rules); err != nil {
+		return entity.FeatureFlag{}, err
+	}
+	var res entity.FeatureFlag
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.repository.Create(tc, flag)
+			return err
+		},
+	)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf("featureFlagUseCase Create %w", err)
+	}
+	u.store.Invalidate()
+	return res, nil
+}
+
+// Update replaces the description and rules of a flag, the name is fixed and the kill switch is set by SetKilled
+func (u *useCase) Update(ctx context.Context, flag entity.FeatureFlag) (entity.FeatureFlag, error) {
+	errorTemplate := "featureFlagUseCase Update %w"
+	if err := validateRules(flag.Rules); err != nil {
+		return entity.FeatureFlag{}, err
+	}
+	existing, err := u.repository.GetById(ctx, flag.Id)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	existing.Description = flag.Description
+	existing.Rules = flag.Rules
+	existing.UpdatedBy = flag.UpdatedBy
+	res, err := u.update(ctx, existing)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	u.store.Invalidate()
+	return res, nil
+}
+
+// SetKilled turns a flag off for everyone, or back to its rules, without touching them
+func (u *useCase) SetKilled(ctx context.Context, id int64, killed bool, updatedBy string) (entity.FeatureFlag, error) {
+	errorTemplate := "featureFlagUseCase SetKilled %w"
+	existing, err := u.repository.GetById(ctx, id)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	existing.Killed = killed
+	existing.UpdatedBy = updatedBy
+	res, err := u.update(ctx, existing)
+	if err != nil {
+		return entity.FeatureFlag{}, fmt.Errorf(errorTemplate, err)
+	}
+	u.store.Invalidate()
+	return res, nil
+}
+
+// Delete removes a flag, the feature of the config with the same name applies again
+func (u *useCase) Delete(ctx context.Context, id int64) error {
+	err := u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			return u.repository.Delete(tc, id)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("featureFlagUseCase Delete %w", err)
+	}
+	u.store.Invalidate()
+	return nil
+}
+
+// update saves flag through atomicExecutor, which records the admin making the change for the audit log
+func (u *useCase) update(ctx context.Context, flag entity.FeatureFlag) (res entity.FeatureFlag, err error) {
+	err = u.atomicExecutor.Execute(
+		ctx, func(tc context.Context) (err error) {
+			res, err = u.repository.Update(tc, flag)
+			return err
+		},
+	)
+	return res, err
+}
+
+func validateRules(rules entity.FeatureFlagRules) error {
+	if rules.RolloutPercentage < 0 || rules.RolloutPercentage > 100 {
+		return apperrors.ErrInvalidInput("rollout percentage must be between 0 and 100")
+	}
+	for _, prefix := range rules.CustodyCodePrefixes {
+		if prefix == "" {
+			return apperrors.ErrInvalidInput("custody code prefixes must not be empty")
+		}
+	}
+	for _, status := range rules.MarginStatuses {
+		if entity.MarginStatusFromString(status.String()) == "" {
+			return apperrors.ErrInvalidInput(fmt.Sprintf("unsupported margin status %s", status))
+		}
+	}
+	if rules.MinAppVersion != "" {
+		if _, ok := parseVersion(rules.MinAppVersion); !ok {
+			return apperrors.ErrInvalidInput(fmt.Sprintf("invalid app version %s", rules.MinAppVersion))
+		}
 	}
+	return nil
 }
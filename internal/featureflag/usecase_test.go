@@ -0,0 +1,292 @@
+package featureflag
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestFeatureFlagUseCase_IsFeatureEnable(t *testing.T) {
+	t.Parallel()
+	features := map[string]config.FeatureConfig{
+		"loanRequest": {Enable: false, InvestorIds: []string{"0001000115"}},
+		"loanOffer":   {Enable: true},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	newUseCase := func(t *testing.T) (UseCase, *mock.MockFeatureFlagRepository, *mock.MockInvestorAccountRepository) {
+		repository := mock.NewMockFeatureFlagRepository(t)
+		investorAccountRepository := mock.NewMockInvestorAccountRepository(t)
+		store := NewStore(config.FeatureFlagConfig{RefreshInterval: time.Minute}, logger, repository, nil)
+		return NewUseCase(
+			features, store, repository, investorAccountRepository, mock.NewMockAtomicExecutorExecutePassthrough(t),
+		), repository, investorAccountRepository
+	}
+	subject := entity.FeatureFlagSubject{InvestorId: "0001000115", CustodyCode: "064C000115"}
+
+	t.Run(
+		"fall back to the config", func(t *testing.T) {
+			useCase, repository, _ := newUseCase(t)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{}, nil).Once()
+
+			res, err := useCase.IsFeatureEnable(context.Background(), "loanRequest", subject)
+			assert.Nil(t, err)
+			assert.True(t, res)
+			res, err = useCase.IsFeatureEnable(context.Background(), "loanRequest", entity.FeatureFlagSubject{InvestorId: "1"})
+			assert.Nil(t, err)
+			assert.False(t, res)
+			res, err = useCase.IsFeatureEnable(context.Background(), "loanOffer", entity.FeatureFlagSubject{InvestorId: "1"})
+			assert.Nil(t, err)
+			assert.True(t, res)
+			res, err = useCase.IsFeatureEnable(context.Background(), "unknown", subject)
+			assert.Nil(t, err)
+			assert.False(t, res)
+		},
+	)
+
+	t.Run(
+		"stored flag overrides the config", func(t *testing.T) {
+			useCase, repository, _ := newUseCase(t)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return(
+				[]entity.FeatureFlag{{Name: "loanOffer", Killed: true}}, nil,
+			).Once()
+
+			res, err := useCase.IsFeatureEnable(context.Background(), "loanOffer", subject)
+			assert.Nil(t, err)
+			assert.False(t, res)
+		},
+	)
+
+	t.Run(
+		"load margin statuses of the investor", func(t *testing.T) {
+			useCase, repository, investorAccountRepository := newUseCase(t)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return(
+				[]entity.FeatureFlag{
+					{
+						Name: "loanRequest",
+						Rules: entity.FeatureFlagRules{
+							RolloutPercentage: 100,
+							MarginStatuses:    []entity.MarginStatus{entity.MarginStatusVersion3},
+						},
+					},
+				}, nil,
+			).Once()
+			investorAccountRepository.EXPECT().GetByInvestorId(testifyMock.Anything, "0001000115").Return(
+				[]entity.InvestorAccount{{AccountNo: "0001000115", MarginStatus: entity.MarginStatusVersion3}}, nil,
+			)
+
+			res, err := useCase.IsFeatureEnable(context.Background(), "loanRequest", subject)
+			assert.Nil(t, err)
+			assert.True(t, res)
+		},
+	)
+
+	t.Run(
+		"load flags error", func(t *testing.T) {
+			useCase, repository, _ := newUseCase(t)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return(nil, assert.AnError)
+
+			_, err := useCase.IsFeatureEnable(context.Background(), "loanRequest", subject)
+			assert.ErrorIs(t, err, assert.AnError)
+		},
+	)
+}
+
+func TestFeatureFlagUseCase_Write(t *testing.T) {
+	t.Parallel()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	newUseCase := func(t *testing.T) (UseCase, *Store, *mock.MockFeatureFlagRepository) {
+		repository := mock.NewMockFeatureFlagRepository(t)
+		store := NewStore(config.FeatureFlagConfig{RefreshInterval: time.Minute}, logger, repository, nil)
+		return NewUseCase(
+			nil, store, repository, mock.NewMockInvestorAccountRepository(t), mock.NewMockAtomicExecutorExecutePassthrough(t),
+		), store, repository
+	}
+	existing := entity.FeatureFlag{
+		Id:          1,
+		Name:        "loanRequest",
+		Description: "loan package requests",
+		Rules:       entity.FeatureFlagRules{RolloutPercentage: 10},
+		CreatedBy:   "admin",
+		UpdatedBy:   "admin",
+	}
+
+	t.Run(
+		"create invalid rules", func(t *testing.T) {
+			useCase, _, _ := newUseCase(t)
+			for _, rules := range []entity.FeatureFlagRules{
+				{RolloutPercentage: 101},
+				{CustodyCodePrefixes: []string{""}},
+				{MarginStatuses: []entity.MarginStatus{"v1"}},
+				{MinAppVersion: "latest"},
+			} {
+				_, err := useCase.Create(context.Background(), entity.FeatureFlag{Name: "loanRequest", Rules: rules})
+				assert.IsType(t, apperrors.AppError{}, err)
+			}
+		},
+	)
+
+	t.Run(
+		"update keeps name and kill switch", func(t *testing.T) {
+			useCase, _, repository := newUseCase(t)
+			killed := existing
+			killed.Killed = true
+			repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(killed, nil)
+			expected := killed
+			expected.Description = "rolled out to v3"
+			expected.Rules = entity.FeatureFlagRules{
+				RolloutPercentage: 50, MarginStatuses: []entity.MarginStatus{entity.MarginStatusVersion3},
+			}
+			expected.UpdatedBy = "operator"
+			repository.EXPECT().Update(testifyMock.Anything, expected).Return(expected, nil)
+
+			res, err := useCase.Update(
+				context.Background(), entity.FeatureFlag{
+					Id:          1,
+					Name:        "renamed",
+					Description: expected.Description,
+					Rules:       expected.Rules,
+					UpdatedBy:   "operator",
+				},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, res)
+		},
+	)
+
+	t.Run(
+		"kill switch reloads the flags", func(t *testing.T) {
+			useCase, store, repository := newUseCase(t)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{existing}, nil).Once()
+			flag, ok, err := store.Get(context.Background(), "loanRequest")
+			assert.Nil(t, err)
+			assert.True(t, ok)
+			assert.False(t, flag.Killed)
+
+			killed := existing
+			killed.Killed = true
+			killed.UpdatedBy = "operator"
+			repository.EXPECT().GetById(testifyMock.Anything, int64(1)).Return(existing, nil)
+			repository.EXPECT().Update(testifyMock.Anything, killed).Return(killed, nil)
+			_, err = useCase.SetKilled(context.Background(), 1, true, "operator")
+			assert.Nil(t, err)
+
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{killed}, nil).Once()
+			flag, _, err = store.Get(context.Background(), "loanRequest")
+			assert.Nil(t, err)
+			assert.True(t, flag.Killed)
+		},
+	)
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	flag := entity.FeatureFlag{Id: 1, Name: "loanRequest"}
+
+	t.Run(
+		"keep flags until notified", func(t *testing.T) {
+			repository := mock.NewMockFeatureFlagRepository(t)
+			store := NewStore(config.FeatureFlagConfig{RefreshInterval: time.Minute}, logger, repository, nil)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{flag}, nil).Times(2)
+
+			for range 3 {
+				_, ok, err := store.Get(context.Background(), "loanRequest")
+				assert.Nil(t, err)
+				assert.True(t, ok)
+			}
+			assert.Nil(t, store.OnNotify(context.Background(), "loanRequest"))
+			_, ok, err := store.Get(context.Background(), "loanRequest")
+			assert.Nil(t, err)
+			assert.True(t, ok)
+		},
+	)
+
+	t.Run(
+		"reload after the listener reconnects", func(t *testing.T) {
+			repository := mock.NewMockFeatureFlagRepository(t)
+			store := NewStore(config.FeatureFlagConfig{RefreshInterval: time.Minute}, logger, repository, nil)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{}, nil).Once()
+			_, ok, _ := store.Get(context.Background(), "loanRequest")
+			assert.False(t, ok)
+
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{flag}, nil).Once()
+			assert.Nil(t, store.OnReconnect(context.Background()))
+			_, ok, err := store.Get(context.Background(), "loanRequest")
+			assert.Nil(t, err)
+			assert.True(t, ok)
+		},
+	)
+
+	t.Run(
+		"reload after refresh interval", func(t *testing.T) {
+			repository := mock.NewMockFeatureFlagRepository(t)
+			store := NewStore(config.FeatureFlagConfig{RefreshInterval: time.Millisecond}, logger, repository, nil)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{}, nil).Once()
+			_, ok, _ := store.Get(context.Background(), "loanRequest")
+			assert.False(t, ok)
+
+			time.Sleep(5 * time.Millisecond)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{flag}, nil).Once()
+			_, ok, _ = store.Get(context.Background(), "loanRequest")
+			assert.True(t, ok)
+		},
+	)
+
+	t.Run(
+		"serve previous flags when reload fails", func(t *testing.T) {
+			repository := mock.NewMockFeatureFlagRepository(t)
+			store := NewStore(config.FeatureFlagConfig{RefreshInterval: time.Minute}, logger, repository, nil)
+			repository.EXPECT().GetAll(testifyMock.Anything).Return([]entity.FeatureFlag{flag}, nil).Once()
+			_, _, _ = store.Get(context.Background(), "loanRequest")
+
+			store.Invalidate()
+			repository.EXPECT().GetAll(testifyMock.Anything).Return(nil, assert.AnError).Once()
+			res, ok, err := store.Get(context.Background(), "loanRequest")
+			assert.Nil(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, flag, res)
+		},
+	)
+
+	t.Run(
+		"start listens to channel", func(t *testing.T) {
+			var channels []string
+			store := NewStore(
+				config.FeatureFlagConfig{}, logger, mock.NewMockFeatureFlagRepository(t),
+				listenerFunc(
+					func(
+						_ context.Context, channel string, _ func(ctx context.Context, data string) error,
+						_ func(ctx context.Context) error,
+					) error {
+						channels = append(channels, channel)
+						return nil
+					},
+				),
+			)
+			assert.Nil(t, store.Start(context.Background()))
+			assert.Equal(t, []string{Channel}, channels)
+		},
+	)
+}
+
+type listenerFunc func(
+	ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+	onReconnect func(ctx context.Context) error,
+) error
+
+func (f listenerFunc) Listen(
+	ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+	onReconnect func(ctx context.Context) error,
+) error {
+	return f(ctx, channel, callback, onReconnect)
+}
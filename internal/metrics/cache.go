@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"financing-offer/pkg/cache"
+)
+
+var _ cache.Cache = (*instrumentedCache)(nil)
+
+type instrumentedCache struct {
+	cache.Cache
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+// InstrumentCache counts the lookups on the cache under the given name, the store itself is untouched
+func InstrumentCache(name string, store cache.Cache) cache.Cache {
+	return &instrumentedCache{
+		Cache:  store,
+		hits:   CacheRequests.WithLabelValues(name, CacheResultHit),
+		misses: CacheRequests.WithLabelValues(name, CacheResultMiss),
+	}
+}
+
+func (c *instrumentedCache) Get(key string) (any, bool) {
+	value, ok := c.Cache.Get(key)
+	if ok {
+		c.hits.Inc()
+	} else {
+		c.misses.Inc()
+	}
+	return value, ok
+}
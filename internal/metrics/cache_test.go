@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/pkg/cache"
+)
+
+type mapCache map[string]any
+
+func (c mapCache) Get(key string) (any, bool) {
+	value, ok := c[key]
+	return value, ok
+}
+
+func (c mapCache) SetTtl(key string, value any, _ time.Duration) {
+	c[key] = value
+}
+
+func (c mapCache) Del(key string) {
+	delete(c, key)
+}
+
+func (c mapCache) DelPrefix(prefix string) {
+	for key := range c {
+		if strings.HasPrefix(key, prefix) {
+			delete(c, key)
+		}
+	}
+}
+
+func TestInstrumentCache(t *testing.T) {
+	t.Parallel()
+	store := InstrumentCache("test_cache", mapCache{})
+	hits := CacheRequests.WithLabelValues("test_cache", CacheResultHit)
+	misses := CacheRequests.WithLabelValues("test_cache", CacheResultMiss)
+
+	_, err := cache.Do[int](store, "key", cache.DefaultTtl, func() (int, error) { return 1, nil })
+	assert.Nil(t, err)
+	value, err := cache.Do[int](store, "key", cache.DefaultTtl, func() (int, error) { return 2, nil })
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, value)
+	assert.Equal(t, float64(1), testutil.ToFloat64(hits))
+	assert.Equal(t, float64(1), testutil.ToFloat64(misses))
+}
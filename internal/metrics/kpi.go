@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/metrics/repository"
+)
+
+var _ prometheus.Collector = (*KpiCollector)(nil)
+
+var (
+	pendingRequestsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "pending_loan_requests"),
+		"Loan package requests waiting for an offer, by asset type.",
+		[]string{"asset_type"}, nil,
+	)
+	offersNearingExpiryDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "offers_nearing_expiry"),
+		"Offers with a pending line expiring within the configured window.",
+		nil, nil,
+	)
+	stalePackageCreatingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "stale_package_creating_lines"),
+		"Offer lines left PACKAGE_CREATING longer than the configured duration.",
+		nil, nil,
+	)
+)
+
+// KpiCollector reads the business gauges from the database on every scrape, a gauge whose query failed
+// is left out of the scrape rather than reported as zero
+type KpiCollector struct {
+	cfg        config.MetricsConfig
+	logger     *slog.Logger
+	repository repository.KpiRepository
+}
+
+func NewKpiCollector(cfg config.MetricsConfig, logger *slog.Logger, repository repository.KpiRepository) *KpiCollector {
+	return &KpiCollector{
+		cfg:        cfg,
+		logger:     logger,
+		repository: repository,
+	}
+}
+
+func (c *KpiCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pendingRequestsDesc
+	ch <- offersNearingExpiryDesc
+	ch <- stalePackageCreatingDesc
+}
+
+func (c *KpiCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.KpiTimeout)
+	defer cancel()
+	now := time.Now()
+	pendingRequests, err := c.repository.CountPendingRequestsByAssetType(ctx)
+	if err != nil {
+		c.logger.Error("KpiCollector pending requests not collected", slog.String("error", err.Error()))
+	} else {
+		for _, assetType := range []entity.AssetType{entity.AssetTypeUnderlying, entity.AssetTypeDerivative} {
+			ch <- prometheus.MustNewConstMetric(
+				pendingRequestsDesc, prometheus.GaugeValue, float64(pendingRequests[assetType]), assetType.String(),
+			)
+		}
+	}
+	offers, err := c.repository.CountOffersExpiringBefore(ctx, now.Add(c.cfg.OfferExpiryWindow))
+	if err != nil {
+		c.logger.Error("KpiCollector offers nearing expiry not collected", slog.String("error", err.Error()))
+	} else {
+		ch <- prometheus.MustNewConstMetric(offersNearingExpiryDesc, prometheus.GaugeValue, float64(offers))
+	}
+	lines, err := c.repository.CountCreatingLinesUpdatedBefore(ctx, now.Add(-c.cfg.PackageCreatingStaleAfter))
+	if err != nil {
+		c.logger.Error("KpiCollector stale package creating lines not collected", slog.String("error", err.Error()))
+	} else {
+		ch <- prometheus.MustNewConstMetric(stalePackageCreatingDesc, prometheus.GaugeValue, float64(lines))
+	}
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestKpiCollector_Collect(t *testing.T) {
+	t.Parallel()
+	cfg := config.MetricsConfig{
+		OfferExpiryWindow:         24 * time.Hour,
+		PackageCreatingStaleAfter: 15 * time.Minute,
+		KpiTimeout:                time.Second,
+	}
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	t.Run("collect business gauges", func(t *testing.T) {
+		repository := mock.NewMockKpiRepository(t)
+		repository.EXPECT().CountPendingRequestsByAssetType(testifyMock.Anything).Return(
+			map[entity.AssetType]int64{entity.AssetTypeUnderlying: 3}, nil,
+		)
+		repository.EXPECT().CountOffersExpiringBefore(
+			testifyMock.Anything, testifyMock.MatchedBy(
+				func(before time.Time) bool {
+					return before.After(time.Now().Add(23 * time.Hour))
+				},
+			),
+		).Return(2, nil)
+		repository.EXPECT().CountCreatingLinesUpdatedBefore(
+			testifyMock.Anything, testifyMock.MatchedBy(
+				func(before time.Time) bool {
+					return before.Before(time.Now().Add(-14 * time.Minute))
+				},
+			),
+		).Return(1, nil)
+
+		err := testutil.CollectAndCompare(
+			NewKpiCollector(cfg, logger, repository), strings.NewReader(`
+# HELP financing_offer_offers_nearing_expiry Offers with a pending line expiring within the configured window.
+# TYPE financing_offer_offers_nearing_expiry gauge
+financing_offer_offers_nearing_expiry 2
+# HELP financing_offer_pending_loan_requests Loan package requests waiting for an offer, by asset type.
+# TYPE financing_offer_pending_loan_requests gauge
+financing_offer_pending_loan_requests{asset_type="DERIVATIVE"} 0
+financing_offer_pending_loan_requests{asset_type="UNDERLYING"} 3
+# HELP financing_offer_stale_package_creating_lines Offer lines left PACKAGE_CREATING longer than the configured duration.
+# TYPE financing_offer_stale_package_creating_lines gauge
+financing_offer_stale_package_creating_lines 1
+`),
+		)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("omit gauge when query fails", func(t *testing.T) {
+		repository := mock.NewMockKpiRepository(t)
+		repository.EXPECT().CountPendingRequestsByAssetType(testifyMock.Anything).Return(nil, errors.New("db down"))
+		repository.EXPECT().CountOffersExpiringBefore(testifyMock.Anything, testifyMock.Anything).Return(0, errors.New("db down"))
+		repository.EXPECT().CountCreatingLinesUpdatedBefore(testifyMock.Anything, testifyMock.Anything).Return(4, nil)
+
+		collector := NewKpiCollector(cfg, logger, repository)
+
+		assert.Equal(t, 1, testutil.CollectAndCount(collector))
+	})
+}
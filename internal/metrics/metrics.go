@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "financing_offer"
+
+const (
+	CacheResultHit  = "hit"
+	CacheResultMiss = "miss"
+)
+
+// Registry holds the collectors exposed on /metrics, it is kept apart from the default registry so only
+// the metrics of this service are exposed
+var Registry = prometheus.NewRegistry()
+
+var (
+	HttpRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of the http requests served, by route template and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"},
+	)
+	UpstreamCallDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "upstream_call_duration_seconds",
+			Help:      "Latency of every attempt of a call to an upstream service, by outcome.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"upstream", "operation", "outcome"},
+	)
+	UpstreamCallErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_call_errors_total",
+			Help:      "Failed calls to an upstream service, by reason.",
+		}, []string{"upstream", "reason"},
+	)
+	JobRuns = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "job_runs_total",
+			Help:      "Scheduler job runs, by status.",
+		}, []string{"job", "status"},
+	)
+	JobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "job_duration_seconds",
+			Help:      "Duration of the scheduler job runs this instance executed, by status.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+		}, []string{"job", "status"},
+	)
+	KafkaPublishFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "kafka_publish_failures_total",
+			Help:      "Messages kafka refused, by topic.",
+		}, []string{"topic"},
+	)
+	CacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_requests_total",
+			Help:      "Cache lookups by result, the hit ratio is hit over all lookups.",
+		}, []string{"cache", "result"},
+	)
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HttpRequestDuration,
+		UpstreamCallDuration,
+		UpstreamCallErrors,
+		JobRuns,
+		JobDuration,
+		KafkaPublishFailures,
+		CacheRequests,
+	)
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
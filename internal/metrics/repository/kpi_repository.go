@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financing-offer/internal/core/entity"
+)
+
+// KpiRepository counts the business figures exposed as gauges on /metrics
+type KpiRepository interface {
+	CountPendingRequestsByAssetType(ctx context.Context) (map[entity.AssetType]int64, error)
+	// CountOffersExpiringBefore counts the offers not expired yet that expire before and still have a pending line
+	CountOffersExpiringBefore(ctx context.Context, before time.Time) (int64, error)
+	CountCreatingLinesUpdatedBefore(ctx context.Context, before time.Time) (int64, error)
+}
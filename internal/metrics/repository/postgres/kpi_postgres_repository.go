@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/internal/metrics/repository"
+)
+
+var _ repository.KpiRepository = (*KpiPostgresRepository)(nil)
+
+type KpiPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewKpiPostgresRepository(getDbFunc database.GetDbFunc) *KpiPostgresRepository {
+	return &KpiPostgresRepository{getDbFunc: getDbFunc}
+}
+
+type assetTypeCount struct {
+	AssetType string
+	Count     int64
+}
+
+func (r *KpiPostgresRepository) CountPendingRequestsByAssetType(ctx context.Context) (map[entity.AssetType]int64, error) {
+	dest := make([]assetTypeCount, 0)
+	if err := table.LoanPackageRequest.SELECT(
+		table.LoanPackageRequest.AssetType.AS("asset_type_count.asset_type"),
+		postgres.COUNT(table.LoanPackageRequest.ID).AS("asset_type_count.count"),
+	).
+		WHERE(table.LoanPackageRequest.Status.EQ(postgres.String(entity.LoanPackageRequestStatusPending.String()))).
+		GROUP_BY(table.LoanPackageRequest.AssetType).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("KpiPostgresRepository CountPendingRequestsByAssetType %w", err)
+	}
+	counts := make(map[entity.AssetType]int64, len(dest))
+	for _, row := range dest {
+		counts[entity.AssetType(row.AssetType)] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *KpiPostgresRepository) CountOffersExpiringBefore(ctx context.Context, before time.Time) (int64, error) {
+	dest := struct {
+		Count int64
+	}{}
+	pendingLine := table.LoanPackageOfferInterest.
+		SELECT(table.LoanPackageOfferInterest.ID).
+		WHERE(
+			table.LoanPackageOfferInterest.LoanPackageOfferID.EQ(table.LoanPackageOffer.ID).
+				AND(table.LoanPackageOfferInterest.Status.EQ(postgres.String(entity.LoanPackageOfferInterestStatusPending.String()))),
+		)
+	if err := table.LoanPackageOffer.SELECT(postgres.COUNT(table.LoanPackageOffer.ID).AS("count")).
+		WHERE(
+			table.LoanPackageOffer.ExpiredAt.GT(postgres.TimestampT(time.Now())).
+				AND(table.LoanPackageOffer.ExpiredAt.LT_EQ(postgres.TimestampT(before))).
+				AND(postgres.EXISTS(pendingLine)),
+		).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return 0, fmt.Errorf("KpiPostgresRepository CountOffersExpiringBefore %w", err)
+	}
+	return dest.Count, nil
+}
+
+func (r *KpiPostgresRepository) CountCreatingLinesUpdatedBefore(ctx context.Context, before time.Time) (int64, error) {
+	dest := struct {
+		Count int64
+	}{}
+	if err := table.LoanPackageOfferInterest.SELECT(postgres.COUNT(table.LoanPackageOfferInterest.ID).AS("count")).
+		WHERE(
+			table.LoanPackageOfferInterest.Status.EQ(postgres.String(entity.LoanPackageOfferInterestStatusCreatingLoanPackage.String())).
+				AND(table.LoanPackageOfferInterest.UpdatedAt.LT(postgres.TimestampT(before))),
+		).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest); err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return 0, fmt.Errorf("KpiPostgresRepository CountCreatingLinesUpdatedBefore %w", err)
+	}
+	return dest.Count, nil
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestKpiPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, err := dbtest.New()
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	repo := NewKpiPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+
+	t.Run(
+		"count pending requests by asset type", func(t *testing.T) {
+			mock.ExpectQuery(`SELECT (.+) FROM public.loan_package_request WHERE (.+) GROUP BY (.+)`).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"asset_type_count.asset_type", "asset_type_count.count"}).
+						AddRow("UNDERLYING", 3).
+						AddRow("DERIVATIVE", 1),
+				)
+			counts, err := repo.CountPendingRequestsByAssetType(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, map[entity.AssetType]int64{entity.AssetTypeUnderlying: 3, entity.AssetTypeDerivative: 1}, counts)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"count offers expiring before", func(t *testing.T) {
+			mock.ExpectQuery(`SELECT (.+) FROM public.loan_package_offer WHERE (.+)EXISTS (.+)`).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			count, err := repo.CountOffersExpiringBefore(context.Background(), time.Now().Add(time.Hour))
+			assert.Nil(t, err)
+			assert.Equal(t, int64(2), count)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"count creating lines updated before error", func(t *testing.T) {
+			mock.ExpectQuery(`SELECT (.+) FROM public.loan_package_offer_interest`).WillReturnError(assert.AnError)
+			_, err := repo.CountCreatingLinesUpdatedBefore(context.Background(), time.Now())
+			assert.ErrorIs(t, err, assert.AnError)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		},
+	)
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/client"
+)
+
+const (
+	temporalUpstream       = "temporal"
+	temporalRequestLatency = "temporal_request_latency"
+	temporalRequestFailure = "temporal_request_failure"
+	temporalOperationTag   = "operation"
+)
+
+var _ client.MetricsHandler = TemporalHandler{}
+
+// TemporalHandler records the calls of the Temporal client to the server as upstream calls, the other
+// metrics of the sdk are dropped
+type TemporalHandler struct {
+	operation string
+}
+
+func NewTemporalHandler() TemporalHandler {
+	return TemporalHandler{}
+}
+
+func (h TemporalHandler) WithTags(tags map[string]string) client.MetricsHandler {
+	if operation, ok := tags[temporalOperationTag]; ok {
+		h.operation = operation
+	}
+	return h
+}
+
+func (h TemporalHandler) Counter(name string) client.MetricsCounter {
+	if name != temporalRequestFailure {
+		return client.MetricsNopHandler.Counter(name)
+	}
+	errors := UpstreamCallErrors.WithLabelValues(temporalUpstream, "request_failure")
+	return counterFunc(func(d int64) { errors.Add(float64(d)) })
+}
+
+func (h TemporalHandler) Gauge(name string) client.MetricsGauge {
+	return client.MetricsNopHandler.Gauge(name)
+}
+
+func (h TemporalHandler) Timer(name string) client.MetricsTimer {
+	if name != temporalRequestLatency {
+		return client.MetricsNopHandler.Timer(name)
+	}
+	duration := UpstreamCallDuration.WithLabelValues(temporalUpstream, h.operation, "completed")
+	return timerFunc(func(d time.Duration) { duration.Observe(d.Seconds()) })
+}
+
+type counterFunc func(int64)
+
+func (f counterFunc) Inc(d int64) { f(d) }
+
+type timerFunc func(time.Duration)
+
+func (f timerFunc) Record(d time.Duration) { f(d) }
@@ -0,0 +1,92 @@
+package permission
+
+type Permission string
+
+const (
+	SymbolRead                Permission = "symbol:read"
+	SymbolWrite               Permission = "symbol:write"
+	SymbolBlacklist           Permission = "symbol:blacklist"
+	SymbolCancelRequests      Permission = "symbol:cancel-requests"
+	StockExchangeRead         Permission = "stock-exchange:read"
+	StockExchangeWrite        Permission = "stock-exchange:write"
+	SymbolScoreWrite          Permission = "symbol-score:write"
+	LoanRequestRead           Permission = "loan-request:read"
+	LoanRequestConfirm        Permission = "loan-request:confirm"
+	LoanRequestDecline        Permission = "loan-request:decline"
+	ScoreGroupRead            Permission = "score-group:read"
+	ScoreGroupWrite           Permission = "score-group:write"
+	LoanOfferRead             Permission = "loan-offer:read"
+	LoanOfferWrite            Permission = "loan-offer:write"
+	ConfigRead                Permission = "config:read"
+	ConfigLoanRateWrite       Permission = "config:loan-rate:write"
+	ConfigMarginPoolWrite     Permission = "config:margin-pool:write"
+	PromotionRead             Permission = "promotion:read"
+	PromotionWrite            Permission = "promotion:write"
+	LoanPolicyRead            Permission = "loan-policy:read"
+	LoanPolicyWrite           Permission = "loan-policy:write"
+	SchedulerRead             Permission = "scheduler:read"
+	SchedulerWrite            Permission = "scheduler:write"
+	InvestorAccountWrite      Permission = "investor-account:write"
+	SubmissionWrite           Permission = "submission:write"
+	SubmissionApprove         Permission = "submission:approve"
+	SuggestedOfferRead        Permission = "suggested-offer-config:read"
+	SuggestedOfferWrite       Permission = "suggested-offer-config:write"
+	SubmissionDefaultRead     Permission = "submission-default:read"
+	SubmissionDefaultSet      Permission = "submission-default:write"
+	AuditLogRead              Permission = "audit-log:read"
+	TradingCalendarRead       Permission = "trading-calendar:read"
+	TradingCalendarWrite      Permission = "trading-calendar:write"
+	WebhookRead               Permission = "webhook:read"
+	WebhookWrite              Permission = "webhook:write"
+	NotificationTemplateRead  Permission = "notification-template:read"
+	NotificationTemplateWrite Permission = "notification-template:write"
+	CachePurge                Permission = "cache:purge"
+	FeatureFlagRead           Permission = "feature-flag:read"
+	FeatureFlagWrite          Permission = "feature-flag:write"
+
+	// Wildcard grants every permission to a role
+	Wildcard = "*"
+)
+
+var All = []Permission{
+	SymbolRead,
+	SymbolWrite,
+	SymbolBlacklist,
+	SymbolCancelRequests,
+	StockExchangeRead,
+	StockExchangeWrite,
+	SymbolScoreWrite,
+	LoanRequestRead,
+	LoanRequestConfirm,
+	LoanRequestDecline,
+	ScoreGroupRead,
+	ScoreGroupWrite,
+	LoanOfferRead,
+	LoanOfferWrite,
+	ConfigRead,
+	ConfigLoanRateWrite,
+	ConfigMarginPoolWrite,
+	PromotionRead,
+	PromotionWrite,
+	LoanPolicyRead,
+	LoanPolicyWrite,
+	SchedulerRead,
+	SchedulerWrite,
+	InvestorAccountWrite,
+	SubmissionWrite,
+	SubmissionApprove,
+	SuggestedOfferRead,
+	SuggestedOfferWrite,
+	SubmissionDefaultRead,
+	SubmissionDefaultSet,
+	AuditLogRead,
+	TradingCalendarRead,
+	TradingCalendarWrite,
+	WebhookRead,
+	WebhookWrite,
+	NotificationTemplateRead,
+	NotificationTemplateWrite,
+	CachePurge,
+	FeatureFlagRead,
+	FeatureFlagWrite,
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/appcontext"
+	"financing-offer/internal/handler"
+	"financing-offer/internal/permission"
+)
+
+type PermissionHandler struct {
+	handler.BaseHandler
+	useCase permission.UseCase
+}
+
+func NewPermissionHandler(baseHandler handler.BaseHandler, useCase permission.UseCase) *PermissionHandler {
+	return &PermissionHandler{
+		BaseHandler: baseHandler,
+		useCase:     useCase,
+	}
+}
+
+type MyPermissionsResponse struct {
+	Roles       []string                `json:"roles"`
+	Permissions []permission.Permission `json:"permissions"`
+}
+
+// GetMyPermissions godoc
+//
+//	@Summary		Get my permissions
+//	@Description	Get the permissions granted to the roles of the current user
+//	@Tags			permission
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	handler.BaseResponse[MyPermissionsResponse]
+//	@Failure		401	{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/me/permissions [get]
+func (h *PermissionHandler) GetMyPermissions(ctx *gin.Context) {
+	user := appcontext.ContextGetCustomerInfo(ctx)
+	if user == nil {
+		h.RenderUnauthenticated(ctx, "Unauthorized")
+		return
+	}
+	roles := user.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	ctx.JSON(
+		http.StatusOK, handler.BaseResponse[MyPermissionsResponse]{
+			Data: MyPermissionsResponse{
+				Roles:       roles,
+				Permissions: h.useCase.GetPermissions(roles),
+			},
+		},
+	)
+}
@@ -0,0 +1,48 @@
+package permission
+
+import (
+	"slices"
+	"strings"
+)
+
+type UseCase interface {
+	HasPermission(roles []string, permission Permission) bool
+	// GetPermissions returns the permissions granted by any of the roles, in the order of All
+	GetPermissions(roles []string) []Permission
+}
+
+type useCase struct {
+	// rolePermissions is keyed by upper-cased role name
+	rolePermissions map[string][]string
+}
+
+func NewUseCase(rolePermissions map[string][]string) UseCase {
+	normalized := make(map[string][]string, len(rolePermissions))
+	for role, permissions := range rolePermissions {
+		upperRole := strings.ToUpper(role)
+		normalized[upperRole] = append(normalized[upperRole], permissions...)
+	}
+	return &useCase{
+		rolePermissions: normalized,
+	}
+}
+
+func (u *useCase) HasPermission(roles []string, permission Permission) bool {
+	for _, role := range roles {
+		granted := u.rolePermissions[strings.ToUpper(role)]
+		if slices.Contains(granted, Wildcard) || slices.Contains(granted, string(permission)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *useCase) GetPermissions(roles []string) []Permission {
+	permissions := make([]Permission, 0)
+	for _, permission := range All {
+		if u.HasPermission(roles, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
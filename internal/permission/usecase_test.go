@@ -0,0 +1,40 @@
+package permission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionUseCase(t *testing.T) {
+	t.Parallel()
+	useCase := NewUseCase(
+		map[string][]string{
+			"ADMIN":             {Wildcard},
+			"FINANCIAL_ADMIN":   {string(LoanRequestRead), string(SubmissionApprove)},
+			"financial_auditor": {string(LoanRequestRead)},
+		},
+	)
+
+	t.Run(
+		"HasPermission", func(t *testing.T) {
+			assert.True(t, useCase.HasPermission([]string{"admin"}, ConfigLoanRateWrite))
+			assert.True(t, useCase.HasPermission([]string{"FINANCIAL_ADMIN"}, SubmissionApprove))
+			assert.False(t, useCase.HasPermission([]string{"FINANCIAL_ADMIN"}, ConfigLoanRateWrite))
+			assert.True(t, useCase.HasPermission([]string{"FINANCIAL_AUDITOR"}, LoanRequestRead))
+			assert.False(t, useCase.HasPermission([]string{"UNKNOWN"}, LoanRequestRead))
+			assert.False(t, useCase.HasPermission(nil, LoanRequestRead))
+		},
+	)
+
+	t.Run(
+		"GetPermissions", func(t *testing.T) {
+			assert.Equal(t, All, useCase.GetPermissions([]string{"ADMIN"}))
+			assert.Equal(
+				t, []Permission{LoanRequestRead, SubmissionApprove},
+				useCase.GetPermissions([]string{"FINANCIAL_AUDITOR", "FINANCIAL_ADMIN"}),
+			)
+			assert.Equal(t, []Permission{}, useCase.GetPermissions(nil))
+		},
+	)
+}
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+)
+
+var _ Limiter = (*InProcessLimiter)(nil)
+
+// inProcessSweepInterval is how often the buckets that are full again are dropped
+const inProcessSweepInterval = time.Minute
+
+type inProcessBucket struct {
+	bucket    entity.RateLimitBucket
+	expiresAt time.Time
+}
+
+// InProcessLimiter keeps buckets in process memory, it only limits correctly when the service runs a single replica
+type InProcessLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]inProcessBucket
+	sweptAt time.Time
+}
+
+func NewInProcessLimiter() *InProcessLimiter {
+	return &InProcessLimiter{buckets: make(map[string]inProcessBucket)}
+}
+
+func (l *InProcessLimiter) Allow(_ context.Context, key string, rule config.RateLimitRule) (Decision, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.sweep(now)
+	bucket, decision := take(l.buckets[key].bucket, rule, now)
+	bucket.Key = key
+	l.buckets[key] = inProcessBucket{bucket: bucket, expiresAt: now.Add(fillDuration(rule))}
+	return decision, nil
+}
+
+// sweep drops the buckets that are full again, a new bucket starts full as well
+func (l *InProcessLimiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < inProcessSweepInterval {
+		return
+	}
+	l.sweptAt = now
+	for key, bucket := range l.buckets {
+		if now.After(bucket.expiresAt) {
+			delete(l.buckets, key)
+		}
+	}
+}
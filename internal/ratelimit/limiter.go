@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+)
+
+type Decision struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	// Allow takes a token from the bucket of key, refilled according to rule
+	Allow(ctx context.Context, key string, rule config.RateLimitRule) (Decision, error)
+}
+
+// take refills the bucket for the time elapsed since its last refill and tries to consume one token from it
+func take(bucket entity.RateLimitBucket, rule config.RateLimitRule, now time.Time) (entity.RateLimitBucket, Decision) {
+	burst := burstOf(rule)
+	rate := ratePerSecond(rule)
+	if bucket.RefilledAt.IsZero() {
+		bucket.Tokens = burst
+	} else if elapsed := now.Sub(bucket.RefilledAt); elapsed > 0 {
+		bucket.Tokens = math.Min(burst, bucket.Tokens+elapsed.Seconds()*rate)
+	}
+	bucket.RefilledAt = now
+	if bucket.Tokens < 1 {
+		retryAfter := time.Duration(math.Ceil((1 - bucket.Tokens) / rate * float64(time.Second)))
+		return bucket, Decision{RetryAfter: retryAfter}
+	}
+	bucket.Tokens--
+	return bucket, Decision{Allowed: true, Remaining: int(bucket.Tokens)}
+}
+
+func burstOf(rule config.RateLimitRule) float64 {
+	if rule.Burst > 0 {
+		return float64(rule.Burst)
+	}
+	return float64(rule.Requests)
+}
+
+func ratePerSecond(rule config.RateLimitRule) float64 {
+	return float64(rule.Requests) / rule.Period.Seconds()
+}
+
+// fillDuration is how long an empty bucket takes to be full again, after which it can be forgotten
+func fillDuration(rule config.RateLimitRule) time.Duration {
+	return time.Duration(burstOf(rule) / ratePerSecond(rule) * float64(time.Second))
+}
@@ -0,0 +1,178 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+func TestTake(t *testing.T) {
+	t.Parallel()
+	rule := config.RateLimitRule{Requests: 60, Period: time.Minute, Burst: 2}
+	now := time.Now()
+
+	t.Run(
+		"new bucket starts full", func(t *testing.T) {
+			bucket, decision := take(entity.RateLimitBucket{}, rule, now)
+			assert.True(t, decision.Allowed)
+			assert.Equal(t, 1, decision.Remaining)
+			assert.Equal(t, float64(1), bucket.Tokens)
+			assert.Equal(t, now, bucket.RefilledAt)
+		},
+	)
+
+	t.Run(
+		"empty bucket is denied with retry after", func(t *testing.T) {
+			bucket, decision := take(
+				entity.RateLimitBucket{Tokens: 0.25, RefilledAt: now}, rule, now.Add(250*time.Millisecond),
+			)
+			assert.False(t, decision.Allowed)
+			assert.Equal(t, 500*time.Millisecond, decision.RetryAfter)
+			assert.Equal(t, 0.5, bucket.Tokens)
+		},
+	)
+
+	t.Run(
+		"refill is capped by burst", func(t *testing.T) {
+			bucket, decision := take(entity.RateLimitBucket{Tokens: 0, RefilledAt: now}, rule, now.Add(time.Hour))
+			assert.True(t, decision.Allowed)
+			assert.Equal(t, float64(1), bucket.Tokens)
+		},
+	)
+
+	t.Run(
+		"burst defaults to requests", func(t *testing.T) {
+			_, decision := take(entity.RateLimitBucket{}, config.RateLimitRule{Requests: 5, Period: time.Second}, now)
+			assert.Equal(t, 4, decision.Remaining)
+		},
+	)
+}
+
+func TestInProcessLimiter_Allow(t *testing.T) {
+	t.Parallel()
+	rule := config.RateLimitRule{Requests: 1, Period: time.Hour, Burst: 2}
+
+	t.Run(
+		"Allow_per_key", func(t *testing.T) {
+			limiter := NewInProcessLimiter()
+			for _, allowed := range []bool{true, true, false} {
+				decision, err := limiter.Allow(context.Background(), "investor", rule)
+				assert.Nil(t, err)
+				assert.Equal(t, allowed, decision.Allowed)
+			}
+			decision, err := limiter.Allow(context.Background(), "other-investor", rule)
+			assert.Nil(t, err)
+			assert.True(t, decision.Allowed)
+		},
+	)
+
+	t.Run(
+		"Allow_concurrent_calls", func(t *testing.T) {
+			limiter := NewInProcessLimiter()
+			rule := config.RateLimitRule{Requests: 5, Period: time.Hour}
+			var (
+				wg      sync.WaitGroup
+				mu      sync.Mutex
+				allowed int
+			)
+			for range 50 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					decision, err := limiter.Allow(context.Background(), "investor", rule)
+					assert.Nil(t, err)
+					if decision.Allowed {
+						mu.Lock()
+						allowed++
+						mu.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+			assert.Equal(t, 5, allowed)
+		},
+	)
+
+	t.Run(
+		"Allow_drops_full_buckets", func(t *testing.T) {
+			limiter := NewInProcessLimiter()
+			rule := config.RateLimitRule{Requests: 1, Period: time.Millisecond}
+			_, _ = limiter.Allow(context.Background(), "investor", rule)
+			limiter.sweptAt = time.Now().Add(-inProcessSweepInterval)
+			time.Sleep(5 * time.Millisecond)
+			_, _ = limiter.Allow(context.Background(), "other-investor", rule)
+			assert.Len(t, limiter.buckets, 1)
+		},
+	)
+}
+
+func TestPostgresLimiter_Allow(t *testing.T) {
+	t.Parallel()
+	rule := config.RateLimitRule{Requests: 1, Period: time.Hour, Burst: 2}
+	newLimiter := func(t *testing.T) (*PostgresLimiter, *mock.MockRateLimitBucketRepository) {
+		repository := mock.NewMockRateLimitBucketRepository(t)
+		limiter := NewPostgresLimiter(
+			config.RateLimitConfig{Retention: time.Hour}, repository, mock.NewMockAtomicExecutorExecutePassthrough(t),
+		)
+		return limiter, repository
+	}
+
+	t.Run(
+		"Allow_new_bucket", func(t *testing.T) {
+			limiter, repository := newLimiter(t)
+			repository.EXPECT().GetOrCreateForUpdate(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(bucket entity.RateLimitBucket) bool {
+						return bucket.Key == "investor" && bucket.Tokens == 2
+					},
+				),
+			).RunAndReturn(
+				func(_ context.Context, bucket entity.RateLimitBucket) (entity.RateLimitBucket, error) {
+					return bucket, nil
+				},
+			)
+			repository.EXPECT().Upsert(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(bucket entity.RateLimitBucket) bool {
+						return bucket.Key == "investor" && bucket.Tokens == 1
+					},
+				),
+			).Return(nil)
+			decision, err := limiter.Allow(context.Background(), "investor", rule)
+			assert.Nil(t, err)
+			assert.True(t, decision.Allowed)
+		},
+	)
+
+	t.Run(
+		"Allow_denied", func(t *testing.T) {
+			limiter, repository := newLimiter(t)
+			repository.EXPECT().GetOrCreateForUpdate(testifyMock.Anything, testifyMock.Anything).
+				Return(entity.RateLimitBucket{Key: "investor", Tokens: 0, RefilledAt: time.Now()}, nil)
+			repository.EXPECT().Upsert(testifyMock.Anything, testifyMock.Anything).Return(nil)
+			decision, err := limiter.Allow(context.Background(), "investor", rule)
+			assert.Nil(t, err)
+			assert.False(t, decision.Allowed)
+			assert.Greater(t, decision.RetryAfter, 59*time.Minute)
+		},
+	)
+
+	t.Run(
+		"Allow_error", func(t *testing.T) {
+			limiter, repository := newLimiter(t)
+			repository.EXPECT().GetOrCreateForUpdate(testifyMock.Anything, testifyMock.Anything).
+				Return(entity.RateLimitBucket{}, errors.New("db error"))
+			_, err := limiter.Allow(context.Background(), "investor", rule)
+			assert.Equal(t, "PostgresLimiter Allow: db error", err.Error())
+		},
+	)
+}
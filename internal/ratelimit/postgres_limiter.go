@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financing-offer/internal/atomicity"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/ratelimit/repository"
+)
+
+var _ Limiter = (*PostgresLimiter)(nil)
+
+// PostgresLimiter shares buckets between replicas, each take locks the bucket row for the duration of a short transaction
+type PostgresLimiter struct {
+	cfg            config.RateLimitConfig
+	repository     repository.RateLimitBucketRepository
+	atomicExecutor atomicity.AtomicExecutor
+}
+
+func NewPostgresLimiter(
+	cfg config.RateLimitConfig,
+	repository repository.RateLimitBucketRepository,
+	atomicExecutor atomicity.AtomicExecutor,
+) *PostgresLimiter {
+	return &PostgresLimiter{
+		cfg:            cfg,
+		repository:     repository,
+		atomicExecutor: atomicExecutor,
+	}
+}
+
+func (l *PostgresLimiter) Allow(ctx context.Context, key string, rule config.RateLimitRule) (Decision, error) {
+	decision := Decision{}
+	if err := l.atomicExecutor.Execute(
+		ctx, func(tc context.Context) error {
+			// a new bucket starts full
+			bucket, err := l.repository.GetOrCreateForUpdate(
+				tc, entity.RateLimitBucket{Key: key, Tokens: burstOf(rule), RefilledAt: time.Now()},
+			)
+			if err != nil {
+				return err
+			}
+			bucket, decision = take(bucket, rule, time.Now())
+			bucket.Key = key
+			return l.repository.Upsert(tc, bucket)
+		},
+	); err != nil {
+		return Decision{}, fmt.Errorf("PostgresLimiter Allow: %w", err)
+	}
+	return decision, nil
+}
+
+// PurgeIdle deletes buckets unused for the configured retention,
+// which must be longer than the time any bucket takes to be full again
+func (l *PostgresLimiter) PurgeIdle(ctx context.Context) (int64, error) {
+	deleted, err := l.repository.DeleteRefilledBefore(ctx, time.Now().Add(-l.cfg.Retention))
+	if err != nil {
+		return 0, fmt.Errorf("PostgresLimiter PurgeIdle: %w", err)
+	}
+	return deleted, nil
+}
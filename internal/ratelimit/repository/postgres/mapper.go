@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func MapRateLimitBucketDbToEntity(bucket model.RateLimitBucket) entity.RateLimitBucket {
+	return entity.RateLimitBucket{
+		Key:        bucket.BucketKey,
+		Tokens:     bucket.Tokens,
+		RefilledAt: bucket.RefilledAt,
+	}
+}
+
+func MapRateLimitBucketEntityToDb(bucket entity.RateLimitBucket) model.RateLimitBucket {
+	return model.RateLimitBucket{
+		BucketKey:  bucket.Key,
+		Tokens:     bucket.Tokens,
+		RefilledAt: bucket.RefilledAt,
+	}
+}
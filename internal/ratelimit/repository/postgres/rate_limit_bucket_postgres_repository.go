@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/internal/ratelimit/repository"
+)
+
+var _ repository.RateLimitBucketRepository = (*RateLimitBucketPostgresRepository)(nil)
+
+type RateLimitBucketPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewRateLimitBucketPostgresRepository(getDbFunc database.GetDbFunc) *RateLimitBucketPostgresRepository {
+	return &RateLimitBucketPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *RateLimitBucketPostgresRepository) GetOrCreateForUpdate(ctx context.Context, bucket entity.RateLimitBucket) (entity.RateLimitBucket, error) {
+	dest := model.RateLimitBucket{}
+	// the no-op update locks an existing row and returns it as stored
+	err := table.RateLimitBucket.INSERT(table.RateLimitBucket.AllColumns).
+		MODEL(MapRateLimitBucketEntityToDb(bucket)).
+		ON_CONFLICT(table.RateLimitBucket.BucketKey).
+		DO_UPDATE(
+			postgres.SET(
+				table.RateLimitBucket.BucketKey.SET(table.RateLimitBucket.EXCLUDED.BucketKey),
+			),
+		).
+		RETURNING(table.RateLimitBucket.AllColumns).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return entity.RateLimitBucket{}, fmt.Errorf("RateLimitBucketPostgresRepository GetOrCreateForUpdate: %w", err)
+	}
+	return MapRateLimitBucketDbToEntity(dest), nil
+}
+
+func (r *RateLimitBucketPostgresRepository) Upsert(ctx context.Context, bucket entity.RateLimitBucket) error {
+	_, err := table.RateLimitBucket.INSERT(table.RateLimitBucket.AllColumns).
+		MODEL(MapRateLimitBucketEntityToDb(bucket)).
+		ON_CONFLICT(table.RateLimitBucket.BucketKey).
+		DO_UPDATE(
+			postgres.SET(
+				table.RateLimitBucket.Tokens.SET(table.RateLimitBucket.EXCLUDED.Tokens),
+				table.RateLimitBucket.RefilledAt.SET(table.RateLimitBucket.EXCLUDED.RefilledAt),
+			),
+		).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("RateLimitBucketPostgresRepository Upsert: %w", err)
+	}
+	return nil
+}
+
+func (r *RateLimitBucketPostgresRepository) DeleteRefilledBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := table.RateLimitBucket.DELETE().
+		WHERE(table.RateLimitBucket.RefilledAt.LT(postgres.TimestampT(before))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("RateLimitBucketPostgresRepository DeleteRefilledBefore: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("RateLimitBucketPostgresRepository DeleteRefilledBefore: %w", err)
+	}
+	return deleted, nil
+}
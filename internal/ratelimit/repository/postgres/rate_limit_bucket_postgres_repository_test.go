@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestRateLimitBucketPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, _ := dbtest.New()
+	repo := NewRateLimitBucketPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	columns := []string{
+		"rate_limit_bucket.bucket_key",
+		"rate_limit_bucket.tokens",
+		"rate_limit_bucket.refilled_at",
+	}
+
+	t.Run("GetOrCreateForUpdateSuccess", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(`(?s)INSERT INTO public.rate_limit_bucket .+ON CONFLICT .+DO UPDATE .+bucket_key = excluded.bucket_key.+RETURNING`).
+			WillReturnRows(mock.NewRows(columns).AddRow("investor", 1.5, now))
+		bucket, err := repo.GetOrCreateForUpdate(
+			context.Background(), entity.RateLimitBucket{Key: "investor", Tokens: 10, RefilledAt: now},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, entity.RateLimitBucket{Key: "investor", Tokens: 1.5, RefilledAt: now}, bucket)
+	})
+
+	t.Run("GetOrCreateForUpdateFailure", func(t *testing.T) {
+		mock.ExpectQuery("INSERT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.GetOrCreateForUpdate(context.Background(), entity.RateLimitBucket{Key: "investor"})
+		assert.Equal(t, "RateLimitBucketPostgresRepository GetOrCreateForUpdate: jet: error", err.Error())
+	})
+
+	t.Run("UpsertSuccess", func(t *testing.T) {
+		mock.ExpectExec(`(?s)INSERT INTO public.rate_limit_bucket .+ON CONFLICT .+DO UPDATE`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		err := repo.Upsert(context.Background(), entity.RateLimitBucket{Key: "investor", Tokens: 1, RefilledAt: time.Now()})
+		assert.Nil(t, err)
+	})
+
+	t.Run("UpsertFailure", func(t *testing.T) {
+		mock.ExpectExec("INSERT").WillReturnError(fmt.Errorf("error"))
+		err := repo.Upsert(context.Background(), entity.RateLimitBucket{})
+		assert.Equal(t, "RateLimitBucketPostgresRepository Upsert: error", err.Error())
+	})
+
+	t.Run("DeleteRefilledBeforeSuccess", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM public.rate_limit_bucket").WillReturnResult(sqlmock.NewResult(0, 4))
+		deleted, err := repo.DeleteRefilledBefore(context.Background(), time.Now())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), deleted)
+	})
+
+	t.Run("DeleteRefilledBeforeFailure", func(t *testing.T) {
+		mock.ExpectExec("DELETE").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.DeleteRefilledBefore(context.Background(), time.Now())
+		assert.Equal(t, "RateLimitBucketPostgresRepository DeleteRefilledBefore: error", err.Error())
+	})
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financing-offer/internal/core/entity"
+)
+
+type RateLimitBucketRepository interface {
+	// GetOrCreateForUpdate inserts bucket unless a bucket of its key exists, and locks the stored bucket until the end
+	// of the transaction. Concurrent callers of a new key wait for the first insert and get the bucket it stored
+	GetOrCreateForUpdate(ctx context.Context, bucket entity.RateLimitBucket) (entity.RateLimitBucket, error)
+	Upsert(ctx context.Context, bucket entity.RateLimitBucket) error
+	DeleteRefilledBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/scheduler"
+	"financing-offer/internal/ratelimit"
+)
+
+type RateLimitScheduler struct {
+	logger       *slog.Logger
+	limiter      *ratelimit.PostgresLimiter
+	errorService apperrors.Service
+}
+
+func NewRateLimitScheduler(logger *slog.Logger, limiter *ratelimit.PostgresLimiter, errorService apperrors.Service) *RateLimitScheduler {
+	return &RateLimitScheduler{
+		logger:       logger,
+		limiter:      limiter,
+		errorService: errorService,
+	}
+}
+
+func (s *RateLimitScheduler) PurgeIdleBuckets(ctx context.Context) error {
+	deleted, err := s.limiter.PurgeIdle(ctx)
+	if err != nil {
+		s.logger.Error("PurgeIdleBuckets", slog.String("error", err.Error()))
+		if err := s.errorService.NotifyError(ctx, err); err != nil {
+			s.logger.Error("PurgeIdleBuckets NotifyError", slog.String("error", err.Error()))
+		}
+		return err
+	}
+	s.logger.Info("PurgeIdleBuckets", slog.Int64("deleted", deleted))
+	scheduler.Track(ctx, "deleted", deleted)
+	return nil
+}
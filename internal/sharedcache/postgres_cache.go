@@ -0,0 +1,215 @@
+package sharedcache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/sharedcache/repository"
+	"financing-offer/pkg/cache"
+)
+
+// Channel is the postgres channel the replicas notify their invalidations on
+const Channel = "cache_invalidation"
+
+var (
+	_ cache.Cache = (*PostgresCache)(nil)
+	_ Purger      = (*PostgresCache)(nil)
+)
+
+type Listener interface {
+	Listen(
+		ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+		onReconnect func(ctx context.Context) error,
+	) error
+}
+
+// invalidation is the payload notified on Channel, Key is a prefix when Prefix is set
+type invalidation struct {
+	Origin string `json:"origin"`
+	Key    string `json:"key"`
+	Prefix bool   `json:"prefix,omitempty"`
+}
+
+// PostgresCache shares the cached values between the replicas through the cache_entry table.
+// Each replica keeps the values it reads locally for LocalTtl at most and drops them as soon as another replica
+// notifies a change. The local values are all dropped when the listener reconnects, since the invalidations notified
+// while it was disconnected are lost.
+// Failures of postgres are logged and turn into cache misses
+type PostgresCache struct {
+	cfg        config.CacheConfig
+	logger     *slog.Logger
+	local      cache.Cache
+	repository repository.CacheEntryRepository
+	listener   Listener
+	origin     string
+}
+
+func NewPostgresCache(
+	cfg config.CacheConfig,
+	logger *slog.Logger,
+	local cache.Cache,
+	repository repository.CacheEntryRepository,
+	listener Listener,
+) *PostgresCache {
+	return &PostgresCache{
+		cfg:        cfg,
+		logger:     logger,
+		local:      local,
+		repository: repository,
+		listener:   listener,
+		origin:     uuid.NewString(),
+	}
+}
+
+// Start listens to the invalidations notified by the other replicas until ctx is cancelled
+func (c *PostgresCache) Start(ctx context.Context) error {
+	if err := c.listener.Listen(ctx, Channel, c.OnNotify, c.OnReconnect); err != nil {
+		return fmt.Errorf("PostgresCache Start: %w", err)
+	}
+	return nil
+}
+
+// OnNotify drops the local values invalidated by another replica
+func (c *PostgresCache) OnNotify(_ context.Context, data string) error {
+	var message invalidation
+	if err := json.Unmarshal([]byte(data), &message); err != nil {
+		return fmt.Errorf("PostgresCache OnNotify: %w", err)
+	}
+	if message.Origin == c.origin {
+		return nil
+	}
+	if message.Prefix {
+		c.local.DelPrefix(message.Key)
+	} else {
+		c.local.Del(message.Key)
+	}
+	return nil
+}
+
+// OnReconnect drops every local value, the shared ones are read again
+func (c *PostgresCache) OnReconnect(_ context.Context) error {
+	c.local.DelPrefix("")
+	c.logger.Warn("dropped local cache after the listener reconnected")
+	return nil
+}
+
+// Get returns the local value of key, or else the shared one as json.RawMessage
+func (c *PostgresCache) Get(key string) (any, bool) {
+	if value, ok := c.local.Get(key); ok {
+		return value, true
+	}
+	ctx, cancel := c.operationContext()
+	defer cancel()
+	now := time.Now()
+	entry, err := c.repository.Get(ctx, key, now)
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			c.logError("Get", key, err)
+		}
+		return nil, false
+	}
+	c.local.SetTtl(key, entry.Value, c.localTtl(entry.ExpiresAt.Sub(now)))
+	return entry.Value, true
+}
+
+// SetTtl shares value with the other replicas, which drop their local value of key.
+// Values which cannot be encoded to json stay in the local cache only
+func (c *PostgresCache) SetTtl(key string, value any, ttl time.Duration) {
+	c.local.SetTtl(key, value, c.localTtl(ttl))
+	data, err := json.Marshal(value)
+	if err != nil {
+		c.logError("SetTtl", key, err)
+		return
+	}
+	ctx, cancel := c.operationContext()
+	defer cancel()
+	entry := entity.CacheEntry{Key: key, Value: data, ExpiresAt: time.Now().Add(ttl)}
+	if err := c.repository.Upsert(ctx, entry); err != nil {
+		c.logError("SetTtl", key, err)
+		return
+	}
+	if err := c.notify(ctx, invalidation{Key: key}); err != nil {
+		c.logError("SetTtl", key, err)
+	}
+}
+
+func (c *PostgresCache) Del(key string) {
+	c.local.Del(key)
+	ctx, cancel := c.operationContext()
+	defer cancel()
+	if err := c.repository.Delete(ctx, key); err != nil {
+		c.logError("Del", key, err)
+		return
+	}
+	if err := c.notify(ctx, invalidation{Key: key}); err != nil {
+		c.logError("Del", key, err)
+	}
+}
+
+func (c *PostgresCache) DelPrefix(prefix string) {
+	ctx, cancel := c.operationContext()
+	defer cancel()
+	if err := c.Purge(ctx, prefix); err != nil {
+		c.logError("DelPrefix", prefix, err)
+	}
+}
+
+// Purge deletes the shared values of every key starting with prefix and has every replica drop its local ones
+func (c *PostgresCache) Purge(ctx context.Context, prefix string) error {
+	c.local.DelPrefix(prefix)
+	deleted, err := c.repository.DeleteByPrefix(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("PostgresCache Purge: %w", err)
+	}
+	if err := c.notify(ctx, invalidation{Key: prefix, Prefix: true}); err != nil {
+		return fmt.Errorf("PostgresCache Purge: %w", err)
+	}
+	c.logger.Info("purged cache", slog.String("prefix", prefix), slog.Int64("deleted", deleted))
+	return nil
+}
+
+// PurgeExpired deletes the shared values which expired, they are never read again but take space until then
+func (c *PostgresCache) PurgeExpired(ctx context.Context) (int64, error) {
+	deleted, err := c.repository.DeleteExpiredBefore(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("PostgresCache PurgeExpired: %w", err)
+	}
+	return deleted, nil
+}
+
+func (c *PostgresCache) notify(ctx context.Context, message invalidation) error {
+	message.Origin = c.origin
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return c.repository.Notify(ctx, Channel, string(payload))
+}
+
+func (c *PostgresCache) localTtl(ttl time.Duration) time.Duration {
+	if c.cfg.LocalTtl > 0 && c.cfg.LocalTtl < ttl {
+		return c.cfg.LocalTtl
+	}
+	return ttl
+}
+
+func (c *PostgresCache) operationContext() (context.Context, context.CancelFunc) {
+	if c.cfg.OperationTimeout > 0 {
+		return context.WithTimeout(context.Background(), c.cfg.OperationTimeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+func (c *PostgresCache) logError(operation string, key string, err error) {
+	c.logger.Error(
+		"PostgresCache "+operation, slog.String("key", key), slog.String("error", err.Error()),
+	)
+}
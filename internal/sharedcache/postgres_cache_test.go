@@ -0,0 +1,251 @@
+package sharedcache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"financing-offer/test/mock"
+)
+
+type localEntry struct {
+	value any
+	ttl   time.Duration
+}
+
+type mapCache map[string]localEntry
+
+func (c mapCache) Get(key string) (any, bool) {
+	entry, ok := c[key]
+	return entry.value, ok
+}
+
+func (c mapCache) SetTtl(key string, value any, ttl time.Duration) {
+	c[key] = localEntry{value: value, ttl: ttl}
+}
+
+func (c mapCache) Del(key string) {
+	delete(c, key)
+}
+
+func (c mapCache) DelPrefix(prefix string) {
+	for key := range c {
+		if strings.HasPrefix(key, prefix) {
+			delete(c, key)
+		}
+	}
+}
+
+type listenerFunc func(
+	ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+	onReconnect func(ctx context.Context) error,
+) error
+
+func (f listenerFunc) Listen(
+	ctx context.Context, channel string, callback func(ctx context.Context, data string) error,
+	onReconnect func(ctx context.Context) error,
+) error {
+	return f(ctx, channel, callback, onReconnect)
+}
+
+func TestPostgresCache(t *testing.T) {
+	t.Parallel()
+	cfg := config.CacheConfig{LocalTtl: 10 * time.Second, OperationTimeout: time.Second}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	newCache := func(t *testing.T) (*PostgresCache, mapCache, *mock.MockCacheEntryRepository) {
+		local := mapCache{}
+		repository := mock.NewMockCacheEntryRepository(t)
+		return NewPostgresCache(cfg, logger, local, repository, nil), local, repository
+	}
+	isInvalidation := func(c *PostgresCache, key string, prefix bool) any {
+		return testifyMock.MatchedBy(
+			func(payload string) bool {
+				message := invalidation{}
+				_ = json.Unmarshal([]byte(payload), &message)
+				return message == invalidation{Origin: c.origin, Key: key, Prefix: prefix}
+			},
+		)
+	}
+
+	t.Run(
+		"Get_local_value", func(t *testing.T) {
+			c, local, _ := newCache(t)
+			local.SetTtl("key", 1, time.Second)
+			value, ok := c.Get("key")
+			assert.True(t, ok)
+			assert.Equal(t, 1, value)
+		},
+	)
+
+	t.Run(
+		"Get_shared_value_kept_locally", func(t *testing.T) {
+			c, local, repository := newCache(t)
+			repository.EXPECT().Get(testifyMock.Anything, "key", testifyMock.Anything).Return(
+				entity.CacheEntry{Key: "key", Value: json.RawMessage(`1`), ExpiresAt: time.Now().Add(time.Hour)}, nil,
+			)
+			value, ok := c.Get("key")
+			assert.True(t, ok)
+			assert.Equal(t, json.RawMessage(`1`), value)
+			assert.Equal(t, localEntry{value: json.RawMessage(`1`), ttl: cfg.LocalTtl}, local["key"])
+		},
+	)
+
+	t.Run(
+		"Get_miss", func(t *testing.T) {
+			c, local, repository := newCache(t)
+			repository.EXPECT().Get(testifyMock.Anything, "key", testifyMock.Anything).
+				Return(entity.CacheEntry{}, qrm.ErrNoRows)
+			_, ok := c.Get("key")
+			assert.False(t, ok)
+			assert.Empty(t, local)
+		},
+	)
+
+	t.Run(
+		"Get_failure_is_a_miss", func(t *testing.T) {
+			c, _, repository := newCache(t)
+			repository.EXPECT().Get(testifyMock.Anything, "key", testifyMock.Anything).
+				Return(entity.CacheEntry{}, errors.New("db down"))
+			_, ok := c.Get("key")
+			assert.False(t, ok)
+		},
+	)
+
+	t.Run(
+		"SetTtl_shares_value", func(t *testing.T) {
+			c, local, repository := newCache(t)
+			repository.EXPECT().Upsert(
+				testifyMock.Anything, testifyMock.MatchedBy(
+					func(entry entity.CacheEntry) bool {
+						return entry.Key == "key" && string(entry.Value) == `{"id":1}` &&
+							entry.ExpiresAt.After(time.Now().Add(59*time.Minute))
+					},
+				),
+			).Return(nil)
+			repository.EXPECT().Notify(testifyMock.Anything, Channel, isInvalidation(c, "key", false)).Return(nil)
+			c.SetTtl("key", map[string]int{"id": 1}, time.Hour)
+			assert.Equal(t, localEntry{value: map[string]int{"id": 1}, ttl: cfg.LocalTtl}, local["key"])
+		},
+	)
+
+	t.Run(
+		"SetTtl_keeps_values_without_json_locally", func(t *testing.T) {
+			c, local, _ := newCache(t)
+			c.SetTtl("key", func() {}, time.Second)
+			assert.Equal(t, time.Second, local["key"].ttl)
+		},
+	)
+
+	t.Run(
+		"Del", func(t *testing.T) {
+			c, local, repository := newCache(t)
+			local.SetTtl("key", 1, time.Second)
+			repository.EXPECT().Delete(testifyMock.Anything, "key").Return(nil)
+			repository.EXPECT().Notify(testifyMock.Anything, Channel, isInvalidation(c, "key", false)).Return(nil)
+			c.Del("key")
+			assert.Empty(t, local)
+		},
+	)
+
+	t.Run(
+		"Purge", func(t *testing.T) {
+			c, local, repository := newCache(t)
+			local.SetTtl("financial_product_margin_basket_1", 1, time.Second)
+			local.SetTtl("financial_product_loan_package_1", 1, time.Second)
+			repository.EXPECT().DeleteByPrefix(testifyMock.Anything, "financial_product_margin_basket_").
+				Return(3, nil)
+			repository.EXPECT().Notify(
+				testifyMock.Anything, Channel, isInvalidation(c, "financial_product_margin_basket_", true),
+			).Return(nil)
+			err := c.Purge(context.Background(), "financial_product_margin_basket_")
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"financial_product_loan_package_1"}, keysOf(local))
+		},
+	)
+
+	t.Run(
+		"Purge_failure", func(t *testing.T) {
+			c, _, repository := newCache(t)
+			repository.EXPECT().DeleteByPrefix(testifyMock.Anything, "key").Return(0, errors.New("db down"))
+			err := c.Purge(context.Background(), "key")
+			assert.Equal(t, "PostgresCache Purge: db down", err.Error())
+		},
+	)
+
+	t.Run(
+		"OnNotify_drops_values_of_other_replicas", func(t *testing.T) {
+			c, local, _ := newCache(t)
+			local.SetTtl("financial_product_margin_basket_1", 1, time.Second)
+			local.SetTtl("financial_product_loan_package_1", 1, time.Second)
+			local.SetTtl("financial_product_loan_package_2", 1, time.Second)
+
+			err := c.OnNotify(
+				context.Background(), `{"origin":"other","key":"financial_product_margin_basket_","prefix":true}`,
+			)
+			assert.Nil(t, err)
+			err = c.OnNotify(context.Background(), `{"origin":"other","key":"financial_product_loan_package_1"}`)
+			assert.Nil(t, err)
+
+			assert.Equal(t, []string{"financial_product_loan_package_2"}, keysOf(local))
+		},
+	)
+
+	t.Run(
+		"OnReconnect_drops_every_local_value", func(t *testing.T) {
+			c, local, _ := newCache(t)
+			local.SetTtl("financial_product_margin_basket_1", 1, time.Second)
+			local.SetTtl("financial_product_loan_package_1", 1, time.Second)
+			assert.Nil(t, c.OnReconnect(context.Background()))
+			assert.Empty(t, local)
+		},
+	)
+
+	t.Run(
+		"OnNotify_ignores_own_notifications", func(t *testing.T) {
+			c, local, _ := newCache(t)
+			local.SetTtl("key", 1, time.Second)
+			payload, _ := json.Marshal(invalidation{Origin: c.origin, Key: "key"})
+			assert.Nil(t, c.OnNotify(context.Background(), string(payload)))
+			assert.Len(t, local, 1)
+		},
+	)
+
+	t.Run(
+		"Start_listens_to_channel", func(t *testing.T) {
+			var channels []string
+			c := NewPostgresCache(
+				cfg, logger, mapCache{}, mock.NewMockCacheEntryRepository(t),
+				listenerFunc(
+					func(
+						_ context.Context, channel string, _ func(ctx context.Context, data string) error,
+						_ func(ctx context.Context) error,
+					) error {
+						channels = append(channels, channel)
+						return nil
+					},
+				),
+			)
+			assert.Nil(t, c.Start(context.Background()))
+			assert.Equal(t, []string{Channel}, channels)
+		},
+	)
+}
+
+func keysOf(c mapCache) []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
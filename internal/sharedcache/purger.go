@@ -0,0 +1,26 @@
+package sharedcache
+
+import (
+	"context"
+
+	"financing-offer/pkg/cache"
+)
+
+// Purger drops the cached values of every key starting with a prefix
+type Purger interface {
+	Purge(ctx context.Context, prefix string) error
+}
+
+type localPurger struct {
+	store cache.Cache
+}
+
+// NewLocalPurger purges a cache living in this process only, the other replicas keep their values until they expire
+func NewLocalPurger(store cache.Cache) Purger {
+	return &localPurger{store: store}
+}
+
+func (p *localPurger) Purge(_ context.Context, prefix string) error {
+	p.store.DelPrefix(prefix)
+	return nil
+}
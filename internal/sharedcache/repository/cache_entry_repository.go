@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"financing-offer/internal/core/entity"
+)
+
+type CacheEntryRepository interface {
+	// Get returns the entry of key unless it expired before now, it returns qrm.ErrNoRows otherwise
+	Get(ctx context.Context, key string, now time.Time) (entity.CacheEntry, error)
+	Upsert(ctx context.Context, entry entity.CacheEntry) error
+	Delete(ctx context.Context, key string) error
+	// DeleteByPrefix deletes the entries of every key starting with prefix
+	DeleteByPrefix(ctx context.Context, prefix string) (int64, error)
+	DeleteExpiredBefore(ctx context.Context, before time.Time) (int64, error)
+	// Notify sends payload to the sessions listening to channel once the current transaction, if any, commits
+	Notify(ctx context.Context, channel string, payload string) error
+}
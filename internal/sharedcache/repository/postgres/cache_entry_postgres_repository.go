@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/database/dbmodels/finoffer/public/table"
+	"financing-offer/internal/sharedcache/repository"
+)
+
+var _ repository.CacheEntryRepository = (*CacheEntryPostgresRepository)(nil)
+
+// likeEscaper escapes the wildcards of LIKE, with backslash being the default escape character of postgres
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+type CacheEntryPostgresRepository struct {
+	getDbFunc database.GetDbFunc
+}
+
+func NewCacheEntryPostgresRepository(getDbFunc database.GetDbFunc) *CacheEntryPostgresRepository {
+	return &CacheEntryPostgresRepository{getDbFunc: getDbFunc}
+}
+
+func (r *CacheEntryPostgresRepository) Get(ctx context.Context, key string, now time.Time) (entity.CacheEntry, error) {
+	dest := model.CacheEntry{}
+	err := table.CacheEntry.SELECT(table.CacheEntry.AllColumns).
+		WHERE(
+			table.CacheEntry.CacheKey.EQ(postgres.String(key)).
+				AND(table.CacheEntry.ExpiresAt.GT(postgres.TimestampT(now))),
+		).
+		QueryContext(ctx, r.getDbFunc(ctx), &dest)
+	if err != nil {
+		return entity.CacheEntry{}, fmt.Errorf("CacheEntryPostgresRepository Get: %w", err)
+	}
+	return MapCacheEntryDbToEntity(dest), nil
+}
+
+func (r *CacheEntryPostgresRepository) Upsert(ctx context.Context, entry entity.CacheEntry) error {
+	_, err := table.CacheEntry.INSERT(table.CacheEntry.AllColumns).
+		MODEL(MapCacheEntryEntityToDb(entry)).
+		ON_CONFLICT(table.CacheEntry.CacheKey).
+		DO_UPDATE(
+			postgres.SET(
+				table.CacheEntry.Value.SET(table.CacheEntry.EXCLUDED.Value),
+				table.CacheEntry.ExpiresAt.SET(table.CacheEntry.EXCLUDED.ExpiresAt),
+			),
+		).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("CacheEntryPostgresRepository Upsert: %w", err)
+	}
+	return nil
+}
+
+func (r *CacheEntryPostgresRepository) Delete(ctx context.Context, key string) error {
+	_, err := table.CacheEntry.DELETE().
+		WHERE(table.CacheEntry.CacheKey.EQ(postgres.String(key))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("CacheEntryPostgresRepository Delete: %w", err)
+	}
+	return nil
+}
+
+func (r *CacheEntryPostgresRepository) DeleteByPrefix(ctx context.Context, prefix string) (int64, error) {
+	res, err := table.CacheEntry.DELETE().
+		WHERE(table.CacheEntry.CacheKey.LIKE(postgres.String(likeEscaper.Replace(prefix)+"%"))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("CacheEntryPostgresRepository DeleteByPrefix: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("CacheEntryPostgresRepository DeleteByPrefix: %w", err)
+	}
+	return deleted, nil
+}
+
+func (r *CacheEntryPostgresRepository) DeleteExpiredBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := table.CacheEntry.DELETE().
+		WHERE(table.CacheEntry.ExpiresAt.LT_EQ(postgres.TimestampT(before))).
+		ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("CacheEntryPostgresRepository DeleteExpiredBefore: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("CacheEntryPostgresRepository DeleteExpiredBefore: %w", err)
+	}
+	return deleted, nil
+}
+
+func (r *CacheEntryPostgresRepository) Notify(ctx context.Context, channel string, payload string) error {
+	_, err := postgres.RawStatement(
+		"SELECT pg_notify(#channel, #payload)",
+		postgres.RawArgs{"#channel": channel, "#payload": payload},
+	).ExecContext(ctx, r.getDbFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("CacheEntryPostgresRepository Notify: %w", err)
+	}
+	return nil
+}
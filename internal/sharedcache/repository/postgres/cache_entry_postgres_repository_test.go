@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database"
+	"financing-offer/pkg/dbtest"
+)
+
+func TestCacheEntryPostgresRepository(t *testing.T) {
+	t.Parallel()
+	db, mock, _ := dbtest.New()
+	repo := NewCacheEntryPostgresRepository(
+		func(ctx context.Context) database.DB {
+			return db
+		},
+	)
+	columns := []string{
+		"cache_entry.cache_key",
+		"cache_entry.value",
+		"cache_entry.expires_at",
+	}
+
+	t.Run("GetSuccess", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Minute)
+		mock.ExpectQuery(`(?s)SELECT .+ FROM public.cache_entry .+expires_at > `).
+			WillReturnRows(mock.NewRows(columns).AddRow("financial_product_margin_basket_1", `{"id":1}`, expiresAt))
+		entry, err := repo.Get(context.Background(), "financial_product_margin_basket_1", time.Now())
+		assert.Nil(t, err)
+		assert.Equal(
+			t, entity.CacheEntry{
+				Key: "financial_product_margin_basket_1", Value: json.RawMessage(`{"id":1}`), ExpiresAt: expiresAt,
+			}, entry,
+		)
+	})
+
+	t.Run("GetFailure", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.Get(context.Background(), "key", time.Now())
+		assert.Equal(t, "CacheEntryPostgresRepository Get: jet: error", err.Error())
+	})
+
+	t.Run("UpsertSuccess", func(t *testing.T) {
+		mock.ExpectExec(`(?s)INSERT INTO public.cache_entry .+ON CONFLICT .+DO UPDATE`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		err := repo.Upsert(
+			context.Background(),
+			entity.CacheEntry{Key: "key", Value: json.RawMessage(`1`), ExpiresAt: time.Now()},
+		)
+		assert.Nil(t, err)
+	})
+
+	t.Run("UpsertFailure", func(t *testing.T) {
+		mock.ExpectExec("INSERT").WillReturnError(fmt.Errorf("error"))
+		err := repo.Upsert(context.Background(), entity.CacheEntry{})
+		assert.Equal(t, "CacheEntryPostgresRepository Upsert: error", err.Error())
+	})
+
+	t.Run("DeleteSuccess", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM public.cache_entry").WillReturnResult(sqlmock.NewResult(0, 1))
+		err := repo.Delete(context.Background(), "key")
+		assert.Nil(t, err)
+	})
+
+	t.Run("DeleteFailure", func(t *testing.T) {
+		mock.ExpectExec("DELETE").WillReturnError(fmt.Errorf("error"))
+		err := repo.Delete(context.Background(), "key")
+		assert.Equal(t, "CacheEntryPostgresRepository Delete: error", err.Error())
+	})
+
+	t.Run("DeleteByPrefixEscapesWildcards", func(t *testing.T) {
+		mock.ExpectExec(`(?s)DELETE FROM public.cache_entry .+LIKE`).
+			WithArgs(`financial\_product\_margin\_basket\_%`).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		deleted, err := repo.DeleteByPrefix(context.Background(), "financial_product_margin_basket_")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), deleted)
+	})
+
+	t.Run("DeleteByPrefixFailure", func(t *testing.T) {
+		mock.ExpectExec("DELETE").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.DeleteByPrefix(context.Background(), "key")
+		assert.Equal(t, "CacheEntryPostgresRepository DeleteByPrefix: error", err.Error())
+	})
+
+	t.Run("DeleteExpiredBeforeSuccess", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM public.cache_entry").WillReturnResult(sqlmock.NewResult(0, 4))
+		deleted, err := repo.DeleteExpiredBefore(context.Background(), time.Now())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), deleted)
+	})
+
+	t.Run("DeleteExpiredBeforeFailure", func(t *testing.T) {
+		mock.ExpectExec("DELETE").WillReturnError(fmt.Errorf("error"))
+		_, err := repo.DeleteExpiredBefore(context.Background(), time.Now())
+		assert.Equal(t, "CacheEntryPostgresRepository DeleteExpiredBefore: error", err.Error())
+	})
+
+	t.Run("NotifySuccess", func(t *testing.T) {
+		mock.ExpectExec(`SELECT pg_notify`).
+			WithArgs("cache_invalidation", `{"key":"key"}`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		err := repo.Notify(context.Background(), "cache_invalidation", `{"key":"key"}`)
+		assert.Nil(t, err)
+	})
+
+	t.Run("NotifyFailure", func(t *testing.T) {
+		mock.ExpectExec("SELECT").WillReturnError(fmt.Errorf("error"))
+		err := repo.Notify(context.Background(), "cache_invalidation", "")
+		assert.Equal(t, "CacheEntryPostgresRepository Notify: error", err.Error())
+	})
+}
@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+)
+
+func MapCacheEntryDbToEntity(entry model.CacheEntry) entity.CacheEntry {
+	return entity.CacheEntry{
+		Key:       entry.CacheKey,
+		Value:     json.RawMessage(entry.Value),
+		ExpiresAt: entry.ExpiresAt,
+	}
+}
+
+func MapCacheEntryEntityToDb(entry entity.CacheEntry) model.CacheEntry {
+	return model.CacheEntry{
+		CacheKey:  entry.Key,
+		Value:     string(entry.Value),
+		ExpiresAt: entry.ExpiresAt,
+	}
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"financing-offer/internal/handler"
+	"financing-offer/internal/sharedcache"
+)
+
+type CacheHandler struct {
+	handler.BaseHandler
+	purger sharedcache.Purger
+}
+
+func NewCacheHandler(baseHandler handler.BaseHandler, purger sharedcache.Purger) *CacheHandler {
+	return &CacheHandler{BaseHandler: baseHandler, purger: purger}
+}
+
+// Purge godoc
+//
+//	@Summary		Purge cached values
+//	@Description	Drop the cached values of every key starting with prefix on every replica, a trailing * is ignored
+//	@Tags			cache,admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			prefix	query		string	true	"key prefix, e.g. financial_product_margin_basket_*"
+//	@Success		204		{object}	handler.BaseResponse[string]
+//	@Failure		400		{object}	handler.ErrorResponse
+//	@Failure		500		{object}	handler.ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/v1/cache [delete]
+func (h *CacheHandler) Purge(ctx *gin.Context) {
+	prefix := strings.TrimSuffix(ctx.Query("prefix"), "*")
+	if prefix == "" {
+		h.RenderBadRequest(ctx, "prefix is required")
+		return
+	}
+	if err := h.purger.Purge(ctx, prefix); err != nil {
+		h.RenderError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusNoContent, handler.BaseResponse[string]{Data: "ok"})
+}
@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/core/scheduler"
+	"financing-offer/internal/sharedcache"
+)
+
+type CacheScheduler struct {
+	logger       *slog.Logger
+	cache        *sharedcache.PostgresCache
+	errorService apperrors.Service
+}
+
+func NewCacheScheduler(logger *slog.Logger, cache *sharedcache.PostgresCache, errorService apperrors.Service) *CacheScheduler {
+	return &CacheScheduler{
+		logger:       logger,
+		cache:        cache,
+		errorService: errorService,
+	}
+}
+
+func (s *CacheScheduler) PurgeExpiredEntries(ctx context.Context) error {
+	deleted, err := s.cache.PurgeExpired(ctx)
+	if err != nil {
+		s.logger.Error("PurgeExpiredEntries", slog.String("error", err.Error()))
+		if err := s.errorService.NotifyError(ctx, err); err != nil {
+			s.logger.Error("PurgeExpiredEntries NotifyError", slog.String("error", err.Error()))
+		}
+		return err
+	}
+	s.logger.Info("PurgeExpiredEntries", slog.Int64("deleted", deleted))
+	scheduler.Track(ctx, "deleted", deleted)
+	return nil
+}
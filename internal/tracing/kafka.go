@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var _ propagation.TextMapCarrier = (*KafkaHeaderCarrier)(nil)
+
+// KafkaHeaderCarrier reads and writes the trace context in the headers of a kafka message
+type KafkaHeaderCarrier struct {
+	message *kafka.Message
+}
+
+func NewKafkaHeaderCarrier(message *kafka.Message) KafkaHeaderCarrier {
+	return KafkaHeaderCarrier{message: message}
+}
+
+func (c KafkaHeaderCarrier) Get(key string) string {
+	for _, header := range c.message.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (c KafkaHeaderCarrier) Set(key string, value string) {
+	for i, header := range c.message.Headers {
+		if header.Key == key {
+			c.message.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	c.message.Headers = append(c.message.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c KafkaHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.message.Headers))
+	for _, header := range c.message.Headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+// InjectKafkaHeaders writes the trace context of ctx in the headers of message
+func InjectKafkaHeaders(ctx context.Context, message *kafka.Message) {
+	propagator.Inject(ctx, NewKafkaHeaderCarrier(message))
+}
+
+// ExtractKafkaHeaders continues in ctx the trace context found in the headers of message
+func ExtractKafkaHeaders(ctx context.Context, message kafka.Message) context.Context {
+	return propagator.Extract(ctx, NewKafkaHeaderCarrier(&message))
+}
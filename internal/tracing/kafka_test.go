@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestKafkaHeaders(t *testing.T) {
+	t.Parallel()
+	ctx := remoteContext(t)
+
+	t.Run("round trip", func(t *testing.T) {
+		message := kafka.Message{Headers: []kafka.Header{{Key: "x-dlq-attempts", Value: []byte("3")}}}
+
+		InjectKafkaHeaders(ctx, &message)
+		extracted := ExtractKafkaHeaders(context.Background(), message)
+
+		assert.Equal(t, []string{"x-dlq-attempts", "traceparent", "baggage"}, NewKafkaHeaderCarrier(&message).Keys())
+		assert.Equal(t, trace.SpanContextFromContext(ctx).TraceID(), trace.SpanContextFromContext(extracted).TraceID())
+	})
+
+	t.Run("replace existing trace context", func(t *testing.T) {
+		message := kafka.Message{Headers: []kafka.Header{{Key: "traceparent", Value: []byte("stale")}}}
+
+		InjectKafkaHeaders(ctx, &message)
+
+		assert.Len(t, message.Headers, 2)
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", NewKafkaHeaderCarrier(&message).Get("traceparent"))
+	})
+}
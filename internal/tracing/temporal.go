@@ -0,0 +1,121 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/sdk/interceptor"
+)
+
+const (
+	temporalTracerName = "financing-offer/internal/tracing/temporal"
+	temporalHeaderKey  = "_tracer-data"
+)
+
+type temporalSpanContextKey struct{}
+
+var _ interceptor.Tracer = (*temporalTracer)(nil)
+
+// temporalTracer carries the trace context through the Temporal headers, so the workflows and activities started
+// from a request are spans of its trace
+type temporalTracer struct {
+	interceptor.BaseTracer
+	tracer trace.Tracer
+}
+
+// NewTemporalInterceptor traces the calls of the Temporal client and, through the workers of the client, the
+// workflows and activities they run
+func NewTemporalInterceptor() interceptor.Interceptor {
+	return interceptor.NewTracingInterceptor(&temporalTracer{tracer: otel.Tracer(temporalTracerName)})
+}
+
+type temporalSpanRef struct {
+	spanContext trace.SpanContext
+	baggage     baggage.Baggage
+}
+
+type temporalSpan struct {
+	trace.Span
+	baggage baggage.Baggage
+}
+
+func (s *temporalSpan) Finish(options *interceptor.TracerFinishSpanOptions) {
+	if options.Error != nil {
+		s.RecordError(options.Error)
+		s.SetStatus(codes.Error, options.Error.Error())
+	}
+	s.End()
+}
+
+func (t *temporalTracer) Options() interceptor.TracerOptions {
+	return interceptor.TracerOptions{
+		SpanContextKey: temporalSpanContextKey{},
+		HeaderKey:      temporalHeaderKey,
+	}
+}
+
+func (t *temporalTracer) UnmarshalSpan(data map[string]string) (interceptor.TracerSpanRef, error) {
+	ctx := propagator.Extract(context.Background(), propagation.MapCarrier(data))
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil, fmt.Errorf("temporalTracer UnmarshalSpan no span context in header")
+	}
+	return &temporalSpanRef{spanContext: spanContext, baggage: baggage.FromContext(ctx)}, nil
+}
+
+func (t *temporalTracer) MarshalSpan(span interceptor.TracerSpan) (map[string]string, error) {
+	s, ok := span.(*temporalSpan)
+	if !ok {
+		return nil, fmt.Errorf("temporalTracer MarshalSpan unexpected span %T", span)
+	}
+	ctx := baggage.ContextWithBaggage(trace.ContextWithSpan(context.Background(), s.Span), s.baggage)
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier, nil
+}
+
+func (t *temporalTracer) SpanFromContext(ctx context.Context) interceptor.TracerSpan {
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		return nil
+	}
+	return &temporalSpan{Span: span, baggage: baggage.FromContext(ctx)}
+}
+
+func (t *temporalTracer) ContextWithSpan(ctx context.Context, span interceptor.TracerSpan) context.Context {
+	s, ok := span.(*temporalSpan)
+	if !ok {
+		return ctx
+	}
+	return baggage.ContextWithBaggage(trace.ContextWithSpan(ctx, s.Span), s.baggage)
+}
+
+func (t *temporalTracer) StartSpan(options *interceptor.TracerStartSpanOptions) (interceptor.TracerSpan, error) {
+	parent := context.Background()
+	var bag baggage.Baggage
+	switch ref := options.Parent.(type) {
+	case nil:
+	case *temporalSpan:
+		parent, bag = trace.ContextWithSpan(parent, ref.Span), ref.baggage
+	case *temporalSpanRef:
+		parent, bag = trace.ContextWithRemoteSpanContext(parent, ref.spanContext), ref.baggage
+	default:
+		return nil, fmt.Errorf("temporalTracer StartSpan unexpected parent %T", ref)
+	}
+	attributes := make([]attribute.KeyValue, 0, len(options.Tags))
+	for key, value := range options.Tags {
+		attributes = append(attributes, attribute.String(key, value))
+	}
+	_, span := t.tracer.Start(
+		parent, t.SpanName(options),
+		trace.WithTimestamp(options.Time),
+		trace.WithAttributes(attributes...),
+	)
+	return &temporalSpan{Span: span, baggage: bag}, nil
+}
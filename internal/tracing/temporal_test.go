@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.temporal.io/sdk/interceptor"
+)
+
+func TestTemporalTracer(t *testing.T) {
+	t.Parallel()
+	tracer := &temporalTracer{tracer: sdktrace.NewTracerProvider().Tracer(temporalTracerName)}
+	ctx := remoteContext(t)
+
+	t.Run("header round trip keeps trace and baggage", func(t *testing.T) {
+		parent := tracer.SpanFromContext(ctx)
+		assert.NotNil(t, parent)
+		span, err := tracer.StartSpan(&interceptor.TracerStartSpanOptions{Parent: parent, Operation: "StartWorkflow", Name: "workflow"})
+		assert.Nil(t, err)
+
+		header, err := tracer.MarshalSpan(span)
+		assert.Nil(t, err)
+		ref, err := tracer.UnmarshalSpan(header)
+		assert.Nil(t, err)
+		child, err := tracer.StartSpan(&interceptor.TracerStartSpanOptions{Parent: ref, Operation: "RunWorkflow", Name: "workflow"})
+		assert.Nil(t, err)
+		childCtx := tracer.ContextWithSpan(context.Background(), child)
+
+		assert.Equal(t, parent.(*temporalSpan).SpanContext().TraceID(), child.(*temporalSpan).SpanContext().TraceID())
+		assert.Equal(t, "request-id", baggage.FromContext(childCtx).Member("request_id").Value())
+	})
+
+	t.Run("header without span", func(t *testing.T) {
+		_, err := tracer.UnmarshalSpan(map[string]string{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("no span in context", func(t *testing.T) {
+		assert.Nil(t, tracer.SpanFromContext(context.Background()))
+	})
+}
@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"financing-offer/internal/config"
+	"financing-offer/pkg/shutdown"
+)
+
+// propagator is the format of the trace context on the wire: w3c trace context and baggage
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// Setup installs the global tracer provider and propagator, the spans still
+// buffered are flushed by the shutdown tasks
+func Setup(cfg config.TracingConfig, tasks *shutdown.Tasks) error {
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName))),
+	}
+	switch cfg.Exporter {
+	case config.TracingExporterOtlp:
+		exporterOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			exporterOptions = append(exporterOptions, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(context.Background(), exporterOptions...)
+		if err != nil {
+			return fmt.Errorf("tracing Setup %w", err)
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	case config.TracingExporterNoop:
+	default:
+		return fmt.Errorf("tracing Setup unknown exporter %q", cfg.Exporter)
+	}
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	tasks.AddShutdownTask(provider.Shutdown)
+	return nil
+}
+
+// Inject returns the trace context of ctx as a map, to be stored with work picked up later in another context
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier
+}
+
+// Extract continues the trace context returned by Inject in ctx
+func Extract(ctx context.Context, traceContext map[string]string) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier(traceContext))
+}
+
+// InjectHttpHeaders writes the trace context of ctx in the headers of an outgoing request
+func InjectHttpHeaders(ctx context.Context, header http.Header) {
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+
+	"financing-offer/internal/config"
+	"financing-offer/pkg/shutdown"
+)
+
+// remoteContext carries a sampled span context and a request id in the baggage, as a traced request does
+func remoteContext(t *testing.T) context.Context {
+	traceId, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	assert.Nil(t, err)
+	spanId, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	assert.Nil(t, err)
+	ctx := trace.ContextWithRemoteSpanContext(
+		context.Background(), trace.NewSpanContext(
+			trace.SpanContextConfig{TraceID: traceId, SpanID: spanId, TraceFlags: trace.FlagsSampled, Remote: true},
+		),
+	)
+	member, err := baggage.NewMember("request_id", "request-id")
+	assert.Nil(t, err)
+	bag, err := baggage.New(member)
+	assert.Nil(t, err)
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	tasks, _ := shutdown.NewShutdownTasks(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	t.Run("noop exporter", func(t *testing.T) {
+		assert.Nil(t, Setup(config.TracingConfig{Exporter: config.TracingExporterNoop, ServiceName: "test", SampleRatio: 1}, tasks))
+	})
+
+	t.Run("unknown exporter", func(t *testing.T) {
+		assert.NotNil(t, Setup(config.TracingConfig{Exporter: "jaeger"}, tasks))
+	})
+}
+
+func TestInjectExtract(t *testing.T) {
+	t.Parallel()
+	ctx := remoteContext(t)
+
+	t.Run("map round trip", func(t *testing.T) {
+		traceContext := Inject(ctx)
+
+		extracted := Extract(context.Background(), traceContext)
+
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", traceContext["traceparent"])
+		assert.Equal(t, trace.SpanContextFromContext(ctx).TraceID(), trace.SpanContextFromContext(extracted).TraceID())
+		assert.Equal(t, "request-id", baggage.FromContext(extracted).Member("request_id").Value())
+	})
+
+	t.Run("nothing to inject without a span", func(t *testing.T) {
+		assert.Empty(t, Inject(context.Background()))
+	})
+
+	t.Run("http headers", func(t *testing.T) {
+		header := http.Header{}
+
+		InjectHttpHeaders(ctx, header)
+
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", header.Get("traceparent"))
+		assert.Equal(t, "request_id=request-id", header.Get("baggage"))
+	})
+}
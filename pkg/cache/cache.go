@@ -1,8 +1,13 @@
 package cache
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
+
+	"golang.org/x/sync/singleflight"
 )
 
 var DefaultTtl = time.Minute
@@ -11,23 +16,51 @@ type Cache interface {
 	Get(key string) (any, bool)
 	SetTtl(key string, value any, ttl time.Duration)
 	Del(key string)
+	// DelPrefix deletes every key starting with prefix
+	DelPrefix(prefix string)
+}
+
+// ErrValueType is returned by Do when a load shared with another caller of the key produced a value of another type
+var ErrValueType = errors.New("cached value of another type")
+
+// groups holds the singleflight group of every cache, so the loads of the same key in two caches are not merged.
+// A cache is its own key, the implementations are pointers
+var groups sync.Map
+
+func groupOf(cache Cache) *singleflight.Group {
+	group, _ := groups.LoadOrStore(cache, &singleflight.Group{})
+	return group.(*singleflight.Group)
 }
 
-// Do execute the function f and cache the result with the provided key and ttl, if the key exists in the cache, return the cached value
+// Do execute the function f and cache the result with the provided key and ttl, if the key exists in the cache, return the cached value.
+// Concurrent calls missing the same key of the cache wait for a single execution of f and share its result
 func Do[T any](cache Cache, key string, ttl time.Duration, f func() (T, error)) (T, error) {
 	if cachedValue, ok := cache.Get(key); ok {
-		if valueCast, ok := cachedValue.(T); ok {
+		if valueCast, ok := valueOf[T](cachedValue); ok {
 			return valueCast, nil
 		} else {
 			cache.Del(key)
 		}
 	}
-	value, err := f()
+	value, err, _ := groupOf(cache).Do(
+		key, func() (any, error) {
+			value, err := f()
+			if err != nil {
+				return value, err
+			}
+			cache.SetTtl(key, value, ttl)
+			return value, nil
+		},
+	)
 	if err != nil {
-		return value, fmt.Errorf("DoWithCache: %w", err)
+		valueCast, _ := value.(T)
+		return valueCast, fmt.Errorf("DoWithCache: %w", err)
 	}
-	cache.SetTtl(key, value, ttl)
-	return value, nil
+	valueCast, ok := value.(T)
+	if !ok && value != nil {
+		return valueCast, fmt.Errorf("DoWithCache: key %s holds %T: %w", key, value, ErrValueType)
+	}
+	return valueCast, nil
 }
 
 // Get gets cached value by provided key from the provided cache,
@@ -38,11 +71,7 @@ func Get[T any](cache Cache, key string) (T, bool) {
 	if !ok {
 		return defaultValue, false
 	}
-	t, ok := value.(T)
-	if !ok {
-		return defaultValue, false
-	}
-	return t, true
+	return valueOf[T](value)
 }
 
 // SetTtl sets a key value pair to the provided cache with a ttl
@@ -54,3 +83,24 @@ func SetTtl(cache Cache, key string, value any, ttl time.Duration) {
 func Del(cache Cache, key string) {
 	cache.Del(key)
 }
+
+// DelPrefix deletes every key starting with prefix from the provided cache
+func DelPrefix(cache Cache, prefix string) {
+	cache.DelPrefix(prefix)
+}
+
+// valueOf casts a cached value to T, caches shared between processes return their values as json
+func valueOf[T any](value any) (T, bool) {
+	if t, ok := value.(T); ok {
+		return t, true
+	}
+	var t T
+	raw, ok := value.(json.RawMessage)
+	if !ok {
+		return t, false
+	}
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return t, false
+	}
+	return t, true
+}
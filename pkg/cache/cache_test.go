@@ -0,0 +1,236 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/pkg/shutdown"
+)
+
+type entry struct {
+	value any
+	ttl   time.Duration
+}
+
+type mapCache struct {
+	mu      sync.Mutex
+	entries map[string]entry
+	onGet   func()
+}
+
+func newMapCache() *mapCache {
+	return &mapCache{entries: map[string]entry{}, onGet: func() {}}
+}
+
+func (c *mapCache) Get(key string) (any, bool) {
+	defer c.onGet()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	return e.value, ok
+}
+
+func (c *mapCache) SetTtl(key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = entry{value: value, ttl: ttl}
+}
+
+func (c *mapCache) Del(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+func (c *mapCache) DelPrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+type basket struct {
+	Id      int64    `json:"id"`
+	Symbols []string `json:"symbols"`
+}
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"load and cache on miss", func(t *testing.T) {
+			store := newMapCache()
+			value, err := Do(store, "do_miss", time.Minute, func() (int, error) { return 1, nil })
+			assert.Nil(t, err)
+			assert.Equal(t, 1, value)
+			assert.Equal(t, entry{value: 1, ttl: time.Minute}, store.entries["do_miss"])
+		},
+	)
+
+	t.Run(
+		"decode shared json values", func(t *testing.T) {
+			store := newMapCache()
+			store.SetTtl("do_json", json.RawMessage(`{"id":1,"symbols":["HPG"]}`), time.Minute)
+			value, err := Do(
+				store, "do_json", time.Minute, func() (basket, error) {
+					return basket{}, errors.New("should not load")
+				},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, basket{Id: 1, Symbols: []string{"HPG"}}, value)
+		},
+	)
+
+	t.Run(
+		"reload values of another type", func(t *testing.T) {
+			store := newMapCache()
+			store.SetTtl("do_type", "1", time.Minute)
+			value, err := Do(store, "do_type", time.Minute, func() (int, error) { return 2, nil })
+			assert.Nil(t, err)
+			assert.Equal(t, 2, value)
+		},
+	)
+
+	t.Run(
+		"do not cache errors", func(t *testing.T) {
+			store := newMapCache()
+			_, err := Do(store, "do_error", time.Minute, func() (int, error) { return 0, errors.New("upstream") })
+			assert.Equal(t, "DoWithCache: upstream", err.Error())
+			assert.Empty(t, store.entries)
+		},
+	)
+
+	t.Run(
+		"load once for concurrent misses", func(t *testing.T) {
+			const callers = 10
+			store := newMapCache()
+			missed := sync.WaitGroup{}
+			missed.Add(callers)
+			store.onGet = missed.Done
+			loads := atomic.Int32{}
+			results := make(chan int, callers)
+			for range callers {
+				go func() {
+					value, _ := Do(
+						store, "do_concurrent", time.Minute, func() (int, error) {
+							loads.Add(1)
+							missed.Wait()
+							time.Sleep(10 * time.Millisecond)
+							return 1, nil
+						},
+					)
+					results <- value
+				}()
+			}
+			for range callers {
+				assert.Equal(t, 1, <-results)
+			}
+			assert.Equal(t, int32(1), loads.Load())
+		},
+	)
+
+	t.Run(
+		"load the same key of two caches on their own", func(t *testing.T) {
+			const callers = 2
+			stores := []*mapCache{newMapCache(), newMapCache()}
+			missed := sync.WaitGroup{}
+			missed.Add(callers)
+			loads := atomic.Int32{}
+			results := make(chan int, callers)
+			for i, store := range stores {
+				store.onGet = missed.Done
+				go func() {
+					value, _ := Do(
+						store, "do_two_caches", time.Minute, func() (int, error) {
+							loads.Add(1)
+							missed.Wait()
+							return i, nil
+						},
+					)
+					results <- value
+				}()
+			}
+			assert.ElementsMatch(t, []int{0, 1}, []int{<-results, <-results})
+			assert.Equal(t, int32(callers), loads.Load())
+			assert.Equal(t, 0, stores[0].entries["do_two_caches"].value)
+			assert.Equal(t, 1, stores[1].entries["do_two_caches"].value)
+		},
+	)
+
+	t.Run(
+		"return an error for a shared load of another type", func(t *testing.T) {
+			store := newMapCache()
+			missed := sync.WaitGroup{}
+			missed.Add(2)
+			store.onGet = missed.Done
+			load := func() {
+				missed.Wait()
+				time.Sleep(10 * time.Millisecond)
+			}
+			errs := make(chan error, 2)
+			go func() {
+				_, err := Do(store, "do_shared_type", time.Minute, func() (int, error) { load(); return 1, nil })
+				errs <- err
+			}()
+			go func() {
+				_, err := Do(store, "do_shared_type", time.Minute, func() (string, error) { load(); return "1", nil })
+				errs <- err
+			}()
+			// whichever caller loads, the other one joins its load and gets a value of another type
+			first, second := <-errs, <-errs
+			assert.True(t, errors.Is(first, ErrValueType) != errors.Is(second, ErrValueType))
+			assert.True(t, first == nil || second == nil)
+		},
+	)
+}
+
+func TestWithFamilyTtls(t *testing.T) {
+	t.Parallel()
+	store := newMapCache()
+	c := WithFamilyTtls(
+		store, map[string]time.Duration{
+			"financial_product_":               time.Minute,
+			"financial_product_margin_basket_": 5 * time.Minute,
+		},
+	)
+
+	c.SetTtl("financial_product_margin_basket_1", 1, DefaultTtl)
+	c.SetTtl("financial_product_loan_package_1", 1, DefaultTtl)
+	c.SetTtl("public-promotion-loan-package-HPG", 1, 30*time.Second)
+
+	assert.Equal(t, 5*time.Minute, store.entries["financial_product_margin_basket_1"].ttl)
+	assert.Equal(t, time.Minute, store.entries["financial_product_loan_package_1"].ttl)
+	assert.Equal(t, 30*time.Second, store.entries["public-promotion-loan-package-HPG"].ttl)
+}
+
+func TestInProcessCacheDelPrefix(t *testing.T) {
+	t.Parallel()
+	tasks, _ := shutdown.NewShutdownTasks(slog.Default())
+	c, err := NewInProcessCache(tasks)
+	assert.Nil(t, err)
+	c.SetTtl("financial_product_margin_basket_1", 1, time.Minute)
+	c.SetTtl("financial_product_margin_basket_2", 2, time.Minute)
+	c.SetTtl("financial_product_loan_package_1", 3, time.Minute)
+	c.store.Wait()
+
+	c.DelPrefix("financial_product_margin_basket_")
+
+	_, ok := c.Get("financial_product_margin_basket_1")
+	assert.False(t, ok)
+	_, ok = c.Get("financial_product_margin_basket_2")
+	assert.False(t, ok)
+	value, ok := c.Get("financial_product_loan_package_1")
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+}
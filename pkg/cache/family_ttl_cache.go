@@ -0,0 +1,33 @@
+package cache
+
+import (
+	"strings"
+	"time"
+)
+
+type familyTtlCache struct {
+	Cache
+	ttls map[string]time.Duration
+}
+
+// WithFamilyTtls overrides the ttl of the keys starting with one of the prefixes of ttls, the longest matching prefix wins
+func WithFamilyTtls(store Cache, ttls map[string]time.Duration) Cache {
+	if len(ttls) == 0 {
+		return store
+	}
+	return &familyTtlCache{Cache: store, ttls: ttls}
+}
+
+func (c *familyTtlCache) SetTtl(key string, value any, ttl time.Duration) {
+	c.Cache.SetTtl(key, value, c.ttlOf(key, ttl))
+}
+
+func (c *familyTtlCache) ttlOf(key string, ttl time.Duration) time.Duration {
+	longest := -1
+	for prefix, familyTtl := range c.ttls {
+		if len(prefix) > longest && strings.HasPrefix(key, prefix) {
+			longest, ttl = len(prefix), familyTtl
+		}
+	}
+	return ttl
+}
@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -11,6 +13,8 @@ import (
 
 type InProcessCache struct {
 	store *ristretto.Cache
+	// keys indexes the expiry of the stored keys for DelPrefix, ristretto cannot iterate over them
+	keys sync.Map
 }
 
 // Get gets cached value by provided key, the bool return value indicates whether the key exists in the cache
@@ -20,12 +24,34 @@ func (c *InProcessCache) Get(key string) (any, bool) {
 
 // SetTtl sets a key value pair with a ttl
 func (c *InProcessCache) SetTtl(key string, value any, ttl time.Duration) {
+	expiresAt := time.Time{}
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+	c.keys.Store(key, expiresAt)
 	c.store.SetWithTTL(key, value, 1, ttl)
 }
 
 // Del deletes a key value pair
 func (c *InProcessCache) Del(key string) {
 	c.store.Del(key)
+	c.keys.Delete(key)
+}
+
+// DelPrefix deletes every key starting with prefix, the keys which already expired are dropped from the index on the way
+func (c *InProcessCache) DelPrefix(prefix string) {
+	now := time.Now()
+	c.keys.Range(
+		func(k, v any) bool {
+			key, expiresAt := k.(string), v.(time.Time)
+			if strings.HasPrefix(key, prefix) {
+				c.Del(key)
+			} else if !expiresAt.IsZero() && now.After(expiresAt) {
+				c.keys.Delete(key)
+			}
+			return true
+		},
+	)
 }
 
 func NewInProcessCache(task *shutdown.Tasks) (*InProcessCache, error) {
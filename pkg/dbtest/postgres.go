@@ -134,6 +134,7 @@ func truncateAll(db database.DB) {
 		table.FinancialConfiguration.TableName(),
 		table.SuggestedOffer.TableName(),
 		table.PromotionCampaign.TableName(),
+		"audit.logged_actions",
 	}
 	for _, t := range tables {
 		_, err := db.Exec(fmt.Sprintf("TRUNCATE %s RESTART IDENTITY CASCADE;", t))
@@ -22,7 +22,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Fill investor ids from requests",
+                "description": "Fill investor ids from requests, deprecated in favor of triggering the FillInvestorIds scheduler job",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,6 +34,7 @@ const docTemplate = `{
                     "admin"
                 ],
                 "summary": "Fill investor ids from requests",
+                "deprecated": true,
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -57,7 +58,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Manual trigger expire loan offers",
+                "description": "Manual trigger expire loan offers, deprecated in favor of triggering the ExpireLoanOffers scheduler job",
                 "consumes": [
                     "application/json"
                 ],
@@ -70,6 +71,7 @@ const docTemplate = `{
                     "internal"
                 ],
                 "summary": "Manual trigger expire loan offers",
+                "deprecated": true,
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1566,7 +1568,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create loan request scheduler config",
+                "description": "Create loan request scheduler config, a pending request is checked against the most specific rule matching it only, without the thresholds of broader rules",
                 "consumes": [
                     "application/json"
                 ],
@@ -4161,11 +4163,17 @@ const docTemplate = `{
             "type": "string",
             "enum": [
                 "PENDING",
-                "CONFIRMED"
+                "CONFIRMED",
+                "DECLINED",
+                "CANCELLED",
+                "EXPIRED"
             ],
             "x-enum-varnames": [
                 "LoanPackageRequestStatusPending",
-                "LoanPackageRequestStatusConfirmed"
+                "LoanPackageRequestStatusConfirmed",
+                "LoanPackageRequestStatusDeclined",
+                "LoanPackageRequestStatusCancelled",
+                "LoanPackageRequestStatusExpired"
             ]
         },
         "financing-offer_internal_core_entity.LoanPackageRequestType": {
@@ -1,7 +1,6 @@
 package financialproduct
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,7 +10,6 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -20,27 +18,34 @@ import (
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/financialproduct/repository"
 	"financing-offer/internal/funcs"
+	"financing-offer/pkg/infra/httpclient"
 )
 
 var _ repository.FinancialProductRepository = (*Client)(nil)
 
-const maxFetchSize = 500
+const (
+	maxFetchSize = 500
+	// maxBasketFetches bounds the basket details fetched at once, so a long list of ids does not take every
+	// call slot of the upstream
+	maxBasketFetches = 8
+)
 
 type Client struct {
-	httpClient *http.Client
+	httpClient *httpclient.Client
 	config     config.FinancialProductConfig
 }
 
 func (c *Client) GetMarginBasketsByIds(ctx context.Context, ids []int64) ([]entity.MarginBasket, error) {
 	var (
-		errorGroup errgroup.Group
-		mu         sync.Mutex
-		baskets    = make([]entity.MarginBasket, 0, len(ids))
+		mu      sync.Mutex
+		baskets = make([]entity.MarginBasket, 0, len(ids))
 	)
+	errorGroup, groupCtx := errgroup.WithContext(ctx)
+	errorGroup.SetLimit(maxBasketFetches)
 	for _, id := range ids {
 		errorGroup.Go(
 			func() error {
-				basket, err := c.GetMarginBasketDetail(ctx, id)
+				basket, err := c.GetMarginBasketDetail(groupCtx, id)
 				if err != nil {
 					return err
 				}
@@ -124,24 +129,17 @@ func (c *Client) AssignLoanPackageOrGetLoanPackageAccountId(ctx context.Context,
 
 func NewClient(config config.FinancialProductConfig) *Client {
 	return &Client{
-		config: config,
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
-		},
+		config:     config,
+		httpClient: httpclient.New("financial-product", config.Http),
 	}
 }
 
 func (c *Client) NewRequest(ctx context.Context, method, url string, body any) (*http.Request, error) {
-	marshaledBody, err := json.Marshal(body)
-	if err != nil {
-		return nil, fmt.Errorf("NewRequest %w", err)
-	}
-	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(marshaledBody))
+	req, err := httpclient.NewJSONRequest(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("NewRequest %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.config.Token))
-	req.Header.Set("Content-Type", "application/json")
 	return req, nil
 }
 
@@ -2,8 +2,11 @@ package financialproduct
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
 	"github.com/shopspring/decimal"
@@ -12,6 +15,7 @@ import (
 	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/infra/httpclient"
 )
 
 func TestClient_AssignLoanPackage(t *testing.T) {
@@ -553,3 +557,96 @@ func TestClient_GetMarginBasketsByIds(t *testing.T) {
 		},
 	)
 }
+
+func TestClient_Resilience(t *testing.T) {
+	defer gock.Off()
+	financingProductConfig := config.FinancialProductConfig{
+		Url:   "http://financing-product",
+		Token: "financing-product-token",
+		Http: config.HttpClientConfig{
+			RetryBackoff:        time.Millisecond,
+			BreakerThreshold:    3,
+			BreakerOpenDuration: time.Minute,
+		},
+	}
+	client := NewClient(financingProductConfig)
+	t.Run(
+		"get all account detail retried on 5xx", func(t *testing.T) {
+			gock.New(financingProductConfig.Url).Get("/accounts").Reply(http.StatusServiceUnavailable)
+			gock.New(financingProductConfig.Url).Get("/accounts").MatchParam("investorId", "0001000115").Reply(200).JSON(
+				FinancialAccountsResponse{Accounts: []entity.FinancialAccountDetail{{AccountNo: "0001000115"}}},
+			)
+			accounts, err := client.GetAllAccountDetail(context.Background(), "0001000115")
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(accounts))
+			assert.True(t, gock.IsDone())
+		},
+	)
+	t.Run(
+		"assign loan package not retried", func(t *testing.T) {
+			gock.New(financingProductConfig.Url).Post("/v2/loan-package-accounts").Reply(http.StatusBadGateway).JSON(
+				ErrorResponse{Message: "bad gateway"},
+			)
+			_, err := client.AssignLoanPackage(context.Background(), "0001000115", 878, "")
+			assert.ErrorContains(t, err, "got error Status 502")
+		},
+	)
+	t.Run(
+		"refuse calls while circuit breaker is open", func(t *testing.T) {
+			defer gock.Off()
+			client := NewClient(financingProductConfig)
+			// each call fails after its 3 attempts, the breaker opens on the third failed call
+			gock.New(financingProductConfig.Url).Get("/accounts").Times(9).ReplyError(errors.New("connection refused"))
+			for i := 0; i < financingProductConfig.Http.BreakerThreshold; i++ {
+				_, err := client.GetAllAccountDetail(context.Background(), "0001000115")
+				var appErr apperrors.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, apperrors.ErrUpstreamUnavailable("", nil).Code, appErr.Code)
+			}
+
+			_, err := client.GetAllAccountDetail(context.Background(), "0001000115")
+			assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+			assert.True(t, gock.IsDone())
+		},
+	)
+	t.Run(
+		"get all account detail times out", func(t *testing.T) {
+			defer gock.Off()
+			client := NewClient(
+				config.FinancialProductConfig{
+					Url:   financingProductConfig.Url,
+					Token: financingProductConfig.Token,
+					Http:  config.HttpClientConfig{Timeout: 20 * time.Millisecond, MaxRetries: -1},
+				},
+			)
+			gock.New(financingProductConfig.Url).Get("/accounts").Reply(http.StatusOK).Delay(time.Second)
+			_, err := client.GetAllAccountDetail(context.Background(), "0001000115")
+			var appErr apperrors.AppError
+			assert.ErrorAs(t, err, &appErr)
+			assert.Equal(t, apperrors.ErrUpstreamTimeout("", nil).Code, appErr.Code)
+		},
+	)
+	t.Run(
+		"get all account detail refused while bulkhead is full", func(t *testing.T) {
+			defer gock.Off()
+			client := NewClient(
+				config.FinancialProductConfig{
+					Url:   financingProductConfig.Url,
+					Token: financingProductConfig.Token,
+					Http:  config.HttpClientConfig{MaxConcurrent: 1, MaxWait: 10 * time.Millisecond},
+				},
+			)
+			gock.New(financingProductConfig.Url).Get("/accounts").Reply(http.StatusOK).Delay(200 * time.Millisecond)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = client.GetAllAccountDetail(context.Background(), "0001000115")
+			}()
+			// let the first call take the only slot
+			time.Sleep(50 * time.Millisecond)
+			_, err := client.GetAllAccountDetail(context.Background(), "0001000115")
+			assert.ErrorIs(t, err, httpclient.ErrBulkheadFull)
+			<-done
+		},
+	)
+}
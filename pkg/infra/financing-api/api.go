@@ -9,19 +9,18 @@ import (
 	"time"
 
 	"financing-offer/internal/config"
+	"financing-offer/pkg/infra/httpclient"
 )
 
 type Client struct {
-	httpClient *http.Client
+	httpClient *httpclient.Client
 	config     config.FinancingApiConfig
 }
 
 func NewClient(config config.FinancingApiConfig) *Client {
 	return &Client{
-		config: config,
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
-		},
+		config:     config,
+		httpClient: httpclient.New("financing-api", config.Http),
 	}
 }
 
@@ -0,0 +1,123 @@
+package financing_api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/pkg/infra/httpclient"
+)
+
+func TestClient_GetDateAfter(t *testing.T) {
+	defer gock.Off()
+	financingApiConfig := config.FinancingApiConfig{
+		Url:   "http://financing-api",
+		Token: "financing-api-token",
+		Http: config.HttpClientConfig{
+			RetryBackoff: time.Millisecond,
+		},
+	}
+	client := NewClient(financingApiConfig)
+	date := time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC)
+	t.Run(
+		"get date after success", func(t *testing.T) {
+			gock.New(financingApiConfig.Url).Get("/internal/api/business_dates/2024-05-10").HeaderPresent("Authorization").
+				MatchParam("expandedDays", "2").
+				Reply(200).
+				JSON(DateAfterResponse{Data: "2024-05-14"})
+			res, err := client.GetDateAfter(date, 2)
+			assert.Nil(t, err)
+			assert.Equal(t, time.Date(2024, 5, 14, 9, 30, 0, 0, time.UTC), res)
+		})
+	t.Run(
+		"get date after retried on 5xx", func(t *testing.T) {
+			gock.New(financingApiConfig.Url).Get("/internal/api/business_dates/2024-05-10").Reply(502)
+			gock.New(financingApiConfig.Url).Get("/internal/api/business_dates/2024-05-10").
+				Reply(200).
+				JSON(DateAfterResponse{Data: "2024-05-14"})
+			res, err := client.GetDateAfter(date, 2)
+			assert.Nil(t, err)
+			assert.Equal(t, time.Date(2024, 5, 14, 9, 30, 0, 0, time.UTC), res)
+			assert.True(t, gock.IsDone())
+		})
+	t.Run(
+		"get date after fails when retries are exhausted", func(t *testing.T) {
+			gock.New(financingApiConfig.Url).Get("/internal/api/business_dates/2024-05-10").Times(3).Reply(500).
+				BodyString("internal error")
+			_, err := client.GetDateAfter(date, 2)
+			assert.ErrorContains(t, err, "got error Status 500, message: internal error")
+			assert.True(t, gock.IsDone())
+		})
+}
+
+func TestClient_Resilience(t *testing.T) {
+	newClient := func(httpConfig config.HttpClientConfig) (*Client, config.FinancingApiConfig) {
+		financingApiConfig := config.FinancingApiConfig{
+			Url:   "http://financing-api",
+			Token: "financing-api-token",
+			Http:  httpConfig,
+		}
+		return NewClient(financingApiConfig), financingApiConfig
+	}
+	assertAppErrorCode := func(t *testing.T, err error, code int) {
+		var appErr apperrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, code, appErr.Code)
+	}
+	t.Run(
+		"get date after times out", func(t *testing.T) {
+			defer gock.Off()
+			client, financingApiConfig := newClient(config.HttpClientConfig{Timeout: 20 * time.Millisecond, MaxRetries: -1})
+			gock.New(financingApiConfig.Url).Get("/internal/api/business_dates/2024-05-10").Reply(http.StatusOK).Delay(time.Second)
+			_, err := client.GetDateAfter(time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC), 2)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamTimeout("", nil).Code)
+		},
+	)
+	t.Run(
+		"get date after retried on 5xx", func(t *testing.T) {
+			defer gock.Off()
+			client, financingApiConfig := newClient(config.HttpClientConfig{RetryBackoff: time.Millisecond})
+			gock.New(financingApiConfig.Url).Get("/internal/api/business_dates/2024-05-10").Times(3).Reply(http.StatusServiceUnavailable)
+			_, err := client.GetDateAfter(time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC), 2)
+			assert.Error(t, err)
+			assert.True(t, gock.IsDone())
+		},
+	)
+	t.Run(
+		"get date after refused while circuit breaker is open", func(t *testing.T) {
+			defer gock.Off()
+			client, financingApiConfig := newClient(
+				config.HttpClientConfig{MaxRetries: -1, BreakerThreshold: 1, BreakerOpenDuration: time.Minute},
+			)
+			gock.New(financingApiConfig.Url).Get("/internal/api/business_dates/2024-05-10").Reply(http.StatusServiceUnavailable)
+			_, err := client.GetDateAfter(time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC), 2)
+			assert.Error(t, err)
+			_, err = client.GetDateAfter(time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC), 2)
+			assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+		},
+	)
+	t.Run(
+		"get date after refused while bulkhead is full", func(t *testing.T) {
+			defer gock.Off()
+			client, financingApiConfig := newClient(config.HttpClientConfig{MaxConcurrent: 1, MaxWait: 10 * time.Millisecond})
+			gock.New(financingApiConfig.Url).Get("/internal/api/business_dates/2024-05-10").Reply(http.StatusOK).Delay(200 * time.Millisecond)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = client.GetDateAfter(time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC), 2)
+			}()
+			// let the first call take the only slot
+			time.Sleep(50 * time.Millisecond)
+			_, err := client.GetDateAfter(time.Date(2024, 5, 10, 9, 30, 0, 0, time.UTC), 2)
+			assert.ErrorIs(t, err, httpclient.ErrBulkheadFull)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+			<-done
+		},
+	)
+}
@@ -1,28 +1,25 @@
 package flex_api
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"time"
 
 	"financing-offer/internal/config"
+	"financing-offer/pkg/infra/httpclient"
 )
 
 type Client struct {
-	httpClient *http.Client
+	httpClient *httpclient.Client
 	config     config.FlexOpenApiConfig
 }
 
 func NewClient(config config.FlexOpenApiConfig) *Client {
 	return &Client{
-		config: config,
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
-		},
+		config:     config,
+		httpClient: httpclient.New("flex-api", config.Http),
 	}
 }
 
@@ -59,15 +56,10 @@ func (c *Client) IsHOActive(ctx context.Context) (bool, error) {
 }
 
 func (c *Client) NewRequest(ctx context.Context, method, url string, body any) (*http.Request, error) {
-	marshaledBody, err := json.Marshal(body)
-	if err != nil {
-		return nil, fmt.Errorf("NewRequest %w", err)
-	}
-	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(marshaledBody))
+	req, err := httpclient.NewJSONRequest(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("NewRequest %w", err)
 	}
 	req.SetBasicAuth(c.config.Username, c.config.Password)
-	req.Header.Set("Content-Type", "application/json")
 	return req, nil
 }
@@ -2,20 +2,33 @@ package flex_api
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
 	"github.com/stretchr/testify/assert"
 
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
+	"financing-offer/pkg/infra/httpclient"
 )
 
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
 func TestClient_IsHOActive(t *testing.T) {
 	defer gock.Off()
 	flexOpenApiConfig := config.FlexOpenApiConfig{
 		Url:      "http://flex-api",
 		Username: "admin",
 		Password: "123456",
+		Http: config.HttpClientConfig{
+			RetryBackoff: time.Millisecond,
+		},
 	}
 	client := NewClient(flexOpenApiConfig)
 	t.Run(
@@ -60,4 +73,81 @@ func TestClient_IsHOActive(t *testing.T) {
 			assert.ErrorContains(t, err, "got error code error")
 			assert.Equal(t, false, isActive)
 		})
+	t.Run(
+		"check when HO status times out", func(t *testing.T) {
+			gock.New(flexOpenApiConfig.Url).Get("/system/checkHOStatus").Times(3).ReplyError(timeoutError{})
+			_, err := client.IsHOActive(context.Background())
+			var appErr apperrors.AppError
+			assert.ErrorAs(t, err, &appErr)
+			assert.Equal(t, apperrors.ErrUpstreamTimeout("", nil).Code, appErr.Code)
+			assert.True(t, gock.IsDone())
+		})
+}
+
+func TestClient_Resilience(t *testing.T) {
+	newClient := func(httpConfig config.HttpClientConfig) (*Client, config.FlexOpenApiConfig) {
+		flexOpenApiConfig := config.FlexOpenApiConfig{
+			Url:      "http://flex-api",
+			Username: "admin",
+			Password: "123456",
+			Http:     httpConfig,
+		}
+		return NewClient(flexOpenApiConfig), flexOpenApiConfig
+	}
+	assertAppErrorCode := func(t *testing.T, err error, code int) {
+		var appErr apperrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, code, appErr.Code)
+	}
+	t.Run(
+		"check HO status times out", func(t *testing.T) {
+			defer gock.Off()
+			client, flexOpenApiConfig := newClient(config.HttpClientConfig{Timeout: 20 * time.Millisecond, MaxRetries: -1})
+			gock.New(flexOpenApiConfig.Url).Get("/system/checkHOStatus").Reply(http.StatusOK).Delay(time.Second)
+			_, err := client.IsHOActive(context.Background())
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamTimeout("", nil).Code)
+		},
+	)
+	t.Run(
+		"check HO status retried on 5xx", func(t *testing.T) {
+			defer gock.Off()
+			client, flexOpenApiConfig := newClient(config.HttpClientConfig{RetryBackoff: time.Millisecond})
+			gock.New(flexOpenApiConfig.Url).Get("/system/checkHOStatus").Times(3).Reply(http.StatusServiceUnavailable)
+			_, err := client.IsHOActive(context.Background())
+			assert.Error(t, err)
+			assert.True(t, gock.IsDone())
+		},
+	)
+	t.Run(
+		"check HO status refused while circuit breaker is open", func(t *testing.T) {
+			defer gock.Off()
+			client, flexOpenApiConfig := newClient(
+				config.HttpClientConfig{MaxRetries: -1, BreakerThreshold: 1, BreakerOpenDuration: time.Minute},
+			)
+			gock.New(flexOpenApiConfig.Url).Get("/system/checkHOStatus").Reply(http.StatusServiceUnavailable)
+			_, err := client.IsHOActive(context.Background())
+			assert.Error(t, err)
+			_, err = client.IsHOActive(context.Background())
+			assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+		},
+	)
+	t.Run(
+		"check HO status refused while bulkhead is full", func(t *testing.T) {
+			defer gock.Off()
+			client, flexOpenApiConfig := newClient(config.HttpClientConfig{MaxConcurrent: 1, MaxWait: 10 * time.Millisecond})
+			gock.New(flexOpenApiConfig.Url).Get("/system/checkHOStatus").Reply(http.StatusOK).Delay(200 * time.Millisecond)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = client.IsHOActive(context.Background())
+			}()
+			// let the first call take the only slot
+			time.Sleep(50 * time.Millisecond)
+			_, err := client.IsHOActive(context.Background())
+			assert.ErrorIs(t, err, httpclient.ErrBulkheadFull)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+			<-done
+		},
+	)
 }
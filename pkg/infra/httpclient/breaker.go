@@ -0,0 +1,92 @@
+package httpclient
+
+import (
+	"sync"
+	"time"
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// breaker opens after threshold consecutive failures and refuses calls for openDuration, then lets a single
+// probe through: the circuit closes again when the probe succeeds and reopens when it fails
+type breaker struct {
+	mu           sync.Mutex
+	threshold    int
+	openDuration time.Duration
+	now          func() time.Time
+	state        breakerState
+	failures     int
+	openedAt     time.Time
+	probing      bool
+}
+
+func newBreaker(threshold int, openDuration time.Duration) *breaker {
+	return &breaker{
+		threshold:    threshold,
+		openDuration: openDuration,
+		now:          time.Now,
+	}
+}
+
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.openDuration {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state = breakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+// abandon gives the probe slot back when a call ended without telling whether the upstream is healthy
+func (b *breaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *breaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if b.state == breakerHalfOpen {
+		b.open()
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.open()
+	}
+}
+
+func (b *breaker) open() {
+	b.state = breakerOpen
+	b.openedAt = b.now()
+	b.failures = 0
+}
@@ -0,0 +1,341 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/metrics"
+	"financing-offer/internal/tracing"
+)
+
+const (
+	defaultTimeout             = 5 * time.Second
+	defaultMaxRetries          = 2
+	defaultRetryBackoff        = 100 * time.Millisecond
+	defaultMaxRetryBackoff     = time.Second
+	defaultBreakerThreshold    = 5
+	defaultBreakerOpenDuration = 30 * time.Second
+	defaultMaxConcurrent       = 32
+	defaultMaxWait             = time.Second
+)
+
+const (
+	outcomeSuccess     = "success"
+	outcomeServerError = "server_error"
+	outcomeTimeout     = "timeout"
+	outcomeTransport   = "transport_error"
+	reasonCircuitOpen  = "circuit_open"
+	reasonBulkheadFull = "bulkhead_full"
+)
+
+const tracerName = "financing-offer/pkg/infra/httpclient"
+
+var (
+	ErrCircuitOpen  = errors.New("circuit breaker is open")
+	ErrBulkheadFull = errors.New("no call slot left")
+)
+
+// Client is the http client the pkg/infra integrations send their requests through. A call is refused while the
+// circuit breaker of the upstream is open and waits up to MaxWait for one of its MaxConcurrent slots, an idempotent
+// request is retried with a jittered backoff on a transport failure or a 5xx until TotalTimeout. The breaker counts
+// the outcome of the call, not of its attempts. Failures to get a response are returned as apperrors, any response
+// is returned to the caller which keeps the handling of the statuses of its upstream
+type Client struct {
+	upstream   string
+	config     config.HttpClientConfig
+	httpClient *http.Client
+	breaker    *breaker
+	slots      chan struct{}
+	tracer     trace.Tracer
+}
+
+func New(upstream string, cfg config.HttpClientConfig) *Client {
+	cfg = withDefaults(cfg)
+	return &Client{
+		upstream: upstream,
+		config:   cfg,
+		httpClient: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+		breaker: newBreaker(cfg.BreakerThreshold, cfg.BreakerOpenDuration),
+		slots:   make(chan struct{}, cfg.MaxConcurrent),
+		tracer:  otel.Tracer(tracerName),
+	}
+}
+
+// withDefaults fills the zero fields of cfg, a negative MaxRetries turns the retries off
+func withDefaults(cfg config.HttpClientConfig) config.HttpClientConfig {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	} else if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = defaultRetryBackoff
+	}
+	if cfg.MaxRetryBackoff <= 0 {
+		cfg.MaxRetryBackoff = defaultMaxRetryBackoff
+	}
+	if cfg.BreakerThreshold <= 0 {
+		cfg.BreakerThreshold = defaultBreakerThreshold
+	}
+	if cfg.BreakerOpenDuration <= 0 {
+		cfg.BreakerOpenDuration = defaultBreakerOpenDuration
+	}
+	if cfg.MaxConcurrent <= 0 {
+		cfg.MaxConcurrent = defaultMaxConcurrent
+	}
+	if cfg.MaxWait <= 0 {
+		cfg.MaxWait = defaultMaxWait
+	}
+	if cfg.TotalTimeout <= 0 {
+		// enough for every attempt to time out and every backoff to be the longest
+		cfg.TotalTimeout = cfg.Timeout*time.Duration(cfg.MaxRetries+1) + cfg.MaxRetryBackoff*time.Duration(cfg.MaxRetries)
+	}
+	return cfg
+}
+
+// Do sends req in a client span of the upstream, the trace context travels to the upstream in the request headers
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	ctx, span := c.tracer.Start(
+		req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.PeerService(c.upstream),
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Host),
+			semconv.URLPath(req.URL.Path),
+		),
+	)
+	defer span.End()
+	req = req.Clone(ctx)
+	tracing.InjectHttpHeaders(ctx, req.Header)
+	res, err := c.do(req)
+	switch {
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	case res.StatusCode >= http.StatusInternalServerError:
+		span.SetAttributes(semconv.HTTPResponseStatusCode(res.StatusCode))
+		span.SetStatus(codes.Error, res.Status)
+	default:
+		span.SetAttributes(semconv.HTTPResponseStatusCode(res.StatusCode))
+	}
+	return res, err
+}
+
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	caller := req.Context()
+	ctx, cancel := context.WithTimeout(caller, c.config.TotalTimeout)
+	res, err := c.call(caller, req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the deadline also bounds reading the body, it is released once the caller closes the body
+	res.Body = cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// call sends req under the breaker and the bulkhead, the breaker records one outcome for the call whatever
+// the number of attempts it took
+func (c *Client) call(caller context.Context, req *http.Request) (*http.Response, error) {
+	if !c.breaker.allow() {
+		metrics.UpstreamCallErrors.WithLabelValues(c.upstream, reasonCircuitOpen).Inc()
+		return nil, apperrors.ErrUpstreamUnavailable(c.upstream, ErrCircuitOpen)
+	}
+	if err := c.acquire(req.Context()); err != nil {
+		c.breaker.abandon()
+		return nil, err
+	}
+	defer func() { <-c.slots }()
+	res, err := c.attempt(req)
+	switch {
+	case err != nil && caller.Err() != nil:
+		// the caller gave up, this says nothing about the health of the upstream
+		c.breaker.abandon()
+	case err != nil || res.StatusCode >= http.StatusInternalServerError:
+		c.breaker.failure()
+	default:
+		c.breaker.success()
+	}
+	return res, err
+}
+
+// acquire waits up to MaxWait for a call slot of the upstream
+func (c *Client) acquire(ctx context.Context) error {
+	select {
+	case c.slots <- struct{}{}:
+		return nil
+	default:
+	}
+	timer := time.NewTimer(c.config.MaxWait)
+	defer timer.Stop()
+	select {
+	case c.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return c.mapError(ctx.Err())
+	case <-timer.C:
+		metrics.UpstreamCallErrors.WithLabelValues(c.upstream, reasonBulkheadFull).Inc()
+		return apperrors.ErrUpstreamUnavailable(c.upstream, ErrBulkheadFull)
+	}
+}
+
+// attempt sends req and retries an idempotent request until MaxRetries or the deadline of req
+func (c *Client) attempt(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	retryable := isIdempotent(req.Method)
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewind(req, attempt)
+		if err != nil {
+			return nil, fmt.Errorf("httpclient Do %w", err)
+		}
+		start := time.Now()
+		res, err := c.httpClient.Do(attemptReq)
+		c.observe(req.Method, start, res, err)
+		lastAttempt := !retryable || attempt >= c.config.MaxRetries
+		switch {
+		case err != nil:
+			if lastAttempt || ctx.Err() != nil {
+				return nil, c.mapError(err)
+			}
+		case res.StatusCode >= http.StatusInternalServerError:
+			if lastAttempt {
+				return res, nil
+			}
+			// drain the body so the connection is reused
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		default:
+			return res, nil
+		}
+		if !sleep(ctx, c.backoff(attempt)) {
+			return nil, c.mapError(ctx.Err())
+		}
+	}
+}
+
+// cancelOnClose releases the deadline of a call when the caller closes the response body
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// observe records the latency of one attempt, an attempt that did not end in a response is also counted as an error
+func (c *Client) observe(method string, start time.Time, res *http.Response, err error) {
+	outcome := outcomeSuccess
+	switch {
+	case err != nil && isTimeout(err):
+		outcome = outcomeTimeout
+	case err != nil:
+		outcome = outcomeTransport
+	case res.StatusCode >= http.StatusInternalServerError:
+		outcome = outcomeServerError
+	}
+	metrics.UpstreamCallDuration.WithLabelValues(c.upstream, method, outcome).Observe(time.Since(start).Seconds())
+	if outcome != outcomeSuccess {
+		metrics.UpstreamCallErrors.WithLabelValues(c.upstream, outcome).Inc()
+	}
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout())
+}
+
+func (c *Client) mapError(err error) error {
+	if isTimeout(err) {
+		return apperrors.ErrUpstreamTimeout(c.upstream, err)
+	}
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("httpclient Do %w", err)
+	}
+	return apperrors.ErrUpstreamUnavailable(c.upstream, err)
+}
+
+// backoff doubles RetryBackoff for every retry up to MaxRetryBackoff and waits a random time between half of it
+// and all of it, so the clients failing together do not retry together
+func (c *Client) backoff(attempt int) time.Duration {
+	backoff := c.config.RetryBackoff
+	for i := 0; i < attempt && backoff < c.config.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.config.MaxRetryBackoff {
+		backoff = c.config.MaxRetryBackoff
+	}
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// rewind returns a request with a fresh body for every retry
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	retryReq := req.Clone(req.Context())
+	retryReq.Body = body
+	return retryReq, nil
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep reports false when ctx was cancelled before d elapsed
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// NewJSONRequest builds a request carrying body as json, the caller adds the authentication of its upstream
+func NewJSONRequest(ctx context.Context, method, url string, body any) (*http.Request, error) {
+	marshaledBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("NewJSONRequest %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(marshaledBody))
+	if err != nil {
+		return nil, fmt.Errorf("NewJSONRequest %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
@@ -0,0 +1,279 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"financing-offer/internal/metrics"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClient_Do(t *testing.T) {
+	defer gock.Off()
+	url := "http://upstream"
+	cfg := config.HttpClientConfig{
+		MaxRetries:          2,
+		RetryBackoff:        time.Millisecond,
+		MaxRetryBackoff:     2 * time.Millisecond,
+		BreakerThreshold:    3,
+		BreakerOpenDuration: time.Minute,
+	}
+	newRequest := func(t *testing.T, method string) *http.Request {
+		req, err := NewJSONRequest(context.Background(), method, url+"/resource", nil)
+		assert.Nil(t, err)
+		return req
+	}
+	assertAppErrorCode := func(t *testing.T, err error, code int) {
+		var appErr apperrors.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, code, appErr.Code)
+	}
+
+	t.Run("retry idempotent request on 5xx", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		gock.New(url).Get("/resource").Times(2).Reply(http.StatusServiceUnavailable)
+		gock.New(url).Get("/resource").Reply(http.StatusOK).JSON(map[string]string{"status": "ok"})
+
+		res, err := client.Do(newRequest(t, http.MethodGet))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		body, err := io.ReadAll(res.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"status":"ok"}`, string(body))
+		assert.Nil(t, res.Body.Close())
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("return last 5xx when retries are exhausted", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		gock.New(url).Get("/resource").Times(3).Reply(http.StatusBadGateway)
+
+		res, err := client.Do(newRequest(t, http.MethodGet))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadGateway, res.StatusCode)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("do not retry non idempotent request", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		gock.New(url).Post("/resource").Reply(http.StatusServiceUnavailable)
+		gock.New(url).Post("/resource").Reply(http.StatusOK)
+
+		res, err := client.Do(newRequest(t, http.MethodPost))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.False(t, gock.IsDone())
+	})
+
+	t.Run("do not retry 4xx", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		gock.New(url).Get("/resource").Reply(http.StatusBadRequest)
+		gock.New(url).Get("/resource").Reply(http.StatusOK)
+
+		res, err := client.Do(newRequest(t, http.MethodGet))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		assert.False(t, gock.IsDone())
+	})
+
+	t.Run("map transport failure to upstream unavailable", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		gock.New(url).Get("/resource").Times(3).ReplyError(errors.New("connection refused"))
+
+		_, err := client.Do(newRequest(t, http.MethodGet))
+
+		assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("map timeout to upstream timeout", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		gock.New(url).Get("/resource").Times(3).ReplyError(timeoutError{})
+
+		_, err := client.Do(newRequest(t, http.MethodGet))
+
+		assertAppErrorCode(t, err, apperrors.ErrUpstreamTimeout("", nil).Code)
+	})
+
+	t.Run("open circuit breaker after consecutive failed calls", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		gock.New(url).Get("/resource").Times(9).Reply(http.StatusInternalServerError)
+		gock.New(url).Get("/resource").Reply(http.StatusOK)
+
+		for i := 0; i < cfg.BreakerThreshold; i++ {
+			res, err := client.Do(newRequest(t, http.MethodGet))
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		}
+
+		_, err := client.Do(newRequest(t, http.MethodGet))
+
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+		assert.False(t, gock.IsDone())
+	})
+
+	t.Run("count the retries of a call as one breaker failure", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		gock.New(url).Get("/resource").Times(3).Reply(http.StatusInternalServerError)
+		gock.New(url).Get("/resource").Reply(http.StatusOK)
+
+		res, err := client.Do(newRequest(t, http.MethodGet))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+
+		res, err = client.Do(newRequest(t, http.MethodGet))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("close circuit breaker after a successful probe", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		now := time.Now()
+		client.breaker.now = func() time.Time { return now }
+		gock.New(url).Get("/resource").Times(9).Reply(http.StatusInternalServerError)
+		gock.New(url).Get("/resource").Times(2).Reply(http.StatusOK)
+
+		for i := 0; i < cfg.BreakerThreshold; i++ {
+			_, _ = client.Do(newRequest(t, http.MethodGet))
+		}
+		_, err := client.Do(newRequest(t, http.MethodGet))
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		now = now.Add(cfg.BreakerOpenDuration)
+		res, err := client.Do(newRequest(t, http.MethodGet))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		res, err = client.Do(newRequest(t, http.MethodGet))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("refuse call when bulkhead stays full", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", config.HttpClientConfig{MaxConcurrent: 1, MaxWait: 10 * time.Millisecond})
+		client.slots <- struct{}{}
+
+		_, err := client.Do(newRequest(t, http.MethodGet))
+
+		assert.ErrorIs(t, err, ErrBulkheadFull)
+		assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+	})
+
+	t.Run("wait for a call slot to free up", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", config.HttpClientConfig{MaxConcurrent: 1, MaxWait: time.Second})
+		gock.New(url).Get("/resource").Reply(http.StatusOK)
+		client.slots <- struct{}{}
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			<-client.slots
+		}()
+
+		res, err := client.Do(newRequest(t, http.MethodGet))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("stop retrying at the total timeout", func(t *testing.T) {
+		defer gock.Off()
+		client := New(
+			"upstream", config.HttpClientConfig{
+				RetryBackoff:     time.Minute,
+				MaxRetryBackoff:  time.Minute,
+				TotalTimeout:     20 * time.Millisecond,
+				BreakerThreshold: 1,
+			},
+		)
+		gock.New(url).Get("/resource").Reply(http.StatusServiceUnavailable)
+
+		_, err := client.Do(newRequest(t, http.MethodGet))
+		assertAppErrorCode(t, err, apperrors.ErrUpstreamTimeout("", nil).Code)
+
+		// running out of time is a failure of the upstream, unlike a caller giving up
+		_, err = client.Do(newRequest(t, http.MethodGet))
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+	})
+
+	t.Run("stop retrying when context is cancelled", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", config.HttpClientConfig{RetryBackoff: time.Minute, MaxRetryBackoff: time.Minute})
+		gock.New(url).Get("/resource").Reply(http.StatusServiceUnavailable)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, err := NewJSONRequest(ctx, http.MethodGet, url+"/resource", nil)
+		assert.Nil(t, err)
+
+		_, err = client.Do(req)
+
+		assertAppErrorCode(t, err, apperrors.ErrUpstreamTimeout("", nil).Code)
+	})
+	t.Run("record upstream call metrics", func(t *testing.T) {
+		defer gock.Off()
+		client := New("metered-upstream", cfg)
+		gock.New(url).Get("/resource").Reply(http.StatusServiceUnavailable)
+		gock.New(url).Get("/resource").Reply(http.StatusOK)
+
+		_, err := client.Do(newRequest(t, http.MethodGet))
+
+		assert.Nil(t, err)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.UpstreamCallErrors.WithLabelValues("metered-upstream", "server_error")))
+		assert.True(t, metrics.UpstreamCallErrors.DeleteLabelValues("metered-upstream", "server_error"))
+		assert.True(t, metrics.UpstreamCallDuration.DeleteLabelValues("metered-upstream", http.MethodGet, "server_error"))
+		assert.True(t, metrics.UpstreamCallDuration.DeleteLabelValues("metered-upstream", http.MethodGet, "success"))
+	})
+	t.Run("send trace context to upstream", func(t *testing.T) {
+		defer gock.Off()
+		client := New("upstream", cfg)
+		traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+		ctx := trace.ContextWithSpanContext(
+			context.Background(), trace.NewSpanContext(
+				trace.SpanContextConfig{TraceID: traceId, SpanID: spanId, TraceFlags: trace.FlagsSampled},
+			),
+		)
+		gock.New(url).Get("/resource").MatchHeader("traceparent", "^00-4bf92f3577b34da6a3ce929d0e0e4736-").Reply(http.StatusOK)
+		req, err := NewJSONRequest(ctx, http.MethodGet, url+"/resource", nil)
+		assert.Nil(t, err)
+
+		_, err = client.Do(req)
+
+		assert.Nil(t, err)
+		assert.True(t, gock.IsDone())
+		assert.Empty(t, req.Header.Get("traceparent"))
+	})
+}
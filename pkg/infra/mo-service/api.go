@@ -1,35 +1,32 @@
 package mo_service
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
 	"financing-offer/internal/core/marginoperation/repository"
 	"financing-offer/internal/funcs"
+	"financing-offer/pkg/infra/httpclient"
 )
 
 var _ repository.MarginOperationRepository = (*Client)(nil)
 
 type Client struct {
-	httpClient *http.Client
+	httpClient *httpclient.Client
 	config     config.MoServiceConfig
 }
 
 func NewClient(config config.MoServiceConfig) *Client {
 	return &Client{
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
-		},
-		config: config,
+		httpClient: httpclient.New("mo-service", config.Http),
+		config:     config,
 	}
 }
 
@@ -111,16 +108,11 @@ func (c *Client) GetMarginPoolsByIds(ctx context.Context, marginPoolIds []int64)
 }
 
 func (c *Client) NewRequest(ctx context.Context, method, url string, body any) (*http.Request, error) {
-	marshaledBody, err := json.Marshal(body)
-	if err != nil {
-		return nil, fmt.Errorf("NewRequest %w", err)
-	}
-	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(marshaledBody))
+	req, err := httpclient.NewJSONRequest(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("NewRequest %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.config.Token))
-	req.Header.Set("Content-Type", "application/json")
 	return req, nil
 }
 
@@ -2,13 +2,16 @@ package mo_service
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
 	"github.com/stretchr/testify/assert"
 
 	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
+	"financing-offer/pkg/infra/httpclient"
 )
 
 func TestClient_GetMarginPoolsByIds(t *testing.T) {
@@ -177,4 +180,84 @@ func TestClient_GetMarginPoolGroupsByIds(t *testing.T) {
 			assert.Equal(t, 400, dest.Code)
 		},
 	)
+
+	t.Run(
+		"get margin pool groups by ids retried on 5xx", func(t *testing.T) {
+			gock.New(moServiceConfig.Url).Get("/pool-groups").MatchParam("ids", "1").Times(2).Reply(503)
+			gock.New(moServiceConfig.Url).Get("/pool-groups").HeaderPresent("Authorization").MatchParam(
+				"ids", "1",
+			).Reply(200).BodyString(`{"data": [{"id": 1}], "total": 1, "start": 0, "end": 1}`)
+			poolGroups, err := client.GetMarginPoolGroupsByIds(context.Background(), []int64{1})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(poolGroups))
+			assert.True(t, gock.IsDone())
+		},
+	)
+}
+
+func TestClient_Resilience(t *testing.T) {
+	newClient := func(httpConfig config.HttpClientConfig) (*Client, config.MoServiceConfig) {
+		moServiceConfig := config.MoServiceConfig{
+			Url:   "http://dnse-mo-service",
+			Token: "financing-product-token",
+			Http:  httpConfig,
+		}
+		return NewClient(moServiceConfig), moServiceConfig
+	}
+	assertAppErrorCode := func(t *testing.T, err error, code int) {
+		var appErr apperrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, code, appErr.Code)
+	}
+	t.Run(
+		"get margin pool by id times out", func(t *testing.T) {
+			defer gock.Off()
+			client, moServiceConfig := newClient(config.HttpClientConfig{Timeout: 20 * time.Millisecond, MaxRetries: -1})
+			gock.New(moServiceConfig.Url).Get("/pools/887").Reply(http.StatusOK).Delay(time.Second)
+			_, err := client.GetMarginPoolById(context.Background(), int64(887))
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamTimeout("", nil).Code)
+		},
+	)
+	t.Run(
+		"get margin pool by id retried on 5xx", func(t *testing.T) {
+			defer gock.Off()
+			client, moServiceConfig := newClient(config.HttpClientConfig{RetryBackoff: time.Millisecond})
+			gock.New(moServiceConfig.Url).Get("/pools/887").Times(3).Reply(http.StatusServiceUnavailable)
+			_, err := client.GetMarginPoolById(context.Background(), int64(887))
+			assert.Error(t, err)
+			assert.True(t, gock.IsDone())
+		},
+	)
+	t.Run(
+		"get margin pool by id refused while circuit breaker is open", func(t *testing.T) {
+			defer gock.Off()
+			client, moServiceConfig := newClient(
+				config.HttpClientConfig{MaxRetries: -1, BreakerThreshold: 1, BreakerOpenDuration: time.Minute},
+			)
+			gock.New(moServiceConfig.Url).Get("/pools/887").Reply(http.StatusServiceUnavailable)
+			_, err := client.GetMarginPoolById(context.Background(), int64(887))
+			assert.Error(t, err)
+			_, err = client.GetMarginPoolById(context.Background(), int64(887))
+			assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+		},
+	)
+	t.Run(
+		"get margin pool by id refused while bulkhead is full", func(t *testing.T) {
+			defer gock.Off()
+			client, moServiceConfig := newClient(config.HttpClientConfig{MaxConcurrent: 1, MaxWait: 10 * time.Millisecond})
+			gock.New(moServiceConfig.Url).Get("/pools/887").Reply(http.StatusOK).Delay(200 * time.Millisecond)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = client.GetMarginPoolById(context.Background(), int64(887))
+			}()
+			// let the first call take the only slot
+			time.Sleep(50 * time.Millisecond)
+			_, err := client.GetMarginPoolById(context.Background(), int64(887))
+			assert.ErrorIs(t, err, httpclient.ErrBulkheadFull)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+			<-done
+		},
+	)
 }
@@ -7,13 +7,29 @@ import (
 	"fmt"
 	"github.com/kolo/xmlrpc"
 	"net/http"
+	"time"
 )
 
 var _ repository.OdooServiceRepository = (*Client)(nil)
 
 type Client struct {
-	rpcClient *xmlrpc.Client
-	config    config.OdooServiceConfig
+	rpcClient        *xmlrpc.Client
+	config           config.OdooServiceConfig
+	loanApprovalTmpl *PayloadTemplate
+}
+
+// loanApprovalRecord is the part of a loan approval record read back by search_read
+type loanApprovalRecord struct {
+	Id    int64  `xmlrpc:"id"`
+	State string `xmlrpc:"state"`
+}
+
+// stateChangeMessage is a chatter message tracking a change of the state of a record,
+// author_id is a many2one and comes as [id, name]
+type stateChangeMessage struct {
+	ResId    int64  `xmlrpc:"res_id"`
+	AuthorId []any  `xmlrpc:"author_id"`
+	Date     string `xmlrpc:"date"`
 }
 
 func NewClient(config config.OdooServiceConfig, transport http.RoundTripper) (*Client, error) {
@@ -21,21 +37,164 @@ func NewClient(config config.OdooServiceConfig, transport http.RoundTripper) (*C
 	if err != nil {
 		return &Client{}, fmt.Errorf("OdooServiceRepository NewClient %w", err)
 	}
+	loanApprovalTmpl, err := newLoanApprovalTemplate(config)
+	if err != nil {
+		return &Client{}, fmt.Errorf("OdooServiceRepository NewClient %w", err)
+	}
 	return &Client{
-		rpcClient: client,
-		config:    config,
+		rpcClient:        client,
+		config:           config,
+		loanApprovalTmpl: loanApprovalTmpl,
+	}, nil
+}
+
+func newLoanApprovalTemplate(cfg config.OdooServiceConfig) (*PayloadTemplate, error) {
+	modelConfig, ok := cfg.Models[config.OdooLoanApprovalModel]
+	if !ok {
+		return nil, fmt.Errorf("model %s is not configured", config.OdooLoanApprovalModel)
+	}
+	return NewPayloadTemplate(modelConfig, (&entity.LoanApprovalRequest{}).OdooValues())
+}
+
+// previewLoanApprovalRequest renders the create call of a loan approval request without sending it
+func previewLoanApprovalRequest(
+	cfg config.OdooServiceConfig, tmpl *PayloadTemplate, loanApprovalRequest entity.LoanApprovalRequest,
+) (entity.OdooPayloadPreview, error) {
+	values, err := tmpl.Render(loanApprovalRequest.OdooValues())
+	if err != nil {
+		return entity.OdooPayloadPreview{}, err
+	}
+	body, err := xmlrpc.EncodeMethodCall(
+		"execute_kw", cfg.Db, cfg.Uid, "***", tmpl.Model, "create", []any{values},
+	)
+	if err != nil {
+		return entity.OdooPayloadPreview{}, err
+	}
+	return entity.OdooPayloadPreview{
+		Model:  tmpl.Model,
+		Method: "create",
+		Values: values,
+		Xml:    string(body),
 	}, nil
 }
 
-func (c *Client) SendLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) error {
+func (c *Client) PreviewLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) (entity.OdooPayloadPreview, error) {
+	preview, err := previewLoanApprovalRequest(c.config, c.loanApprovalTmpl, loanApprovalRequest)
+	if err != nil {
+		return entity.OdooPayloadPreview{}, fmt.Errorf("OdooServiceRepository PreviewLoanApprovalRequest %w", err)
+	}
+	return preview, nil
+}
+
+func (c *Client) SendLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) (int64, error) {
 	errorTemplate := "OdooServiceRepository SendApprovalLoanRequest %w"
+	values, err := c.loanApprovalTmpl.Render(loanApprovalRequest.OdooValues())
+	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
 	args := []any{
 		c.config.Db, c.config.Uid, c.config.Password,
-		"approval.finx", "create",
-		loanApprovalRequest.ToOdooFormat(),
+		c.loanApprovalTmpl.Model, "create",
+		[]any{values},
 	}
-	err := c.rpcClient.Call("execute_kw", args, nil)
+	var recordId int64
+	err = c.rpcClient.Call("execute_kw", args, &recordId)
 	if err != nil {
+		return 0, fmt.Errorf(errorTemplate, err)
+	}
+	return recordId, nil
+}
+
+func (c *Client) GetLoanApprovalDecisions(recordIds []int64) ([]entity.OdooLoanApprovalDecision, error) {
+	errorTemplate := "OdooServiceRepository GetLoanApprovalDecisions %w"
+	if len(recordIds) == 0 {
+		return []entity.OdooLoanApprovalDecision{}, nil
+	}
+	ids := make([]any, 0, len(recordIds))
+	for _, id := range recordIds {
+		ids = append(ids, id)
+	}
+	domain := []any{
+		[]any{"id", "in", ids},
+		[]any{"state", "in", []any{string(entity.OdooLoanApprovalStateApproved), string(entity.OdooLoanApprovalStateRefused)}},
+	}
+	args := []any{
+		c.config.Db, c.config.Uid, c.config.Password,
+		c.loanApprovalTmpl.Model, "search_read",
+		[]any{domain},
+		map[string]any{"fields": []any{"id", "state"}},
+	}
+	records := make([]loanApprovalRecord, 0)
+	if err := c.rpcClient.Call("execute_kw", args, &records); err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	if len(records) == 0 {
+		return []entity.OdooLoanApprovalDecision{}, nil
+	}
+	stateChanges, err := c.getLastStateChanges(records)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	decisions := make([]entity.OdooLoanApprovalDecision, 0, len(records))
+	for _, record := range records {
+		decision := entity.OdooLoanApprovalDecision{
+			RecordId: record.Id,
+			State:    entity.OdooLoanApprovalState(record.State),
+		}
+		// a record without a tracked state change has no decider, applying its approval fails until it is found
+		if stateChange, ok := stateChanges[record.Id]; ok {
+			if len(stateChange.AuthorId) == 2 {
+				decision.DecidedBy, _ = stateChange.AuthorId[1].(string)
+			}
+			// Odoo stores datetimes in UTC without a zone
+			if decidedAt, err := time.ParseInLocation(time.DateTime, stateChange.Date, time.UTC); err == nil {
+				decision.DecidedAt = decidedAt
+			}
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, nil
+}
+
+// getLastStateChanges reads the latest state change tracked on each record, the author of the change is the user
+// who decided, unlike write_uid which is the last user editing any field of the record
+func (c *Client) getLastStateChanges(records []loanApprovalRecord) (map[int64]stateChangeMessage, error) {
+	ids := make([]any, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.Id)
+	}
+	domain := []any{
+		[]any{"model", "=", c.loanApprovalTmpl.Model},
+		[]any{"res_id", "in", ids},
+		[]any{"tracking_value_ids.field_id.name", "=", "state"},
+	}
+	args := []any{
+		c.config.Db, c.config.Uid, c.config.Password,
+		"mail.message", "search_read",
+		[]any{domain},
+		map[string]any{"fields": []any{"res_id", "author_id", "date"}, "order": "id desc"},
+	}
+	messages := make([]stateChangeMessage, 0)
+	if err := c.rpcClient.Call("execute_kw", args, &messages); err != nil {
+		return nil, err
+	}
+	res := make(map[int64]stateChangeMessage, len(records))
+	for _, message := range messages {
+		if _, ok := res[message.ResId]; !ok {
+			res[message.ResId] = message
+		}
+	}
+	return res, nil
+}
+
+func (c *Client) UpdateLoanApprovalState(recordId int64, state entity.OdooLoanApprovalState) error {
+	errorTemplate := "OdooServiceRepository UpdateLoanApprovalState %w"
+	args := []any{
+		c.config.Db, c.config.Uid, c.config.Password,
+		c.loanApprovalTmpl.Model, "write",
+		[]any{[]any{recordId}, map[string]any{"state": string(state)}},
+	}
+	if err := c.rpcClient.Call("execute_kw", args, nil); err != nil {
 		return fmt.Errorf(errorTemplate, err)
 	}
 	return nil
@@ -1,27 +1,157 @@
 package odoo_service
 
 import (
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
 	"github.com/h2non/gock"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
+func newTestOdooServiceConfig(url string) config.OdooServiceConfig {
+	return config.OdooServiceConfig{
+		Url:      url,
+		Db:       "odoo",
+		Uid:      "2",
+		Password: "secret",
+		Models: map[string]config.OdooModelConfig{
+			config.OdooLoanApprovalModel: {
+				Model: "approval.finx",
+				Fields: []config.OdooFieldConfig{
+					{Name: "submission_id", Source: "submission_id", Type: "string", Required: true},
+					{Name: "description", Source: "description", Type: "string"},
+					{Name: "category_id", Source: "category_id", Type: "integer", OmitEmpty: true},
+				},
+			},
+		},
+	}
+}
+
 func TestClient_GetMarginPoolsByIds(t *testing.T) {
 	defer gock.Off()
-	odooServiceConfig := config.OdooServiceConfig{
-		Url: "http://dnse-odoo-service",
-	}
-	client, _ := NewClient(odooServiceConfig, gock.NewTransport())
+	odooServiceConfig := newTestOdooServiceConfig("http://dnse-odoo-service")
+	client, err := NewClient(odooServiceConfig, gock.NewTransport())
+	assert.Nil(t, err)
 	t.Run(
 		"send approval request2", func(t *testing.T) {
 
 			gock.New(odooServiceConfig.Url).
 				Post("/xmlrpc/2/object").
-				Reply(200).JSON(nil)
-			err := client.SendLoanApprovalRequest(entity.LoanApprovalRequest{})
+				Reply(200).
+				BodyString(`<?xml version="1.0"?><methodResponse><params><param><value><int>7</int></value></param></params></methodResponse>`)
+			recordId, err := client.SendLoanApprovalRequest(entity.LoanApprovalRequest{SubmissionId: 1})
+			assert.Nil(t, err)
+			assert.Equal(t, int64(7), recordId)
+		},
+	)
+
+	t.Run(
+		"send approval request missing required field", func(t *testing.T) {
+			_, err := client.SendLoanApprovalRequest(entity.LoanApprovalRequest{})
+			assert.ErrorIs(t, err, apperrors.ErrInvalidOdooPayload("field submission_id is required"))
+		},
+	)
+
+	t.Run(
+		"preview approval request", func(t *testing.T) {
+			preview, err := client.PreviewLoanApprovalRequest(
+				entity.LoanApprovalRequest{SubmissionId: 1, Description: "comment", CategoryId: 60},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, "approval.finx", preview.Model)
+			assert.Equal(t, "create", preview.Method)
+			assert.Equal(
+				t, map[string]any{"submission_id": "1", "description": "comment", "category_id": int64(60)},
+				preview.Values,
+			)
+			assert.Contains(t, preview.Xml, "<methodName>execute_kw</methodName>")
+			assert.Contains(t, preview.Xml, "<string>***</string>")
+			assert.NotContains(t, preview.Xml, "secret")
+		},
+	)
+
+	t.Run(
+		"get loan approval decisions", func(t *testing.T) {
+			gock.New(odooServiceConfig.Url).
+				Post("/xmlrpc/2/object").
+				Reply(200).
+				BodyString(`<?xml version="1.0"?><methodResponse><params><param><value><array><data>
+<value><struct>
+<member><name>id</name><value><int>7</int></value></member>
+<member><name>state</name><value><string>approved</string></value></member>
+</struct></value>
+<value><struct>
+<member><name>id</name><value><int>8</int></value></member>
+<member><name>state</name><value><string>approved</string></value></member>
+</struct></value>
+</data></array></value></param></params></methodResponse>`)
+			gock.New(odooServiceConfig.Url).
+				Post("/xmlrpc/2/object").
+				Reply(200).
+				BodyString(`<?xml version="1.0"?><methodResponse><params><param><value><array><data>
+<value><struct>
+<member><name>res_id</name><value><int>7</int></value></member>
+<member><name>author_id</name><value><array><data><value><int>3</int></value><value><string>Risk Officer</string></value></data></array></value></member>
+<member><name>date</name><value><string>2024-05-02 03:04:05</string></value></member>
+</struct></value>
+<value><struct>
+<member><name>res_id</name><value><int>7</int></value></member>
+<member><name>author_id</name><value><array><data><value><int>4</int></value><value><string>Sales</string></value></data></array></value></member>
+<member><name>date</name><value><string>2024-05-01 03:04:05</string></value></member>
+</struct></value>
+</data></array></value></param></params></methodResponse>`)
+			decisions, err := client.GetLoanApprovalDecisions([]int64{7, 8, 9})
 			assert.Nil(t, err)
+			assert.Equal(
+				t, []entity.OdooLoanApprovalDecision{
+					{
+						RecordId:  7,
+						State:     entity.OdooLoanApprovalStateApproved,
+						DecidedBy: "Risk Officer",
+						DecidedAt: time.Date(2024, 5, 2, 3, 4, 5, 0, time.UTC),
+					},
+					{
+						RecordId: 8,
+						State:    entity.OdooLoanApprovalStateApproved,
+					},
+				}, decisions,
+			)
+			assert.True(t, gock.IsDone())
 		},
 	)
+
+	t.Run(
+		"update loan approval state error", func(t *testing.T) {
+			gock.New(odooServiceConfig.Url).
+				Post("/xmlrpc/2/object").
+				Reply(500)
+			err := client.UpdateLoanApprovalState(7, entity.OdooLoanApprovalStateRefused)
+			assert.NotNil(t, err)
+		},
+	)
+}
+
+func TestInMemoryClient_RoundTrip(t *testing.T) {
+	client, err := NewInMemoryClient(newTestOdooServiceConfig("http://localhost"))
+	assert.Nil(t, err)
+	approvedId, err := client.SendLoanApprovalRequest(entity.LoanApprovalRequest{SubmissionId: 1})
+	assert.Nil(t, err)
+	pendingId, err := client.SendLoanApprovalRequest(entity.LoanApprovalRequest{SubmissionId: 2})
+	assert.Nil(t, err)
+	assert.NotEqual(t, approvedId, pendingId)
+
+	assert.Nil(t, client.Decide(approvedId, entity.OdooLoanApprovalStateApproved, "Risk Officer"))
+	decisions, err := client.GetLoanApprovalDecisions([]int64{approvedId, pendingId})
+	assert.Nil(t, err)
+	assert.Len(t, decisions, 1)
+	assert.Equal(t, approvedId, decisions[0].RecordId)
+	assert.Equal(t, "Risk Officer", decisions[0].DecidedBy)
+	assert.NotNil(t, client.Decide(99, entity.OdooLoanApprovalStateRefused, "Risk Officer"))
+}
+
+func TestNewClient_MissingModel(t *testing.T) {
+	_, err := NewClient(config.OdooServiceConfig{Url: "http://localhost"}, nil)
+	assert.NotNil(t, err)
 }
@@ -3,13 +3,32 @@ package odoo_service
 import (
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/odoo_service/repository"
 	"fmt"
 	"github.com/kolo/xmlrpc"
+	"sync"
+	"time"
 )
 
+var _ repository.OdooServiceRepository = (*InMemoryClient)(nil)
+
+// InMemoryClient stands in for Odoo outside production, Decide plays the Odoo user
+// so the approval round trip can be exercised locally
 type InMemoryClient struct {
-	rpcClient *xmlrpc.Client
-	config    config.OdooServiceConfig
+	rpcClient        *xmlrpc.Client
+	config           config.OdooServiceConfig
+	loanApprovalTmpl *PayloadTemplate
+
+	mu      sync.Mutex
+	lastId  int64
+	records map[int64]*inMemoryLoanApproval
+}
+
+type inMemoryLoanApproval struct {
+	request   entity.LoanApprovalRequest
+	state     entity.OdooLoanApprovalState
+	decidedBy string
+	decidedAt time.Time
 }
 
 func NewInMemoryClient(config config.OdooServiceConfig) (*InMemoryClient, error) {
@@ -17,13 +36,74 @@ func NewInMemoryClient(config config.OdooServiceConfig) (*InMemoryClient, error)
 	if err != nil {
 		return &InMemoryClient{}, fmt.Errorf("OdooServiceRepository NewClient %w", err)
 	}
+	loanApprovalTmpl, err := newLoanApprovalTemplate(config)
+	if err != nil {
+		return &InMemoryClient{}, fmt.Errorf("OdooServiceRepository NewClient %w", err)
+	}
 	return &InMemoryClient{
-		rpcClient: client,
-		config:    config,
+		rpcClient:        client,
+		config:           config,
+		loanApprovalTmpl: loanApprovalTmpl,
+		records:          make(map[int64]*inMemoryLoanApproval),
 	}, nil
 }
 
-func (c *InMemoryClient) SendLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) error {
-	fmt.Printf("SendLoanApprovalRequest: %v\n", loanApprovalRequest)
+func (c *InMemoryClient) PreviewLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) (entity.OdooPayloadPreview, error) {
+	preview, err := previewLoanApprovalRequest(c.config, c.loanApprovalTmpl, loanApprovalRequest)
+	if err != nil {
+		return entity.OdooPayloadPreview{}, fmt.Errorf("OdooServiceRepository PreviewLoanApprovalRequest %w", err)
+	}
+	return preview, nil
+}
+
+func (c *InMemoryClient) SendLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) (int64, error) {
+	values, err := c.loanApprovalTmpl.Render(loanApprovalRequest.OdooValues())
+	if err != nil {
+		return 0, fmt.Errorf("OdooServiceRepository SendApprovalLoanRequest %w", err)
+	}
+	fmt.Printf("SendLoanApprovalRequest: %v\n", values)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastId++
+	c.records[c.lastId] = &inMemoryLoanApproval{request: loanApprovalRequest}
+	return c.lastId, nil
+}
+
+func (c *InMemoryClient) GetLoanApprovalDecisions(recordIds []int64) ([]entity.OdooLoanApprovalDecision, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	decisions := make([]entity.OdooLoanApprovalDecision, 0)
+	for _, id := range recordIds {
+		record, ok := c.records[id]
+		if !ok || record.state == "" {
+			continue
+		}
+		decisions = append(
+			decisions, entity.OdooLoanApprovalDecision{
+				RecordId:  id,
+				State:     record.state,
+				DecidedBy: record.decidedBy,
+				DecidedAt: record.decidedAt,
+			},
+		)
+	}
+	return decisions, nil
+}
+
+func (c *InMemoryClient) UpdateLoanApprovalState(recordId int64, state entity.OdooLoanApprovalState) error {
+	return c.Decide(recordId, state, "")
+}
+
+// Decide approves or refuses a record as decidedBy would in Odoo
+func (c *InMemoryClient) Decide(recordId int64, state entity.OdooLoanApprovalState, decidedBy string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	record, ok := c.records[recordId]
+	if !ok {
+		return fmt.Errorf("OdooServiceRepository Decide: record %d not found", recordId)
+	}
+	record.state = state
+	record.decidedBy = decidedBy
+	record.decidedAt = time.Now().UTC()
 	return nil
 }
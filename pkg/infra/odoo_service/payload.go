@@ -0,0 +1,181 @@
+package odoo_service
+
+import (
+	"errors"
+	"financing-offer/internal/apperrors"
+	"financing-offer/internal/config"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"strconv"
+	"time"
+)
+
+const (
+	fieldTypeString   = "string"
+	fieldTypeInteger  = "integer"
+	fieldTypeFloat    = "float"
+	fieldTypeDatetime = "datetime"
+)
+
+// PayloadTemplate renders payload values into the fields of an Odoo model as configured
+type PayloadTemplate struct {
+	Model    string
+	location *time.Location
+	fields   []config.OdooFieldConfig
+}
+
+func NewPayloadTemplate(modelConfig config.OdooModelConfig, sources map[string]any) (*PayloadTemplate, error) {
+	errorTemplate := "OdooServiceRepository NewPayloadTemplate %w"
+	if modelConfig.Model == "" {
+		return nil, fmt.Errorf(errorTemplate, errors.New("model is required"))
+	}
+	timezone := modelConfig.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf(errorTemplate, err)
+	}
+	if len(modelConfig.Fields) == 0 {
+		return nil, fmt.Errorf(errorTemplate, fmt.Errorf("model %s has no fields", modelConfig.Model))
+	}
+	names := make(map[string]bool, len(modelConfig.Fields))
+	for _, field := range modelConfig.Fields {
+		if field.Name == "" {
+			return nil, fmt.Errorf(errorTemplate, fmt.Errorf("field of source %s has no name", field.Source))
+		}
+		if names[field.Name] {
+			return nil, fmt.Errorf(errorTemplate, fmt.Errorf("field %s is mapped twice", field.Name))
+		}
+		names[field.Name] = true
+		if _, ok := sources[field.Source]; !ok {
+			return nil, fmt.Errorf(errorTemplate, fmt.Errorf("field %s has unknown source %q", field.Name, field.Source))
+		}
+		switch field.Type {
+		case fieldTypeString, fieldTypeInteger, fieldTypeFloat, fieldTypeDatetime:
+		default:
+			return nil, fmt.Errorf(errorTemplate, fmt.Errorf("field %s has unknown type %q", field.Name, field.Type))
+		}
+	}
+	return &PayloadTemplate{
+		Model:    modelConfig.Model,
+		location: location,
+		fields:   modelConfig.Fields,
+	}, nil
+}
+
+// Render maps values by source onto the Odoo fields, it fails with ErrInvalidOdooPayload when a value
+// does not match the field schema
+func (t *PayloadTemplate) Render(values map[string]any) (map[string]any, error) {
+	payload := make(map[string]any, len(t.fields))
+	for _, field := range t.fields {
+		value := values[field.Source]
+		if isEmptyValue(value) {
+			if field.Required {
+				return nil, apperrors.ErrInvalidOdooPayload(fmt.Sprintf("field %s is required", field.Name))
+			}
+			if field.OmitEmpty {
+				continue
+			}
+		}
+		rendered, err := t.renderField(field, value)
+		if err != nil {
+			return nil, apperrors.ErrInvalidOdooPayload(fmt.Sprintf("field %s %s", field.Name, err.Error()))
+		}
+		payload[field.Name] = rendered
+	}
+	return payload, nil
+}
+
+func (t *PayloadTemplate) renderField(field config.OdooFieldConfig, value any) (any, error) {
+	switch field.Type {
+	case fieldTypeDatetime:
+		date, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("expects a time, got %T", value)
+		}
+		layout := field.Layout
+		if layout == "" {
+			layout = time.DateTime
+		}
+		return field.Prefix + date.In(t.location).Format(layout), nil
+	case fieldTypeInteger:
+		number, err := toDecimal(value)
+		if err != nil {
+			return nil, err
+		}
+		if !number.IsInteger() {
+			return nil, fmt.Errorf("expects an integer, got %s", number.String())
+		}
+		return number.IntPart(), nil
+	case fieldTypeFloat:
+		number, err := toDecimal(value)
+		if err != nil {
+			return nil, err
+		}
+		if field.Decimals != nil {
+			number = number.Round(*field.Decimals)
+		}
+		return number.InexactFloat64(), nil
+	default:
+		if text, ok := value.(string); ok {
+			return field.Prefix + text, nil
+		}
+		if date, ok := value.(time.Time); ok {
+			return t.renderField(config.OdooFieldConfig{Type: fieldTypeDatetime, Layout: field.Layout, Prefix: field.Prefix}, date)
+		}
+		number, err := toDecimal(value)
+		if err != nil {
+			return nil, err
+		}
+		if field.Decimals != nil {
+			number = number.Round(*field.Decimals)
+		}
+		return field.Prefix + number.String(), nil
+	}
+}
+
+func toDecimal(value any) (decimal.Decimal, error) {
+	switch v := value.(type) {
+	case decimal.Decimal:
+		return v, nil
+	case int64:
+		return decimal.NewFromInt(v), nil
+	case int32:
+		return decimal.NewFromInt32(v), nil
+	case int:
+		return decimal.NewFromInt(int64(v)), nil
+	case float64:
+		return decimal.NewFromFloat(v), nil
+	case string:
+		number, err := decimal.NewFromString(v)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("expects a number, got %s", strconv.Quote(v))
+		}
+		return number, nil
+	default:
+		return decimal.Decimal{}, fmt.Errorf("expects a number, got %T", value)
+	}
+}
+
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int64:
+		return v == 0
+	case int32:
+		return v == 0
+	case int:
+		return v == 0
+	case decimal.Decimal:
+		return v.IsZero()
+	case time.Time:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,107 @@
+package odoo_service
+
+import (
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/entity"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewPayloadTemplate(t *testing.T) {
+	sources := (&entity.LoanApprovalRequest{}).OdooValues()
+	t.Run(
+		"invalid configurations", func(t *testing.T) {
+			for name, modelConfig := range map[string]config.OdooModelConfig{
+				"missing model": {
+					Fields: []config.OdooFieldConfig{{Name: "symbol", Source: "symbol", Type: "string"}},
+				},
+				"unknown timezone": {
+					Model:    "approval.finx",
+					Timezone: "Mars/Olympus",
+					Fields:   []config.OdooFieldConfig{{Name: "symbol", Source: "symbol", Type: "string"}},
+				},
+				"no fields": {Model: "approval.finx"},
+				"duplicated field": {
+					Model: "approval.finx",
+					Fields: []config.OdooFieldConfig{
+						{Name: "symbol", Source: "symbol", Type: "string"},
+						{Name: "symbol", Source: "account_no", Type: "string"},
+					},
+				},
+				"unknown source": {
+					Model:  "approval.finx",
+					Fields: []config.OdooFieldConfig{{Name: "symbol", Source: "ticker", Type: "string"}},
+				},
+				"unknown type": {
+					Model:  "approval.finx",
+					Fields: []config.OdooFieldConfig{{Name: "symbol", Source: "symbol", Type: "char"}},
+				},
+			} {
+				_, err := NewPayloadTemplate(modelConfig, sources)
+				assert.NotNil(t, err, name)
+			}
+		},
+	)
+}
+
+func TestPayloadTemplate_Render(t *testing.T) {
+	sources := (&entity.LoanApprovalRequest{}).OdooValues()
+	two := int32(2)
+	tmpl, err := NewPayloadTemplate(
+		config.OdooModelConfig{
+			Model:    "approval.finx",
+			Timezone: "Asia/Ho_Chi_Minh",
+			Fields: []config.OdooFieldConfig{
+				{Name: "x_request", Source: "loan_request_id", Type: "integer", Required: true},
+				{Name: "x_created", Source: "create_at", Type: "datetime"},
+				{Name: "x_created_day", Source: "create_at", Type: "string", Layout: time.DateOnly},
+				{Name: "x_rate", Source: "loan_rate", Type: "float", Decimals: &two},
+				{Name: "x_rate_text", Source: "loan_rate", Type: "string"},
+				{Name: "x_description", Source: "description", Type: "string", Prefix: "test-"},
+				{Name: "x_category", Source: "category_id", Type: "integer", OmitEmpty: true},
+			},
+		}, sources,
+	)
+	assert.Nil(t, err)
+
+	t.Run(
+		"render values", func(t *testing.T) {
+			request := entity.LoanApprovalRequest{
+				LoanRequestId: 10,
+				CreateAt:      time.Date(2024, 5, 2, 20, 0, 0, 0, time.UTC),
+				LoanRate:      decimal.RequireFromString("45.678"),
+				Description:   "comment",
+			}
+			payload, err := tmpl.Render(request.OdooValues())
+			assert.Nil(t, err)
+			assert.Equal(
+				t, map[string]any{
+					"x_request":     int64(10),
+					"x_created":     "2024-05-03 03:00:00",
+					"x_created_day": "2024-05-03",
+					"x_rate":        45.68,
+					"x_rate_text":   "45.678",
+					"x_description": "test-comment",
+				}, payload,
+			)
+		},
+	)
+
+	t.Run(
+		"missing required value", func(t *testing.T) {
+			_, err := tmpl.Render((&entity.LoanApprovalRequest{}).OdooValues())
+			assert.NotNil(t, err)
+		},
+	)
+
+	t.Run(
+		"value does not match field type", func(t *testing.T) {
+			values := (&entity.LoanApprovalRequest{LoanRequestId: 10}).OdooValues()
+			values["create_at"] = "yesterday"
+			_, err := tmpl.Render(values)
+			assert.NotNil(t, err)
+		},
+	)
+}
@@ -1,43 +1,35 @@
 package order_service
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 
 	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/infra/httpclient"
 )
 
 type Client struct {
-	httpClient *http.Client
+	httpClient *httpclient.Client
 	config     config.OrderServiceConfig
 }
 
 func NewClient(config config.OrderServiceConfig) *Client {
 	return &Client{
-		config: config,
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second,
-		},
+		config:     config,
+		httpClient: httpclient.New("order-service", config.Http),
 	}
 }
 
 func (c *Client) NewRequest(ctx context.Context, method, url string, body any) (*http.Request, error) {
-	marshaledBody, err := json.Marshal(body)
-	if err != nil {
-		return nil, fmt.Errorf("NewRequest %w", err)
-	}
-	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(marshaledBody))
+	req, err := httpclient.NewJSONRequest(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("NewRequest %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.config.Token))
-	req.Header.Set("Content-Type", "application/json")
 	return req, nil
 }
 
@@ -1,15 +1,19 @@
 package order_service
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 
+	"financing-offer/internal/apperrors"
 	"financing-offer/internal/config"
 	"financing-offer/internal/core/entity"
+	"financing-offer/pkg/infra/httpclient"
 )
 
 func TestClient_GetAllAccountLoanPackages(t *testing.T) {
@@ -78,7 +82,7 @@ func TestClient_GetAllAccountLoanPackages(t *testing.T) {
 
 	t.Run(
 		"GetAllAccountLoanPackages client do error", func(t *testing.T) {
-			gock.New(orderServiceConfig.Url).Get("/v2/accounts/123/loan-packages").HeaderPresent("Authorization").ReplyError(assert.AnError)
+			gock.New(orderServiceConfig.Url).Get("/v2/accounts/123/loan-packages").HeaderPresent("Authorization").Times(3).ReplyError(assert.AnError)
 			_, err := client.GetAllAccountLoanPackages(context.Background(), "123")
 			assert.NotNil(t, err)
 			assert.ErrorIs(t, err, assert.AnError)
@@ -135,7 +139,7 @@ func TestClient_GetAccountByAccountNoAndCustodyCode(t *testing.T) {
 
 	t.Run(
 		"test get account 5xx error", func(t *testing.T) {
-			gock.New(orderServiceConfig.Url).Get("/internal/investors/064C000115/accounts/0001000115").HeaderPresent("Authorization").Reply(500).JSON(
+			gock.New(orderServiceConfig.Url).Get("/internal/investors/064C000115/accounts/0001000115").HeaderPresent("Authorization").Times(3).Reply(500).JSON(
 				ErrorResponse{
 					Message: "Internal server error",
 				},
@@ -164,3 +168,70 @@ func TestClient_GetAccountByAccountNoAndCustodyCode(t *testing.T) {
 		},
 	)
 }
+
+func TestClient_Resilience(t *testing.T) {
+	newClient := func(httpConfig config.HttpClientConfig) (*Client, config.OrderServiceConfig) {
+		orderServiceConfig := config.OrderServiceConfig{
+			Url:   "http://order-service",
+			Token: "OrderServiceToken",
+			Http:  httpConfig,
+		}
+		return NewClient(orderServiceConfig), orderServiceConfig
+	}
+	assertAppErrorCode := func(t *testing.T, err error, code int) {
+		var appErr apperrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, code, appErr.Code)
+	}
+	t.Run(
+		"get all account loan packages times out", func(t *testing.T) {
+			defer gock.Off()
+			client, orderServiceConfig := newClient(config.HttpClientConfig{Timeout: 20 * time.Millisecond, MaxRetries: -1})
+			gock.New(orderServiceConfig.Url).Get("/v2/accounts/123/loan-packages").Reply(http.StatusOK).Delay(time.Second)
+			_, err := client.GetAllAccountLoanPackages(context.Background(), "123")
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamTimeout("", nil).Code)
+		},
+	)
+	t.Run(
+		"get all account loan packages retried on 5xx", func(t *testing.T) {
+			defer gock.Off()
+			client, orderServiceConfig := newClient(config.HttpClientConfig{RetryBackoff: time.Millisecond})
+			gock.New(orderServiceConfig.Url).Get("/v2/accounts/123/loan-packages").Times(3).Reply(http.StatusServiceUnavailable)
+			_, err := client.GetAllAccountLoanPackages(context.Background(), "123")
+			assert.Error(t, err)
+			assert.True(t, gock.IsDone())
+		},
+	)
+	t.Run(
+		"get all account loan packages refused while circuit breaker is open", func(t *testing.T) {
+			defer gock.Off()
+			client, orderServiceConfig := newClient(
+				config.HttpClientConfig{MaxRetries: -1, BreakerThreshold: 1, BreakerOpenDuration: time.Minute},
+			)
+			gock.New(orderServiceConfig.Url).Get("/v2/accounts/123/loan-packages").Reply(http.StatusServiceUnavailable)
+			_, err := client.GetAllAccountLoanPackages(context.Background(), "123")
+			assert.Error(t, err)
+			_, err = client.GetAllAccountLoanPackages(context.Background(), "123")
+			assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+		},
+	)
+	t.Run(
+		"get all account loan packages refused while bulkhead is full", func(t *testing.T) {
+			defer gock.Off()
+			client, orderServiceConfig := newClient(config.HttpClientConfig{MaxConcurrent: 1, MaxWait: 10 * time.Millisecond})
+			gock.New(orderServiceConfig.Url).Get("/v2/accounts/123/loan-packages").Reply(http.StatusOK).Delay(200 * time.Millisecond)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = client.GetAllAccountLoanPackages(context.Background(), "123")
+			}()
+			// let the first call take the only slot
+			time.Sleep(50 * time.Millisecond)
+			_, err := client.GetAllAccountLoanPackages(context.Background(), "123")
+			assert.ErrorIs(t, err, httpclient.ErrBulkheadFull)
+			assertAppErrorCode(t, err, apperrors.ErrUpstreamUnavailable("", nil).Code)
+			<-done
+		},
+	)
+}
@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"financing-offer/internal/config"
+	"financing-offer/internal/core/webhook/repository"
+)
+
+// maxErrorBodyBytes bounds the part of a failed response body kept in the delivery log
+const maxErrorBodyBytes = 512
+
+const tracerName = "financing-offer/pkg/infra/webhook"
+
+var _ repository.WebhookSenderRepository = (*Client)(nil)
+
+type Client struct {
+	httpClient *http.Client
+	tracer     trace.Tracer
+}
+
+func NewClient(config config.WebhookConfig) *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+		},
+		tracer: otel.Tracer(tracerName),
+	}
+}
+
+// Send posts body to a subscriber in a client span, the trace context is not sent outside of the platform
+func (c *Client) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int32, error) {
+	ctx, span := c.tracer.Start(ctx, "HTTP POST", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+	status, err := c.send(ctx, url, headers, body)
+	if status != 0 {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(int(status)))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return status, err
+}
+
+func (c *Client) send(ctx context.Context, url string, headers map[string]string, body []byte) (int32, error) {
+	errorFormat := "webhook Send %w"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf(errorFormat, err)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf(errorFormat, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return int32(resp.StatusCode), fmt.Errorf("webhook Send got error Status %d, Message: %s", resp.StatusCode, message)
+	}
+	// drain the body so the connection is reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return int32(resp.StatusCode), nil
+}
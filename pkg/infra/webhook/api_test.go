@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/config"
+)
+
+func TestClient_Send(t *testing.T) {
+	t.Parallel()
+	client := NewClient(config.WebhookConfig{Timeout: time.Second})
+
+	t.Run(
+		"post body and headers", func(t *testing.T) {
+			var (
+				gotMethod    string
+				gotSignature string
+				gotBody      string
+			)
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						gotMethod = r.Method
+						gotSignature = r.Header.Get("X-Webhook-Signature")
+						body, _ := io.ReadAll(r.Body)
+						gotBody = string(body)
+						w.WriteHeader(http.StatusAccepted)
+					},
+				),
+			)
+			defer server.Close()
+			status, err := client.Send(
+				context.Background(), server.URL, map[string]string{"X-Webhook-Signature": "sha256=abc"}, []byte(`{"id":"1"}`),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, int32(http.StatusAccepted), status)
+			assert.Equal(t, http.MethodPost, gotMethod)
+			assert.Equal(t, "sha256=abc", gotSignature)
+			assert.Equal(t, `{"id":"1"}`, gotBody)
+		},
+	)
+
+	t.Run(
+		"error on non 2xx status", func(t *testing.T) {
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusServiceUnavailable)
+						_, _ = w.Write([]byte("maintenance"))
+					},
+				),
+			)
+			defer server.Close()
+			status, err := client.Send(context.Background(), server.URL, nil, []byte(`{}`))
+			assert.ErrorContains(t, err, "maintenance")
+			assert.Equal(t, int32(http.StatusServiceUnavailable), status)
+		},
+	)
+
+	t.Run(
+		"error on unreachable endpoint", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			server.Close()
+			status, err := client.Send(context.Background(), server.URL, nil, []byte(`{}`))
+			assert.NotNil(t, err)
+			assert.Equal(t, int32(0), status)
+		},
+	)
+}
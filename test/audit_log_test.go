@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/samber/do"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/appcontext"
+	"financing-offer/internal/atomicity"
+	"financing-offer/internal/core/audit/transport/http"
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/symbol"
+	symbolPostgres "financing-offer/internal/core/symbol/repository/postgres"
+	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/jwttoken"
+	"financing-offer/pkg/dbtest"
+	"financing-offer/pkg/gintest"
+	"financing-offer/test/mock"
+	"financing-offer/test/testhelper"
+)
+
+func TestAuditHandler(t *testing.T) {
+	t.Parallel()
+
+	db, tearDownDb, truncateData := dbtest.NewDb(t)
+	defer tearDownDb()
+	injector := testhelper.NewInjector(testhelper.WithDb(db))
+	h := do.MustInvoke[*http.AuditHandler](injector)
+	atomicExecutor := do.MustInvoke[*atomicity.DbAtomicExecutor](injector)
+	symbolRepository := do.MustInvoke[*symbolPostgres.SymbolRepository](injector)
+	symbolUseCase := do.MustInvoke[symbol.UseCase](injector)
+
+	t.Run(
+		"entity audit logs record the actor of the transaction", func(t *testing.T) {
+			defer truncateData()
+			se := mock.SeedStockExchange(t, db, model.StockExchange{Code: "HOSE", MinScore: 50, MaxScore: 100})
+			symbol := mock.SeedSymbol(
+				t, db, model.Symbol{StockExchangeID: se.ID, Symbol: "BID", AssetType: "UNDERLYING", Status: "ACTIVE"},
+			)
+			ctx := context.WithValue(
+				context.Background(), appcontext.UserInformation, &jwttoken.AdminClaims{Sub: "dnse.admin@dnse.com.vn"},
+			)
+			err := atomicExecutor.Execute(
+				ctx, func(tc context.Context) error {
+					_, err := symbolRepository.Update(
+						tc, entity.Symbol{
+							Id:              symbol.ID,
+							StockExchangeId: se.ID,
+							Symbol:          "BID",
+							AssetType:       entity.AssetType("UNDERLYING"),
+							Status:          entity.SymbolStatusInactive,
+							LastUpdatedBy:   "dnse.admin@dnse.com.vn",
+						},
+					)
+					return err
+				},
+			)
+			assert.Nil(t, err)
+
+			handlerFunc := h.GetEntityAuditLogs("symbol")
+			ginCtx, _, recorder := gintest.GetTestContext()
+			ginCtx.Request = gintest.MustMakeRequest("GET", "/api/v1/symbols/1/audit?action=UPDATE", nil)
+			ginCtx.AddParam("id", strconv.FormatInt(symbol.ID, 10))
+			handlerFunc(ginCtx)
+			result := recorder.Result()
+			defer assert.Nil(t, result.Body.Close())
+
+			body := gintest.ExtractBody(result.Body)
+			assert.Equal(t, "UPDATE", testhelper.GetString(body, "data", "[0]", "action"))
+			assert.Equal(t, "dnse.admin@dnse.com.vn", testhelper.GetString(body, "data", "[0]", "actor"))
+			assert.Equal(t, strconv.FormatInt(symbol.ID, 10), testhelper.GetString(body, "data", "[0]", "rowId"))
+		},
+	)
+
+	t.Run(
+		"symbol status updates record the admin making them", func(t *testing.T) {
+			defer truncateData()
+			se := mock.SeedStockExchange(t, db, model.StockExchange{Code: "HOSE", MinScore: 50, MaxScore: 100})
+			seeded := mock.SeedSymbol(
+				t, db, model.Symbol{StockExchangeID: se.ID, Symbol: "BID", AssetType: "UNDERLYING", Status: "ACTIVE"},
+			)
+			ctx := context.WithValue(
+				context.Background(), appcontext.UserInformation, &jwttoken.AdminClaims{Sub: "dnse.admin@dnse.com.vn"},
+			)
+			_, err := symbolUseCase.UpdateStatus(
+				ctx, entity.Symbol{
+					Id:              seeded.ID,
+					StockExchangeId: se.ID,
+					Symbol:          "BID",
+					AssetType:       entity.AssetType("UNDERLYING"),
+					Status:          entity.SymbolStatusInactive,
+					LastUpdatedBy:   "dnse.admin@dnse.com.vn",
+				},
+			)
+			assert.Nil(t, err)
+
+			handlerFunc := h.GetEntityAuditLogs("symbol")
+			ginCtx, _, recorder := gintest.GetTestContext()
+			ginCtx.Request = gintest.MustMakeRequest("GET", "/api/v1/symbols/1/audit?action=UPDATE", nil)
+			ginCtx.AddParam("id", strconv.FormatInt(seeded.ID, 10))
+			handlerFunc(ginCtx)
+			result := recorder.Result()
+			defer assert.Nil(t, result.Body.Close())
+
+			body := gintest.ExtractBody(result.Body)
+			assert.Equal(t, "dnse.admin@dnse.com.vn", testhelper.GetString(body, "data", "[0]", "actor"))
+		},
+	)
+
+	t.Run(
+		"get audit logs rejects an unknown action", func(t *testing.T) {
+			ginCtx, _, recorder := gintest.GetTestContext()
+			ginCtx.Request = gintest.MustMakeRequest("GET", "/api/v1/audit-logs?action=UPSERT", nil)
+			h.GetAll(ginCtx)
+			result := recorder.Result()
+			defer assert.Nil(t, result.Body.Close())
+			assert.Equal(t, 400, result.StatusCode)
+		},
+	)
+}
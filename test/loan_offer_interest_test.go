@@ -297,6 +297,74 @@ func TestLoanOfferInterestHandler(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"investor get loan package creation status", func(t *testing.T) {
+			defer truncateData()
+			se := mock.SeedStockExchange(
+				t, db, model.StockExchange{
+					Code: "HOSE",
+				},
+			)
+			symbol := mock.SeedSymbol(
+				t, db, model.Symbol{
+					StockExchangeID: se.ID,
+					Symbol:          "VIB",
+					AssetType:       "UNDERLYING",
+				},
+			)
+			request := mock.SeedLoanPackageRequest(
+				t, db, model.LoanPackageRequest{
+					SymbolID:   symbol.ID,
+					InvestorID: investorId,
+					AccountNo:  accountNo,
+					LoanRate:   decimal.NewFromFloat(0.7),
+					Type:       "FLEXIBLE",
+					Status:     "CONFIRMED",
+					AssetType:  "UNDERLYING",
+				},
+			)
+			offer := mock.SeedLoanPackageOffer(
+				t, db, model.LoanPackageOffer{
+					LoanPackageRequestID: request.ID,
+					OfferedBy:            "admin",
+					ExpiredAt:            null.TimeFrom(time.Now().Add(time.Hour * 24 * 30)),
+					FlowType:             entity.FlowTypeDnseOnline.String(),
+				},
+			)
+			workflowId := "auto-create-loan-package-v3-1"
+			offerInterest := mock.SeedLoanPackageOfferInterest(
+				t, db, model.LoanPackageOfferInterest{
+					LoanPackageOfferID: offer.ID,
+					LimitAmount:        request.LimitAmount,
+					LoanRate:           request.LoanRate,
+					InterestRate:       decimal.Zero,
+					Status:             "PACKAGE_CREATED",
+					LoanID:             654,
+					AssetType:          "UNDERLYING",
+					WorkflowID:         &workflowId,
+				},
+			)
+			ginCtx, _, recorder := gintest.GetTestContext()
+			ginCtx.Set(
+				appcontext.UserInformation, &jwttoken.AdminClaims{
+					Sub:        investorId,
+					InvestorId: investorId,
+				},
+			)
+			ginCtx.Request = gintest.MustMakeRequest(
+				"GET", fmt.Sprintf("/my-loan-offer-interests/%d/loan-package-status", offerInterest.ID), nil,
+			)
+			ginCtx.AddParam("id", fmt.Sprintf("%d", offerInterest.ID))
+			h.InvestorGetLoanPackageCreationStatus(ginCtx)
+			result := recorder.Result()
+			defer assert.Nil(t, result.Body.Close())
+			body := gintest.ExtractBody(result.Body)
+			assert.Equal(t, "PACKAGE_CREATED", testhelper.GetString(body, "data", "status"))
+			assert.Equal(t, workflowId, testhelper.GetString(body, "data", "workflowId"))
+			assert.Equal(t, int64(654), testhelper.GetInt(body, "data", "loanId"))
+		},
+	)
+
 	t.Run(
 		"Test create loan contract success", func(t *testing.T) {
 			defer truncateData()
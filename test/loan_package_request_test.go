@@ -1458,7 +1458,7 @@ func TestLoanPackageRequest_Admin(t *testing.T) {
 					PreferentialInterestRate: decimal.NewFromFloat(0.442),
 				},
 			)
-			odooServiceRepository.EXPECT().SendLoanApprovalRequest(mock2.Anything).Return(nil)
+			odooServiceRepository.EXPECT().SendLoanApprovalRequest(mock2.Anything).Return(int64(1), nil)
 
 			ginCtx, _, recorder := gintest.GetTestContext()
 			ginCtx.Request = gintest.MustMakeRequest(
@@ -1739,7 +1739,7 @@ func TestLoanPackageRequest_Admin(t *testing.T) {
 				}, nil,
 			)
 
-			odooServiceRepository.EXPECT().SendLoanApprovalRequest(mock2.Anything).Return(nil)
+			odooServiceRepository.EXPECT().SendLoanApprovalRequest(mock2.Anything).Return(int64(1), nil)
 
 			loanPackageRequestHandler.AdminDeclineLoanRequestWithNewLoanPackage(ginCtx)
 			result := recorder.Result()
@@ -16,3 +16,6 @@ func (m *EmptyCache) SetTtl(_ string, _ any, _ time.Duration) {
 
 func (m *EmptyCache) Del(_ string) {
 }
+
+func (m *EmptyCache) DelPrefix(_ string) {
+}
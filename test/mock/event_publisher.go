@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/segmentio/kafka-go"
@@ -8,7 +9,7 @@ import (
 
 type EventPublisher struct{}
 
-func (e EventPublisher) Publish(message kafka.Message) error {
+func (e EventPublisher) Publish(_ context.Context, message kafka.Message) error {
 	fmt.Println(fmt.Sprintf("published message: %s, topic %s", message.Value, message.Topic))
 	return nil
 }
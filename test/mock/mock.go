@@ -24,6 +24,24 @@ func (e ErrReporter) NotifyError(_ context.Context, _ error) error {
 	return nil
 }
 
+// LoanRequestLifecycleRepository behaves like the lifecycle workflows are disabled
+type LoanRequestLifecycleRepository struct{}
+
+func (l *LoanRequestLifecycleRepository) LoanRequestUpdated(ctx context.Context, loanPackageRequestId int64) error {
+	return nil
+}
+
+func (l *LoanRequestLifecycleRepository) OfferInterestConfirmed(ctx context.Context, loanPackageRequestId int64, loanPackageOfferInterestId int64) (bool, error) {
+	return false, nil
+}
+
+// WebhookEventRepository behaves like no webhook subscription exists
+type WebhookEventRepository struct{}
+
+func (w *WebhookEventRepository) Publish(ctx context.Context, eventType entity.WebhookEventType, data any) error {
+	return nil
+}
+
 type LoanPackageRequestEventRepository struct{}
 
 func (l *LoanPackageRequestEventRepository) NotifyRequestDeclined(ctx context.Context, data entity.LoanPackageRequestDeclinedNotify) error {
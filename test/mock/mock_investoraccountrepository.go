@@ -136,6 +136,65 @@ func (_c *MockInvestorAccountRepository_GetByAccountNo_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GetByInvestorId provides a mock function with given fields: ctx, investorId
+func (_m *MockInvestorAccountRepository) GetByInvestorId(ctx context.Context, investorId string) ([]entity.InvestorAccount, error) {
+	ret := _m.Called(ctx, investorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByInvestorId")
+	}
+
+	var r0 []entity.InvestorAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.InvestorAccount, error)); ok {
+		return rf(ctx, investorId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.InvestorAccount); ok {
+		r0 = rf(ctx, investorId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.InvestorAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, investorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInvestorAccountRepository_GetByInvestorId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByInvestorId'
+type MockInvestorAccountRepository_GetByInvestorId_Call struct {
+	*mock.Call
+}
+
+// GetByInvestorId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - investorId string
+func (_e *MockInvestorAccountRepository_Expecter) GetByInvestorId(ctx interface{}, investorId interface{}) *MockInvestorAccountRepository_GetByInvestorId_Call {
+	return &MockInvestorAccountRepository_GetByInvestorId_Call{Call: _e.mock.On("GetByInvestorId", ctx, investorId)}
+}
+
+func (_c *MockInvestorAccountRepository_GetByInvestorId_Call) Run(run func(ctx context.Context, investorId string)) *MockInvestorAccountRepository_GetByInvestorId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInvestorAccountRepository_GetByInvestorId_Call) Return(_a0 []entity.InvestorAccount, _a1 error) *MockInvestorAccountRepository_GetByInvestorId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInvestorAccountRepository_GetByInvestorId_Call) RunAndReturn(run func(context.Context, string) ([]entity.InvestorAccount, error)) *MockInvestorAccountRepository_GetByInvestorId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, account
 func (_m *MockInvestorAccountRepository) Update(ctx context.Context, account entity.InvestorAccount) (entity.InvestorAccount, error) {
 	ret := _m.Called(ctx, account)
@@ -232,6 +232,63 @@ func (_c *MockInvestorPersistenceRepository_GetAllUniqueInvestorIdsFromRequests_
 	return _c
 }
 
+// GetPreferredLocale provides a mock function with given fields: ctx, investorId
+func (_m *MockInvestorPersistenceRepository) GetPreferredLocale(ctx context.Context, investorId string) (entity.Locale, error) {
+	ret := _m.Called(ctx, investorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferredLocale")
+	}
+
+	var r0 entity.Locale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Locale, error)); ok {
+		return rf(ctx, investorId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Locale); ok {
+		r0 = rf(ctx, investorId)
+	} else {
+		r0 = ret.Get(0).(entity.Locale)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, investorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInvestorPersistenceRepository_GetPreferredLocale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreferredLocale'
+type MockInvestorPersistenceRepository_GetPreferredLocale_Call struct {
+	*mock.Call
+}
+
+// GetPreferredLocale is a helper method to define mock.On call
+//   - ctx context.Context
+//   - investorId string
+func (_e *MockInvestorPersistenceRepository_Expecter) GetPreferredLocale(ctx interface{}, investorId interface{}) *MockInvestorPersistenceRepository_GetPreferredLocale_Call {
+	return &MockInvestorPersistenceRepository_GetPreferredLocale_Call{Call: _e.mock.On("GetPreferredLocale", ctx, investorId)}
+}
+
+func (_c *MockInvestorPersistenceRepository_GetPreferredLocale_Call) Run(run func(ctx context.Context, investorId string)) *MockInvestorPersistenceRepository_GetPreferredLocale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInvestorPersistenceRepository_GetPreferredLocale_Call) Return(_a0 entity.Locale, _a1 error) *MockInvestorPersistenceRepository_GetPreferredLocale_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInvestorPersistenceRepository_GetPreferredLocale_Call) RunAndReturn(run func(context.Context, string) (entity.Locale, error)) *MockInvestorPersistenceRepository_GetPreferredLocale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, investor
 func (_m *MockInvestorPersistenceRepository) Update(ctx context.Context, investor entity.Investor) (entity.Investor, error) {
 	ret := _m.Called(ctx, investor)
@@ -289,6 +346,54 @@ func (_c *MockInvestorPersistenceRepository_Update_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// UpdatePreferredLocale provides a mock function with given fields: ctx, investorId, locale
+func (_m *MockInvestorPersistenceRepository) UpdatePreferredLocale(ctx context.Context, investorId string, locale entity.Locale) error {
+	ret := _m.Called(ctx, investorId, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferredLocale")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Locale) error); ok {
+		r0 = rf(ctx, investorId, locale)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInvestorPersistenceRepository_UpdatePreferredLocale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferredLocale'
+type MockInvestorPersistenceRepository_UpdatePreferredLocale_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferredLocale is a helper method to define mock.On call
+//   - ctx context.Context
+//   - investorId string
+//   - locale entity.Locale
+func (_e *MockInvestorPersistenceRepository_Expecter) UpdatePreferredLocale(ctx interface{}, investorId interface{}, locale interface{}) *MockInvestorPersistenceRepository_UpdatePreferredLocale_Call {
+	return &MockInvestorPersistenceRepository_UpdatePreferredLocale_Call{Call: _e.mock.On("UpdatePreferredLocale", ctx, investorId, locale)}
+}
+
+func (_c *MockInvestorPersistenceRepository_UpdatePreferredLocale_Call) Run(run func(ctx context.Context, investorId string, locale entity.Locale)) *MockInvestorPersistenceRepository_UpdatePreferredLocale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entity.Locale))
+	})
+	return _c
+}
+
+func (_c *MockInvestorPersistenceRepository_UpdatePreferredLocale_Call) Return(_a0 error) *MockInvestorPersistenceRepository_UpdatePreferredLocale_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInvestorPersistenceRepository_UpdatePreferredLocale_Call) RunAndReturn(run func(context.Context, string, entity.Locale) error) *MockInvestorPersistenceRepository_UpdatePreferredLocale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockInvestorPersistenceRepository creates a new instance of MockInvestorPersistenceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockInvestorPersistenceRepository(t interface {
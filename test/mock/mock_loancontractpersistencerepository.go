@@ -200,6 +200,63 @@ func (_c *MockLoanContractPersistenceRepository_GetById_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetByLoanOfferInterestId provides a mock function with given fields: ctx, loanOfferInterestId
+func (_m *MockLoanContractPersistenceRepository) GetByLoanOfferInterestId(ctx context.Context, loanOfferInterestId int64) (entity.LoanContract, error) {
+	ret := _m.Called(ctx, loanOfferInterestId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByLoanOfferInterestId")
+	}
+
+	var r0 entity.LoanContract
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (entity.LoanContract, error)); ok {
+		return rf(ctx, loanOfferInterestId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) entity.LoanContract); ok {
+		r0 = rf(ctx, loanOfferInterestId)
+	} else {
+		r0 = ret.Get(0).(entity.LoanContract)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, loanOfferInterestId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByLoanOfferInterestId'
+type MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call struct {
+	*mock.Call
+}
+
+// GetByLoanOfferInterestId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanOfferInterestId int64
+func (_e *MockLoanContractPersistenceRepository_Expecter) GetByLoanOfferInterestId(ctx interface{}, loanOfferInterestId interface{}) *MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call {
+	return &MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call{Call: _e.mock.On("GetByLoanOfferInterestId", ctx, loanOfferInterestId)}
+}
+
+func (_c *MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call) Run(run func(ctx context.Context, loanOfferInterestId int64)) *MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call) Return(_a0 entity.LoanContract, _a1 error) *MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call) RunAndReturn(run func(context.Context, int64) (entity.LoanContract, error)) *MockLoanContractPersistenceRepository_GetByLoanOfferInterestId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetInvestorActiveContract provides a mock function with given fields: ctx, investorId, symbolId
 func (_m *MockLoanContractPersistenceRepository) GetInvestorActiveContract(ctx context.Context, investorId string, symbolId int64) (entity.LoanContract, error) {
 	ret := _m.Called(ctx, investorId, symbolId)
@@ -23,21 +23,31 @@ func (_m *MockLoanPackageOfferInterestEventRepository) EXPECT() *MockLoanPackage
 }
 
 // CreateMarginLoanPackage provides a mock function with given fields: ctx, state
-func (_m *MockLoanPackageOfferInterestEventRepository) CreateMarginLoanPackage(ctx context.Context, state entity.AssignmentState) error {
+func (_m *MockLoanPackageOfferInterestEventRepository) CreateMarginLoanPackage(ctx context.Context, state entity.AssignmentState) (string, error) {
 	ret := _m.Called(ctx, state)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateMarginLoanPackage")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.AssignmentState) error); ok {
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AssignmentState) (string, error)); ok {
+		return rf(ctx, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AssignmentState) string); ok {
 		r0 = rf(ctx, state)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, entity.AssignmentState) error); ok {
+		r1 = rf(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMarginLoanPackage'
@@ -59,12 +69,126 @@ func (_c *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Ca
 	return _c
 }
 
-func (_c *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call) Return(_a0 error) *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call {
-	_c.Call.Return(_a0)
+func (_c *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call) Return(_a0 string, _a1 error) *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call) RunAndReturn(run func(context.Context, entity.AssignmentState) (string, error)) *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanPackageWorkflowResult provides a mock function with given fields: ctx, workflowId
+func (_m *MockLoanPackageOfferInterestEventRepository) GetLoanPackageWorkflowResult(ctx context.Context, workflowId string) (entity.LoanPackageCreationResult, error) {
+	ret := _m.Called(ctx, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanPackageWorkflowResult")
+	}
+
+	var r0 entity.LoanPackageCreationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.LoanPackageCreationResult, error)); ok {
+		return rf(ctx, workflowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.LoanPackageCreationResult); ok {
+		r0 = rf(ctx, workflowId)
+	} else {
+		r0 = ret.Get(0).(entity.LoanPackageCreationResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, workflowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanPackageWorkflowResult'
+type MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call struct {
+	*mock.Call
+}
+
+// GetLoanPackageWorkflowResult is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId string
+func (_e *MockLoanPackageOfferInterestEventRepository_Expecter) GetLoanPackageWorkflowResult(ctx interface{}, workflowId interface{}) *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call {
+	return &MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call{Call: _e.mock.On("GetLoanPackageWorkflowResult", ctx, workflowId)}
+}
+
+func (_c *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call) Run(run func(ctx context.Context, workflowId string)) *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call) Return(_a0 entity.LoanPackageCreationResult, _a1 error) *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call) RunAndReturn(run func(context.Context, string) (entity.LoanPackageCreationResult, error)) *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanPackageWorkflowStatus provides a mock function with given fields: ctx, workflowId
+func (_m *MockLoanPackageOfferInterestEventRepository) GetLoanPackageWorkflowStatus(ctx context.Context, workflowId string) (entity.LoanPackageWorkflowStatus, error) {
+	ret := _m.Called(ctx, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanPackageWorkflowStatus")
+	}
+
+	var r0 entity.LoanPackageWorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.LoanPackageWorkflowStatus, error)); ok {
+		return rf(ctx, workflowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.LoanPackageWorkflowStatus); ok {
+		r0 = rf(ctx, workflowId)
+	} else {
+		r0 = ret.Get(0).(entity.LoanPackageWorkflowStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, workflowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanPackageWorkflowStatus'
+type MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call struct {
+	*mock.Call
+}
+
+// GetLoanPackageWorkflowStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId string
+func (_e *MockLoanPackageOfferInterestEventRepository_Expecter) GetLoanPackageWorkflowStatus(ctx interface{}, workflowId interface{}) *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call {
+	return &MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call{Call: _e.mock.On("GetLoanPackageWorkflowStatus", ctx, workflowId)}
+}
+
+func (_c *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call) Run(run func(ctx context.Context, workflowId string)) *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call) Return(_a0 entity.LoanPackageWorkflowStatus, _a1 error) *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call) RunAndReturn(run func(context.Context, entity.AssignmentState) error) *MockLoanPackageOfferInterestEventRepository_CreateMarginLoanPackage_Call {
+func (_c *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call) RunAndReturn(run func(context.Context, string) (entity.LoanPackageWorkflowStatus, error)) *MockLoanPackageOfferInterestEventRepository_GetLoanPackageWorkflowStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
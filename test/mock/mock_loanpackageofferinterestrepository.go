@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	querymod "financing-offer/pkg/querymod"
+
+	time "time"
 )
 
 // MockLoanPackageOfferInterestRepository is an autogenerated mock type for the LoanPackageOfferInterestRepository type
@@ -498,6 +500,66 @@ func (_c *MockLoanPackageOfferInterestRepository_GetByOfferIdWithLock_Call) RunA
 	return _c
 }
 
+// GetCreatingUpdatedBefore provides a mock function with given fields: ctx, updatedBefore, limit
+func (_m *MockLoanPackageOfferInterestRepository) GetCreatingUpdatedBefore(ctx context.Context, updatedBefore time.Time, limit int64) ([]entity.LoanPackageOfferInterest, error) {
+	ret := _m.Called(ctx, updatedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreatingUpdatedBefore")
+	}
+
+	var r0 []entity.LoanPackageOfferInterest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) ([]entity.LoanPackageOfferInterest, error)); ok {
+		return rf(ctx, updatedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) []entity.LoanPackageOfferInterest); ok {
+		r0 = rf(ctx, updatedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanPackageOfferInterest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int64) error); ok {
+		r1 = rf(ctx, updatedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreatingUpdatedBefore'
+type MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call struct {
+	*mock.Call
+}
+
+// GetCreatingUpdatedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updatedBefore time.Time
+//   - limit int64
+func (_e *MockLoanPackageOfferInterestRepository_Expecter) GetCreatingUpdatedBefore(ctx interface{}, updatedBefore interface{}, limit interface{}) *MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call {
+	return &MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call{Call: _e.mock.On("GetCreatingUpdatedBefore", ctx, updatedBefore, limit)}
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call) Run(run func(ctx context.Context, updatedBefore time.Time, limit int64)) *MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call) Return(_a0 []entity.LoanPackageOfferInterest, _a1 error) *MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call) RunAndReturn(run func(context.Context, time.Time, int64) ([]entity.LoanPackageOfferInterest, error)) *MockLoanPackageOfferInterestRepository_GetCreatingUpdatedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRequestBasedLoanOfferInterests provides a mock function with given fields: ctx
 func (_m *MockLoanPackageOfferInterestRepository) GetRequestBasedLoanOfferInterests(ctx context.Context) ([]entity.LoanPackageOfferInterest, error) {
 	ret := _m.Called(ctx)
@@ -720,6 +782,113 @@ func (_c *MockLoanPackageOfferInterestRepository_UpdateStatus_Call) RunAndReturn
 	return _c
 }
 
+// UpdateStatusFrom provides a mock function with given fields: ctx, id, from, to
+func (_m *MockLoanPackageOfferInterestRepository) UpdateStatusFrom(ctx context.Context, id int64, from entity.LoanPackageOfferInterestStatus, to entity.LoanPackageOfferInterestStatus) (bool, error) {
+	ret := _m.Called(ctx, id, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatusFrom")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entity.LoanPackageOfferInterestStatus, entity.LoanPackageOfferInterestStatus) (bool, error)); ok {
+		return rf(ctx, id, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entity.LoanPackageOfferInterestStatus, entity.LoanPackageOfferInterestStatus) bool); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, entity.LoanPackageOfferInterestStatus, entity.LoanPackageOfferInterestStatus) error); ok {
+		r1 = rf(ctx, id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatusFrom'
+type MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call struct {
+	*mock.Call
+}
+
+// UpdateStatusFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - from entity.LoanPackageOfferInterestStatus
+//   - to entity.LoanPackageOfferInterestStatus
+func (_e *MockLoanPackageOfferInterestRepository_Expecter) UpdateStatusFrom(ctx interface{}, id interface{}, from interface{}, to interface{}) *MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call {
+	return &MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call{Call: _e.mock.On("UpdateStatusFrom", ctx, id, from, to)}
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call) Run(run func(ctx context.Context, id int64, from entity.LoanPackageOfferInterestStatus, to entity.LoanPackageOfferInterestStatus)) *MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(entity.LoanPackageOfferInterestStatus), args[3].(entity.LoanPackageOfferInterestStatus))
+	})
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call) Return(_a0 bool, _a1 error) *MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call) RunAndReturn(run func(context.Context, int64, entity.LoanPackageOfferInterestStatus, entity.LoanPackageOfferInterestStatus) (bool, error)) *MockLoanPackageOfferInterestRepository_UpdateStatusFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWorkflowId provides a mock function with given fields: ctx, id, workflowId
+func (_m *MockLoanPackageOfferInterestRepository) UpdateWorkflowId(ctx context.Context, id int64, workflowId string) error {
+	ret := _m.Called(ctx, id, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorkflowId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, workflowId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkflowId'
+type MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call struct {
+	*mock.Call
+}
+
+// UpdateWorkflowId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - workflowId string
+func (_e *MockLoanPackageOfferInterestRepository_Expecter) UpdateWorkflowId(ctx interface{}, id interface{}, workflowId interface{}) *MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call {
+	return &MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call{Call: _e.mock.On("UpdateWorkflowId", ctx, id, workflowId)}
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call) Run(run func(ctx context.Context, id int64, workflowId string)) *MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call) Return(_a0 error) *MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockLoanPackageOfferInterestRepository_UpdateWorkflowId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockLoanPackageOfferInterestRepository creates a new instance of MockLoanPackageOfferInterestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockLoanPackageOfferInterestRepository(t interface {
@@ -6,8 +6,6 @@ import (
 	context "context"
 	entity "financing-offer/internal/core/entity"
 
-	decimal "github.com/shopspring/decimal"
-
 	mock "github.com/stretchr/testify/mock"
 
 	querymod "financing-offer/pkg/querymod"
@@ -140,6 +138,53 @@ func (_c *MockLoanPackageRequestRepository_Create_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CreateStatusHistories provides a mock function with given fields: ctx, histories
+func (_m *MockLoanPackageRequestRepository) CreateStatusHistories(ctx context.Context, histories []entity.LoanPackageRequestStatusHistory) error {
+	ret := _m.Called(ctx, histories)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStatusHistories")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.LoanPackageRequestStatusHistory) error); ok {
+		r0 = rf(ctx, histories)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLoanPackageRequestRepository_CreateStatusHistories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStatusHistories'
+type MockLoanPackageRequestRepository_CreateStatusHistories_Call struct {
+	*mock.Call
+}
+
+// CreateStatusHistories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - histories []entity.LoanPackageRequestStatusHistory
+func (_e *MockLoanPackageRequestRepository_Expecter) CreateStatusHistories(ctx interface{}, histories interface{}) *MockLoanPackageRequestRepository_CreateStatusHistories_Call {
+	return &MockLoanPackageRequestRepository_CreateStatusHistories_Call{Call: _e.mock.On("CreateStatusHistories", ctx, histories)}
+}
+
+func (_c *MockLoanPackageRequestRepository_CreateStatusHistories_Call) Run(run func(ctx context.Context, histories []entity.LoanPackageRequestStatusHistory)) *MockLoanPackageRequestRepository_CreateStatusHistories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.LoanPackageRequestStatusHistory))
+	})
+	return _c
+}
+
+func (_c *MockLoanPackageRequestRepository_CreateStatusHistories_Call) Return(_a0 error) *MockLoanPackageRequestRepository_CreateStatusHistories_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoanPackageRequestRepository_CreateStatusHistories_Call) RunAndReturn(run func(context.Context, []entity.LoanPackageRequestStatusHistory) error) *MockLoanPackageRequestRepository_CreateStatusHistories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *MockLoanPackageRequestRepository) Delete(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
@@ -246,6 +291,79 @@ func (_c *MockLoanPackageRequestRepository_GetAll_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetAllPendingDeclineCandidates provides a mock function with given fields: ctx, opts
+func (_m *MockLoanPackageRequestRepository) GetAllPendingDeclineCandidates(ctx context.Context, opts ...querymod.GetOption) ([]entity.LoanRequestDeclineCandidate, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPendingDeclineCandidates")
+	}
+
+	var r0 []entity.LoanRequestDeclineCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...querymod.GetOption) ([]entity.LoanRequestDeclineCandidate, error)); ok {
+		return rf(ctx, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...querymod.GetOption) []entity.LoanRequestDeclineCandidate); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoanRequestDeclineCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...querymod.GetOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPendingDeclineCandidates'
+type MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call struct {
+	*mock.Call
+}
+
+// GetAllPendingDeclineCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts ...querymod.GetOption
+func (_e *MockLoanPackageRequestRepository_Expecter) GetAllPendingDeclineCandidates(ctx interface{}, opts ...interface{}) *MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call {
+	return &MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call{Call: _e.mock.On("GetAllPendingDeclineCandidates",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call) Run(run func(ctx context.Context, opts ...querymod.GetOption)) *MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]querymod.GetOption, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(querymod.GetOption)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call) Return(_a0 []entity.LoanRequestDeclineCandidate, _a1 error) *MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call) RunAndReturn(run func(context.Context, ...querymod.GetOption) ([]entity.LoanRequestDeclineCandidate, error)) *MockLoanPackageRequestRepository_GetAllPendingDeclineCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllUnderlyingRequests provides a mock function with given fields: ctx, filter
 func (_m *MockLoanPackageRequestRepository) GetAllUnderlyingRequests(ctx context.Context, filter entity.UnderlyingLoanPackageFilter) ([]entity.UnderlyingLoanPackageRequest, error) {
 	ret := _m.Called(ctx, filter)
@@ -378,29 +496,29 @@ func (_c *MockLoanPackageRequestRepository_GetById_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// LockAllPendingRequestByMaxPercent provides a mock function with given fields: ctx, maximumLoanRate
-func (_m *MockLoanPackageRequestRepository) LockAllPendingRequestByMaxPercent(ctx context.Context, maximumLoanRate decimal.Decimal) ([]entity.LoanPackageRequest, error) {
-	ret := _m.Called(ctx, maximumLoanRate)
+// GetStatusHistories provides a mock function with given fields: ctx, loanPackageRequestId
+func (_m *MockLoanPackageRequestRepository) GetStatusHistories(ctx context.Context, loanPackageRequestId int64) ([]entity.LoanPackageRequestStatusHistory, error) {
+	ret := _m.Called(ctx, loanPackageRequestId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LockAllPendingRequestByMaxPercent")
+		panic("no return value specified for GetStatusHistories")
 	}
 
-	var r0 []entity.LoanPackageRequest
+	var r0 []entity.LoanPackageRequestStatusHistory
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, decimal.Decimal) ([]entity.LoanPackageRequest, error)); ok {
-		return rf(ctx, maximumLoanRate)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]entity.LoanPackageRequestStatusHistory, error)); ok {
+		return rf(ctx, loanPackageRequestId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, decimal.Decimal) []entity.LoanPackageRequest); ok {
-		r0 = rf(ctx, maximumLoanRate)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []entity.LoanPackageRequestStatusHistory); ok {
+		r0 = rf(ctx, loanPackageRequestId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.LoanPackageRequest)
+			r0 = ret.Get(0).([]entity.LoanPackageRequestStatusHistory)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, decimal.Decimal) error); ok {
-		r1 = rf(ctx, maximumLoanRate)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, loanPackageRequestId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -408,31 +526,31 @@ func (_m *MockLoanPackageRequestRepository) LockAllPendingRequestByMaxPercent(ct
 	return r0, r1
 }
 
-// MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockAllPendingRequestByMaxPercent'
-type MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call struct {
+// MockLoanPackageRequestRepository_GetStatusHistories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusHistories'
+type MockLoanPackageRequestRepository_GetStatusHistories_Call struct {
 	*mock.Call
 }
 
-// LockAllPendingRequestByMaxPercent is a helper method to define mock.On call
+// GetStatusHistories is a helper method to define mock.On call
 //   - ctx context.Context
-//   - maximumLoanRate decimal.Decimal
-func (_e *MockLoanPackageRequestRepository_Expecter) LockAllPendingRequestByMaxPercent(ctx interface{}, maximumLoanRate interface{}) *MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call {
-	return &MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call{Call: _e.mock.On("LockAllPendingRequestByMaxPercent", ctx, maximumLoanRate)}
+//   - loanPackageRequestId int64
+func (_e *MockLoanPackageRequestRepository_Expecter) GetStatusHistories(ctx interface{}, loanPackageRequestId interface{}) *MockLoanPackageRequestRepository_GetStatusHistories_Call {
+	return &MockLoanPackageRequestRepository_GetStatusHistories_Call{Call: _e.mock.On("GetStatusHistories", ctx, loanPackageRequestId)}
 }
 
-func (_c *MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call) Run(run func(ctx context.Context, maximumLoanRate decimal.Decimal)) *MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call {
+func (_c *MockLoanPackageRequestRepository_GetStatusHistories_Call) Run(run func(ctx context.Context, loanPackageRequestId int64)) *MockLoanPackageRequestRepository_GetStatusHistories_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(decimal.Decimal))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call) Return(_a0 []entity.LoanPackageRequest, _a1 error) *MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call {
+func (_c *MockLoanPackageRequestRepository_GetStatusHistories_Call) Return(_a0 []entity.LoanPackageRequestStatusHistory, _a1 error) *MockLoanPackageRequestRepository_GetStatusHistories_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call) RunAndReturn(run func(context.Context, decimal.Decimal) ([]entity.LoanPackageRequest, error)) *MockLoanPackageRequestRepository_LockAllPendingRequestByMaxPercent_Call {
+func (_c *MockLoanPackageRequestRepository_GetStatusHistories_Call) RunAndReturn(run func(context.Context, int64) ([]entity.LoanPackageRequestStatusHistory, error)) *MockLoanPackageRequestRepository_GetStatusHistories_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -21,22 +21,146 @@ func (_m *MockOdooServiceRepository) EXPECT() *MockOdooServiceRepository_Expecte
 	return &MockOdooServiceRepository_Expecter{mock: &_m.Mock}
 }
 
+// GetLoanApprovalDecisions provides a mock function with given fields: recordIds
+func (_m *MockOdooServiceRepository) GetLoanApprovalDecisions(recordIds []int64) ([]entity.OdooLoanApprovalDecision, error) {
+	ret := _m.Called(recordIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanApprovalDecisions")
+	}
+
+	var r0 []entity.OdooLoanApprovalDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int64) ([]entity.OdooLoanApprovalDecision, error)); ok {
+		return rf(recordIds)
+	}
+	if rf, ok := ret.Get(0).(func([]int64) []entity.OdooLoanApprovalDecision); ok {
+		r0 = rf(recordIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.OdooLoanApprovalDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(recordIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOdooServiceRepository_GetLoanApprovalDecisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanApprovalDecisions'
+type MockOdooServiceRepository_GetLoanApprovalDecisions_Call struct {
+	*mock.Call
+}
+
+// GetLoanApprovalDecisions is a helper method to define mock.On call
+//   - recordIds []int64
+func (_e *MockOdooServiceRepository_Expecter) GetLoanApprovalDecisions(recordIds interface{}) *MockOdooServiceRepository_GetLoanApprovalDecisions_Call {
+	return &MockOdooServiceRepository_GetLoanApprovalDecisions_Call{Call: _e.mock.On("GetLoanApprovalDecisions", recordIds)}
+}
+
+func (_c *MockOdooServiceRepository_GetLoanApprovalDecisions_Call) Run(run func(recordIds []int64)) *MockOdooServiceRepository_GetLoanApprovalDecisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockOdooServiceRepository_GetLoanApprovalDecisions_Call) Return(_a0 []entity.OdooLoanApprovalDecision, _a1 error) *MockOdooServiceRepository_GetLoanApprovalDecisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOdooServiceRepository_GetLoanApprovalDecisions_Call) RunAndReturn(run func([]int64) ([]entity.OdooLoanApprovalDecision, error)) *MockOdooServiceRepository_GetLoanApprovalDecisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewLoanApprovalRequest provides a mock function with given fields: loanApprovalRequest
+func (_m *MockOdooServiceRepository) PreviewLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) (entity.OdooPayloadPreview, error) {
+	ret := _m.Called(loanApprovalRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewLoanApprovalRequest")
+	}
+
+	var r0 entity.OdooPayloadPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.LoanApprovalRequest) (entity.OdooPayloadPreview, error)); ok {
+		return rf(loanApprovalRequest)
+	}
+	if rf, ok := ret.Get(0).(func(entity.LoanApprovalRequest) entity.OdooPayloadPreview); ok {
+		r0 = rf(loanApprovalRequest)
+	} else {
+		r0 = ret.Get(0).(entity.OdooPayloadPreview)
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.LoanApprovalRequest) error); ok {
+		r1 = rf(loanApprovalRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOdooServiceRepository_PreviewLoanApprovalRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewLoanApprovalRequest'
+type MockOdooServiceRepository_PreviewLoanApprovalRequest_Call struct {
+	*mock.Call
+}
+
+// PreviewLoanApprovalRequest is a helper method to define mock.On call
+//   - loanApprovalRequest entity.LoanApprovalRequest
+func (_e *MockOdooServiceRepository_Expecter) PreviewLoanApprovalRequest(loanApprovalRequest interface{}) *MockOdooServiceRepository_PreviewLoanApprovalRequest_Call {
+	return &MockOdooServiceRepository_PreviewLoanApprovalRequest_Call{Call: _e.mock.On("PreviewLoanApprovalRequest", loanApprovalRequest)}
+}
+
+func (_c *MockOdooServiceRepository_PreviewLoanApprovalRequest_Call) Run(run func(loanApprovalRequest entity.LoanApprovalRequest)) *MockOdooServiceRepository_PreviewLoanApprovalRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.LoanApprovalRequest))
+	})
+	return _c
+}
+
+func (_c *MockOdooServiceRepository_PreviewLoanApprovalRequest_Call) Return(_a0 entity.OdooPayloadPreview, _a1 error) *MockOdooServiceRepository_PreviewLoanApprovalRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOdooServiceRepository_PreviewLoanApprovalRequest_Call) RunAndReturn(run func(entity.LoanApprovalRequest) (entity.OdooPayloadPreview, error)) *MockOdooServiceRepository_PreviewLoanApprovalRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendLoanApprovalRequest provides a mock function with given fields: loanApprovalRequest
-func (_m *MockOdooServiceRepository) SendLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) error {
+func (_m *MockOdooServiceRepository) SendLoanApprovalRequest(loanApprovalRequest entity.LoanApprovalRequest) (int64, error) {
 	ret := _m.Called(loanApprovalRequest)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendLoanApprovalRequest")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(entity.LoanApprovalRequest) error); ok {
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.LoanApprovalRequest) (int64, error)); ok {
+		return rf(loanApprovalRequest)
+	}
+	if rf, ok := ret.Get(0).(func(entity.LoanApprovalRequest) int64); ok {
 		r0 = rf(loanApprovalRequest)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(entity.LoanApprovalRequest) error); ok {
+		r1 = rf(loanApprovalRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockOdooServiceRepository_SendLoanApprovalRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendLoanApprovalRequest'
@@ -57,12 +181,59 @@ func (_c *MockOdooServiceRepository_SendLoanApprovalRequest_Call) Run(run func(l
 	return _c
 }
 
-func (_c *MockOdooServiceRepository_SendLoanApprovalRequest_Call) Return(_a0 error) *MockOdooServiceRepository_SendLoanApprovalRequest_Call {
+func (_c *MockOdooServiceRepository_SendLoanApprovalRequest_Call) Return(_a0 int64, _a1 error) *MockOdooServiceRepository_SendLoanApprovalRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOdooServiceRepository_SendLoanApprovalRequest_Call) RunAndReturn(run func(entity.LoanApprovalRequest) (int64, error)) *MockOdooServiceRepository_SendLoanApprovalRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLoanApprovalState provides a mock function with given fields: recordId, state
+func (_m *MockOdooServiceRepository) UpdateLoanApprovalState(recordId int64, state entity.OdooLoanApprovalState) error {
+	ret := _m.Called(recordId, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLoanApprovalState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, entity.OdooLoanApprovalState) error); ok {
+		r0 = rf(recordId, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOdooServiceRepository_UpdateLoanApprovalState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLoanApprovalState'
+type MockOdooServiceRepository_UpdateLoanApprovalState_Call struct {
+	*mock.Call
+}
+
+// UpdateLoanApprovalState is a helper method to define mock.On call
+//   - recordId int64
+//   - state entity.OdooLoanApprovalState
+func (_e *MockOdooServiceRepository_Expecter) UpdateLoanApprovalState(recordId interface{}, state interface{}) *MockOdooServiceRepository_UpdateLoanApprovalState_Call {
+	return &MockOdooServiceRepository_UpdateLoanApprovalState_Call{Call: _e.mock.On("UpdateLoanApprovalState", recordId, state)}
+}
+
+func (_c *MockOdooServiceRepository_UpdateLoanApprovalState_Call) Run(run func(recordId int64, state entity.OdooLoanApprovalState)) *MockOdooServiceRepository_UpdateLoanApprovalState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(entity.OdooLoanApprovalState))
+	})
+	return _c
+}
+
+func (_c *MockOdooServiceRepository_UpdateLoanApprovalState_Call) Return(_a0 error) *MockOdooServiceRepository_UpdateLoanApprovalState_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockOdooServiceRepository_SendLoanApprovalRequest_Call) RunAndReturn(run func(entity.LoanApprovalRequest) error) *MockOdooServiceRepository_SendLoanApprovalRequest_Call {
+func (_c *MockOdooServiceRepository_UpdateLoanApprovalState_Call) RunAndReturn(run func(int64, entity.OdooLoanApprovalState) error) *MockOdooServiceRepository_UpdateLoanApprovalState_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,7 +3,10 @@
 package mock
 
 import (
+	context "context"
+
 	kafka "github.com/segmentio/kafka-go"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -20,17 +23,17 @@ func (_m *MockPublisher) EXPECT() *MockPublisher_Expecter {
 	return &MockPublisher_Expecter{mock: &_m.Mock}
 }
 
-// Publish provides a mock function with given fields: message
-func (_m *MockPublisher) Publish(message kafka.Message) error {
-	ret := _m.Called(message)
+// Publish provides a mock function with given fields: ctx, message
+func (_m *MockPublisher) Publish(ctx context.Context, message kafka.Message) error {
+	ret := _m.Called(ctx, message)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Publish")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(kafka.Message) error); ok {
-		r0 = rf(message)
+	if rf, ok := ret.Get(0).(func(context.Context, kafka.Message) error); ok {
+		r0 = rf(ctx, message)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,14 +47,15 @@ type MockPublisher_Publish_Call struct {
 }
 
 // Publish is a helper method to define mock.On call
+//   - ctx context.Context
 //   - message kafka.Message
-func (_e *MockPublisher_Expecter) Publish(message interface{}) *MockPublisher_Publish_Call {
-	return &MockPublisher_Publish_Call{Call: _e.mock.On("Publish", message)}
+func (_e *MockPublisher_Expecter) Publish(ctx interface{}, message interface{}) *MockPublisher_Publish_Call {
+	return &MockPublisher_Publish_Call{Call: _e.mock.On("Publish", ctx, message)}
 }
 
-func (_c *MockPublisher_Publish_Call) Run(run func(message kafka.Message)) *MockPublisher_Publish_Call {
+func (_c *MockPublisher_Publish_Call) Run(run func(ctx context.Context, message kafka.Message)) *MockPublisher_Publish_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(kafka.Message))
+		run(args[0].(context.Context), args[1].(kafka.Message))
 	})
 	return _c
 }
@@ -61,7 +65,7 @@ func (_c *MockPublisher_Publish_Call) Return(_a0 error) *MockPublisher_Publish_C
 	return _c
 }
 
-func (_c *MockPublisher_Publish_Call) RunAndReturn(run func(kafka.Message) error) *MockPublisher_Publish_Call {
+func (_c *MockPublisher_Publish_Call) RunAndReturn(run func(context.Context, kafka.Message) error) *MockPublisher_Publish_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -22,6 +22,63 @@ func (_m *MockSchedulerJobRepository) EXPECT() *MockSchedulerJobRepository_Expec
 	return &MockSchedulerJobRepository_Expecter{mock: &_m.Mock}
 }
 
+// Count provides a mock function with given fields: ctx, filter
+func (_m *MockSchedulerJobRepository) Count(ctx context.Context, filter entity.SchedulerJobFilter) (int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SchedulerJobFilter) (int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SchedulerJobFilter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.SchedulerJobFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSchedulerJobRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockSchedulerJobRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.SchedulerJobFilter
+func (_e *MockSchedulerJobRepository_Expecter) Count(ctx interface{}, filter interface{}) *MockSchedulerJobRepository_Count_Call {
+	return &MockSchedulerJobRepository_Count_Call{Call: _e.mock.On("Count", ctx, filter)}
+}
+
+func (_c *MockSchedulerJobRepository_Count_Call) Run(run func(ctx context.Context, filter entity.SchedulerJobFilter)) *MockSchedulerJobRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.SchedulerJobFilter))
+	})
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_Count_Call) Return(_a0 int64, _a1 error) *MockSchedulerJobRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_Count_Call) RunAndReturn(run func(context.Context, entity.SchedulerJobFilter) (int64, error)) *MockSchedulerJobRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, job
 func (_m *MockSchedulerJobRepository) Create(ctx context.Context, job entity.SchedulerJob) error {
 	ret := _m.Called(ctx, job)
@@ -69,6 +126,230 @@ func (_c *MockSchedulerJobRepository_Create_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// FinishRun provides a mock function with given fields: ctx, id, status, trackingData
+func (_m *MockSchedulerJobRepository) FinishRun(ctx context.Context, id int64, status entity.JobStatus, trackingData string) error {
+	ret := _m.Called(ctx, id, status, trackingData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entity.JobStatus, string) error); ok {
+		r0 = rf(ctx, id, status, trackingData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSchedulerJobRepository_FinishRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishRun'
+type MockSchedulerJobRepository_FinishRun_Call struct {
+	*mock.Call
+}
+
+// FinishRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - status entity.JobStatus
+//   - trackingData string
+func (_e *MockSchedulerJobRepository_Expecter) FinishRun(ctx interface{}, id interface{}, status interface{}, trackingData interface{}) *MockSchedulerJobRepository_FinishRun_Call {
+	return &MockSchedulerJobRepository_FinishRun_Call{Call: _e.mock.On("FinishRun", ctx, id, status, trackingData)}
+}
+
+func (_c *MockSchedulerJobRepository_FinishRun_Call) Run(run func(ctx context.Context, id int64, status entity.JobStatus, trackingData string)) *MockSchedulerJobRepository_FinishRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(entity.JobStatus), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_FinishRun_Call) Return(_a0 error) *MockSchedulerJobRepository_FinishRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_FinishRun_Call) RunAndReturn(run func(context.Context, int64, entity.JobStatus, string) error) *MockSchedulerJobRepository_FinishRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *MockSchedulerJobRepository) GetAll(ctx context.Context, filter entity.SchedulerJobFilter) ([]entity.SchedulerJob, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []entity.SchedulerJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SchedulerJobFilter) ([]entity.SchedulerJob, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SchedulerJobFilter) []entity.SchedulerJob); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.SchedulerJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.SchedulerJobFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSchedulerJobRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockSchedulerJobRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.SchedulerJobFilter
+func (_e *MockSchedulerJobRepository_Expecter) GetAll(ctx interface{}, filter interface{}) *MockSchedulerJobRepository_GetAll_Call {
+	return &MockSchedulerJobRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, filter)}
+}
+
+func (_c *MockSchedulerJobRepository_GetAll_Call) Run(run func(ctx context.Context, filter entity.SchedulerJobFilter)) *MockSchedulerJobRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.SchedulerJobFilter))
+	})
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_GetAll_Call) Return(_a0 []entity.SchedulerJob, _a1 error) *MockSchedulerJobRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_GetAll_Call) RunAndReturn(run func(context.Context, entity.SchedulerJobFilter) ([]entity.SchedulerJob, error)) *MockSchedulerJobRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestByJobTypes provides a mock function with given fields: ctx, jobTypes
+func (_m *MockSchedulerJobRepository) GetLatestByJobTypes(ctx context.Context, jobTypes []entity.JobType) ([]entity.SchedulerJob, error) {
+	ret := _m.Called(ctx, jobTypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestByJobTypes")
+	}
+
+	var r0 []entity.SchedulerJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.JobType) ([]entity.SchedulerJob, error)); ok {
+		return rf(ctx, jobTypes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.JobType) []entity.SchedulerJob); ok {
+		r0 = rf(ctx, jobTypes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.SchedulerJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []entity.JobType) error); ok {
+		r1 = rf(ctx, jobTypes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSchedulerJobRepository_GetLatestByJobTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestByJobTypes'
+type MockSchedulerJobRepository_GetLatestByJobTypes_Call struct {
+	*mock.Call
+}
+
+// GetLatestByJobTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobTypes []entity.JobType
+func (_e *MockSchedulerJobRepository_Expecter) GetLatestByJobTypes(ctx interface{}, jobTypes interface{}) *MockSchedulerJobRepository_GetLatestByJobTypes_Call {
+	return &MockSchedulerJobRepository_GetLatestByJobTypes_Call{Call: _e.mock.On("GetLatestByJobTypes", ctx, jobTypes)}
+}
+
+func (_c *MockSchedulerJobRepository_GetLatestByJobTypes_Call) Run(run func(ctx context.Context, jobTypes []entity.JobType)) *MockSchedulerJobRepository_GetLatestByJobTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.JobType))
+	})
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_GetLatestByJobTypes_Call) Return(_a0 []entity.SchedulerJob, _a1 error) *MockSchedulerJobRepository_GetLatestByJobTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_GetLatestByJobTypes_Call) RunAndReturn(run func(context.Context, []entity.JobType) ([]entity.SchedulerJob, error)) *MockSchedulerJobRepository_GetLatestByJobTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartRun provides a mock function with given fields: ctx, job
+func (_m *MockSchedulerJobRepository) StartRun(ctx context.Context, job entity.SchedulerJob) (entity.SchedulerJob, error) {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartRun")
+	}
+
+	var r0 entity.SchedulerJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SchedulerJob) (entity.SchedulerJob, error)); ok {
+		return rf(ctx, job)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SchedulerJob) entity.SchedulerJob); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Get(0).(entity.SchedulerJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.SchedulerJob) error); ok {
+		r1 = rf(ctx, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSchedulerJobRepository_StartRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartRun'
+type MockSchedulerJobRepository_StartRun_Call struct {
+	*mock.Call
+}
+
+// StartRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job entity.SchedulerJob
+func (_e *MockSchedulerJobRepository_Expecter) StartRun(ctx interface{}, job interface{}) *MockSchedulerJobRepository_StartRun_Call {
+	return &MockSchedulerJobRepository_StartRun_Call{Call: _e.mock.On("StartRun", ctx, job)}
+}
+
+func (_c *MockSchedulerJobRepository_StartRun_Call) Run(run func(ctx context.Context, job entity.SchedulerJob)) *MockSchedulerJobRepository_StartRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.SchedulerJob))
+	})
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_StartRun_Call) Return(_a0 entity.SchedulerJob, _a1 error) *MockSchedulerJobRepository_StartRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSchedulerJobRepository_StartRun_Call) RunAndReturn(run func(context.Context, entity.SchedulerJob) (entity.SchedulerJob, error)) *MockSchedulerJobRepository_StartRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSchedulerJobRepository creates a new instance of MockSchedulerJobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSchedulerJobRepository(t interface {
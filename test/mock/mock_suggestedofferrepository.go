@@ -22,6 +22,63 @@ func (_m *MockSuggestedOfferRepository) EXPECT() *MockSuggestedOfferRepository_E
 	return &MockSuggestedOfferRepository_Expecter{mock: &_m.Mock}
 }
 
+// Count provides a mock function with given fields: ctx, filter
+func (_m *MockSuggestedOfferRepository) Count(ctx context.Context, filter entity.SuggestedOfferFilter) (int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SuggestedOfferFilter) (int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SuggestedOfferFilter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.SuggestedOfferFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSuggestedOfferRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockSuggestedOfferRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.SuggestedOfferFilter
+func (_e *MockSuggestedOfferRepository_Expecter) Count(ctx interface{}, filter interface{}) *MockSuggestedOfferRepository_Count_Call {
+	return &MockSuggestedOfferRepository_Count_Call{Call: _e.mock.On("Count", ctx, filter)}
+}
+
+func (_c *MockSuggestedOfferRepository_Count_Call) Run(run func(ctx context.Context, filter entity.SuggestedOfferFilter)) *MockSuggestedOfferRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.SuggestedOfferFilter))
+	})
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_Count_Call) Return(_a0 int64, _a1 error) *MockSuggestedOfferRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_Count_Call) RunAndReturn(run func(context.Context, entity.SuggestedOfferFilter) (int64, error)) *MockSuggestedOfferRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, suggestedOffer
 func (_m *MockSuggestedOfferRepository) Create(ctx context.Context, suggestedOffer entity.SuggestedOffer) (entity.SuggestedOffer, error) {
 	ret := _m.Called(ctx, suggestedOffer)
@@ -79,6 +136,289 @@ func (_c *MockSuggestedOfferRepository_Create_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CreateConversions provides a mock function with given fields: ctx, conversions
+func (_m *MockSuggestedOfferRepository) CreateConversions(ctx context.Context, conversions []entity.SuggestedOfferConversion) error {
+	ret := _m.Called(ctx, conversions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConversions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.SuggestedOfferConversion) error); ok {
+		r0 = rf(ctx, conversions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSuggestedOfferRepository_CreateConversions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConversions'
+type MockSuggestedOfferRepository_CreateConversions_Call struct {
+	*mock.Call
+}
+
+// CreateConversions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversions []entity.SuggestedOfferConversion
+func (_e *MockSuggestedOfferRepository_Expecter) CreateConversions(ctx interface{}, conversions interface{}) *MockSuggestedOfferRepository_CreateConversions_Call {
+	return &MockSuggestedOfferRepository_CreateConversions_Call{Call: _e.mock.On("CreateConversions", ctx, conversions)}
+}
+
+func (_c *MockSuggestedOfferRepository_CreateConversions_Call) Run(run func(ctx context.Context, conversions []entity.SuggestedOfferConversion)) *MockSuggestedOfferRepository_CreateConversions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.SuggestedOfferConversion))
+	})
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_CreateConversions_Call) Return(_a0 error) *MockSuggestedOfferRepository_CreateConversions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_CreateConversions_Call) RunAndReturn(run func(context.Context, []entity.SuggestedOfferConversion) error) *MockSuggestedOfferRepository_CreateConversions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *MockSuggestedOfferRepository) GetAll(ctx context.Context, filter entity.SuggestedOfferFilter) ([]entity.SuggestedOffer, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []entity.SuggestedOffer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SuggestedOfferFilter) ([]entity.SuggestedOffer, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SuggestedOfferFilter) []entity.SuggestedOffer); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.SuggestedOffer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.SuggestedOfferFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSuggestedOfferRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockSuggestedOfferRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.SuggestedOfferFilter
+func (_e *MockSuggestedOfferRepository_Expecter) GetAll(ctx interface{}, filter interface{}) *MockSuggestedOfferRepository_GetAll_Call {
+	return &MockSuggestedOfferRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, filter)}
+}
+
+func (_c *MockSuggestedOfferRepository_GetAll_Call) Run(run func(ctx context.Context, filter entity.SuggestedOfferFilter)) *MockSuggestedOfferRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.SuggestedOfferFilter))
+	})
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_GetAll_Call) Return(_a0 []entity.SuggestedOffer, _a1 error) *MockSuggestedOfferRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_GetAll_Call) RunAndReturn(run func(context.Context, entity.SuggestedOfferFilter) ([]entity.SuggestedOffer, error)) *MockSuggestedOfferRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *MockSuggestedOfferRepository) GetById(ctx context.Context, id int64) (entity.SuggestedOffer, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetById")
+	}
+
+	var r0 entity.SuggestedOffer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (entity.SuggestedOffer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) entity.SuggestedOffer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.SuggestedOffer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSuggestedOfferRepository_GetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetById'
+type MockSuggestedOfferRepository_GetById_Call struct {
+	*mock.Call
+}
+
+// GetById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockSuggestedOfferRepository_Expecter) GetById(ctx interface{}, id interface{}) *MockSuggestedOfferRepository_GetById_Call {
+	return &MockSuggestedOfferRepository_GetById_Call{Call: _e.mock.On("GetById", ctx, id)}
+}
+
+func (_c *MockSuggestedOfferRepository_GetById_Call) Run(run func(ctx context.Context, id int64)) *MockSuggestedOfferRepository_GetById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_GetById_Call) Return(_a0 entity.SuggestedOffer, _a1 error) *MockSuggestedOfferRepository_GetById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_GetById_Call) RunAndReturn(run func(context.Context, int64) (entity.SuggestedOffer, error)) *MockSuggestedOfferRepository_GetById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConversionMetrics provides a mock function with given fields: ctx, filter
+func (_m *MockSuggestedOfferRepository) GetConversionMetrics(ctx context.Context, filter entity.SuggestedOfferMetricFilter) ([]entity.SuggestedOfferConversionMetric, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConversionMetrics")
+	}
+
+	var r0 []entity.SuggestedOfferConversionMetric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SuggestedOfferMetricFilter) ([]entity.SuggestedOfferConversionMetric, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.SuggestedOfferMetricFilter) []entity.SuggestedOfferConversionMetric); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.SuggestedOfferConversionMetric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.SuggestedOfferMetricFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSuggestedOfferRepository_GetConversionMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversionMetrics'
+type MockSuggestedOfferRepository_GetConversionMetrics_Call struct {
+	*mock.Call
+}
+
+// GetConversionMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.SuggestedOfferMetricFilter
+func (_e *MockSuggestedOfferRepository_Expecter) GetConversionMetrics(ctx interface{}, filter interface{}) *MockSuggestedOfferRepository_GetConversionMetrics_Call {
+	return &MockSuggestedOfferRepository_GetConversionMetrics_Call{Call: _e.mock.On("GetConversionMetrics", ctx, filter)}
+}
+
+func (_c *MockSuggestedOfferRepository_GetConversionMetrics_Call) Run(run func(ctx context.Context, filter entity.SuggestedOfferMetricFilter)) *MockSuggestedOfferRepository_GetConversionMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.SuggestedOfferMetricFilter))
+	})
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_GetConversionMetrics_Call) Return(_a0 []entity.SuggestedOfferConversionMetric, _a1 error) *MockSuggestedOfferRepository_GetConversionMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_GetConversionMetrics_Call) RunAndReturn(run func(context.Context, entity.SuggestedOfferMetricFilter) ([]entity.SuggestedOfferConversionMetric, error)) *MockSuggestedOfferRepository_GetConversionMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, from, to, respondedBy, note
+func (_m *MockSuggestedOfferRepository) UpdateStatus(ctx context.Context, id int64, from entity.SuggestedOfferStatus, to entity.SuggestedOfferStatus, respondedBy string, note string) (entity.SuggestedOffer, error) {
+	ret := _m.Called(ctx, id, from, to, respondedBy, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 entity.SuggestedOffer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entity.SuggestedOfferStatus, entity.SuggestedOfferStatus, string, string) (entity.SuggestedOffer, error)); ok {
+		return rf(ctx, id, from, to, respondedBy, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entity.SuggestedOfferStatus, entity.SuggestedOfferStatus, string, string) entity.SuggestedOffer); ok {
+		r0 = rf(ctx, id, from, to, respondedBy, note)
+	} else {
+		r0 = ret.Get(0).(entity.SuggestedOffer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, entity.SuggestedOfferStatus, entity.SuggestedOfferStatus, string, string) error); ok {
+		r1 = rf(ctx, id, from, to, respondedBy, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSuggestedOfferRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockSuggestedOfferRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - from entity.SuggestedOfferStatus
+//   - to entity.SuggestedOfferStatus
+//   - respondedBy string
+//   - note string
+func (_e *MockSuggestedOfferRepository_Expecter) UpdateStatus(ctx interface{}, id interface{}, from interface{}, to interface{}, respondedBy interface{}, note interface{}) *MockSuggestedOfferRepository_UpdateStatus_Call {
+	return &MockSuggestedOfferRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, from, to, respondedBy, note)}
+}
+
+func (_c *MockSuggestedOfferRepository_UpdateStatus_Call) Run(run func(ctx context.Context, id int64, from entity.SuggestedOfferStatus, to entity.SuggestedOfferStatus, respondedBy string, note string)) *MockSuggestedOfferRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(entity.SuggestedOfferStatus), args[3].(entity.SuggestedOfferStatus), args[4].(string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_UpdateStatus_Call) Return(_a0 entity.SuggestedOffer, _a1 error) *MockSuggestedOfferRepository_UpdateStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSuggestedOfferRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, int64, entity.SuggestedOfferStatus, entity.SuggestedOfferStatus, string, string) (entity.SuggestedOffer, error)) *MockSuggestedOfferRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSuggestedOfferRepository creates a new instance of MockSuggestedOfferRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSuggestedOfferRepository(t interface {
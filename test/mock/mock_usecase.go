@@ -22,22 +22,32 @@ func (_m *MockUseCase) EXPECT() *MockUseCase_Expecter {
 	return &MockUseCase_Expecter{mock: &_m.Mock}
 }
 
-// AdminApproveSubmission provides a mock function with given fields: ctx, submissionId
-func (_m *MockUseCase) AdminApproveSubmission(ctx context.Context, submissionId int64) error {
-	ret := _m.Called(ctx, submissionId)
+// AdminApproveSubmission provides a mock function with given fields: ctx, submissionId, approver, onBehalfOf
+func (_m *MockUseCase) AdminApproveSubmission(ctx context.Context, submissionId int64, approver string, onBehalfOf string) (entity.SubmissionSheetApprovalProgress, error) {
+	ret := _m.Called(ctx, submissionId, approver, onBehalfOf)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AdminApproveSubmission")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, submissionId)
+	var r0 entity.SubmissionSheetApprovalProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (entity.SubmissionSheetApprovalProgress, error)); ok {
+		return rf(ctx, submissionId, approver, onBehalfOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) entity.SubmissionSheetApprovalProgress); ok {
+		r0 = rf(ctx, submissionId, approver, onBehalfOf)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(entity.SubmissionSheetApprovalProgress)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, submissionId, approver, onBehalfOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockUseCase_AdminApproveSubmission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdminApproveSubmission'
@@ -48,23 +58,25 @@ type MockUseCase_AdminApproveSubmission_Call struct {
 // AdminApproveSubmission is a helper method to define mock.On call
 //   - ctx context.Context
 //   - submissionId int64
-func (_e *MockUseCase_Expecter) AdminApproveSubmission(ctx interface{}, submissionId interface{}) *MockUseCase_AdminApproveSubmission_Call {
-	return &MockUseCase_AdminApproveSubmission_Call{Call: _e.mock.On("AdminApproveSubmission", ctx, submissionId)}
+//   - approver string
+//   - onBehalfOf string
+func (_e *MockUseCase_Expecter) AdminApproveSubmission(ctx interface{}, submissionId interface{}, approver interface{}, onBehalfOf interface{}) *MockUseCase_AdminApproveSubmission_Call {
+	return &MockUseCase_AdminApproveSubmission_Call{Call: _e.mock.On("AdminApproveSubmission", ctx, submissionId, approver, onBehalfOf)}
 }
 
-func (_c *MockUseCase_AdminApproveSubmission_Call) Run(run func(ctx context.Context, submissionId int64)) *MockUseCase_AdminApproveSubmission_Call {
+func (_c *MockUseCase_AdminApproveSubmission_Call) Run(run func(ctx context.Context, submissionId int64, approver string, onBehalfOf string)) *MockUseCase_AdminApproveSubmission_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_AdminApproveSubmission_Call) Return(_a0 error) *MockUseCase_AdminApproveSubmission_Call {
-	_c.Call.Return(_a0)
+func (_c *MockUseCase_AdminApproveSubmission_Call) Return(_a0 entity.SubmissionSheetApprovalProgress, _a1 error) *MockUseCase_AdminApproveSubmission_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_AdminApproveSubmission_Call) RunAndReturn(run func(context.Context, int64) error) *MockUseCase_AdminApproveSubmission_Call {
+func (_c *MockUseCase_AdminApproveSubmission_Call) RunAndReturn(run func(context.Context, int64, string, string) (entity.SubmissionSheetApprovalProgress, error)) *MockUseCase_AdminApproveSubmission_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -175,6 +187,120 @@ func (_c *MockUseCase_Create_Call) RunAndReturn(run func(context.Context, entity
 	return _c
 }
 
+// CreateDelegation provides a mock function with given fields: ctx, delegation
+func (_m *MockUseCase) CreateDelegation(ctx context.Context, delegation entity.ApprovalDelegation) (entity.ApprovalDelegation, error) {
+	ret := _m.Called(ctx, delegation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDelegation")
+	}
+
+	var r0 entity.ApprovalDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ApprovalDelegation) (entity.ApprovalDelegation, error)); ok {
+		return rf(ctx, delegation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ApprovalDelegation) entity.ApprovalDelegation); ok {
+		r0 = rf(ctx, delegation)
+	} else {
+		r0 = ret.Get(0).(entity.ApprovalDelegation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.ApprovalDelegation) error); ok {
+		r1 = rf(ctx, delegation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_CreateDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDelegation'
+type MockUseCase_CreateDelegation_Call struct {
+	*mock.Call
+}
+
+// CreateDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegation entity.ApprovalDelegation
+func (_e *MockUseCase_Expecter) CreateDelegation(ctx interface{}, delegation interface{}) *MockUseCase_CreateDelegation_Call {
+	return &MockUseCase_CreateDelegation_Call{Call: _e.mock.On("CreateDelegation", ctx, delegation)}
+}
+
+func (_c *MockUseCase_CreateDelegation_Call) Run(run func(ctx context.Context, delegation entity.ApprovalDelegation)) *MockUseCase_CreateDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ApprovalDelegation))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_CreateDelegation_Call) Return(_a0 entity.ApprovalDelegation, _a1 error) *MockUseCase_CreateDelegation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_CreateDelegation_Call) RunAndReturn(run func(context.Context, entity.ApprovalDelegation) (entity.ApprovalDelegation, error)) *MockUseCase_CreateDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApprovals provides a mock function with given fields: ctx, submissionId
+func (_m *MockUseCase) GetApprovals(ctx context.Context, submissionId int64) (entity.SubmissionSheetApprovalProgress, error) {
+	ret := _m.Called(ctx, submissionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApprovals")
+	}
+
+	var r0 entity.SubmissionSheetApprovalProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (entity.SubmissionSheetApprovalProgress, error)); ok {
+		return rf(ctx, submissionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) entity.SubmissionSheetApprovalProgress); ok {
+		r0 = rf(ctx, submissionId)
+	} else {
+		r0 = ret.Get(0).(entity.SubmissionSheetApprovalProgress)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, submissionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetApprovals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApprovals'
+type MockUseCase_GetApprovals_Call struct {
+	*mock.Call
+}
+
+// GetApprovals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - submissionId int64
+func (_e *MockUseCase_Expecter) GetApprovals(ctx interface{}, submissionId interface{}) *MockUseCase_GetApprovals_Call {
+	return &MockUseCase_GetApprovals_Call{Call: _e.mock.On("GetApprovals", ctx, submissionId)}
+}
+
+func (_c *MockUseCase_GetApprovals_Call) Run(run func(ctx context.Context, submissionId int64)) *MockUseCase_GetApprovals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetApprovals_Call) Return(_a0 entity.SubmissionSheetApprovalProgress, _a1 error) *MockUseCase_GetApprovals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetApprovals_Call) RunAndReturn(run func(context.Context, int64) (entity.SubmissionSheetApprovalProgress, error)) *MockUseCase_GetApprovals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestByRequestId provides a mock function with given fields: ctx, id
 func (_m *MockUseCase) GetLatestByRequestId(ctx context.Context, id int64) (entity.SubmissionSheet, error) {
 	ret := _m.Called(ctx, id)
@@ -232,6 +358,223 @@ func (_c *MockUseCase_GetLatestByRequestId_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// PreviewOdooPayload provides a mock function with given fields: ctx, submissionId
+func (_m *MockUseCase) PreviewOdooPayload(ctx context.Context, submissionId int64) (entity.OdooPayloadPreview, error) {
+	ret := _m.Called(ctx, submissionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewOdooPayload")
+	}
+
+	var r0 entity.OdooPayloadPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (entity.OdooPayloadPreview, error)); ok {
+		return rf(ctx, submissionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) entity.OdooPayloadPreview); ok {
+		r0 = rf(ctx, submissionId)
+	} else {
+		r0 = ret.Get(0).(entity.OdooPayloadPreview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, submissionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_PreviewOdooPayload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewOdooPayload'
+type MockUseCase_PreviewOdooPayload_Call struct {
+	*mock.Call
+}
+
+// PreviewOdooPayload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - submissionId int64
+func (_e *MockUseCase_Expecter) PreviewOdooPayload(ctx interface{}, submissionId interface{}) *MockUseCase_PreviewOdooPayload_Call {
+	return &MockUseCase_PreviewOdooPayload_Call{Call: _e.mock.On("PreviewOdooPayload", ctx, submissionId)}
+}
+
+func (_c *MockUseCase_PreviewOdooPayload_Call) Run(run func(ctx context.Context, submissionId int64)) *MockUseCase_PreviewOdooPayload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_PreviewOdooPayload_Call) Return(_a0 entity.OdooPayloadPreview, _a1 error) *MockUseCase_PreviewOdooPayload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_PreviewOdooPayload_Call) RunAndReturn(run func(context.Context, int64) (entity.OdooPayloadPreview, error)) *MockUseCase_PreviewOdooPayload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportOdooDecisions provides a mock function with given fields: ctx
+func (_m *MockUseCase) ReportOdooDecisions(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportOdooDecisions")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_ReportOdooDecisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportOdooDecisions'
+type MockUseCase_ReportOdooDecisions_Call struct {
+	*mock.Call
+}
+
+// ReportOdooDecisions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUseCase_Expecter) ReportOdooDecisions(ctx interface{}) *MockUseCase_ReportOdooDecisions_Call {
+	return &MockUseCase_ReportOdooDecisions_Call{Call: _e.mock.On("ReportOdooDecisions", ctx)}
+}
+
+func (_c *MockUseCase_ReportOdooDecisions_Call) Run(run func(ctx context.Context)) *MockUseCase_ReportOdooDecisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_ReportOdooDecisions_Call) Return(_a0 int, _a1 error) *MockUseCase_ReportOdooDecisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_ReportOdooDecisions_Call) RunAndReturn(run func(context.Context) (int, error)) *MockUseCase_ReportOdooDecisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeDelegation provides a mock function with given fields: ctx, id, delegator
+func (_m *MockUseCase) RevokeDelegation(ctx context.Context, id int64, delegator string) error {
+	ret := _m.Called(ctx, id, delegator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeDelegation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, delegator)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_RevokeDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeDelegation'
+type MockUseCase_RevokeDelegation_Call struct {
+	*mock.Call
+}
+
+// RevokeDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - delegator string
+func (_e *MockUseCase_Expecter) RevokeDelegation(ctx interface{}, id interface{}, delegator interface{}) *MockUseCase_RevokeDelegation_Call {
+	return &MockUseCase_RevokeDelegation_Call{Call: _e.mock.On("RevokeDelegation", ctx, id, delegator)}
+}
+
+func (_c *MockUseCase_RevokeDelegation_Call) Run(run func(ctx context.Context, id int64, delegator string)) *MockUseCase_RevokeDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_RevokeDelegation_Call) Return(_a0 error) *MockUseCase_RevokeDelegation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_RevokeDelegation_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockUseCase_RevokeDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncOdooDecisions provides a mock function with given fields: ctx
+func (_m *MockUseCase) SyncOdooDecisions(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncOdooDecisions")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_SyncOdooDecisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncOdooDecisions'
+type MockUseCase_SyncOdooDecisions_Call struct {
+	*mock.Call
+}
+
+// SyncOdooDecisions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUseCase_Expecter) SyncOdooDecisions(ctx interface{}) *MockUseCase_SyncOdooDecisions_Call {
+	return &MockUseCase_SyncOdooDecisions_Call{Call: _e.mock.On("SyncOdooDecisions", ctx)}
+}
+
+func (_c *MockUseCase_SyncOdooDecisions_Call) Run(run func(ctx context.Context)) *MockUseCase_SyncOdooDecisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_SyncOdooDecisions_Call) Return(_a0 int, _a1 error) *MockUseCase_SyncOdooDecisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_SyncOdooDecisions_Call) RunAndReturn(run func(context.Context) (int, error)) *MockUseCase_SyncOdooDecisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, submissionSheetRequest, loanRate, loanPolicyTemplates
 func (_m *MockUseCase) Update(ctx context.Context, submissionSheetRequest entity.SubmissionSheetShorten, loanRate entity.LoanRate, loanPolicyTemplates []entity.AggregateLoanPolicyTemplate) (entity.SubmissionSheet, error) {
 	ret := _m.Called(ctx, submissionSheetRequest, loanRate, loanPolicyTemplates)
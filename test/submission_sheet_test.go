@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+	"financing-offer/internal/config"
 	configRepo "financing-offer/internal/config/repository"
 	loanPackageRequestRepo "financing-offer/internal/core/loanpackagerequest/repository"
 	odooServiceRepo "financing-offer/internal/core/odoo_service/repository"
@@ -17,15 +19,19 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 
+	"financing-offer/internal/appcontext"
 	"financing-offer/internal/core/entity"
 	financialProductRepo "financing-offer/internal/core/financialproduct/repository"
 	financingApiRepository "financing-offer/internal/core/financing/repository"
 	marginOperationRepo "financing-offer/internal/core/marginoperation/repository"
+	"financing-offer/internal/core/submissionsheet"
 	submissionSheetRepo "financing-offer/internal/core/submissionsheet/repository"
 	"financing-offer/internal/core/submissionsheet/transport/http"
 	"financing-offer/internal/database/dbmodels/finoffer/public/model"
+	"financing-offer/internal/jwttoken"
 	"financing-offer/pkg/dbtest"
 	"financing-offer/pkg/gintest"
+	odooService "financing-offer/pkg/infra/odoo_service"
 	"financing-offer/test/mock"
 	"financing-offer/test/testhelper"
 )
@@ -475,18 +481,86 @@ func TestLoanPackageRequestHandler_AdminApproveSubmissionSheet(t *testing.T) {
 				Key:   "id",
 				Value: strconv.FormatInt(submissionSheetMetadata2.ID, 10),
 			}}
+			ginCtx.Set(
+				appcontext.UserInformation, &jwttoken.AdminClaims{
+					Sub: "dnse.admin@dnse.com.vn",
+				},
+			)
 			handler.AdminApproveSubmissionSheet(ginCtx)
 			result := recorder.Result()
 			defer assert.Nil(t, result.Body.Close())
 			body := gintest.ExtractBody(result.Body)
-			assert.Equal(t, "success", testhelper.GetString(body, "data"))
+			assert.Equal(t, "APPROVED", testhelper.GetString(body, "data", "status"))
+			assert.Equal(t, "dnse.admin@dnse.com.vn", testhelper.GetString(body, "data", "approvals", "[0]", "approver"))
 
 			var submissionMetadata model.SubmissionSheetMetadata
 			err := table.SubmissionSheetMetadata.SELECT(table.SubmissionSheetMetadata.AllColumns).WHERE(table.SubmissionSheetMetadata.ID.EQ(postgres.Int64(submissionSheetMetadata2.ID))).Query(db, &submissionMetadata)
 			assert.Nil(t, err)
 			assert.Equal(t, "APPROVED", submissionMetadata.Status)
+
+			// the decision is written to Odoo by the sync job once the approval commits
+			var odooApproval model.OdooLoanApproval
+			err = table.OdooLoanApproval.SELECT(table.OdooLoanApproval.AllColumns).WHERE(table.OdooLoanApproval.SubmissionSheetID.EQ(postgres.Int64(submissionSheetMetadata2.ID))).Query(db, &odooApproval)
+			assert.Nil(t, err)
+			assert.Equal(t, string(entity.OdooLoanApprovalSyncStatusReporting), odooApproval.SyncStatus)
+			assert.Equal(t, string(entity.OdooLoanApprovalStateApproved), odooApproval.OdooState)
 		})
 
+	t.Run(
+		"creator cannot approve own submission", func(t *testing.T) {
+			defer truncateData()
+			ginCtx, _, recorder := gintest.GetTestContext()
+			se := mock.SeedStockExchange(t, db, model.StockExchange{Code: "HOSE", MinScore: 0, MaxScore: 100})
+			symbol := mock.SeedSymbol(t, db, model.Symbol{StockExchangeID: se.ID, Symbol: "BID", AssetType: "UNDERLYING"})
+			request := mock.SeedLoanPackageRequest(
+				t, db, model.LoanPackageRequest{
+					SymbolID:   symbol.ID,
+					InvestorID: "0001000115",
+					AccountNo:  "0001000115",
+					LoanRate:   decimal.NewFromFloat(0.7),
+					Type:       "FLEXIBLE",
+					Status:     "PENDING",
+					AssetType:  "UNDERLYING",
+				},
+			)
+			submissionSheetMetadata := mock.SeedSubmissionSheetMetadata(
+				t, db, model.SubmissionSheetMetadata{
+					ID:                   1,
+					LoanPackageRequestID: request.ID,
+					Creator:              "dnse.admin@dnse.com.vn",
+					Status:               "SUBMITTED",
+					ActionType:           "REJECT_AND_SEND_OTHER_PROPOSAL",
+					FlowType:             "002",
+					ProposeType:          "NEW_LOAN_PACKAGE",
+				},
+			)
+			mock.SeedSubmissionSheetDetail(
+				t, db, model.SubmissionSheetDetail{
+					ID:                1,
+					SubmissionSheetID: submissionSheetMetadata.ID,
+					LoanRate:          "{\"id\": 7071, \"initialRate\": 0.3}",
+					LoanPolicies:      "[]",
+				},
+			)
+
+			ginCtx.Request = gintest.MustMakeRequest("POST", "/:id/approve", nil)
+			ginCtx.Params = []gin.Param{{
+				Key:   "id",
+				Value: strconv.FormatInt(submissionSheetMetadata.ID, 10),
+			}}
+			ginCtx.Set(
+				appcontext.UserInformation, &jwttoken.AdminClaims{
+					Sub: "dnse.admin@dnse.com.vn",
+				},
+			)
+			handler.AdminApproveSubmissionSheet(ginCtx)
+			result := recorder.Result()
+			defer assert.Nil(t, result.Body.Close())
+
+			assert.Equal(t, gohttp.StatusForbidden, result.StatusCode)
+		},
+	)
+
 	t.Run(
 		"admin approve submission error", func(t *testing.T) {
 			defer truncateData()
@@ -630,3 +704,78 @@ func TestLoanPackageRequestHandler_AdminRejectSubmissionSheet(t *testing.T) {
 		},
 	)
 }
+
+func TestSubmissionSheetUseCase_SyncOdooDecisions(t *testing.T) {
+	t.Parallel()
+
+	db, tearDownDb, truncateData := dbtest.NewDb(t)
+	defer tearDownDb()
+	injector := testhelper.NewInjector(testhelper.WithDb(db))
+	appConfig := do.MustInvoke[config.AppConfig](injector)
+	odooClient, err := odooService.NewInMemoryClient(appConfig.OdooService.ForEnv(appConfig.Env))
+	assert.Nil(t, err)
+	do.OverrideValue[odooServiceRepo.OdooServiceRepository](injector, odooClient)
+	useCase := do.MustInvoke[submissionsheet.UseCase](injector)
+	odooLoanApprovalRepository := do.MustInvoke[odooServiceRepo.OdooLoanApprovalRepository](injector)
+
+	t.Run(
+		"refusal in odoo rejects the submission", func(t *testing.T) {
+			defer truncateData()
+			se := mock.SeedStockExchange(t, db, model.StockExchange{Code: "HOSE", MinScore: 0, MaxScore: 100})
+			symbol := mock.SeedSymbol(t, db, model.Symbol{StockExchangeID: se.ID, Symbol: "BID", AssetType: "UNDERLYING"})
+			request := mock.SeedLoanPackageRequest(
+				t, db, model.LoanPackageRequest{
+					SymbolID:   symbol.ID,
+					InvestorID: "0001000115",
+					AccountNo:  "0001000115",
+					LoanRate:   decimal.NewFromFloat(0.7),
+					Type:       "FLEXIBLE",
+					Status:     "PENDING",
+					AssetType:  "UNDERLYING",
+				},
+			)
+			submissionSheetMetadata := mock.SeedSubmissionSheetMetadata(
+				t, db, model.SubmissionSheetMetadata{
+					ID:                   1,
+					LoanPackageRequestID: request.ID,
+					Creator:              "dnse.admin@dnse.com.vn",
+					Status:               "SUBMITTED",
+					ActionType:           "APPROVE",
+					FlowType:             "002",
+					ProposeType:          "NEW_LOAN_PACKAGE",
+				},
+			)
+			recordId, err := odooClient.SendLoanApprovalRequest(
+				entity.LoanApprovalRequest{LoanRequestId: request.ID, SubmissionId: submissionSheetMetadata.ID},
+			)
+			assert.Nil(t, err)
+			_, err = odooLoanApprovalRepository.Upsert(
+				context.Background(), entity.OdooLoanApproval{
+					SubmissionSheetId: submissionSheetMetadata.ID,
+					OdooRecordId:      recordId,
+					SyncStatus:        entity.OdooLoanApprovalSyncStatusPending,
+					NextAttemptAt:     time.Now().Add(-time.Minute),
+				},
+			)
+			assert.Nil(t, err)
+
+			applied, err := useCase.SyncOdooDecisions(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 0, applied)
+
+			assert.Nil(t, odooClient.Decide(recordId, entity.OdooLoanApprovalStateRefused, "Risk Officer"))
+			applied, err = useCase.SyncOdooDecisions(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 1, applied)
+
+			var submissionMetadata model.SubmissionSheetMetadata
+			err = table.SubmissionSheetMetadata.SELECT(table.SubmissionSheetMetadata.AllColumns).WHERE(table.SubmissionSheetMetadata.ID.EQ(postgres.Int64(submissionSheetMetadata.ID))).Query(db, &submissionMetadata)
+			assert.Nil(t, err)
+			assert.Equal(t, "REJECTED", submissionMetadata.Status)
+			odooApproval, err := odooLoanApprovalRepository.GetBySubmissionId(context.Background(), submissionSheetMetadata.ID)
+			assert.Nil(t, err)
+			assert.Equal(t, entity.OdooLoanApprovalSyncStatusSynced, odooApproval.SyncStatus)
+			assert.Equal(t, entity.OdooLoanApprovalStateRefused, odooApproval.OdooState)
+		},
+	)
+}
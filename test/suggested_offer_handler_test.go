@@ -36,7 +36,7 @@ func TestSuggestedOfferHandler_CreateOffer(t *testing.T) {
 	injector := testhelper.NewInjector(testhelper.WithDb(db))
 	tasks, _ := shutdown.NewShutdownTasks(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
 	kafkaPublisher := mock.NewMockPublisher(t)
-	kafkaPublisher.EXPECT().Publish(testifyMock.Anything).Return(nil)
+	kafkaPublisher.EXPECT().Publish(testifyMock.Anything, testifyMock.Anything).Return(nil)
 	orderServiceRepo := mock.NewMockOrderServiceRepository(t)
 	orderServiceRepo.EXPECT().GetAccountByAccountNoAndCustodyCode(testifyMock.Anything, testifyMock.Anything, testifyMock.Anything).Return(entity.OrderServiceAccount{}, nil)
 	do.OverrideValue[event.Publisher](injector, kafkaPublisher)
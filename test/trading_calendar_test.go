@@ -0,0 +1,117 @@
+package test
+
+import (
+	http2 "net/http"
+	"testing"
+
+	"github.com/samber/do"
+	"github.com/stretchr/testify/assert"
+
+	"financing-offer/internal/core/entity"
+	"financing-offer/internal/core/tradingcalendar/transport/http"
+	"financing-offer/pkg/dbtest"
+	"financing-offer/pkg/gintest"
+	"financing-offer/test/testhelper"
+)
+
+func TestTradingCalendarHandler(t *testing.T) {
+	t.Parallel()
+
+	db, tearDownDb, truncateData := dbtest.NewDb(t)
+	defer tearDownDb()
+	injector := testhelper.NewInjector(testhelper.WithDb(db))
+	h := do.MustInvoke[*http.TradingCalendarHandler](injector)
+
+	createDay := func(t *testing.T, req http.TradingCalendarDayRequest) (int, []byte) {
+		ginCtx, _, recorder := gintest.GetTestContext()
+		ginCtx.Request = gintest.MustMakeRequest("POST", "/api/v1/trading-calendar/days", req)
+		h.CreateDay(ginCtx)
+		result := recorder.Result()
+		defer assert.Nil(t, result.Body.Close())
+		return result.StatusCode, gintest.ExtractBody(result.Body)
+	}
+
+	t.Run(
+		"create half day and get its session", func(t *testing.T) {
+			defer truncateData()
+			status, body := createDay(
+				t, http.TradingCalendarDayRequest{
+					StockExchangeCode: "HOSE",
+					Date:              "2026-04-29",
+					Type:              entity.TradingCalendarDayTypeHalfDay,
+				},
+			)
+			assert.Equal(t, http2.StatusCreated, status)
+			assert.Equal(t, entity.TradingCalendarDaySourceManual.String(), testhelper.GetString(body, "data", "source"))
+
+			ginCtx, _, recorder := gintest.GetTestContext()
+			ginCtx.Request = gintest.MustMakeRequest(
+				"GET", "/api/v1/trading-calendar/session?stockExchangeCode=HOSE&date=2026-04-29", nil,
+			)
+			h.GetSession(ginCtx)
+			result := recorder.Result()
+			defer assert.Nil(t, result.Body.Close())
+			body = gintest.ExtractBody(result.Body)
+			assert.Equal(t, http2.StatusOK, result.StatusCode)
+			assert.True(t, testhelper.GetBoolean(body, "data", "halfDay"))
+			assert.Equal(t, "2026-04-29T11:30:00+07:00", testhelper.GetString(body, "data", "closeAt"))
+		},
+	)
+
+	t.Run(
+		"add trading days over a holiday of all exchanges", func(t *testing.T) {
+			defer truncateData()
+			status, _ := createDay(
+				t, http.TradingCalendarDayRequest{
+					StockExchangeCode: entity.TradingCalendarAllExchanges,
+					Date:              "2026-04-30",
+					Type:              entity.TradingCalendarDayTypeHoliday,
+				},
+			)
+			assert.Equal(t, http2.StatusCreated, status)
+
+			ginCtx, _, recorder := gintest.GetTestContext()
+			ginCtx.Request = gintest.MustMakeRequest(
+				"GET",
+				"/api/v1/trading-calendar/add-trading-days?stockExchangeCode=HNX&from=2026-04-29T10:00:00%2B07:00&days=1",
+				nil,
+			)
+			h.AddTradingDays(ginCtx)
+			result := recorder.Result()
+			defer assert.Nil(t, result.Body.Close())
+			body := gintest.ExtractBody(result.Body)
+			assert.Equal(t, http2.StatusOK, result.StatusCode)
+			assert.Equal(t, "2026-05-01T10:00:00+07:00", testhelper.GetString(body, "data", "result"))
+		},
+	)
+
+	t.Run(
+		"reject duplicated day", func(t *testing.T) {
+			defer truncateData()
+			req := http.TradingCalendarDayRequest{
+				StockExchangeCode: "HOSE",
+				Date:              "2026-09-02",
+				Type:              entity.TradingCalendarDayTypeHoliday,
+			}
+			status, _ := createDay(t, req)
+			assert.Equal(t, http2.StatusCreated, status)
+			status, _ = createDay(t, req)
+			assert.Equal(t, http2.StatusConflict, status)
+		},
+	)
+
+	t.Run(
+		"reject close time of a holiday", func(t *testing.T) {
+			defer truncateData()
+			status, _ := createDay(
+				t, http.TradingCalendarDayRequest{
+					StockExchangeCode: "HOSE",
+					Date:              "2026-09-02",
+					Type:              entity.TradingCalendarDayTypeHoliday,
+					CloseTime:         "11:30",
+				},
+			)
+			assert.Equal(t, http2.StatusBadRequest, status)
+		},
+	)
+}